@@ -22,6 +22,7 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	moduletypes "github.com/regen-network/regen-ledger/types/module"
 	"github.com/regen-network/regen-ledger/types/module/server"
+	datatypes "github.com/regen-network/regen-ledger/x/data"
 	data "github.com/regen-network/regen-ledger/x/data/module"
 	ecocredittypes "github.com/regen-network/regen-ledger/x/ecocredit"
 	group "github.com/regen-network/regen-ledger/x/group/module"
@@ -54,8 +55,9 @@ func setCustomModules(app *RegenApp, interfaceRegistry types.InterfaceRegistry)
 	// BEGIN HACK: this is a total, ugly hack until x/auth & x/bank supports ADR 033 or we have a suitable alternative
 	groupModule := group.Module{AccountKeeper: app.AccountKeeper, BankKeeper: app.BankKeeper}
 	// use a separate newModules from the global NewModules here because we need to pass state into the group module
+	dataModule := data.NewModule(app.GetSubspace(datatypes.DefaultParamspace), app.AccountKeeper, app.BankKeeper)
 	newModules := []moduletypes.Module{
-		data.Module{},
+		dataModule,
 		groupModule,
 	}
 	err := newModuleManager.RegisterModules(newModules)
@@ -92,7 +94,17 @@ func (app *RegenApp) registerUpgradeHandlers() {
 			"transfer":     1,
 		}
 
-		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		vm, err := app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		// the server modules are new in this upgrade, so there is nothing to migrate, but
+		// their versions need to be recorded for the migrations of later upgrades
+		for name, version := range app.smm.GetVersionMap() {
+			vm[name] = version
+		}
+		return vm, nil
 	})
 
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
@@ -126,7 +138,10 @@ func (app *RegenApp) setCustomSimulationManager() []module.AppModuleSimulation {
 			BankKeeper:    app.BankKeeper,
 			AccountKeeper: app.AccountKeeper,
 		},
+		data.NewModule(app.GetSubspace(datatypes.DefaultParamspace), app.AccountKeeper, app.BankKeeper),
 	}
 }
 
-func initCustomParamsKeeper(paramsKeeper *paramskeeper.Keeper) {}
+func initCustomParamsKeeper(paramsKeeper *paramskeeper.Keeper) {
+	paramsKeeper.Subspace(datatypes.DefaultParamspace)
+}
@@ -81,7 +81,17 @@ func (app *RegenApp) registerUpgradeHandlers() {
 		modules[ecocredittypes.ModuleName] = app.cdc.MustMarshalJSON(gen)
 		app.smm.InitGenesis(ctx, modules, []abci.ValidatorUpdate{})
 
-		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		vm, err := app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		// the server modules are new in this upgrade, so there is nothing to migrate, but
+		// their versions need to be recorded for the migrations of later upgrades
+		for name, version := range app.smm.GetVersionMap() {
+			vm[name] = version
+		}
+		return vm, nil
 	})
 
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	data "github.com/regen-network/regen-ledger/x/data/client/testsuite"
 	group "github.com/regen-network/regen-ledger/x/group/client/testsuite"
 )
 
@@ -15,3 +16,9 @@ func TestGroupIntegration(t *testing.T) {
 
 	suite.Run(t, group.NewIntegrationTestSuite(cfg))
 }
+
+func TestDataIntegration(t *testing.T) {
+	cfg := DefaultConfig()
+
+	suite.Run(t, data.NewIntegrationTestSuite(cfg))
+}
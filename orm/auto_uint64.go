@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
 )
 
 var _ Indexable = &AutoUInt64TableBuilder{}
@@ -11,10 +12,10 @@ var _ Indexable = &AutoUInt64TableBuilder{}
 // NewAutoUInt64TableBuilder creates a builder to setup a AutoUInt64Table object.
 func NewAutoUInt64TableBuilder(prefixData byte, prefixSeq byte, storeKey sdk.StoreKey, model codec.ProtoMarshaler, cdc codec.Codec) (*AutoUInt64TableBuilder, error) {
 	if prefixData == prefixSeq {
-		return nil, ErrUniqueConstraint.Wrap("prefixData and prefixSeq must be unique")
+		return nil, errors.Wrap(ErrUniqueConstraint, "prefixData and prefixSeq must be unique")
 	}
 
-	uInt64KeyCodec := FixLengthIndexKeys(EncodedSeqLength)
+	uInt64KeyCodec := UInt64IndexKeys()
 	tableBuilder, err := newTableBuilder(prefixData, storeKey, model, uInt64KeyCodec, cdc)
 	if err != nil {
 		return nil, err
@@ -153,6 +154,15 @@ func (a AutoUInt64Table) Export(ctx HasKVStore, dest ModelSlicePtr) (uint64, err
 	return a.seq.CurVal(ctx), nil
 }
 
+// ExportStream calls fn for every value in the table in ascending RowID order and returns the
+// current value of the associated sequence. See PrimaryKeyTable.ExportStream.
+func (a AutoUInt64Table) ExportStream(ctx HasKVStore, fn func(rowID RowID, obj proto.Message) error) (uint64, error) {
+	if err := a.table.ExportStream(ctx, fn); err != nil {
+		return 0, err
+	}
+	return a.seq.CurVal(ctx), nil
+}
+
 // Import clears the table and initializes it from the given data interface{}.
 // data should be a slice of structs that implement PrimaryKeyed.
 func (a AutoUInt64Table) Import(ctx HasKVStore, data interface{}, seqValue uint64) error {
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/regen-network/regen-ledger/orm"
 	"github.com/regen-network/regen-ledger/orm/testdata"
 	"github.com/stretchr/testify/require"
@@ -54,3 +55,87 @@ func TestImportExportTableData(t *testing.T) {
 		require.Equal(t, g, groups[i])
 	}
 }
+
+func TestExportStreamAutoUInt64Table(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	storeKey := sdk.NewKVStoreKey("test")
+	const prefix = iota
+	builder, err := orm.NewAutoUInt64TableBuilder(prefix, 0x1, storeKey, &testdata.GroupInfo{}, cdc)
+	require.NoError(t, err)
+	table := builder.Build()
+
+	ctx := orm.NewMockContext()
+
+	groups := []*testdata.GroupInfo{
+		{GroupId: 1, Admin: sdk.AccAddress([]byte("admin1-address"))},
+		{GroupId: 3, Admin: sdk.AccAddress([]byte("admin2-address"))},
+	}
+	require.NoError(t, table.Import(ctx, groups, 5))
+
+	var exported []*testdata.GroupInfo
+	seq, err := table.ExportStream(ctx, func(rowID orm.RowID, obj proto.Message) error {
+		exported = append(exported, obj.(*testdata.GroupInfo))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), seq)
+	require.Equal(t, groups, exported)
+
+	// an error from the callback aborts the iteration
+	_, err = table.ExportStream(ctx, func(rowID orm.RowID, obj proto.Message) error {
+		return orm.ErrArgument
+	})
+	require.True(t, orm.ErrArgument.Is(err))
+}
+
+func TestExportStreamPrimaryKeyTable(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	storeKey := sdk.NewKVStoreKey("test")
+	const prefix = iota
+	builder, err := orm.NewPrimaryKeyTableBuilder(prefix, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+	table := builder.Build()
+
+	ctx := orm.NewMockContext()
+
+	members := []*testdata.GroupMember{
+		{Group: []byte("group-a"), Member: []byte("member-one"), Weight: 1},
+		{Group: []byte("group-a"), Member: []byte("member-two"), Weight: 2},
+		{Group: []byte("group-b"), Member: []byte("member-one"), Weight: 3},
+	}
+	// create in reverse order to ensure the export is sorted by key
+	for i := len(members) - 1; i >= 0; i-- {
+		require.NoError(t, table.Create(ctx, members[i]))
+	}
+
+	var (
+		exported []*testdata.GroupMember
+		rowIDs   []orm.RowID
+	)
+	err = table.ExportStream(ctx, func(rowID orm.RowID, obj proto.Message) error {
+		rowIDs = append(rowIDs, rowID)
+		exported = append(exported, obj.(*testdata.GroupMember))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, members, exported)
+	for i, m := range members {
+		require.Equal(t, orm.RowID(orm.PrimaryKey(m)), rowIDs[i])
+	}
+
+	// an error from the callback aborts the iteration
+	var calls int
+	err = table.ExportStream(ctx, func(rowID orm.RowID, obj proto.Message) error {
+		calls++
+		if calls == 2 {
+			return orm.ErrArgument
+		}
+		return nil
+	})
+	require.True(t, orm.ErrArgument.Is(err))
+	require.Equal(t, 2, calls)
+}
@@ -2,10 +2,14 @@ package orm
 
 import (
 	"bytes"
+	"fmt"
+
+	metrics "github.com/armon/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -40,15 +44,15 @@ func NewIndex(builder Indexable, prefix byte, indexerF IndexerFunc) (MultiKeyInd
 func newIndex(builder Indexable, prefix byte, indexer *Indexer) (MultiKeyIndex, error) {
 	codec := builder.IndexKeyCodec()
 	if codec == nil {
-		return MultiKeyIndex{}, ErrArgument.Wrap("IndexKeyCodec must not be nil")
+		return MultiKeyIndex{}, errors.Wrap(ErrArgument, "IndexKeyCodec must not be nil")
 	}
 	storeKey := builder.StoreKey()
 	if storeKey == nil {
-		return MultiKeyIndex{}, ErrArgument.Wrap("StoreKey must not be nil")
+		return MultiKeyIndex{}, errors.Wrap(ErrArgument, "StoreKey must not be nil")
 	}
 	rowGetter := builder.RowGetter()
 	if rowGetter == nil {
-		return MultiKeyIndex{}, ErrArgument.Wrap("RowGetter must not be nil")
+		return MultiKeyIndex{}, errors.Wrap(ErrArgument, "RowGetter must not be nil")
 	}
 
 	idx := MultiKeyIndex{
@@ -63,12 +67,16 @@ func newIndex(builder Indexable, prefix byte, indexer *Indexer) (MultiKeyIndex,
 	return idx, nil
 }
 
-// Has checks if a key exists. Panics on nil key.
-func (i MultiKeyIndex) Has(ctx HasKVStore, key []byte) bool {
+// Has checks if at least one row is indexed under the key. Only the index
+// entries are looked at, the rows are not loaded. Returns an error on nil key.
+func (i MultiKeyIndex) Has(ctx HasKVStore, key []byte) (bool, error) {
+	if key == nil {
+		return false, errors.Wrap(ErrArgument, "key must not be nil")
+	}
 	store := prefix.NewStore(ctx.KVStore(i.storeKey), []byte{i.prefix})
 	it := store.Iterator(PrefixRange(key))
 	defer it.Close()
-	return it.Valid()
+	return it.Valid(), nil
 }
 
 // Get returns a result iterator for the searchKey. Parameters must not be nil.
@@ -86,12 +94,53 @@ func (i MultiKeyIndex) GetPaginated(ctx HasKVStore, searchKey []byte, pageReques
 	start, end := PrefixRange(searchKey)
 
 	if pageRequest != nil && len(pageRequest.Key) != 0 {
+		if err := validateIndexKey(i.indexKeyCodec, searchKey, RowID(pageRequest.Key)); err != nil {
+			return NewInvalidIterator(), errors.Wrap(err, "page request key")
+		}
 		start = i.indexKeyCodec.BuildIndexKey(searchKey, RowID(pageRequest.Key))
 	}
 	it := store.Iterator(start, end)
 	return indexIterator{ctx: ctx, it: it, rowGetter: i.rowGetter, keyCodec: i.indexKeyCodec}, nil
 }
 
+// GetPaginatedReverse returns an Iterator over a single page of the objects for the searchKey in
+// descending RowID order, together with the PageResponse to use for loading the next page.
+// The pageRequest.Key is the rowID to start from (inclusive) while searchKey is a MultiKeyIndex key.
+// Key, offset, limit and count-total of the page request are respected the same way as in Paginate.
+func (i MultiKeyIndex) GetPaginatedReverse(ctx HasKVStore, searchKey []byte, pageRequest *query.PageRequest) (Iterator, *query.PageResponse, error) {
+	pageRequest, err := normalizePageRequest(pageRequest)
+	if err != nil {
+		return NewInvalidIterator(), nil, err
+	}
+
+	start, end := PrefixRange(searchKey)
+	if len(pageRequest.Key) != 0 {
+		if err := validateIndexKey(i.indexKeyCodec, searchKey, RowID(pageRequest.Key)); err != nil {
+			return NewInvalidIterator(), nil, errors.Wrap(err, "page request key")
+		}
+		// the smallest key after the index key of the given rowID, so that it is included
+		end = append(i.indexKeyCodec.BuildIndexKey(searchKey, RowID(pageRequest.Key)), 0)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(i.storeKey), []byte{i.prefix})
+	it := store.ReverseIterator(start, end)
+	defer it.Close()
+	indexKeys, _, res := loadPage(it, pageRequest)
+	if res.NextKey != nil {
+		res.NextKey = i.indexKeyCodec.StripRowID(res.NextKey)
+	}
+
+	var pos int
+	return IteratorFunc(func(dest codec.ProtoMarshaler) (RowID, error) {
+		if pos >= len(indexKeys) {
+			return nil, ErrIteratorDone
+		}
+		rowID := i.indexKeyCodec.StripRowID(indexKeys[pos])
+		pos++
+		return rowID, i.rowGetter(ctx, rowID, dest)
+	}), res, nil
+}
+
 // PrefixScan returns an Iterator over a domain of keys in ascending order. End is exclusive.
 // Start is an MultiKeyIndex key or prefix. It must be less than end, or the Iterator is invalid and error is returned.
 // Iterator must be closed by caller.
@@ -117,6 +166,24 @@ func (i MultiKeyIndex) PrefixScan(ctx HasKVStore, start []byte, end []byte) (Ite
 	return indexIterator{ctx: ctx, it: it, rowGetter: i.rowGetter, keyCodec: i.indexKeyCodec}, nil
 }
 
+// PartialKeyPrefixScan returns an Iterator in ascending order over all entries where the MultiKeyIndex key
+// starts with the given partial key. This is meant to be used with keys that are built by BuildCompositeKey,
+// so that scanning by the first components of a composite key returns all the rows sharing them.
+// Iterator must be closed by caller.
+//
+// WARNING: The use of a PartialKeyPrefixScan can be very expensive in terms of Gas. Please make sure you do not expose
+// this as an endpoint to the public without further limits. See `LimitIterator`
+//
+// CONTRACT: No writes may happen within a domain while an iterator exists over it.
+func (i MultiKeyIndex) PartialKeyPrefixScan(ctx HasKVStore, partialKey []byte) (Iterator, error) {
+	if len(partialKey) == 0 {
+		return NewInvalidIterator(), errors.Wrap(ErrArgument, "partial key must not be empty")
+	}
+	store := prefix.NewStore(ctx.KVStore(i.storeKey), []byte{i.prefix})
+	it := store.Iterator(PrefixRange(partialKey))
+	return indexIterator{ctx: ctx, it: it, rowGetter: i.rowGetter, keyCodec: i.indexKeyCodec}, nil
+}
+
 // ReversePrefixScan returns an Iterator over a domain of keys in descending order. End is exclusive.
 // Start is an MultiKeyIndex key or prefix. It must be less than end, or the Iterator is invalid  and error is returned.
 // Iterator must be closed by caller.
@@ -135,19 +202,72 @@ func (i MultiKeyIndex) ReversePrefixScan(ctx HasKVStore, start []byte, end []byt
 	return indexIterator{ctx: ctx, it: it, rowGetter: i.rowGetter, keyCodec: i.indexKeyCodec}, nil
 }
 
+// KeysForRowID returns the searchable keys of all index entries that currently point to the given RowID,
+// in ascending order. This is meant for diagnosing an index that is out of sync with its table.
+//
+// WARNING: KeysForRowID scans the whole index and can be very expensive in terms of Gas. Please make sure
+// you do not expose this as an endpoint to the public.
+func (i MultiKeyIndex) KeysForRowID(ctx HasKVStore, rowID RowID) ([][]byte, error) {
+	if len(rowID) == 0 {
+		return nil, errors.Wrap(ErrArgument, "rowID must not be empty")
+	}
+	// the codecs encode the RowID as a suffix that doesn't depend on the searchable key
+	suffix := i.indexKeyCodec.BuildIndexKey(nil, rowID)
+
+	store := prefix.NewStore(ctx.KVStore(i.storeKey), []byte{i.prefix})
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		indexKey := it.Key()
+		if !bytes.HasSuffix(indexKey, suffix) {
+			continue
+		}
+		key := make([]byte, len(indexKey)-len(suffix))
+		copy(key, indexKey)
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
 func (i MultiKeyIndex) onSet(ctx HasKVStore, rowID RowID, newValue, oldValue codec.ProtoMarshaler) error {
 	store := prefix.NewStore(ctx.KVStore(i.storeKey), []byte{i.prefix})
 	if oldValue == nil {
-		return i.indexer.OnCreate(store, rowID, newValue)
+		if err := i.indexer.OnCreate(store, rowID, newValue); err != nil {
+			return err
+		}
+		i.incrCounter("create")
+		return nil
+	}
+	if err := i.indexer.OnUpdate(store, rowID, newValue, oldValue); err != nil {
+		return err
 	}
-	return i.indexer.OnUpdate(store, rowID, newValue, oldValue)
+	i.incrCounter("update")
+	return nil
 }
 
 func (i MultiKeyIndex) onDelete(ctx HasKVStore, rowID RowID, oldValue codec.ProtoMarshaler) error {
 	store := prefix.NewStore(ctx.KVStore(i.storeKey), []byte{i.prefix})
-	return i.indexer.OnDelete(store, rowID, oldValue)
+	if err := i.indexer.OnDelete(store, rowID, oldValue); err != nil {
+		return err
+	}
+	i.incrCounter("delete")
+	return nil
+}
+
+// incrCounter emits a telemetry counter for the given index maintenance operation labeled
+// by the hex encoded index prefix. It is a no-op when telemetry is disabled.
+func (i MultiKeyIndex) incrCounter(op string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"orm", "index", op},
+		1,
+		[]metrics.Label{telemetry.NewLabel("index", fmt.Sprintf("%02X", i.prefix))},
+	)
 }
 
+// UniqueIndex is an index where at most one entry can point to an underlying object. Creating or updating
+// an object that would map to an already indexed key fails with ErrUniqueConstraint.
 type UniqueIndex struct {
 	MultiKeyIndex
 }
@@ -167,6 +287,20 @@ func NewUniqueIndex(builder Indexable, prefix byte, uniqueIndexerFunc UniqueInde
 	}, nil
 }
 
+// GetOne returns the RowID of the single object that is indexed by the given key or ErrNotFound when there is none.
+func (i UniqueIndex) GetOne(ctx HasKVStore, key []byte) (RowID, error) {
+	if len(key) == 0 {
+		return nil, errors.Wrap(ErrArgument, "key must not be empty")
+	}
+	store := prefix.NewStore(ctx.KVStore(i.storeKey), []byte{i.prefix})
+	it := store.Iterator(PrefixRange(key))
+	defer it.Close()
+	if !it.Valid() {
+		return nil, ErrNotFound
+	}
+	return i.indexKeyCodec.StripRowID(it.Key()), nil
+}
+
 // indexIterator uses rowGetter to lazy load new model values on request.
 type indexIterator struct {
 	ctx       HasKVStore
@@ -1,5 +1,26 @@
 package orm
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// indexKeyValidator is implemented by IndexKeyCodecs which can't encode all index keys, so that
+// indexes can fail with an error when persisting an entry instead of panicking in BuildIndexKey.
+type indexKeyValidator interface {
+	validateIndexKey(searchableKey []byte, rowID RowID) error
+}
+
+// validateIndexKey returns an error if the codec can't encode the searchable key and RowID.
+func validateIndexKey(codec IndexKeyCodec, searchableKey []byte, rowID RowID) error {
+	if v, ok := codec.(indexKeyValidator); ok {
+		return v.validateIndexKey(searchableKey, rowID)
+	}
+	return nil
+}
+
 // Max255DynamicLengthIndexKeyCodec works with up to 255 byte dynamic size RowIDs.
 // They are encoded as `concat(searchableKey, rowID, len(rowID)[0])` and can be used
 // with PrimaryKey or external Key tables for example.
@@ -24,6 +45,12 @@ func (Max255DynamicLengthIndexKeyCodec) BuildIndexKey(searchableKey []byte, rowI
 	return res
 }
 
+// validateIndexKey requires a non empty RowID of up to 255 bytes. The searchableKey is not length
+// prefixed by this codec and therefore not limited in size.
+func (Max255DynamicLengthIndexKeyCodec) validateIndexKey(_ []byte, rowID RowID) error {
+	return validateDynamicRowIDLength(rowID, math.MaxUint8)
+}
+
 // StripRowID returns the RowID from the combined persistentIndexKey. It is the reverse operation to BuildIndexKey
 // but with the searchableKey and length int dropped.
 func (Max255DynamicLengthIndexKeyCodec) StripRowID(persistentIndexKey []byte) RowID {
@@ -32,6 +59,54 @@ func (Max255DynamicLengthIndexKeyCodec) StripRowID(persistentIndexKey []byte) Ro
 	return persistentIndexKey[n-int(searchableKeyLen)-1 : n-1]
 }
 
+// Max65535DynamicLengthIndexKeyCodec works with up to 65535 byte dynamic size RowIDs.
+// They are encoded as `concat(searchableKey, rowID, len(rowID)[0:2])` with the length as
+// 2 byte big endian and can be used with PrimaryKey tables that have LongBytes key parts.
+type Max65535DynamicLengthIndexKeyCodec struct{}
+
+// BuildIndexKey builds the index key by appending searchableKey with rowID and length int.
+// The RowID length must not be greater than 65535.
+func (Max65535DynamicLengthIndexKeyCodec) BuildIndexKey(searchableKey []byte, rowID RowID) []byte {
+	rowIDLen := len(rowID)
+	switch {
+	case rowIDLen == 0:
+		panic("Empty RowID")
+	case rowIDLen > math.MaxUint16:
+		panic("RowID exceeds max size")
+	}
+
+	searchableKeyLen := len(searchableKey)
+	res := make([]byte, searchableKeyLen+rowIDLen+2)
+	copy(res, searchableKey)
+	copy(res[searchableKeyLen:], rowID)
+	binary.BigEndian.PutUint16(res[searchableKeyLen+rowIDLen:], uint16(rowIDLen))
+	return res
+}
+
+// validateIndexKey requires a non empty RowID of up to 65535 bytes.
+func (Max65535DynamicLengthIndexKeyCodec) validateIndexKey(_ []byte, rowID RowID) error {
+	return validateDynamicRowIDLength(rowID, math.MaxUint16)
+}
+
+// StripRowID returns the RowID from the combined persistentIndexKey. It is the reverse operation to BuildIndexKey
+// but with the searchableKey and length int dropped.
+func (Max65535DynamicLengthIndexKeyCodec) StripRowID(persistentIndexKey []byte) RowID {
+	n := len(persistentIndexKey)
+	rowIDLen := int(binary.BigEndian.Uint16(persistentIndexKey[n-2:]))
+	return persistentIndexKey[n-rowIDLen-2 : n-2]
+}
+
+// validateDynamicRowIDLength returns an error if the RowID is empty or exceeds maxLen bytes.
+func validateDynamicRowIDLength(rowID RowID, maxLen int) error {
+	switch n := len(rowID); {
+	case n == 0:
+		return errors.Wrap(ErrArgument, "empty RowID")
+	case n > maxLen:
+		return errors.Wrapf(ErrArgument, "RowID length %d exceeds max size %d", n, maxLen)
+	}
+	return nil
+}
+
 // FixLengthIndexKeyCodec expects the RowID to always have the same length with all entries.
 // They are encoded as `concat(searchableKey, rowID)` and can be used
 // with AutoUint64Tables and length EncodedSeqLength for example.
@@ -44,6 +119,23 @@ func FixLengthIndexKeys(rowIDLength int) *FixLengthIndexKeyCodec {
 	return &FixLengthIndexKeyCodec{rowIDLength: rowIDLength}
 }
 
+// UInt64IndexKeys is a constructor for a FixLengthIndexKeyCodec with 8 byte RowIDs, as used by
+// AutoUInt64Tables.
+func UInt64IndexKeys() *FixLengthIndexKeyCodec {
+	return FixLengthIndexKeys(EncodedSeqLength)
+}
+
+// UUIDIndexKeys is a constructor for a FixLengthIndexKeyCodec with 16 byte RowIDs, like UUIDs.
+func UUIDIndexKeys() *FixLengthIndexKeyCodec {
+	return FixLengthIndexKeys(16)
+}
+
+// Hash256IndexKeys is a constructor for a FixLengthIndexKeyCodec with 32 byte RowIDs, like
+// SHA-256 hashes.
+func Hash256IndexKeys() *FixLengthIndexKeyCodec {
+	return FixLengthIndexKeys(32)
+}
+
 // BuildIndexKey builds the index key by appending searchableKey with rowID.
 // The RowID length must not be greater than what is defined by rowIDLength in construction.
 func (c FixLengthIndexKeyCodec) BuildIndexKey(searchableKey []byte, rowID RowID) []byte {
@@ -60,6 +152,16 @@ func (c FixLengthIndexKeyCodec) BuildIndexKey(searchableKey []byte, rowID RowID)
 	return res
 }
 
+// validateIndexKey requires the RowID to have exactly the length defined by rowIDLength in
+// construction. Shorter RowIDs would otherwise be padded by BuildIndexKey and not be restored
+// by StripRowID.
+func (c FixLengthIndexKeyCodec) validateIndexKey(_ []byte, rowID RowID) error {
+	if n := len(rowID); n != c.rowIDLength {
+		return errors.Wrapf(ErrArgument, "RowID length %d does not match the expected length %d", n, c.rowIDLength)
+	}
+	return nil
+}
+
 // StripRowID returns the RowID from the combined persistentIndexKey. It is the reverse operation to BuildIndexKey
 // but with the searchableKey dropped.
 func (c FixLengthIndexKeyCodec) StripRowID(persistentIndexKey []byte) RowID {
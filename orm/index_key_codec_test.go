@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -46,6 +47,30 @@ func TestEncodeIndexKey(t *testing.T) {
 			enc:      Max255DynamicLengthIndexKeyCodec{},
 			expPanic: true,
 		},
+		"uint16 dynamic length example": {
+			srcKey:   []byte{0x0, 0x1},
+			srcRowID: []byte{0x2, 0x3, 0x4},
+			enc:      Max65535DynamicLengthIndexKeyCodec{},
+			expKey:   []byte{0x0, 0x1, 0x2, 0x3, 0x4, 0x0, 0x3},
+		},
+		"uint16 dynamic length large row ID": {
+			srcKey:   []byte{0x0, 0x1},
+			srcRowID: []byte(strings.Repeat("a", 300)),
+			enc:      Max65535DynamicLengthIndexKeyCodec{},
+			expKey:   append(append([]byte{0x0, 0x1}, []byte(strings.Repeat("a", 300))...), 0x1, 0x2c),
+		},
+		"uint16 dynamic length panics with empty rowID": {
+			srcKey:   []byte{0x0, 0x1},
+			srcRowID: []byte{},
+			enc:      Max65535DynamicLengthIndexKeyCodec{},
+			expPanic: true,
+		},
+		"uint16 dynamic length exceeds max row ID": {
+			srcKey:   []byte{0x0, 0x1},
+			srcRowID: []byte(strings.Repeat("a", 65536)),
+			enc:      Max65535DynamicLengthIndexKeyCodec{},
+			expPanic: true,
+		},
 		"uint64 example": {
 			srcKey:   []byte{0x0, 0x1, 0x2},
 			srcRowID: []byte{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8},
@@ -64,6 +89,12 @@ func TestEncodeIndexKey(t *testing.T) {
 			enc:      FixLengthIndexKeys(8),
 			expPanic: true,
 		},
+		"uuid example": {
+			srcKey:   []byte{0x0, 0x1},
+			srcRowID: []byte("0123456789abcdef"),
+			enc:      UUIDIndexKeys(),
+			expKey:   append([]byte{0x0, 0x1}, []byte("0123456789abcdef")...),
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -100,6 +131,16 @@ func TestDecodeIndexKey(t *testing.T) {
 			enc:      Max255DynamicLengthIndexKeyCodec{},
 			expRowID: []byte(strings.Repeat("a", 255)),
 		},
+		"uint16 dynamic length example": {
+			srcKey:   []byte{0x0, 0x1, 0x2, 0x3, 0x4, 0x0, 0x3},
+			enc:      Max65535DynamicLengthIndexKeyCodec{},
+			expRowID: []byte{0x2, 0x3, 0x4},
+		},
+		"uint16 dynamic length large row ID": {
+			srcKey:   append(append([]byte{0x0, 0x1}, []byte(strings.Repeat("a", 300))...), 0x1, 0x2c),
+			enc:      Max65535DynamicLengthIndexKeyCodec{},
+			expRowID: []byte(strings.Repeat("a", 300)),
+		},
 		"uint64 example": {
 			srcKey:   []byte{0x0, 0x1, 0x2, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8},
 			expRowID: []byte{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8},
@@ -113,3 +154,84 @@ func TestDecodeIndexKey(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateIndexKey(t *testing.T) {
+	specs := map[string]struct {
+		srcKey   []byte
+		srcRowID []byte
+		enc      IndexKeyCodec
+		expErr   *errors.Error
+	}{
+		"uint64 matching length": {
+			srcRowID: EncodeSequence(1),
+			enc:      UInt64IndexKeys(),
+		},
+		"uint64 shorter rowID": {
+			srcRowID: []byte{0x1},
+			enc:      UInt64IndexKeys(),
+			expErr:   ErrArgument,
+		},
+		"uuid matching length": {
+			srcRowID: []byte("0123456789abcdef"),
+			enc:      UUIDIndexKeys(),
+		},
+		"uuid with uint64 rowID": {
+			srcRowID: EncodeSequence(1),
+			enc:      UUIDIndexKeys(),
+			expErr:   ErrArgument,
+		},
+		"uuid exceeds length": {
+			srcRowID: []byte("0123456789abcdef0"),
+			enc:      UUIDIndexKeys(),
+			expErr:   ErrArgument,
+		},
+		"uuid empty rowID": {
+			srcRowID: []byte{},
+			enc:      UUIDIndexKeys(),
+			expErr:   ErrArgument,
+		},
+		"uint8 dynamic length with 300 byte searchable key": {
+			srcKey:   []byte(strings.Repeat("a", 300)),
+			srcRowID: []byte{0x1},
+			enc:      Max255DynamicLengthIndexKeyCodec{},
+		},
+		"uint8 dynamic length max rowID": {
+			srcRowID: []byte(strings.Repeat("a", 255)),
+			enc:      Max255DynamicLengthIndexKeyCodec{},
+		},
+		"uint8 dynamic length exceeds max rowID": {
+			srcRowID: []byte(strings.Repeat("a", 256)),
+			enc:      Max255DynamicLengthIndexKeyCodec{},
+			expErr:   ErrArgument,
+		},
+		"uint8 dynamic length empty rowID": {
+			srcRowID: []byte{},
+			enc:      Max255DynamicLengthIndexKeyCodec{},
+			expErr:   ErrArgument,
+		},
+		"uint16 dynamic length exceeds max rowID": {
+			srcRowID: []byte(strings.Repeat("a", 65536)),
+			enc:      Max65535DynamicLengthIndexKeyCodec{},
+			expErr:   ErrArgument,
+		},
+		"uint16 dynamic length empty rowID": {
+			srcRowID: []byte{},
+			enc:      Max65535DynamicLengthIndexKeyCodec{},
+			expErr:   ErrArgument,
+		},
+		"hash256 matching length": {
+			srcRowID: make([]byte, 32),
+			enc:      Hash256IndexKeys(),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			srcKey := spec.srcKey
+			if srcKey == nil {
+				srcKey = []byte{0x0, 0x1}
+			}
+			err := validateIndexKey(spec.enc, srcKey, spec.srcRowID)
+			require.True(t, spec.expErr.Is(err), err)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package orm_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -306,6 +307,84 @@ func TestIndexPrefixScan(t *testing.T) {
 	}
 }
 
+func TestIndexPartialKeyPrefixScan(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	storeKey := sdk.NewKVStoreKey("test")
+
+	tableBuilder, err := orm.NewPrimaryKeyTableBuilder(GroupMemberTablePrefix, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+	// composite index on (weight, member)
+	idx, err := orm.NewIndex(tableBuilder, 0x10, func(val interface{}) ([]orm.RowID, error) {
+		m := val.(*testdata.GroupMember)
+		return []orm.RowID{orm.BuildCompositeKey(m.Weight, []byte(m.Member))}, nil
+	})
+	require.NoError(t, err)
+	myTable := tableBuilder.Build()
+
+	ctx := orm.NewMockContext()
+
+	m1 := testdata.GroupMember{
+		Group:  sdk.AccAddress(orm.EncodeSequence(1)),
+		Member: sdk.AccAddress([]byte("member-one")),
+		Weight: 1,
+	}
+	m2 := testdata.GroupMember{
+		Group:  sdk.AccAddress(orm.EncodeSequence(2)),
+		Member: sdk.AccAddress([]byte("member-two")),
+		Weight: 1,
+	}
+	m3 := testdata.GroupMember{
+		Group:  sdk.AccAddress(orm.EncodeSequence(1)),
+		Member: sdk.AccAddress([]byte("member-two")),
+		Weight: 2,
+	}
+	for _, m := range []testdata.GroupMember{m1, m2, m3} {
+		require.NoError(t, myTable.Create(ctx, &m))
+	}
+
+	specs := map[string]struct {
+		partialKey []byte
+		expResult  []testdata.GroupMember
+		expError   *errors.Error
+	}{
+		"first component": {
+			partialKey: orm.BuildCompositeKey(uint64(1)),
+			expResult:  []testdata.GroupMember{m1, m2},
+		},
+		"other first component": {
+			partialKey: orm.BuildCompositeKey(uint64(2)),
+			expResult:  []testdata.GroupMember{m3},
+		},
+		"full key": {
+			partialKey: orm.BuildCompositeKey(uint64(1), []byte("member-two")),
+			expResult:  []testdata.GroupMember{m2},
+		},
+		"no match": {
+			partialKey: orm.BuildCompositeKey(uint64(3)),
+			expResult:  []testdata.GroupMember{},
+		},
+		"empty key": {
+			partialKey: []byte{},
+			expError:   orm.ErrArgument,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			it, err := idx.PartialKeyPrefixScan(ctx, spec.partialKey)
+			require.True(t, spec.expError.Is(err), "expected #+v but got #+v", spec.expError, err)
+			if spec.expError != nil {
+				return
+			}
+			var loaded []testdata.GroupMember
+			_, err = orm.ReadAll(it, &loaded)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expResult, loaded)
+		})
+	}
+}
+
 func TestUniqueIndex(t *testing.T) {
 	interfaceRegistry := types.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(interfaceRegistry)
@@ -333,7 +412,9 @@ func TestUniqueIndex(t *testing.T) {
 	indexedKey := []byte{byte('m')}
 
 	// Has
-	assert.True(t, uniqueIdx.Has(ctx, indexedKey))
+	exists, err := uniqueIdx.Has(ctx, indexedKey)
+	require.NoError(t, err)
+	assert.True(t, exists)
 
 	// Get
 	it, err := uniqueIdx.Get(ctx, indexedKey)
@@ -415,7 +496,132 @@ func TestUniqueIndex(t *testing.T) {
 	require.NoError(t, err)
 
 	// then no persistent element
-	assert.False(t, uniqueIdx.Has(ctx, indexedKey))
+	exists, err = uniqueIdx.Has(ctx, indexedKey)
+	require.NoError(t, err)
+	assert.False(t, exists)
+	_, err = uniqueIdx.GetOne(ctx, indexedKey)
+	require.True(t, orm.ErrNotFound.Is(err))
+}
+
+func TestUniqueIndexGetOne(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	storeKey := sdk.NewKVStoreKey("test")
+
+	tableBuilder, err := orm.NewPrimaryKeyTableBuilder(GroupMemberTablePrefix, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+	uniqueIdx, err := orm.NewUniqueIndex(tableBuilder, 0x10, func(val interface{}) (orm.RowID, error) {
+		return []byte{val.(*testdata.GroupMember).Member[0]}, nil
+	})
+	require.NoError(t, err)
+	myTable := tableBuilder.Build()
+
+	ctx := orm.NewMockContext()
+
+	m1 := testdata.GroupMember{
+		Group:  sdk.AccAddress(orm.EncodeSequence(1)),
+		Member: sdk.AccAddress([]byte("member-address")),
+		Weight: 10,
+	}
+	m2 := testdata.GroupMember{
+		Group:  sdk.AccAddress(orm.EncodeSequence(1)),
+		Member: sdk.AccAddress([]byte("other-address")),
+		Weight: 10,
+	}
+	require.NoError(t, myTable.Create(ctx, &m1))
+	require.NoError(t, myTable.Create(ctx, &m2))
+
+	specs := map[string]struct {
+		key      []byte
+		expRowID orm.RowID
+		expErr   *errors.Error
+	}{
+		"first": {
+			key:      []byte{byte('m')},
+			expRowID: orm.PrimaryKey(&m1),
+		},
+		"second": {
+			key:      []byte{byte('o')},
+			expRowID: orm.PrimaryKey(&m2),
+		},
+		"not found": {
+			key:    []byte{byte('n')},
+			expErr: orm.ErrNotFound,
+		},
+		"empty key": {
+			key:    []byte{},
+			expErr: orm.ErrArgument,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			rowID, err := uniqueIdx.GetOne(ctx, spec.key)
+			require.True(t, spec.expErr.Is(err), err)
+			assert.Equal(t, spec.expRowID, rowID)
+		})
+	}
+}
+
+func TestUniqueIndexConstraint(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	storeKey := sdk.NewKVStoreKey("test")
+
+	tableBuilder, err := orm.NewPrimaryKeyTableBuilder(GroupMemberTablePrefix, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+	// index by weight so that an update of a mutable field can cause a collision
+	uniqueIdx, err := orm.NewUniqueIndex(tableBuilder, 0x10, func(val interface{}) (orm.RowID, error) {
+		return orm.EncodeSequence(val.(*testdata.GroupMember).Weight), nil
+	})
+	require.NoError(t, err)
+	myTable := tableBuilder.Build()
+
+	ctx := orm.NewMockContext()
+
+	m1 := testdata.GroupMember{
+		Group:  sdk.AccAddress(orm.EncodeSequence(1)),
+		Member: sdk.AccAddress([]byte("member-one")),
+		Weight: 1,
+	}
+	m2 := testdata.GroupMember{
+		Group:  sdk.AccAddress(orm.EncodeSequence(1)),
+		Member: sdk.AccAddress([]byte("member-two")),
+		Weight: 2,
+	}
+	require.NoError(t, myTable.Create(ctx, &m1))
+	require.NoError(t, myTable.Create(ctx, &m2))
+
+	// create with an already indexed key fails
+	m3 := testdata.GroupMember{
+		Group:  sdk.AccAddress(orm.EncodeSequence(1)),
+		Member: sdk.AccAddress([]byte("member-three")),
+		Weight: 1,
+	}
+	err = myTable.Create(ctx, &m3)
+	require.True(t, orm.ErrUniqueConstraint.Is(err), err)
+
+	// update without changing the indexed key succeeds
+	require.NoError(t, myTable.Update(ctx, &m2))
+
+	// update to an unused key succeeds and moves the index entry
+	m2.Weight = 3
+	require.NoError(t, myTable.Update(ctx, &m2))
+	exists, err := uniqueIdx.Has(ctx, orm.EncodeSequence(2))
+	require.NoError(t, err)
+	assert.False(t, exists)
+	rowID, err := uniqueIdx.GetOne(ctx, orm.EncodeSequence(3))
+	require.NoError(t, err)
+	assert.Equal(t, orm.RowID(orm.PrimaryKey(&m2)), rowID)
+
+	// update causing a collision fails
+	m2.Weight = 1
+	err = myTable.Update(ctx, &m2)
+	require.True(t, orm.ErrUniqueConstraint.Is(err), err)
+	rowID, err = uniqueIdx.GetOne(ctx, orm.EncodeSequence(1))
+	require.NoError(t, err)
+	assert.Equal(t, orm.RowID(orm.PrimaryKey(&m1)), rowID)
 }
 
 func TestPrefixRange(t *testing.T) {
@@ -448,3 +654,254 @@ func TestPrefixRange(t *testing.T) {
 		})
 	}
 }
+
+func TestIndexKeysForRowID(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	storeKey := sdk.NewKVStoreKey("test")
+	const (
+		testTablePrefix = iota
+		testTableSeqPrefix
+	)
+	tBuilder, err := orm.NewAutoUInt64TableBuilder(testTablePrefix, testTableSeqPrefix, storeKey, &testdata.GroupInfo{}, cdc)
+	require.NoError(t, err)
+	indexerFunc := func(val interface{}) ([]orm.RowID, error) {
+		g := val.(*testdata.GroupInfo)
+		return []orm.RowID{[]byte(g.Admin), []byte(g.Description)}, nil
+	}
+	idx, err := orm.NewIndex(tBuilder, GroupByAdminIndexPrefix, indexerFunc)
+	require.NoError(t, err)
+	tb := tBuilder.Build()
+	ctx := orm.NewMockContext()
+
+	groups := []*testdata.GroupInfo{
+		{Description: "my test 1", Admin: sdk.AccAddress([]byte("admin-address-a"))},
+		{Description: "my test 2", Admin: sdk.AccAddress([]byte("admin-address-b"))},
+		{Description: "my test 3", Admin: sdk.AccAddress([]byte("admin-address-b"))},
+	}
+	rowIDs := make([]orm.RowID, len(groups))
+	for i, g := range groups {
+		id, err := tb.Create(ctx, g)
+		require.NoError(t, err)
+		rowIDs[i] = orm.EncodeSequence(id)
+	}
+
+	assertKeys := func(t *testing.T, rowID orm.RowID, g *testdata.GroupInfo) {
+		expKeys, err := indexerFunc(g)
+		require.NoError(t, err)
+		keys, err := idx.KeysForRowID(ctx, rowID)
+		require.NoError(t, err)
+		require.Len(t, keys, len(expKeys))
+		for _, k := range expKeys {
+			assert.Contains(t, keys, []byte(k))
+		}
+	}
+
+	t.Run("created rows", func(t *testing.T) {
+		for i, g := range groups {
+			assertKeys(t, rowIDs[i], g)
+		}
+	})
+	t.Run("updated row", func(t *testing.T) {
+		updated := *groups[0]
+		updated.Admin = sdk.AccAddress([]byte("admin-address-c"))
+		require.NoError(t, tb.Update(ctx, 1, &updated))
+		assertKeys(t, rowIDs[0], &updated)
+	})
+	t.Run("deleted row", func(t *testing.T) {
+		require.NoError(t, tb.Delete(ctx, 3))
+		keys, err := idx.KeysForRowID(ctx, rowIDs[2])
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+	t.Run("empty rowID", func(t *testing.T) {
+		_, err := idx.KeysForRowID(ctx, nil)
+		require.True(t, orm.ErrArgument.Is(err))
+	})
+}
+
+func TestIndexHas(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	storeKey := sdk.NewKVStoreKey("test")
+	const (
+		testTablePrefix = iota
+		testTableSeqPrefix
+	)
+	tBuilder, err := orm.NewAutoUInt64TableBuilder(testTablePrefix, testTableSeqPrefix, storeKey, &testdata.GroupInfo{}, cdc)
+	require.NoError(t, err)
+	idx, err := orm.NewIndex(tBuilder, GroupByAdminIndexPrefix, func(val interface{}) ([]orm.RowID, error) {
+		return []orm.RowID{[]byte(val.(*testdata.GroupInfo).Admin)}, nil
+	})
+	require.NoError(t, err)
+	tb := tBuilder.Build()
+	ctx := orm.NewMockContext()
+
+	adminA := sdk.AccAddress([]byte("admin-address-a"))
+	adminB := sdk.AccAddress([]byte("admin-address-b"))
+	_, err = tb.Create(ctx, &testdata.GroupInfo{Description: "my test 1", Admin: adminA})
+	require.NoError(t, err)
+	id, err := tb.Create(ctx, &testdata.GroupInfo{Description: "my test 2", Admin: adminB})
+	require.NoError(t, err)
+	_, err = tb.Create(ctx, &testdata.GroupInfo{Description: "my test 3", Admin: adminB})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		key    []byte
+		expHas bool
+	}{
+		"present key": {
+			key:    adminA,
+			expHas: true,
+		},
+		"present key with multiple rows": {
+			key:    adminB,
+			expHas: true,
+		},
+		"absent key": {
+			key:    sdk.AccAddress([]byte("admin-address-c")),
+			expHas: false,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			has, err := idx.Has(ctx, spec.key)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expHas, has)
+		})
+	}
+
+	t.Run("key of a deleted row", func(t *testing.T) {
+		require.NoError(t, tb.Delete(ctx, id))
+		has, err := idx.Has(ctx, adminB)
+		require.NoError(t, err)
+		assert.True(t, has, "one row left")
+
+		require.NoError(t, tb.Delete(ctx, id+1))
+		has, err = idx.Has(ctx, adminB)
+		require.NoError(t, err)
+		assert.False(t, has)
+	})
+	t.Run("nil key", func(t *testing.T) {
+		_, err := idx.Has(ctx, nil)
+		require.True(t, orm.ErrArgument.Is(err))
+	})
+}
+
+func TestIndexRejectsEmptyKey(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	storeKey := sdk.NewKVStoreKey("test")
+	const (
+		testTablePrefix = iota
+		testTableSeqPrefix
+	)
+	tBuilder, err := orm.NewAutoUInt64TableBuilder(testTablePrefix, testTableSeqPrefix, storeKey, &testdata.GroupInfo{}, cdc)
+	require.NoError(t, err)
+	idx, err := orm.NewIndex(tBuilder, GroupByAdminIndexPrefix, func(val interface{}) ([]orm.RowID, error) {
+		return []orm.RowID{[]byte(val.(*testdata.GroupInfo).Admin)}, nil
+	})
+	require.NoError(t, err)
+	tb := tBuilder.Build()
+	ctx := orm.NewMockContext()
+
+	// an empty admin must not be indexed under the empty key
+	_, err = tb.Create(ctx, &testdata.GroupInfo{Description: "no admin"})
+	require.True(t, orm.ErrArgument.Is(err), err)
+	exists, err := idx.Has(ctx, []byte{})
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	id, err := tb.Create(ctx, &testdata.GroupInfo{Description: "my test", Admin: sdk.AccAddress([]byte("admin-address"))})
+	require.NoError(t, err)
+	err = tb.Update(ctx, id, &testdata.GroupInfo{Description: "my test"})
+	require.True(t, orm.ErrArgument.Is(err), err)
+}
+
+func TestIndexPaginationRejectsInvalidKeys(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	storeKey := sdk.NewKVStoreKey("test")
+	const (
+		testTablePrefix = iota
+		testTableSeqPrefix
+	)
+	tBuilder, err := orm.NewAutoUInt64TableBuilder(testTablePrefix, testTableSeqPrefix, storeKey, &testdata.GroupInfo{}, cdc)
+	require.NoError(t, err)
+	idx, err := orm.NewIndex(tBuilder, GroupByAdminIndexPrefix, func(val interface{}) ([]orm.RowID, error) {
+		return []orm.RowID{[]byte(val.(*testdata.GroupInfo).Admin)}, nil
+	})
+	require.NoError(t, err)
+	tb := tBuilder.Build()
+	ctx := orm.NewMockContext()
+
+	admin := sdk.AccAddress([]byte("admin-address"))
+	_, err = tb.Create(ctx, &testdata.GroupInfo{Description: "my test", Admin: admin})
+	require.NoError(t, err)
+
+	// the RowIDs of an auto uint64 table are always 8 bytes long
+	for name, key := range map[string][]byte{
+		"too short": {1},
+		"too long":  bytes.Repeat([]byte{1}, 9),
+	} {
+		t.Run(name, func(t *testing.T) {
+			pageReq := &query.PageRequest{Key: key}
+			require.NotPanics(t, func() {
+				_, err = idx.GetPaginated(ctx, admin, pageReq)
+			})
+			require.True(t, orm.ErrArgument.Is(err), err)
+			require.NotPanics(t, func() {
+				_, _, err = idx.GetPaginatedReverse(ctx, admin, pageReq)
+			})
+			require.True(t, orm.ErrArgument.Is(err), err)
+		})
+	}
+}
+
+func TestIndexOversizedKeys(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	storeKey := sdk.NewKVStoreKey("test")
+
+	tableBuilder, err := orm.NewPrimaryKeyTableBuilder(GroupMemberTablePrefix, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+	// index by a searchable key longer than 255 bytes
+	idx, err := orm.NewIndex(tableBuilder, 0x10, func(val interface{}) ([]orm.RowID, error) {
+		m := val.(*testdata.GroupMember)
+		return []orm.RowID{bytes.Repeat(m.Member, 300/len(m.Member)+1)[:300]}, nil
+	})
+	require.NoError(t, err)
+	myTable := tableBuilder.Build()
+
+	ctx := orm.NewMockContext()
+
+	// a 300 byte searchable key is not length prefixed by the codec and can be stored
+	m1 := testdata.GroupMember{
+		Group:  sdk.AccAddress(orm.EncodeSequence(1)),
+		Member: sdk.AccAddress([]byte("member-one")),
+		Weight: 1,
+	}
+	require.NotPanics(t, func() {
+		err = myTable.Create(ctx, &m1)
+	})
+	require.NoError(t, err)
+	searchableKey := bytes.Repeat(m1.Member, 300/len(m1.Member)+1)[:300]
+	it, err := idx.Get(ctx, searchableKey)
+	require.NoError(t, err)
+	var loaded []testdata.GroupMember
+	_, err = orm.ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{m1}, loaded)
+
+	// a RowID longer than 255 bytes can't be encoded and fails instead of panicking
+	m2 := testdata.GroupMember{
+		Group:  sdk.AccAddress(bytes.Repeat([]byte{1}, 200)),
+		Member: sdk.AccAddress(bytes.Repeat([]byte{2}, 200)),
+		Weight: 2,
+	}
+	require.NotPanics(t, func() {
+		err = myTable.Create(ctx, &m2)
+	})
+	require.True(t, orm.ErrArgument.Is(err), err)
+}
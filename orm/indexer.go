@@ -21,13 +21,13 @@ type Indexer struct {
 // NewIndexer returns an indexer that supports multiple reference keys for an entity.
 func NewIndexer(indexerFunc IndexerFunc, codec IndexKeyCodec) (*Indexer, error) {
 	if indexerFunc == nil {
-		return nil, ErrArgument.Wrap("Indexer func must not be nil")
+		return nil, errors.Wrap(ErrArgument, "Indexer func must not be nil")
 	}
 	if codec == nil {
-		return nil, ErrArgument.Wrap("IndexKeyCodec must not be nil")
+		return nil, errors.Wrap(ErrArgument, "IndexKeyCodec must not be nil")
 	}
 	return &Indexer{
-		indexerFunc:   pruneEmptyKeys(indexerFunc),
+		indexerFunc:   indexerFunc,
 		addFunc:       multiKeyAddFunc,
 		indexKeyCodec: codec,
 	}, nil
@@ -36,7 +36,7 @@ func NewIndexer(indexerFunc IndexerFunc, codec IndexKeyCodec) (*Indexer, error)
 // NewUniqueIndexer returns an indexer that requires exactly one reference keys for an entity.
 func NewUniqueIndexer(f UniqueIndexerFunc, codec IndexKeyCodec) (*Indexer, error) {
 	if f == nil {
-		return nil, ErrArgument.Wrap("Indexer func must not be nil")
+		return nil, errors.Wrap(ErrArgument, "Indexer func must not be nil")
 	}
 	adaptor := func(indexerFunc UniqueIndexerFunc) IndexerFunc {
 		return func(v interface{}) ([]RowID, error) {
@@ -54,7 +54,7 @@ func NewUniqueIndexer(f UniqueIndexerFunc, codec IndexKeyCodec) (*Indexer, error
 
 // OnCreate persists the secondary index entries for the new object.
 func (i Indexer) OnCreate(store sdk.KVStore, rowID RowID, value interface{}) error {
-	secondaryIndexKeys, err := i.indexerFunc(value)
+	secondaryIndexKeys, err := i.newKeys(rowID, value)
 	if err != nil {
 		return err
 	}
@@ -69,7 +69,7 @@ func (i Indexer) OnCreate(store sdk.KVStore, rowID RowID, value interface{}) err
 
 // OnDelete removes the secondary index entries for the deleted object.
 func (i Indexer) OnDelete(store sdk.KVStore, rowID RowID, value interface{}) error {
-	secondaryIndexKeys, err := i.indexerFunc(value)
+	secondaryIndexKeys, err := pruneEmptyKeys(i.indexerFunc)(value)
 	if err != nil {
 		return err
 	}
@@ -83,11 +83,11 @@ func (i Indexer) OnDelete(store sdk.KVStore, rowID RowID, value interface{}) err
 
 // OnUpdate rebuilds the secondary index entries for the updated object.
 func (i Indexer) OnUpdate(store sdk.KVStore, rowID RowID, newValue, oldValue interface{}) error {
-	oldSecIdxKeys, err := i.indexerFunc(oldValue)
+	oldSecIdxKeys, err := pruneEmptyKeys(i.indexerFunc)(oldValue)
 	if err != nil {
 		return err
 	}
-	newSecIdxKeys, err := i.indexerFunc(newValue)
+	newSecIdxKeys, err := i.newKeys(rowID, newValue)
 	if err != nil {
 		return err
 	}
@@ -102,6 +102,26 @@ func (i Indexer) OnUpdate(store sdk.KVStore, rowID RowID, newValue, oldValue int
 	return nil
 }
 
+// newKeys returns the secondary index keys to persist for the given object. Unlike on delete, where
+// empty keys are skipped as they were never persisted, an empty key fails as it would otherwise
+// index the object under an empty prefix. Keys which the IndexKeyCodec can't encode fail too,
+// before anything is written.
+func (i Indexer) newKeys(rowID RowID, value interface{}) ([]RowID, error) {
+	keys, err := i.indexerFunc(value)
+	if err != nil {
+		return nil, err
+	}
+	for j := range keys {
+		if len(keys[j]) == 0 {
+			return nil, errors.Wrapf(ErrArgument, "indexer func returned empty key at position %d for row %X", j, rowID)
+		}
+		if err := validateIndexKey(i.indexKeyCodec, keys[j], rowID); err != nil {
+			return nil, errors.Wrapf(err, "index key at position %d for row %X", j, rowID)
+		}
+	}
+	return keys, nil
+}
+
 // uniqueKeysAddFunc enforces keys to be unique
 func uniqueKeysAddFunc(store sdk.KVStore, codec IndexKeyCodec, secondaryIndexKey []byte, rowID RowID) error {
 	if len(secondaryIndexKey) == 0 {
@@ -127,12 +127,14 @@ func TestIndexerOnCreate(t *testing.T) {
 			srcFunc: func(value interface{}) ([]RowID, error) {
 				return []RowID{{}}, nil
 			},
+			expErr:           ErrArgument.Wrap("indexer func returned empty key at position 0 for row 0000000000000001"),
 			expAddFuncCalled: false,
 		},
 		"nil key in slice": {
 			srcFunc: func(value interface{}) ([]RowID, error) {
-				return []RowID{nil}, nil
+				return []RowID{{0, 0, 0, 0, 0, 0, 0, 1}, nil}, nil
 			},
+			expErr:           ErrArgument.Wrap("indexer func returned empty key at position 1 for row 0000000000000001"),
 			expAddFuncCalled: false,
 		},
 		"empty key": {
@@ -164,7 +166,8 @@ func TestIndexerOnCreate(t *testing.T) {
 
 			err = idx.OnCreate(nil, myRowID, nil)
 			if spec.expErr != nil {
-				require.Equal(t, spec.expErr, err)
+				require.EqualError(t, err, spec.expErr.Error())
+				assert.Equal(t, spec.expAddFuncCalled, mockPolicy.called)
 				return
 			}
 			require.NoError(t, err)
@@ -312,11 +315,22 @@ func TestIndexerOnUpdate(t *testing.T) {
 			srcFunc: func(value interface{}) ([]RowID, error) {
 				return []RowID{{}}, nil
 			},
+			expErr: ErrArgument.Wrap("indexer func returned empty key at position 0 for row 0000000000000001"),
 		},
 		"nil key in slice": {
 			srcFunc: func(value interface{}) ([]RowID, error) {
 				return []RowID{nil}, nil
 			},
+			expErr: ErrArgument.Wrap("indexer func returned empty key at position 0 for row 0000000000000001"),
+		},
+		"empty key in slice with old value only": {
+			srcFunc: func(value interface{}) ([]RowID, error) {
+				keys := []RowID{{}, EncodeSequence(2)}
+				return []RowID{keys[value.(int)]}, nil
+			},
+			expAddedKeys: []RowID{
+				append(EncodeSequence(2), myRowID...),
+			},
 		},
 		"error case with new value": {
 			srcFunc: func(value interface{}) ([]RowID, error) {
@@ -354,7 +368,7 @@ func TestIndexerOnUpdate(t *testing.T) {
 			}
 			err = idx.OnUpdate(store, myRowID, 1, 0)
 			if spec.expErr != nil {
-				require.Equal(t, spec.expErr, err)
+				require.EqualError(t, err, spec.expErr.Error())
 				return
 			}
 			require.NoError(t, err)
@@ -363,6 +377,53 @@ func TestIndexerOnUpdate(t *testing.T) {
 	}
 }
 
+func TestIndexerRejectsMismatchedRowIDLength(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("test")
+	store := NewMockContext().KVStore(storeKey)
+	indexerFunc := func(value interface{}) ([]RowID, error) {
+		return []RowID{[]byte("my-key")}, nil
+	}
+
+	specs := map[string]struct {
+		rowID  RowID
+		expErr *errors.Error
+	}{
+		"matching length": {
+			rowID: []byte("0123456789abcdef"),
+		},
+		"shorter": {
+			rowID:  EncodeSequence(1),
+			expErr: ErrArgument,
+		},
+		"longer": {
+			rowID:  []byte("0123456789abcdef0"),
+			expErr: ErrArgument,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			idx, err := NewIndexer(indexerFunc, UUIDIndexKeys())
+			require.NoError(t, err)
+			var addFuncCalled bool
+			idx.addFunc = func(_ sdk.KVStore, _ IndexKeyCodec, _ []byte, _ RowID) error {
+				addFuncCalled = true
+				return nil
+			}
+
+			require.NotPanics(t, func() {
+				err = idx.OnCreate(store, spec.rowID, nil)
+			})
+			require.True(t, spec.expErr.Is(err), err)
+			assert.Equal(t, spec.expErr == nil, addFuncCalled)
+
+			require.NotPanics(t, func() {
+				err = idx.OnUpdate(store, spec.rowID, 1, 0)
+			})
+			require.True(t, spec.expErr.Is(err), err)
+		})
+	}
+}
+
 func TestUniqueKeyAddFunc(t *testing.T) {
 	myRowID := EncodeSequence(1)
 	myPresetKey := append([]byte("my-preset-key"), myRowID...)
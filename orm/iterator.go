@@ -5,8 +5,10 @@ import (
 	"reflect"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gogo/protobuf/proto"
 )
 
 // IteratorFunc is a function type that satisfies the Iterator interface
@@ -47,9 +49,14 @@ func NewInvalidIterator() Iterator {
 }
 
 // LimitedIterator returns up to defined maximum number of elements.
+// The parent iterator is closed as soon as the maximum number of elements
+// was returned or the parent is done, so that scans bounded by a limit don't
+// hold on to the underlying store iterator. Close can still be called safely
+// afterwards.
 type LimitedIterator struct {
 	remainingCount int
 	parentIterator Iterator
+	closed         bool
 }
 
 // LimitIterator returns a new iterator that returns max number of elements.
@@ -57,10 +64,10 @@ type LimitedIterator struct {
 // max can be 0 or any positive number
 func LimitIterator(parent Iterator, max int) (*LimitedIterator, error) {
 	if max < 0 {
-		return nil, ErrArgument.Wrap("quantity must not be negative")
+		return nil, errors.Wrap(ErrArgument, "quantity must not be negative")
 	}
 	if parent == nil {
-		return nil, ErrArgument.Wrap("parent iterator must not be nil")
+		return nil, errors.Wrap(ErrArgument, "parent iterator must not be nil")
 	}
 	return &LimitedIterator{remainingCount: max, parentIterator: parent}, nil
 }
@@ -69,15 +76,79 @@ func LimitIterator(parent Iterator, max int) (*LimitedIterator, error) {
 // are no more items or the defined max number of elements was returned the `ErrIteratorDone` error is returned
 // The key is the rowID and not any MultiKeyIndex key.
 func (i *LimitedIterator) LoadNext(dest codec.ProtoMarshaler) (RowID, error) {
+	if i.closed {
+		return nil, ErrIteratorDone
+	}
 	if i.remainingCount == 0 {
+		if err := i.Close(); err != nil {
+			return nil, err
+		}
 		return nil, ErrIteratorDone
 	}
 	i.remainingCount--
-	return i.parentIterator.LoadNext(dest)
+	rowID, err := i.parentIterator.LoadNext(dest)
+	if ErrIteratorDone.Is(err) {
+		if err := i.Close(); err != nil {
+			return nil, err
+		}
+	}
+	return rowID, err
+}
+
+// Close releases the parent iterator unless it was already released. It
+// should be called at the end of iteration.
+func (i *LimitedIterator) Close() error {
+	if i.closed {
+		return nil
+	}
+	i.closed = true
+	return i.parentIterator.Close()
+}
+
+// filterIterator returns the elements of the parent iterator for which keep
+// returns true.
+type filterIterator struct {
+	parentIterator Iterator
+	keep           func(rowID RowID, obj proto.Message) (bool, error)
+	err            error
+}
+
+// FilterIterator returns a new iterator that lazily skips the elements of the
+// parent iterator for which keep returns false. An error returned by keep
+// aborts the iteration: it is returned by this and all subsequent LoadNext
+// calls. Closing the returned iterator closes the parent.
+// The parent iterator and keep must not be nil.
+func FilterIterator(parent Iterator, keep func(rowID RowID, obj proto.Message) (bool, error)) Iterator {
+	return &filterIterator{parentIterator: parent, keep: keep}
 }
 
-// Close releases the iterator and should be called at the end of iteration
-func (i LimitedIterator) Close() error {
+// LoadNext loads the next value kept by the filter into the pointer passed as dest and returns the key.
+// If there are no more items the ErrIteratorDone error is returned.
+// The key is the rowID and not any MultiKeyIndex key.
+func (i *filterIterator) LoadNext(dest codec.ProtoMarshaler) (RowID, error) {
+	if i.err != nil {
+		return nil, i.err
+	}
+	for {
+		rowID, err := i.parentIterator.LoadNext(dest)
+		if err != nil {
+			return nil, err
+		}
+		keep, err := i.keep(rowID, dest)
+		if err != nil {
+			i.err = err
+			return nil, err
+		}
+		if keep {
+			return rowID, nil
+		}
+		// don't leak fields of a skipped row into the next one
+		dest.Reset()
+	}
+}
+
+// Close releases the parent iterator and should be called at the end of iteration
+func (i *filterIterator) Close() error {
 	return i.parentIterator.Close()
 }
 
@@ -215,6 +286,65 @@ func Paginate(
 	return res, nil
 }
 
+// normalizePageRequest returns a copy of the pageRequest with the defaults of Paginate applied.
+func normalizePageRequest(pageRequest *query.PageRequest) (*query.PageRequest, error) {
+	// if the PageRequest is nil, use default PageRequest
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+	res := *pageRequest
+
+	if res.Offset > 0 && res.Key != nil {
+		return nil, errors.Wrap(ErrArgument, "either offset or key is expected, got both")
+	}
+
+	if res.Limit == 0 {
+		res.Limit = 100
+
+		// count total results when the limit is zero/not supplied
+		res.CountTotal = true
+	}
+	return &res, nil
+}
+
+// loadPage reads a single page of raw entries from the store iterator, which must start at the
+// first entry of the requested domain or at the pageRequest.Key. The pageRequest must have been
+// normalized. The NextKey of the returned PageResponse is the store key of the next entry.
+func loadPage(it types.Iterator, pageRequest *query.PageRequest) ([]RowID, [][]byte, *query.PageResponse) {
+	var (
+		keys    []RowID
+		values  [][]byte
+		nextKey []byte
+		count   uint64
+		end     = pageRequest.Offset + pageRequest.Limit
+	)
+	for ; it.Valid(); it.Next() {
+		count++
+
+		if count <= pageRequest.Offset {
+			continue
+		}
+
+		if count <= end {
+			keys = append(keys, copyBytes(it.Key()))
+			values = append(values, copyBytes(it.Value()))
+		} else if count == end+1 {
+			nextKey = copyBytes(it.Key())
+
+			// countTotal is only respected when offset is used. It is ignored when key is set.
+			if !pageRequest.CountTotal || len(pageRequest.Key) != 0 {
+				break
+			}
+		}
+	}
+
+	res := &query.PageResponse{NextKey: nextKey}
+	if pageRequest.CountTotal && len(pageRequest.Key) == 0 {
+		res.Total = count
+	}
+	return keys, values, res
+}
+
 // ModelSlicePtr represents a pointer to a slice of models. Think of it as
 // *[]Model Because of Go's type system, using []Model type would not work for us.
 // Instead we use a placeholder type and the validation is done during the
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -147,6 +148,132 @@ func TestLimitedIterator(t *testing.T) {
 	}
 }
 
+func TestLimitedIteratorClosesParent(t *testing.T) {
+	rowIDs := []orm.RowID{orm.EncodeSequence(1), orm.EncodeSequence(2)}
+	values := []codec.ProtoMarshaler{&testdata.GroupInfo{Description: "first"}, &testdata.GroupInfo{Description: "second"}}
+
+	t.Run("when the limit is reached", func(t *testing.T) {
+		parent := newCloseCountingIter(rowIDs, values)
+		it, err := orm.LimitIterator(parent, 1)
+		require.NoError(t, err)
+
+		var loaded testdata.GroupInfo
+		rowID, err := it.LoadNext(&loaded)
+		require.NoError(t, err)
+		require.Equal(t, rowIDs[0], rowID)
+		require.Equal(t, "first", loaded.Description)
+		require.Equal(t, 0, parent.closed)
+
+		_, err = it.LoadNext(&loaded)
+		require.True(t, orm.ErrIteratorDone.Is(err))
+		require.Equal(t, 1, parent.closed)
+
+		// the parent is closed only once
+		_, err = it.LoadNext(&loaded)
+		require.True(t, orm.ErrIteratorDone.Is(err))
+		require.NoError(t, it.Close())
+		require.Equal(t, 1, parent.closed)
+	})
+
+	t.Run("when the parent is done", func(t *testing.T) {
+		parent := newCloseCountingIter(rowIDs, values)
+		it, err := orm.LimitIterator(parent, 5)
+		require.NoError(t, err)
+
+		var loaded []testdata.GroupInfo
+		_, err = orm.ReadAll(it, &loaded)
+		require.NoError(t, err)
+		require.Len(t, loaded, 2)
+		require.Equal(t, 1, parent.closed)
+	})
+
+	t.Run("when closed", func(t *testing.T) {
+		parent := newCloseCountingIter(rowIDs, values)
+		it, err := orm.LimitIterator(parent, 5)
+		require.NoError(t, err)
+
+		require.NoError(t, it.Close())
+		require.Equal(t, 1, parent.closed)
+
+		var loaded testdata.GroupInfo
+		_, err = it.LoadNext(&loaded)
+		require.True(t, orm.ErrIteratorDone.Is(err))
+		require.Equal(t, 1, parent.closed)
+	})
+}
+
+func TestFilterIterator(t *testing.T) {
+	rowIDs := []orm.RowID{orm.EncodeSequence(1), orm.EncodeSequence(2), orm.EncodeSequence(3)}
+	values := []codec.ProtoMarshaler{
+		&testdata.GroupInfo{Description: "keep", Admin: sdk.AccAddress([]byte("admin-address"))},
+		&testdata.GroupInfo{Description: "skip", Admin: sdk.AccAddress([]byte("other-address"))},
+		&testdata.GroupInfo{Description: "keep"},
+	}
+
+	t.Run("only matching rows", func(t *testing.T) {
+		parent := newCloseCountingIter(rowIDs, values)
+		it := orm.FilterIterator(parent, func(_ orm.RowID, obj proto.Message) (bool, error) {
+			return obj.(*testdata.GroupInfo).Description == "keep", nil
+		})
+
+		var loaded []testdata.GroupInfo
+		keys, err := orm.ReadAll(it, &loaded)
+		require.NoError(t, err)
+		require.Equal(t, []orm.RowID{rowIDs[0], rowIDs[2]}, keys)
+		require.Equal(t, []testdata.GroupInfo{
+			{Description: "keep", Admin: sdk.AccAddress([]byte("admin-address"))},
+			{Description: "keep"},
+		}, loaded)
+		require.Equal(t, 1, parent.closed)
+	})
+
+	t.Run("by row id", func(t *testing.T) {
+		it := orm.FilterIterator(newCloseCountingIter(rowIDs, values), func(rowID orm.RowID, _ proto.Message) (bool, error) {
+			return orm.DecodeSequence(rowID) == 2, nil
+		})
+
+		var loaded testdata.GroupInfo
+		rowID, err := orm.First(it, &loaded)
+		require.NoError(t, err)
+		require.Equal(t, rowIDs[1], rowID)
+		require.Equal(t, "skip", loaded.Description)
+	})
+
+	t.Run("with a limit", func(t *testing.T) {
+		filtered := orm.FilterIterator(newCloseCountingIter(rowIDs, values), func(_ orm.RowID, obj proto.Message) (bool, error) {
+			return obj.(*testdata.GroupInfo).Description == "keep", nil
+		})
+		it, err := orm.LimitIterator(filtered, 1)
+		require.NoError(t, err)
+
+		var loaded []testdata.GroupInfo
+		keys, err := orm.ReadAll(it, &loaded)
+		require.NoError(t, err)
+		require.Equal(t, []orm.RowID{rowIDs[0]}, keys)
+	})
+
+	t.Run("predicate error aborts iteration", func(t *testing.T) {
+		myErr := errors.New("test", 1, "my error")
+		var calls int
+		it := orm.FilterIterator(newCloseCountingIter(rowIDs, values), func(_ orm.RowID, _ proto.Message) (bool, error) {
+			calls++
+			if calls == 2 {
+				return false, myErr
+			}
+			return true, nil
+		})
+
+		var loaded testdata.GroupInfo
+		_, err := it.LoadNext(&loaded)
+		require.NoError(t, err)
+		_, err = it.LoadNext(&loaded)
+		require.True(t, myErr.Is(err))
+		_, err = it.LoadNext(&loaded)
+		require.True(t, myErr.Is(err))
+		require.Equal(t, 2, calls)
+	})
+}
+
 func TestFirst(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -351,6 +478,36 @@ func mockIter(rowID orm.RowID, val codec.ProtoMarshaler) orm.Iterator {
 	return orm.NewSingleValueIterator(rowID, b)
 }
 
+// closeCountingIter iterates over the given values and counts the calls to
+// Close.
+type closeCountingIter struct {
+	rowIDs []orm.RowID
+	values []codec.ProtoMarshaler
+	closed int
+}
+
+func newCloseCountingIter(rowIDs []orm.RowID, values []codec.ProtoMarshaler) *closeCountingIter {
+	return &closeCountingIter{rowIDs: rowIDs, values: values}
+}
+
+func (i *closeCountingIter) LoadNext(dest codec.ProtoMarshaler) (orm.RowID, error) {
+	if len(i.values) == 0 {
+		return nil, orm.ErrIteratorDone
+	}
+	bz, err := i.values[0].Marshal()
+	if err != nil {
+		return nil, err
+	}
+	rowID := i.rowIDs[0]
+	i.rowIDs, i.values = i.rowIDs[1:], i.values[1:]
+	return rowID, dest.Unmarshal(bz)
+}
+
+func (i *closeCountingIter) Close() error {
+	i.closed++
+	return nil
+}
+
 func noopIter() orm.Iterator {
 	return orm.IteratorFunc(func(dest codec.ProtoMarshaler) (orm.RowID, error) {
 		return nil, nil
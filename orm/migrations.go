@@ -0,0 +1,51 @@
+package orm
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MigrateTablePrefix moves all entries that are stored with the oldPrefix to the newPrefix
+// within the store of the given storeKey. This can be used for tables as well as for index
+// entries, as keys and values are moved unchanged.
+//
+// The migration fails with an ErrUniqueConstraint before anything is moved when the newPrefix
+// range is not empty. Re-running a completed migration is a no-op.
+func MigrateTablePrefix(ctx HasKVStore, storeKey sdk.StoreKey, oldPrefix, newPrefix byte) error {
+	if oldPrefix == newPrefix {
+		return errors.Wrap(ErrArgument, "old and new prefix must be different")
+	}
+	store := ctx.KVStore(storeKey)
+	oldStore := prefix.NewStore(store, []byte{oldPrefix})
+	newStore := prefix.NewStore(store, []byte{newPrefix})
+
+	if firstKey(oldStore) == nil {
+		// nothing to move, either the table is empty or it was migrated already
+		return nil
+	}
+	if key := firstKey(newStore); key != nil {
+		return errors.Wrapf(ErrUniqueConstraint, "new prefix range is not empty: %X", key)
+	}
+
+	var keys [][]byte
+	it := oldStore.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, copyBytes(it.Key()))
+	}
+	it.Close()
+	for _, key := range keys {
+		newStore.Set(key, oldStore.Get(key))
+		oldStore.Delete(key)
+	}
+	return nil
+}
+
+func firstKey(store sdk.KVStore) []byte {
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	if !it.Valid() {
+		return nil
+	}
+	return copyBytes(it.Key())
+}
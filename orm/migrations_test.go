@@ -0,0 +1,121 @@
+package orm_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/orm/testdata"
+)
+
+func TestMigrateTablePrefix(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	storeKey := sdk.NewKVStoreKey("test")
+
+	const (
+		oldTablePrefix byte = iota
+		oldIndexPrefix
+		newTablePrefix
+		newIndexPrefix
+	)
+	buildTable := func(tablePrefix, indexPrefix byte) (orm.PrimaryKeyTable, orm.MultiKeyIndex) {
+		builder, err := orm.NewPrimaryKeyTableBuilder(tablePrefix, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+		require.NoError(t, err)
+		idx, err := orm.NewIndex(builder, indexPrefix, func(val interface{}) ([]orm.RowID, error) {
+			return []orm.RowID{[]byte(val.(*testdata.GroupMember).Group)}, nil
+		})
+		require.NoError(t, err)
+		return builder.Build(), idx
+	}
+
+	members := []testdata.GroupMember{
+		{Group: []byte("group-a"), Member: []byte("member-one"), Weight: 1},
+		{Group: []byte("group-a"), Member: []byte("member-two"), Weight: 2},
+		{Group: []byte("group-b"), Member: []byte("member-one"), Weight: 3},
+	}
+	setup := func() orm.HasKVStore {
+		ctx := orm.NewMockContext()
+		oldTable, _ := buildTable(oldTablePrefix, oldIndexPrefix)
+		for i := range members {
+			require.NoError(t, oldTable.Create(ctx, &members[i]))
+		}
+		return ctx
+	}
+	assertMigrated := func(t *testing.T, ctx orm.HasKVStore, newTable orm.PrimaryKeyTable, newIdx orm.MultiKeyIndex) {
+		it, err := newTable.PrefixScan(ctx, nil, nil)
+		require.NoError(t, err)
+		var loaded []testdata.GroupMember
+		_, err = orm.ReadAll(it, &loaded)
+		require.NoError(t, err)
+		assert.Equal(t, members, loaded)
+
+		it, err = newIdx.Get(ctx, []byte("group-a"))
+		require.NoError(t, err)
+		loaded = nil
+		_, err = orm.ReadAll(it, &loaded)
+		require.NoError(t, err)
+		assert.Equal(t, members[:2], loaded)
+
+		oldTable, _ := buildTable(oldTablePrefix, oldIndexPrefix)
+		it, err = oldTable.PrefixScan(ctx, nil, nil)
+		require.NoError(t, err)
+		loaded = nil
+		_, err = orm.ReadAll(it, &loaded)
+		require.NoError(t, err)
+		assert.Empty(t, loaded)
+	}
+
+	t.Run("move table and index", func(t *testing.T) {
+		ctx := setup()
+		require.NoError(t, orm.MigrateTablePrefix(ctx, storeKey, oldTablePrefix, newTablePrefix))
+		require.NoError(t, orm.MigrateTablePrefix(ctx, storeKey, oldIndexPrefix, newIndexPrefix))
+		newTable, newIdx := buildTable(newTablePrefix, newIndexPrefix)
+		assertMigrated(t, ctx, newTable, newIdx)
+
+		// re-running a completed migration is a no-op
+		require.NoError(t, orm.MigrateTablePrefix(ctx, storeKey, oldTablePrefix, newTablePrefix))
+		assertMigrated(t, ctx, newTable, newIdx)
+	})
+
+	t.Run("move table and rebuild index", func(t *testing.T) {
+		ctx := setup()
+		require.NoError(t, orm.MigrateTablePrefix(ctx, storeKey, oldTablePrefix, newTablePrefix))
+		newTable, newIdx := buildTable(newTablePrefix, newIndexPrefix)
+		require.NoError(t, newTable.RebuildIndexes(ctx))
+		assertMigrated(t, ctx, newTable, newIdx)
+	})
+
+	t.Run("new prefix not empty", func(t *testing.T) {
+		ctx := setup()
+		newTable, _ := buildTable(newTablePrefix, newIndexPrefix)
+		other := testdata.GroupMember{Group: []byte("group-c"), Member: []byte("member-one"), Weight: 4}
+		require.NoError(t, newTable.Create(ctx, &other))
+
+		err := orm.MigrateTablePrefix(ctx, storeKey, oldTablePrefix, newTablePrefix)
+		require.True(t, orm.ErrUniqueConstraint.Is(err), err)
+		assert.False(t, newTable.Contains(ctx, &members[0]))
+	})
+
+	t.Run("new prefix with key below the old keys", func(t *testing.T) {
+		ctx := setup()
+		newTable, _ := buildTable(newTablePrefix, newIndexPrefix)
+		other := testdata.GroupMember{Group: []byte("group-0"), Member: []byte("member-one"), Weight: 4}
+		require.NoError(t, newTable.Create(ctx, &other))
+
+		err := orm.MigrateTablePrefix(ctx, storeKey, oldTablePrefix, newTablePrefix)
+		require.True(t, orm.ErrUniqueConstraint.Is(err), err)
+		assert.False(t, newTable.Contains(ctx, &members[0]))
+	})
+
+	t.Run("same prefix", func(t *testing.T) {
+		ctx := setup()
+		err := orm.MigrateTablePrefix(ctx, storeKey, oldTablePrefix, oldTablePrefix)
+		require.True(t, orm.ErrArgument.Is(err), err)
+	})
+}
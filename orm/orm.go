@@ -25,6 +25,7 @@ var (
 	ErrArgument          = errors.Register(ormCodespace, 112, "invalid argument")
 	ErrIndexKeyMaxLength = errors.Register(ormCodespace, 113, "index key exceeds max length")
 	ErrEmptyKey          = errors.Register(ormCodespace, 114, "cannot use empty key")
+	ErrIndexOutOfSync    = errors.Register(ormCodespace, 115, "index out of sync with table")
 )
 
 // HasKVStore is a subset of the cosmos-sdk context defined for loose coupling and simpler test setups.
@@ -65,8 +66,9 @@ type Persistent interface {
 // so that the row PrimaryKey is allows a fixed with 8 byte integer. This allows the MultiKeyIndex key bytes to be
 // variable length and scanned iteratively. The
 type Index interface {
-	// Has checks if a key exists. Panics on nil key.
-	Has(ctx HasKVStore, key []byte) bool
+	// Has checks if at least one row is indexed under the key, without loading
+	// any row. An error is returned for a nil key.
+	Has(ctx HasKVStore, key []byte) (bool, error)
 
 	// Get returns a result iterator for the searchKey.
 	// searchKey must not be nil.
@@ -141,6 +143,12 @@ type AfterSetInterceptor func(ctx HasKVStore, rowID RowID, newValue, oldValue co
 // AfterDeleteInterceptor defines a callback function to be called on Delete operations.
 type AfterDeleteInterceptor func(ctx HasKVStore, rowID RowID, value codec.ProtoMarshaler) error
 
+// RowObserver defines a callback function that is notified about a row lifecycle event. Unlike the
+// interceptors used by indexes, observers are meant for reacting to changes, e.g. telemetry or cache
+// invalidation. They run within the same transaction after all interceptors, and a returned error
+// makes the mutating call fail.
+type RowObserver func(ctx HasKVStore, rowID RowID, obj codec.ProtoMarshaler) error
+
 // RowGetter loads a persistent object by row ID into the destination object. The dest parameter must therefore be a pointer.
 // Any implementation must return `ErrNotFound` when no object for the rowID exists
 type RowGetter func(ctx HasKVStore, rowID RowID, dest codec.ProtoMarshaler) error
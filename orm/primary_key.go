@@ -1,10 +1,16 @@
 package orm
 
 import (
+	"encoding/binary"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gogo/protobuf/proto"
 )
 
 var _ Indexable = &PrimaryKeyTableBuilder{}
@@ -37,10 +43,14 @@ type PrimaryKeyed interface {
 	//
 	// PrimaryKey parts can be []byte, string, and integer types. []byte is
 	// encoded with a length prefix, strings are null-terminated, and
-	// integers are encoded using 4 or 8 byte big endian.
+	// integers are encoded using 4 or 8 byte big endian. Signed integers
+	// have their sign bit flipped so that negative values sort first.
+	// time.Time is encoded with a fixed width in chronological order and
+	// bool as a single byte, so that false sorts before true.
 	//
 	// IMPORTANT: []byte parts are encoded with a single byte length prefix,
-	// so cannot be longer than 255 bytes.
+	// so cannot be longer than 255 bytes. Use LongBytes for parts of up to
+	// 65535 bytes, which are encoded with a 2 byte length prefix instead.
 	//
 	// The `IndexKeyCodec` used with the `PrimaryKeyTable` may add certain
 	// constraints to the byte representation as max length = 255 in
@@ -83,14 +93,43 @@ func buildPrimaryKey(fields []interface{}) []byte {
 	return primaryKey
 }
 
+// BuildCompositeKey encodes and concatenates the given parts the same way as the fields of
+// a primary key are encoded. See PrimaryKey for the supported types and their encoding.
+// As every part is either of fixed length, length prefixed or null-terminated, a key built
+// from only the first parts is a prefix of all keys that share these parts. This allows
+// to use composite keys in an IndexerFunc and scan them by a partial key later on.
+// Example:
+//			idx, err := NewIndex(builder, prefix, func(val interface{}) ([]RowID, error) {
+//				m := val.(*GroupMember)
+//				return []RowID{BuildCompositeKey(m.GroupId, m.Member.Bytes())}, nil
+//			})
+//			...
+//			it, err := idx.PartialKeyPrefixScan(ctx, BuildCompositeKey(groupID))
+//
+// The function panics if a part is of an unsupported type.
+func BuildCompositeKey(parts ...interface{}) []byte {
+	return buildPrimaryKey(parts)
+}
+
 func primaryKeyFieldBytes(field interface{}) []byte {
 	switch v := field.(type) {
 	case []byte:
 		return AddLengthPrefix(v)
+	case LongBytes:
+		return AddUint16LengthPrefix(v)
 	case string:
 		return NullTerminatedBytes(v)
 	case uint64:
 		return EncodeSequence(v)
+	case int64:
+		return EncodeInt64(v)
+	case time.Time:
+		return EncodeTime(v)
+	case bool:
+		if v {
+			return []byte{1}
+		}
+		return []byte{0}
 	default:
 		panic(fmt.Sprintf("Type %T not allowed as primary key field", v))
 	}
@@ -110,6 +149,78 @@ func AddLengthPrefix(bytes []byte) []byte {
 	return prefixedBytes
 }
 
+// EncodeInt64 converts a signed integer into an 8 byte big endian representation
+// that preserves the numeric order when compared bytewise. The sign bit is flipped
+// so that negative values sort before positive ones.
+func EncodeInt64(val int64) []byte {
+	return EncodeSequence(uint64(val) ^ (1 << 63))
+}
+
+// DecodeInt64 converts the binary representation built by EncodeInt64 back into an int64 value.
+func DecodeInt64(bz []byte) int64 {
+	return int64(DecodeSequence(bz) ^ (1 << 63))
+}
+
+// EncodedTimeLength number of bytes used for the binary representation of a time.Time value.
+const EncodedTimeLength = EncodedSeqLength + 4
+
+// EncodeTime converts a time.Time into a fixed width binary representation that preserves
+// the chronological order when compared bytewise. It consists of the seconds since the
+// Unix epoch encoded with EncodeInt64 followed by the nanoseconds as 4 byte big endian, so
+// that values outside of the range of UnixNano, like the zero time, can be encoded as well.
+// The location of the time is not persisted.
+func EncodeTime(t time.Time) []byte {
+	bz := make([]byte, EncodedTimeLength)
+	copy(bz, EncodeInt64(t.Unix()))
+	binary.BigEndian.PutUint32(bz[EncodedSeqLength:], uint32(t.Nanosecond()))
+	return bz
+}
+
+// DecodeTime converts the binary representation built by EncodeTime back into a time.Time in UTC.
+func DecodeTime(bz []byte) (time.Time, error) {
+	if len(bz) != EncodedTimeLength {
+		return time.Time{}, errors.Wrapf(ErrArgument, "invalid time length: %d", len(bz))
+	}
+	secs := DecodeInt64(bz[:EncodedSeqLength])
+	nanos := binary.BigEndian.Uint32(bz[EncodedSeqLength:])
+	return time.Unix(secs, int64(nanos)).UTC(), nil
+}
+
+// LongBytes is a []byte primary key part that is encoded with a 2 byte big endian length
+// prefix, so that it can be up to 65535 bytes long. Plain []byte parts keep the single byte
+// length prefix.
+type LongBytes []byte
+
+// MaxUint16LengthPrefixed is the max length of a []byte that can be prefixed by AddUint16LengthPrefix.
+const MaxUint16LengthPrefixed = math.MaxUint16
+
+// AddUint16LengthPrefix prefixes the byte array with its length as 2 byte big endian.
+// The function will panic if the bytes length is bigger than 65535.
+func AddUint16LengthPrefix(bytes []byte) []byte {
+	byteLen := len(bytes)
+	if byteLen > MaxUint16LengthPrefixed {
+		panic("Cannot create primary key with an []byte of length greater than 65535 bytes. Try again with a smaller []byte.")
+	}
+
+	prefixedBytes := make([]byte, 2+byteLen)
+	binary.BigEndian.PutUint16(prefixedBytes, uint16(byteLen))
+	copy(prefixedBytes[2:], bytes)
+	return prefixedBytes
+}
+
+// DecodeUint16LengthPrefixed is the reverse operation to AddUint16LengthPrefix. It returns the
+// length prefixed bytes at the start of bz and the remaining bytes after them.
+func DecodeUint16LengthPrefixed(bz []byte) ([]byte, []byte, error) {
+	if len(bz) < 2 {
+		return nil, nil, errors.Wrap(ErrArgument, "missing length prefix")
+	}
+	n := int(binary.BigEndian.Uint16(bz)) + 2
+	if len(bz) < n {
+		return nil, nil, errors.Wrapf(ErrArgument, "expected %d bytes but got %d", n-2, len(bz)-2)
+	}
+	return bz[2:n], bz[n:], nil
+}
+
 // Convert string to byte array and null terminate it
 func NullTerminatedBytes(s string) []byte {
 	bytes := make([]byte, len(s)+1)
@@ -135,6 +246,22 @@ func (a PrimaryKeyTable) Create(ctx HasKVStore, obj PrimaryKeyed) error {
 	return a.table.Create(ctx, rowID, obj)
 }
 
+// CreateBatch persists all the given objects under their primary keys. It validates all objects
+// up front and fails with an `ErrUniqueConstraint` when the batch contains the same primary key
+// twice or a key already exists. It is all-or-nothing, so on any error none of the objects is
+// persisted.
+//
+// CreateBatch iterates through the registered callbacks that may add secondary index keys.
+func (a PrimaryKeyTable) CreateBatch(ctx HasKVStore, objs []PrimaryKeyed) error {
+	rowIDs := make([]RowID, len(objs))
+	values := make([]codec.ProtoMarshaler, len(objs))
+	for i, obj := range objs {
+		rowIDs[i] = PrimaryKey(obj)
+		values[i] = obj
+	}
+	return a.table.CreateBatch(ctx, rowIDs, values)
+}
+
 // Update updates the given object under the primary key. It expects the key to
 // exists already and fails with an `ErrNotFound` otherwise. Any caller must
 // therefore make sure that this contract is fulfilled. Parameters must not be
@@ -217,11 +344,39 @@ func (a PrimaryKeyTable) ReversePrefixScan(ctx HasKVStore, start, end []byte) (I
 	return a.table.ReversePrefixScan(ctx, start, end)
 }
 
+// PaginatedPrefixScan returns an Iterator over a single page of rows whose primary key starts with
+// the given prefix, together with the PageResponse to use for loading the next page. An empty or nil
+// prefix selects all rows of the table. Key, offset, limit and count-total of the page request are
+// respected the same way as in Paginate; the iterator holds no store resources but should still be closed.
+// Example:
+//			it, pageRes, err := tb.PaginatedPrefixScan(ctx, AddLengthPrefix(group), req.Pagination)
+//			if err != nil {
+//				return err
+//			}
+//			var members []*GroupMember
+//			_, err = ReadAll(it, &members)
+func (a PrimaryKeyTable) PaginatedPrefixScan(ctx HasKVStore, prefix []byte, pageRequest *query.PageRequest) (Iterator, *query.PageResponse, error) {
+	return a.table.PaginatedPrefixScan(ctx, prefix, pageRequest)
+}
+
 // Export stores all the values in the table in the passed ModelSlicePtr.
 func (a PrimaryKeyTable) Export(ctx HasKVStore, dest ModelSlicePtr) (uint64, error) {
 	return a.table.Export(ctx, dest)
 }
 
+// ExportStream calls fn for every value in the table in ascending primary key order. Unlike
+// Export, it does not materialize the whole table, so callers can encode the values one by one.
+// The first error returned by fn aborts the iteration and is returned.
+func (a PrimaryKeyTable) ExportStream(ctx HasKVStore, fn func(rowID RowID, obj proto.Message) error) error {
+	return a.table.ExportStream(ctx, fn)
+}
+
+// RebuildIndexes populates the secondary indexes of the table from all its values.
+// The index entries must not exist already. See MigrateTablePrefix.
+func (a PrimaryKeyTable) RebuildIndexes(ctx HasKVStore) error {
+	return a.table.RebuildIndexes(ctx)
+}
+
 // Import clears the table and initializes it from the given data interface{}.
 // data should be a slice of structs that implement PrimaryKeyed.
 func (a PrimaryKeyTable) Import(ctx HasKVStore, data interface{}, seqValue uint64) error {
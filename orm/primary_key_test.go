@@ -1,12 +1,16 @@
 package orm_test
 
 import (
+	"bytes"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -239,6 +243,172 @@ func TestPrimaryKeyTablePrefixScan(t *testing.T) {
 	}
 }
 
+func TestPrimaryKeyTablePaginatedPrefixScan(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	storeKey := sdk.NewKVStoreKey("test")
+	const (
+		testTablePrefix = iota
+	)
+
+	builder, err := orm.NewPrimaryKeyTableBuilder(testTablePrefix, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+	tb := builder.Build()
+
+	ctx := orm.NewMockContext()
+
+	const anyWeight = 1
+	m1 := testdata.GroupMember{
+		Group:  []byte("group-a"),
+		Member: []byte("member-one"),
+		Weight: anyWeight,
+	}
+	m2 := testdata.GroupMember{
+		Group:  []byte("group-a"),
+		Member: []byte("member-two"),
+		Weight: anyWeight,
+	}
+	m3 := testdata.GroupMember{
+		Group:  []byte("group-a"),
+		Member: []byte("member-three"),
+		Weight: anyWeight,
+	}
+	m4 := testdata.GroupMember{
+		Group:  []byte("group-b"),
+		Member: []byte("member-one"),
+		Weight: anyWeight,
+	}
+	for _, g := range []testdata.GroupMember{m1, m2, m3, m4} {
+		require.NoError(t, tb.Create(ctx, &g))
+	}
+
+	groupA := orm.AddLengthPrefix([]byte("group-a"))
+	specs := map[string]struct {
+		prefix     []byte
+		pageReq    *query.PageRequest
+		expResult  []testdata.GroupMember
+		expNextKey []byte
+		expTotal   uint64
+		expError   *errors.Error
+	}{
+		"nil page request counts total": {
+			prefix:    groupA,
+			expResult: []testdata.GroupMember{m1, m2, m3},
+			expTotal:  3,
+		},
+		"nil prefix selects all": {
+			pageReq:   &query.PageRequest{CountTotal: true, Limit: 10},
+			expResult: []testdata.GroupMember{m1, m2, m3, m4},
+			expTotal:  4,
+		},
+		"limit with next key": {
+			prefix:     groupA,
+			pageReq:    &query.PageRequest{Limit: 2},
+			expResult:  []testdata.GroupMember{m1, m2},
+			expNextKey: orm.PrimaryKey(&m3),
+		},
+		"limit with count total": {
+			prefix:     groupA,
+			pageReq:    &query.PageRequest{Limit: 1, CountTotal: true},
+			expResult:  []testdata.GroupMember{m1},
+			expNextKey: orm.PrimaryKey(&m2),
+			expTotal:   3,
+		},
+		"offset": {
+			prefix:     groupA,
+			pageReq:    &query.PageRequest{Offset: 1, Limit: 1},
+			expResult:  []testdata.GroupMember{m2},
+			expNextKey: orm.PrimaryKey(&m3),
+		},
+		"offset with count total": {
+			prefix:    groupA,
+			pageReq:   &query.PageRequest{Offset: 2, Limit: 2, CountTotal: true},
+			expResult: []testdata.GroupMember{m3},
+			expTotal:  3,
+		},
+		"offset beyond results": {
+			prefix:    groupA,
+			pageReq:   &query.PageRequest{Offset: 5, Limit: 2, CountTotal: true},
+			expResult: []testdata.GroupMember{},
+			expTotal:  3,
+		},
+		"key": {
+			prefix:     groupA,
+			pageReq:    &query.PageRequest{Key: orm.PrimaryKey(&m2), Limit: 1},
+			expResult:  []testdata.GroupMember{m2},
+			expNextKey: orm.PrimaryKey(&m3),
+		},
+		"key ignores count total": {
+			prefix:    groupA,
+			pageReq:   &query.PageRequest{Key: orm.PrimaryKey(&m2), Limit: 2, CountTotal: true},
+			expResult: []testdata.GroupMember{m2, m3},
+		},
+		"key stops at prefix end": {
+			prefix:    groupA,
+			pageReq:   &query.PageRequest{Key: orm.PrimaryKey(&m3), Limit: 5},
+			expResult: []testdata.GroupMember{m3},
+		},
+		"non matching prefix": {
+			prefix:    []byte("nobody"),
+			pageReq:   &query.PageRequest{Limit: 5, CountTotal: true},
+			expResult: []testdata.GroupMember{},
+		},
+		"key outside of prefix": {
+			prefix:   groupA,
+			pageReq:  &query.PageRequest{Key: orm.PrimaryKey(&m4), Limit: 1},
+			expError: orm.ErrArgument,
+		},
+		"both key and offset": {
+			prefix:   groupA,
+			pageReq:  &query.PageRequest{Key: orm.PrimaryKey(&m2), Offset: 1},
+			expError: orm.ErrArgument,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			it, res, err := tb.PaginatedPrefixScan(ctx, spec.prefix, spec.pageReq)
+			require.True(t, spec.expError.Is(err), "expected #+v but got #+v", spec.expError, err)
+			if spec.expError != nil {
+				return
+			}
+			var loaded []testdata.GroupMember
+			_, err = orm.ReadAll(it, &loaded)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expResult, loaded)
+			assert.Equal(t, spec.expNextKey, res.NextKey)
+			assert.Equal(t, spec.expTotal, res.Total)
+		})
+	}
+
+	t.Run("walk all pages by key", func(t *testing.T) {
+		var (
+			all     []testdata.GroupMember
+			nextKey []byte
+		)
+		for {
+			it, res, err := tb.PaginatedPrefixScan(ctx, nil, &query.PageRequest{Key: nextKey, Limit: 3})
+			require.NoError(t, err)
+			var loaded []testdata.GroupMember
+			_, err = orm.ReadAll(it, &loaded)
+			require.NoError(t, err)
+			all = append(all, loaded...)
+			if res.NextKey == nil {
+				break
+			}
+			nextKey = res.NextKey
+		}
+		assert.Equal(t, []testdata.GroupMember{m1, m2, m3, m4}, all)
+	})
+
+	t.Run("wrong destination type", func(t *testing.T) {
+		it, _, err := tb.PaginatedPrefixScan(ctx, nil, nil)
+		require.NoError(t, err)
+		_, err = it.LoadNext(&testdata.GroupInfo{})
+		require.True(t, orm.ErrType.Is(err))
+	})
+}
+
 func TestContains(t *testing.T) {
 	interfaceRegistry := types.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(interfaceRegistry)
@@ -295,6 +465,205 @@ func TestContains(t *testing.T) {
 	}
 }
 
+func TestPrimaryKeyTableCreateBatch(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	storeKey := sdk.NewKVStoreKey("test")
+	const (
+		testTablePrefix = iota
+		testUniqueIndexPrefix
+	)
+
+	builder, err := orm.NewPrimaryKeyTableBuilder(testTablePrefix, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+	// unique weights so that an index callback can fail within a batch
+	weightIdx, err := orm.NewUniqueIndex(builder, testUniqueIndexPrefix, func(val interface{}) (orm.RowID, error) {
+		return orm.EncodeSequence(val.(*testdata.GroupMember).Weight), nil
+	})
+	require.NoError(t, err)
+	tb := builder.Build()
+
+	member := func(group, member string, weight uint64) *testdata.GroupMember {
+		return &testdata.GroupMember{Group: []byte(group), Member: []byte(member), Weight: weight}
+	}
+	existing := member("group-a", "member-existing", 100)
+
+	specs := map[string]struct {
+		objs     []orm.PrimaryKeyed
+		expError *errors.Error
+	}{
+		"all created": {
+			objs: []orm.PrimaryKeyed{member("group-a", "member-one", 1), member("group-a", "member-two", 2), member("group-b", "member-one", 3)},
+		},
+		"empty batch": {
+			objs: []orm.PrimaryKeyed{},
+		},
+		"duplicate in batch": {
+			objs:     []orm.PrimaryKeyed{member("group-a", "member-one", 1), member("group-b", "member-one", 2), member("group-a", "member-one", 3)},
+			expError: orm.ErrUniqueConstraint,
+		},
+		"already persisted": {
+			objs:     []orm.PrimaryKeyed{member("group-a", "member-one", 1), member("group-a", "member-existing", 2)},
+			expError: orm.ErrUniqueConstraint,
+		},
+		"index callback fails": {
+			objs:     []orm.PrimaryKeyed{member("group-a", "member-one", 1), member("group-a", "member-two", 1)},
+			expError: orm.ErrUniqueConstraint,
+		},
+		"wrong type": {
+			objs:     []orm.PrimaryKeyed{member("group-a", "member-one", 1), mockPrimaryKeyed{member("group-a", "member-two", 2)}},
+			expError: orm.ErrType,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx := orm.NewMockContext()
+			require.NoError(t, tb.Create(ctx, existing))
+
+			err := tb.CreateBatch(ctx, spec.objs)
+			require.True(t, spec.expError.Is(err), "expected #+v but got #+v", spec.expError, err)
+
+			for _, obj := range spec.objs {
+				m, ok := obj.(*testdata.GroupMember)
+				if !ok || m.Member != nil && string(m.Member) == "member-existing" {
+					continue
+				}
+				exp := spec.expError == nil
+				assert.Equal(t, exp, tb.Contains(ctx, m))
+				exists, err := weightIdx.Has(ctx, orm.EncodeSequence(m.Weight))
+				require.NoError(t, err)
+				assert.Equal(t, exp, exists)
+			}
+
+			// the previously persisted object is untouched
+			var loaded testdata.GroupMember
+			require.NoError(t, tb.GetOne(ctx, orm.PrimaryKey(existing), &loaded))
+			assert.Equal(t, *existing, loaded)
+			exists, err := weightIdx.Has(ctx, orm.EncodeSequence(existing.Weight))
+			require.NoError(t, err)
+			assert.True(t, exists)
+		})
+	}
+}
+
+func TestPrimaryKeyTableCreateBatchObservers(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	storeKey := sdk.NewKVStoreKey("test")
+	builder, err := orm.NewPrimaryKeyTableBuilder(0x1, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+	_, err = orm.NewUniqueIndex(builder, 0x2, func(val interface{}) (orm.RowID, error) {
+		return orm.EncodeSequence(val.(*testdata.GroupMember).Weight), nil
+	})
+	require.NoError(t, err)
+
+	var tb orm.PrimaryKeyTable
+	var notified []uint64
+	builder.AddAfterCreateObserver(func(ctx orm.HasKVStore, rowID orm.RowID, obj codec.ProtoMarshaler) error {
+		m := obj.(*testdata.GroupMember)
+		// the whole batch is written before any observer is notified
+		var loaded testdata.GroupMember
+		require.NoError(t, tb.GetOne(ctx, orm.PrimaryKey(m), &loaded))
+		notified = append(notified, m.Weight)
+		return nil
+	})
+	tb = builder.Build()
+
+	member := func(member string, weight uint64) *testdata.GroupMember {
+		return &testdata.GroupMember{Group: []byte("group"), Member: []byte(member), Weight: weight}
+	}
+	ctx := orm.NewMockContext()
+
+	// a failing batch doesn't notify the observers of the objects created before the failure
+	err = tb.CreateBatch(ctx, []orm.PrimaryKeyed{member("member-one", 1), member("member-two", 1)})
+	require.True(t, orm.ErrUniqueConstraint.Is(err), err)
+	assert.Empty(t, notified)
+
+	require.NoError(t, tb.CreateBatch(ctx, []orm.PrimaryKeyed{member("member-one", 1), member("member-two", 2)}))
+	assert.Equal(t, []uint64{1, 2}, notified)
+}
+
+func TestPrimaryKeyTableCreateBatchFailingObserver(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	storeKey := sdk.NewKVStoreKey("test")
+	builder, err := orm.NewPrimaryKeyTableBuilder(0x1, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+	builder.AddAfterCreateObserver(func(ctx orm.HasKVStore, rowID orm.RowID, obj codec.ProtoMarshaler) error {
+		if obj.(*testdata.GroupMember).Weight == 2 {
+			return errors.ErrInvalidRequest
+		}
+		return nil
+	})
+	tb := builder.Build()
+
+	objs := []*testdata.GroupMember{
+		{Group: []byte("group"), Member: []byte("member-one"), Weight: 1},
+		{Group: []byte("group"), Member: []byte("member-two"), Weight: 2},
+	}
+	ctx := orm.NewMockContext()
+	err = tb.CreateBatch(ctx, []orm.PrimaryKeyed{objs[0], objs[1]})
+	require.True(t, errors.ErrInvalidRequest.Is(err), err)
+
+	// an observer failure discards the whole batch
+	for _, obj := range objs {
+		assert.False(t, tb.Contains(ctx, obj))
+	}
+}
+
+func BenchmarkPrimaryKeyTableCreateBatch(b *testing.B) {
+	tb, objs := benchmarkPrimaryKeyTable(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ctx := orm.NewMockContext()
+		if err := tb.CreateBatch(ctx, objs); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPrimaryKeyTableLoopedCreate(b *testing.B) {
+	tb, objs := benchmarkPrimaryKeyTable(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ctx := orm.NewMockContext()
+		for _, obj := range objs {
+			if err := tb.Create(ctx, obj); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func benchmarkPrimaryKeyTable(b *testing.B) (orm.PrimaryKeyTable, []orm.PrimaryKeyed) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	storeKey := sdk.NewKVStoreKey("test")
+	builder, err := orm.NewPrimaryKeyTableBuilder(0x1, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	if err != nil {
+		b.Fatal(err)
+	}
+	_, err = orm.NewIndex(builder, 0x2, func(val interface{}) ([]orm.RowID, error) {
+		return []orm.RowID{[]byte(val.(*testdata.GroupMember).Member)}, nil
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	const n = 1000
+	objs := make([]orm.PrimaryKeyed, n)
+	for i := range objs {
+		objs[i] = &testdata.GroupMember{
+			Group:  []byte("group"),
+			Member: orm.EncodeSequence(uint64(i)),
+			Weight: 1,
+		}
+	}
+	return builder.Build(), objs
+}
+
 func TestAddLengthPrefix(t *testing.T) {
 	tcs := []struct {
 		name     string
@@ -317,6 +686,214 @@ func TestAddLengthPrefix(t *testing.T) {
 	})
 }
 
+func TestBuildCompositeKey(t *testing.T) {
+	tcs := []struct {
+		name     string
+		in       []interface{}
+		expected []byte
+	}{
+		{"single part", []interface{}{uint64(1)}, []byte{0, 0, 0, 0, 0, 0, 0, 1}},
+		{"mixed parts", []interface{}{uint64(1), []byte{7, 8}, "a"}, []byte{0, 0, 0, 0, 0, 0, 0, 1, 2, 7, 8, 0x61, 0}},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			out := orm.BuildCompositeKey(tc.in...)
+			require.Equal(t, tc.expected, out)
+		})
+	}
+
+	full := orm.BuildCompositeKey([]byte("group"), "member")
+	require.True(t, bytes.HasPrefix(full, orm.BuildCompositeKey([]byte("group"))))
+	require.False(t, bytes.HasPrefix(full, orm.BuildCompositeKey([]byte("grou"))))
+
+	require.Panics(t, func() {
+		orm.BuildCompositeKey(1.5)
+	})
+}
+
+func TestEncodeInt64(t *testing.T) {
+	tcs := []struct {
+		name     string
+		in       int64
+		expected []byte
+	}{
+		{"min", math.MinInt64, []byte{0, 0, 0, 0, 0, 0, 0, 0}},
+		{"minus one", -1, []byte{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{"zero", 0, []byte{0x80, 0, 0, 0, 0, 0, 0, 0}},
+		{"one", 1, []byte{0x80, 0, 0, 0, 0, 0, 0, 1}},
+		{"max", math.MaxInt64, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			out := orm.EncodeInt64(tc.in)
+			require.Equal(t, tc.expected, out)
+			require.Equal(t, tc.in, orm.DecodeInt64(out))
+		})
+	}
+}
+
+func TestInt64PrimaryKeyOrder(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("test")
+	ctx := orm.NewMockContext()
+	store := ctx.KVStore(storeKey)
+
+	values := []int64{42, -1, math.MaxInt64, 0, -300, math.MinInt64, 1, -2}
+	for _, v := range values {
+		store.Set(orm.BuildCompositeKey(v, "suffix"), []byte{})
+	}
+
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	var loaded []int64
+	for ; it.Valid(); it.Next() {
+		loaded = append(loaded, orm.DecodeInt64(it.Key()[:orm.EncodedSeqLength]))
+	}
+	assert.Equal(t, []int64{math.MinInt64, -300, -2, -1, 0, 1, 42, math.MaxInt64}, loaded)
+}
+
+func TestEncodeTime(t *testing.T) {
+	tcs := []struct {
+		name string
+		in   time.Time
+	}{
+		{"zero", time.Time{}},
+		{"epoch", time.Unix(0, 0).UTC()},
+		{"before epoch", time.Date(1600, 2, 3, 4, 5, 6, 7, time.UTC)},
+		{"with nanos", time.Date(2021, 6, 1, 12, 30, 0, 999999999, time.UTC)},
+		{"far future", time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			out := orm.EncodeTime(tc.in)
+			require.Len(t, out, orm.EncodedTimeLength)
+			decoded, err := orm.DecodeTime(out)
+			require.NoError(t, err)
+			require.True(t, tc.in.Equal(decoded), "expected %s but got %s", tc.in, decoded)
+		})
+	}
+
+	_, err := orm.DecodeTime([]byte{1, 2, 3})
+	require.True(t, orm.ErrArgument.Is(err))
+}
+
+func TestTimePrimaryKeyOrder(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("test")
+	ctx := orm.NewMockContext()
+	store := ctx.KVStore(storeKey)
+
+	now := time.Date(2021, 6, 1, 12, 30, 0, 0, time.UTC)
+	expected := []time.Time{
+		{},
+		time.Date(1600, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Unix(-1, 0).UTC(),
+		time.Unix(0, 0).UTC(),
+		now,
+		now.Add(time.Nanosecond),
+		now.Add(time.Second),
+		time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+	}
+	for _, i := range []int{4, 7, 0, 2, 6, 1, 5, 3} {
+		store.Set(orm.BuildCompositeKey(expected[i], uint64(i)), []byte{})
+	}
+
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	var loaded []time.Time
+	for ; it.Valid(); it.Next() {
+		decoded, err := orm.DecodeTime(it.Key()[:orm.EncodedTimeLength])
+		require.NoError(t, err)
+		loaded = append(loaded, decoded)
+	}
+	require.Len(t, loaded, len(expected))
+	for i := range expected {
+		assert.True(t, expected[i].Equal(loaded[i]), "expected %s but got %s", expected[i], loaded[i])
+	}
+}
+
+func TestAddUint16LengthPrefix(t *testing.T) {
+	large := bytes.Repeat([]byte{0xab}, 300)
+	tcs := []struct {
+		name     string
+		in       []byte
+		expected []byte
+	}{
+		{"empty", []byte{}, []byte{0, 0}},
+		{"nil", nil, []byte{0, 0}},
+		{"some data", []byte{0, 1, 100, 200}, []byte{0, 4, 0, 1, 100, 200}},
+		{"300 bytes", large, append([]byte{0x1, 0x2c}, large...)},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			out := orm.AddUint16LengthPrefix(tc.in)
+			require.Equal(t, tc.expected, out)
+
+			decoded, rest, err := orm.DecodeUint16LengthPrefixed(append(out, 0xff))
+			require.NoError(t, err)
+			require.True(t, bytes.Equal(tc.in, decoded))
+			require.Equal(t, []byte{0xff}, rest)
+		})
+	}
+
+	require.Panics(t, func() {
+		orm.AddUint16LengthPrefix(make([]byte, 65536))
+	})
+
+	_, _, err := orm.DecodeUint16LengthPrefixed([]byte{0})
+	require.True(t, orm.ErrArgument.Is(err))
+	_, _, err = orm.DecodeUint16LengthPrefixed([]byte{0, 3, 1, 2})
+	require.True(t, orm.ErrArgument.Is(err))
+}
+
+func TestLongBytesPrimaryKeyOrder(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("test")
+	ctx := orm.NewMockContext()
+	store := ctx.KVStore(storeKey)
+
+	expected := [][]byte{
+		[]byte("a"),
+		[]byte("b"),
+		bytes.Repeat([]byte("a"), 255),
+		bytes.Repeat([]byte("a"), 256),
+		bytes.Repeat([]byte("a"), 300),
+		bytes.Repeat([]byte("b"), 300),
+	}
+	for _, i := range []int{4, 1, 5, 0, 3, 2} {
+		store.Set(orm.BuildCompositeKey(orm.LongBytes(expected[i]), uint64(i)), []byte{})
+	}
+
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	var loaded [][]byte
+	for ; it.Valid(); it.Next() {
+		part, rest, err := orm.DecodeUint16LengthPrefixed(it.Key())
+		require.NoError(t, err)
+		require.Len(t, rest, orm.EncodedSeqLength)
+		loaded = append(loaded, part)
+	}
+	assert.Equal(t, expected, loaded)
+}
+
+func TestBoolPrimaryKeyOrder(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("test")
+	ctx := orm.NewMockContext()
+	store := ctx.KVStore(storeKey)
+
+	require.Equal(t, []byte{2, 0xa, 0xb, 0}, orm.BuildCompositeKey([]byte{0xa, 0xb}, false))
+	require.Equal(t, []byte{2, 0xa, 0xb, 1}, orm.BuildCompositeKey([]byte{0xa, 0xb}, true))
+
+	// rows differing only in the bool part coexist
+	store.Set(orm.BuildCompositeKey([]byte("address"), true), []byte("retired"))
+	store.Set(orm.BuildCompositeKey([]byte("address"), false), []byte("tradable"))
+
+	it := store.Iterator(orm.PrefixRange(orm.BuildCompositeKey([]byte("address"))))
+	defer it.Close()
+	var loaded []string
+	for ; it.Valid(); it.Next() {
+		loaded = append(loaded, string(it.Value()))
+	}
+	assert.Equal(t, []string{"tradable", "retired"}, loaded)
+}
+
 func TestNullTerminatedBytes(t *testing.T) {
 	tcs := []struct {
 		name     string
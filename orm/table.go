@@ -2,13 +2,20 @@ package orm
 
 import (
 	"bytes"
+	"fmt"
 	"reflect"
 
+	metrics "github.com/armon/go-metrics"
+
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gogo/protobuf/proto"
 )
 
 var _ Indexable = &tableBuilder{}
@@ -20,19 +27,21 @@ type tableBuilder struct {
 	indexKeyCodec IndexKeyCodec
 	afterSet      []AfterSetInterceptor
 	afterDelete   []AfterDeleteInterceptor
+	observers     rowObservers
+	name          string
 	cdc           codec.Codec
 }
 
 // newTableBuilder creates a builder to setup a table object.
 func newTableBuilder(prefixData byte, storeKey sdk.StoreKey, model codec.ProtoMarshaler, idxKeyCodec IndexKeyCodec, cdc codec.Codec) (*tableBuilder, error) {
 	if model == nil {
-		return nil, ErrArgument.Wrap("Model must not be nil")
+		return nil, errors.Wrap(ErrArgument, "Model must not be nil")
 	}
 	if storeKey == nil {
-		return nil, ErrArgument.Wrap("StoreKey must not be nil")
+		return nil, errors.Wrap(ErrArgument, "StoreKey must not be nil")
 	}
 	if idxKeyCodec == nil {
-		return nil, ErrArgument.Wrap("IndexKeyCodec must not be nil")
+		return nil, errors.Wrap(ErrArgument, "IndexKeyCodec must not be nil")
 	}
 	tp := reflect.TypeOf(model)
 	if tp.Kind() == reflect.Ptr {
@@ -74,10 +83,18 @@ func (a tableBuilder) Build() table {
 		storeKey:    a.storeKey,
 		afterSet:    a.afterSet,
 		afterDelete: a.afterDelete,
+		observers:   a.observers,
+		name:        a.name,
 		cdc:         a.cdc,
 	}
 }
 
+// SetName sets the name of the table that is used as label for its telemetry metrics.
+// When no name is set, the hex encoded table prefix is used instead.
+func (a *tableBuilder) SetName(name string) {
+	a.name = name
+}
+
 // AddAfterSetInterceptor can be used to register a callback function that is executed after an object is created and/or updated.
 func (a *tableBuilder) AddAfterSetInterceptor(interceptor AfterSetInterceptor) {
 	a.afterSet = append(a.afterSet, interceptor)
@@ -88,6 +105,38 @@ func (a *tableBuilder) AddAfterDeleteInterceptor(interceptor AfterDeleteIntercep
 	a.afterDelete = append(a.afterDelete, interceptor)
 }
 
+// AddAfterCreateObserver registers an observer that is notified with the new object after an object is created.
+func (a *tableBuilder) AddAfterCreateObserver(observer RowObserver) {
+	a.observers.afterCreate = append(a.observers.afterCreate, observer)
+}
+
+// AddAfterUpdateObserver registers an observer that is notified with the new object after an object is updated.
+func (a *tableBuilder) AddAfterUpdateObserver(observer RowObserver) {
+	a.observers.afterUpdate = append(a.observers.afterUpdate, observer)
+}
+
+// AddAfterDeleteObserver registers an observer that is notified with the old object after an object is deleted.
+func (a *tableBuilder) AddAfterDeleteObserver(observer RowObserver) {
+	a.observers.afterDelete = append(a.observers.afterDelete, observer)
+}
+
+// rowObservers holds the observers registered for each row lifecycle event.
+type rowObservers struct {
+	afterCreate []RowObserver
+	afterUpdate []RowObserver
+	afterDelete []RowObserver
+}
+
+// notify calls the observers in registration order and stops at the first error.
+func notify(observers []RowObserver, ctx HasKVStore, rowID RowID, obj codec.ProtoMarshaler) error {
+	for i, o := range observers {
+		if err := o(ctx, rowID, obj); err != nil {
+			return errors.Wrapf(err, "observer %d failed", i)
+		}
+	}
+	return nil
+}
+
 var _ TableExportable = &table{}
 
 // table is the high level object to storage mapper functionality. Persistent
@@ -106,6 +155,8 @@ type table struct {
 	storeKey    sdk.StoreKey
 	afterSet    []AfterSetInterceptor
 	afterDelete []AfterDeleteInterceptor
+	observers   rowObservers
+	name        string
 	cdc         codec.Codec
 }
 
@@ -153,13 +204,27 @@ func (a table) Set(ctx HasKVStore, rowID RowID, newValue codec.ProtoMarshaler) e
 		return err
 	}
 
-	store := prefix.NewStore(ctx.KVStore(a.storeKey), []byte{a.prefix})
-
 	var oldValue codec.ProtoMarshaler
 	if a.Has(ctx, rowID) {
 		oldValue = reflect.New(a.model).Interface().(codec.ProtoMarshaler)
 		a.GetOne(ctx, rowID, oldValue)
 	}
+	return a.set(ctx, rowID, newValue, oldValue)
+}
+
+// set persists the given object under the rowID key and iterates through the registered
+// callbacks with the given oldValue, which must be nil when the key did not exist before.
+func (a table) set(ctx HasKVStore, rowID RowID, newValue, oldValue codec.ProtoMarshaler) error {
+	if err := a.write(ctx, rowID, newValue, oldValue); err != nil {
+		return err
+	}
+	return a.notifySet(ctx, rowID, newValue, oldValue == nil)
+}
+
+// write persists the given object under the rowID key and iterates through the registered
+// interceptors. Observers are not notified.
+func (a table) write(ctx HasKVStore, rowID RowID, newValue, oldValue codec.ProtoMarshaler) error {
+	store := prefix.NewStore(ctx.KVStore(a.storeKey), []byte{a.prefix})
 
 	newValueEncoded, err := a.cdc.Marshal(newValue)
 	if err != nil {
@@ -175,6 +240,87 @@ func (a table) Set(ctx HasKVStore, rowID RowID, newValue codec.ProtoMarshaler) e
 	return nil
 }
 
+// notifySet notifies the create or update observers about the persisted object and
+// emits the telemetry counter of the operation.
+func (a table) notifySet(ctx HasKVStore, rowID RowID, newValue codec.ProtoMarshaler, created bool) error {
+	if created {
+		if err := notify(a.observers.afterCreate, ctx, rowID, newValue); err != nil {
+			return err
+		}
+		a.incrCounter("create")
+		return nil
+	}
+	if err := notify(a.observers.afterUpdate, ctx, rowID, newValue); err != nil {
+		return err
+	}
+	a.incrCounter("update")
+	return nil
+}
+
+// incrCounter emits a telemetry counter for the given table operation. It is a no-op
+// when telemetry is disabled.
+func (a table) incrCounter(op string) {
+	name := a.name
+	if name == "" {
+		name = fmt.Sprintf("%02X", a.prefix)
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{"orm", "table", op},
+		1,
+		[]metrics.Label{telemetry.NewLabel("table", name)},
+	)
+}
+
+// CreateBatch persists all the given objects under their rowID keys. The rowIDs and objects are
+// validated up front, and duplicate keys within the batch or keys that exist already are rejected
+// with an ErrUniqueConstraint.
+// The batch is all-or-nothing: the writes, including the ones of the registered interceptors and
+// create observers, are buffered and only written to the store when all objects were created and
+// all observers succeeded. Observers see the buffered batch, telemetry is only emitted once it is
+// written.
+func (a table) CreateBatch(ctx HasKVStore, rowIDs []RowID, objs []codec.ProtoMarshaler) error {
+	if len(rowIDs) != len(objs) {
+		return errors.Wrapf(ErrArgument, "got %d row IDs for %d objects", len(rowIDs), len(objs))
+	}
+	seen := make(map[string]struct{}, len(rowIDs))
+	for i, rowID := range rowIDs {
+		if len(rowID) == 0 {
+			return errors.Wrapf(ErrEmptyKey, "object %d", i)
+		}
+		if err := assertCorrectType(a.model, objs[i]); err != nil {
+			return errors.Wrapf(err, "object %d", i)
+		}
+		if err := assertValid(objs[i]); err != nil {
+			return errors.Wrapf(err, "object %d", i)
+		}
+		if _, ok := seen[string(rowID)]; ok {
+			return errors.Wrapf(ErrUniqueConstraint, "duplicate key in batch at object %d", i)
+		}
+		seen[string(rowID)] = struct{}{}
+		if a.Has(ctx, rowID) {
+			return errors.Wrapf(ErrUniqueConstraint, "object %d", i)
+		}
+	}
+
+	batchCtx := newBatchContext(ctx)
+	for i, rowID := range rowIDs {
+		if err := a.write(batchCtx, rowID, objs[i], nil); err != nil {
+			return errors.Wrapf(err, "object %d", i)
+		}
+	}
+	for i, rowID := range rowIDs {
+		if err := notify(a.observers.afterCreate, batchCtx, rowID, objs[i]); err != nil {
+			return errors.Wrapf(err, "object %d", i)
+		}
+	}
+	batchCtx.write()
+
+	for range rowIDs {
+		a.incrCounter("create")
+	}
+	return nil
+}
+
 func assertValid(obj codec.ProtoMarshaler) error {
 	if v, ok := obj.(Validateable); ok {
 		if err := v.ValidateBasic(); err != nil {
@@ -204,6 +350,10 @@ func (a table) Delete(ctx HasKVStore, rowID RowID) error {
 			return errors.Wrapf(err, "delete interceptor %d failed", i)
 		}
 	}
+	if err := notify(a.observers.afterDelete, ctx, rowID, oldValue); err != nil {
+		return err
+	}
+	a.incrCounter("delete")
 	return nil
 }
 
@@ -279,6 +429,53 @@ func (a table) ReversePrefixScan(ctx HasKVStore, start, end RowID) (Iterator, er
 	}, nil
 }
 
+// PaginatedPrefixScan returns an Iterator over the page of rows whose key starts with the given prefix,
+// together with the matching PageResponse. An empty prefix selects the whole table. The page request
+// honors key, offset, limit and count-total the same way as Paginate does: the returned NextKey is the
+// row ID to pass as key to load the following page. Keys and values of the page are loaded eagerly so
+// that the returned Iterator does not hold an iterator over the underlying store.
+func (a table) PaginatedPrefixScan(ctx HasKVStore, prefixKey []byte, pageRequest *query.PageRequest) (Iterator, *query.PageResponse, error) {
+	pageRequest, err := normalizePageRequest(pageRequest)
+	if err != nil {
+		return NewInvalidIterator(), nil, err
+	}
+
+	if prefixKey == nil {
+		prefixKey = []byte{}
+	}
+	start, end := PrefixRange(prefixKey)
+	if len(pageRequest.Key) != 0 {
+		if !bytes.HasPrefix(pageRequest.Key, prefixKey) {
+			return NewInvalidIterator(), nil, errors.Wrap(ErrArgument, "key must start with prefix")
+		}
+		start = pageRequest.Key
+	}
+
+	store := prefix.NewStore(ctx.KVStore(a.storeKey), []byte{a.prefix})
+	it := store.Iterator(start, end)
+	defer it.Close()
+	rowIDs, values, res := loadPage(it, pageRequest)
+
+	var pos int
+	return IteratorFunc(func(dest codec.ProtoMarshaler) (RowID, error) {
+		if pos >= len(rowIDs) {
+			return nil, ErrIteratorDone
+		}
+		if err := assertCorrectType(a.model, dest); err != nil {
+			return nil, err
+		}
+		rowID, value := rowIDs[pos], values[pos]
+		pos++
+		return rowID, a.cdc.Unmarshal(value, dest)
+	}), res, nil
+}
+
+func copyBytes(bz []byte) []byte {
+	res := make([]byte, len(bz))
+	copy(res, bz)
+	return res
+}
+
 // Export stores all the values in the table in the passed ModelSlicePtr.
 func (a table) Export(ctx HasKVStore, dest ModelSlicePtr) (uint64, error) {
 	it, err := a.PrefixScan(ctx, nil, nil)
@@ -292,6 +489,52 @@ func (a table) Export(ctx HasKVStore, dest ModelSlicePtr) (uint64, error) {
 	return 0, nil
 }
 
+// ExportStream calls fn for every value in the table in ascending RowID order, without
+// loading all values into memory at once. The iteration is aborted with the error returned
+// by fn, if any.
+func (a table) ExportStream(ctx HasKVStore, fn func(rowID RowID, obj proto.Message) error) error {
+	store := prefix.NewStore(ctx.KVStore(a.storeKey), []byte{a.prefix})
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		obj := reflect.New(a.model).Interface().(codec.ProtoMarshaler)
+		if err := a.cdc.Unmarshal(it.Value(), obj); err != nil {
+			return errors.Wrapf(err, "failed to deserialize %T", obj)
+		}
+		if err := fn(copyBytes(it.Key()), obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RebuildIndexes runs the registered AfterSetInterceptors for all values in the table as if
+// they were just created. This allows to populate secondary indexes from scratch, e.g. after the
+// table was moved with MigrateTablePrefix and the index entries were dropped. The index entries
+// must not exist already as unique indexes would fail with an ErrUniqueConstraint otherwise.
+func (a table) RebuildIndexes(ctx HasKVStore) error {
+	var (
+		rowIDs []RowID
+		values []codec.ProtoMarshaler
+	)
+	err := a.ExportStream(ctx, func(rowID RowID, obj proto.Message) error {
+		rowIDs = append(rowIDs, rowID)
+		values = append(values, obj.(codec.ProtoMarshaler))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for i, rowID := range rowIDs {
+		for j, itc := range a.afterSet {
+			if err := itc(ctx, rowID, values[i], nil); err != nil {
+				return errors.Wrapf(err, "interceptor %d failed", j)
+			}
+		}
+	}
+	return nil
+}
+
 // Import clears the table and initializes it from the given data interface{}.
 // data should be a slice of structs that implement PrimaryKeyed.
 func (a table) Import(ctx HasKVStore, data interface{}, _ uint64) error {
@@ -326,6 +569,35 @@ func (a table) Import(ctx HasKVStore, data interface{}, _ uint64) error {
 	return nil
 }
 
+// batchContext buffers the writes to the stores of the wrapped context, so that
+// they can be either written all at once or discarded.
+type batchContext struct {
+	parent HasKVStore
+	stores map[sdk.StoreKey]types.CacheKVStore
+	keys   []sdk.StoreKey
+}
+
+func newBatchContext(parent HasKVStore) *batchContext {
+	return &batchContext{parent: parent, stores: make(map[sdk.StoreKey]types.CacheKVStore)}
+}
+
+func (c *batchContext) KVStore(key sdk.StoreKey) sdk.KVStore {
+	if s, ok := c.stores[key]; ok {
+		return s
+	}
+	s := cachekv.NewStore(c.parent.KVStore(key))
+	c.stores[key] = s
+	c.keys = append(c.keys, key)
+	return s
+}
+
+// write flushes the buffered writes to the parent stores in the order the stores were first accessed.
+func (c *batchContext) write() {
+	for _, key := range c.keys {
+		c.stores[key].Write()
+	}
+}
+
 // typeSafeIterator is initialized with a type safe RowGetter only.
 type typeSafeIterator struct {
 	ctx       HasKVStore
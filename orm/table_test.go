@@ -1,6 +1,7 @@
 package orm_test
 
 import (
+	stderrors "errors"
 	"fmt"
 	"testing"
 
@@ -201,3 +202,136 @@ func TestUpdate(t *testing.T) {
 	}
 
 }
+
+func TestTableErrorsIs(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	storeKey := sdk.NewKVStoreKey("test")
+	const anyPrefix = 0x10
+	tableBuilder, err := orm.TestTableBuilder(anyPrefix, storeKey, &testdata.GroupInfo{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+	myTable := tableBuilder.Build()
+
+	ctx := orm.NewMockContext()
+	value := testdata.GroupInfo{
+		Description: "my group",
+		Admin:       sdk.AccAddress([]byte("my-admin-address")),
+	}
+	require.NoError(t, myTable.Create(ctx, []byte("my-id"), &value))
+
+	var loaded testdata.GroupInfo
+	err = myTable.GetOne(ctx, []byte("unknown-id"), &loaded)
+	require.True(t, stderrors.Is(err, orm.ErrNotFound), "got %v", err)
+
+	err = myTable.GetOne(ctx, nil, &loaded)
+	require.True(t, stderrors.Is(err, orm.ErrNotFound), "got %v", err)
+
+	err = myTable.Create(ctx, []byte("my-id"), &value)
+	require.True(t, stderrors.Is(err, orm.ErrUniqueConstraint), "got %v", err)
+
+	err = myTable.Update(ctx, []byte("unknown-id"), &value)
+	require.True(t, stderrors.Is(err, orm.ErrNotFound), "got %v", err)
+
+	err = myTable.Delete(ctx, []byte("unknown-id"))
+	require.True(t, stderrors.Is(err, orm.ErrNotFound), "got %v", err)
+	require.False(t, stderrors.Is(err, orm.ErrUniqueConstraint))
+
+	it, err := myTable.PrefixScan(ctx, nil, nil)
+	require.NoError(t, err)
+	defer it.Close()
+	_, err = it.LoadNext(&loaded)
+	require.NoError(t, err)
+	_, err = it.LoadNext(&loaded)
+	require.True(t, stderrors.Is(err, orm.ErrIteratorDone), "got %v", err)
+
+	_, err = orm.TestTableBuilder(anyPrefix, storeKey, nil, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.True(t, stderrors.Is(err, orm.ErrArgument), "got %v", err)
+}
+
+func TestRowObservers(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	storeKey := sdk.NewKVStoreKey("test")
+	builder, err := orm.NewPrimaryKeyTableBuilder(0x1, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+
+	var (
+		events  []string
+		failOn  string
+		errFail = fmt.Errorf("observer failure")
+	)
+	observer := func(name string) orm.RowObserver {
+		return func(ctx orm.HasKVStore, rowID orm.RowID, obj codec.ProtoMarshaler) error {
+			events = append(events, fmt.Sprintf("%s:%d", name, obj.(*testdata.GroupMember).Weight))
+			if name == failOn {
+				return errFail
+			}
+			return nil
+		}
+	}
+	builder.AddAfterCreateObserver(observer("create-1"))
+	builder.AddAfterCreateObserver(observer("create-2"))
+	builder.AddAfterUpdateObserver(observer("update-1"))
+	builder.AddAfterUpdateObserver(observer("update-2"))
+	builder.AddAfterDeleteObserver(observer("delete-1"))
+	builder.AddAfterDeleteObserver(observer("delete-2"))
+	tb := builder.Build()
+
+	ctx := orm.NewMockContext()
+	m := testdata.GroupMember{Group: []byte("group"), Member: []byte("member"), Weight: 1}
+
+	// observers fire in registration order
+	require.NoError(t, tb.Create(ctx, &m))
+	m.Weight = 2
+	require.NoError(t, tb.Update(ctx, &m))
+	m.Weight = 3
+	require.NoError(t, tb.Set(ctx, &m))
+	require.NoError(t, tb.Delete(ctx, &m))
+	assert.Equal(t, []string{
+		"create-1:1", "create-2:1",
+		"update-1:2", "update-2:2",
+		"update-1:3", "update-2:3",
+		"delete-1:3", "delete-2:3",
+	}, events)
+
+	// an error propagates out of the mutating call and stops further observers
+	specs := map[string]struct {
+		failOn    string
+		setup     func(ctx orm.HasKVStore)
+		mutate    func(ctx orm.HasKVStore) error
+		expEvents []string
+	}{
+		"create": {
+			failOn:    "create-1",
+			mutate:    func(ctx orm.HasKVStore) error { return tb.Create(ctx, &m) },
+			expEvents: []string{"create-1:3"},
+		},
+		"update": {
+			failOn:    "update-2",
+			setup:     func(ctx orm.HasKVStore) { require.NoError(t, tb.Create(ctx, &m)) },
+			mutate:    func(ctx orm.HasKVStore) error { return tb.Update(ctx, &m) },
+			expEvents: []string{"update-1:3", "update-2:3"},
+		},
+		"delete": {
+			failOn:    "delete-1",
+			setup:     func(ctx orm.HasKVStore) { require.NoError(t, tb.Create(ctx, &m)) },
+			mutate:    func(ctx orm.HasKVStore) error { return tb.Delete(ctx, &m) },
+			expEvents: []string{"delete-1:3"},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx := orm.NewMockContext()
+			failOn = ""
+			if spec.setup != nil {
+				spec.setup(ctx)
+			}
+			events, failOn = nil, spec.failOn
+			err := spec.mutate(ctx)
+			require.ErrorIs(t, err, errFail)
+			assert.Equal(t, spec.expEvents, events)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package orm_test
+
+import (
+	"testing"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/orm/testdata"
+)
+
+func TestTelemetry(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	storeKey := sdk.NewKVStoreKey("test")
+
+	builder, err := orm.NewPrimaryKeyTableBuilder(0x1, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+	builder.SetName("members")
+	idx, err := orm.NewUniqueIndex(builder, 0x2, func(val interface{}) (orm.RowID, error) {
+		return orm.EncodeSequence(val.(*testdata.GroupMember).Weight), nil
+	})
+	require.NoError(t, err)
+	tb := builder.Build()
+
+	// run the same operations with telemetry disabled and enabled
+	run := func() ([]testdata.GroupMember, bool) {
+		ctx := orm.NewMockContext()
+		m1 := testdata.GroupMember{Group: []byte("group"), Member: []byte("member-one"), Weight: 1}
+		m2 := testdata.GroupMember{Group: []byte("group"), Member: []byte("member-two"), Weight: 2}
+		require.NoError(t, tb.Create(ctx, &m1))
+		require.NoError(t, tb.Create(ctx, &m2))
+		m1.Weight = 3
+		require.NoError(t, tb.Update(ctx, &m1))
+		// failing operations are not counted
+		m2.Weight = 3
+		require.True(t, orm.ErrUniqueConstraint.Is(tb.Update(ctx, &m2)))
+		require.NoError(t, tb.Delete(ctx, &m1))
+
+		it, err := tb.PrefixScan(ctx, nil, nil)
+		require.NoError(t, err)
+		var loaded []testdata.GroupMember
+		_, err = orm.ReadAll(it, &loaded)
+		require.NoError(t, err)
+		has, err := idx.Has(ctx, orm.EncodeSequence(3))
+		require.NoError(t, err)
+		return loaded, has
+	}
+	expLoaded, expHas := run()
+
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("test")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err = metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = metrics.NewGlobal(cfg, &metrics.BlackholeSink{})
+	})
+
+	loaded, has := run()
+	assert.Equal(t, expLoaded, loaded)
+	assert.Equal(t, expHas, has)
+
+	counters := sink.Data()[0].Counters
+	counts := make(map[string]int, len(counters))
+	for k, v := range counters {
+		counts[k] = v.Count
+	}
+	assert.Equal(t, map[string]int{
+		"test.orm.table.create;table=members": 2,
+		"test.orm.table.update;table=members": 1,
+		"test.orm.table.delete;table=members": 1,
+		"test.orm.index.create;index=02":      2,
+		"test.orm.index.update;index=02":      1,
+		"test.orm.index.delete;index=02":      1,
+	}, counts)
+}
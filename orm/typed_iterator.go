@@ -0,0 +1,36 @@
+package orm
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// LoadAll consumes all values of the iterator into a new slice of T and returns it together
+// with the RowIDs. New values are allocated with alloc, e.g. `func() *GroupMember { return &GroupMember{} }`.
+// The slice can be empty when the iterator does not return any values but not nil. The iterator
+// is closed afterwards, also when loading a value fails. Values are decoded by the iterator with
+// the codec of its table, so no codec is passed in.
+// Example:
+//			members, _, err := LoadAll(it, func() *GroupMember { return &GroupMember{} })
+func LoadAll[T codec.ProtoMarshaler](it Iterator, alloc func() T) ([]T, []RowID, error) {
+	if it == nil {
+		return nil, nil, errors.Wrap(ErrArgument, "iterator must not be nil")
+	}
+	defer it.Close()
+
+	res := make([]T, 0)
+	var rowIDs []RowID
+	for {
+		obj := alloc()
+		rowID, err := it.LoadNext(obj)
+		switch {
+		case err == nil:
+			res = append(res, obj)
+			rowIDs = append(rowIDs, rowID)
+		case ErrIteratorDone.Is(err):
+			return res, rowIDs, nil
+		default:
+			return nil, nil, errors.Wrapf(err, "load value %d", len(res))
+		}
+	}
+}
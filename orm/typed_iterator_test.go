@@ -0,0 +1,89 @@
+package orm_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/orm/testdata"
+)
+
+func TestLoadAll(t *testing.T) {
+	newMember := func() *testdata.GroupMember { return &testdata.GroupMember{} }
+	members := []*testdata.GroupMember{
+		{Group: []byte("group"), Member: []byte("member-one"), Weight: 1},
+		{Group: []byte("group"), Member: []byte("member-two"), Weight: 2},
+	}
+
+	specs := map[string]struct {
+		src       []*testdata.GroupMember
+		failAt    int
+		expResult []*testdata.GroupMember
+		expRowIDs []orm.RowID
+		expErr    bool
+	}{
+		"empty": {
+			failAt:    -1,
+			expResult: []*testdata.GroupMember{},
+		},
+		"all values": {
+			src:       members,
+			failAt:    -1,
+			expResult: members,
+			expRowIDs: []orm.RowID{orm.PrimaryKey(members[0]), orm.PrimaryKey(members[1])},
+		},
+		"decode error mid-stream": {
+			src:    members,
+			failAt: 1,
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			it := &closeTrackingIterator{src: spec.src, failAt: spec.failAt}
+			loaded, rowIDs, err := orm.LoadAll(it, newMember)
+			assert.True(t, it.closed)
+			if spec.expErr {
+				require.True(t, orm.ErrType.Is(err), err)
+				assert.Nil(t, loaded)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expResult, loaded)
+			assert.Equal(t, spec.expRowIDs, rowIDs)
+		})
+	}
+
+	_, _, err := orm.LoadAll(nil, newMember)
+	require.True(t, orm.ErrArgument.Is(err))
+}
+
+// closeTrackingIterator returns the src values and records when it was closed.
+// LoadNext fails with an ErrType at position failAt.
+type closeTrackingIterator struct {
+	src    []*testdata.GroupMember
+	pos    int
+	failAt int
+	closed bool
+}
+
+func (i *closeTrackingIterator) LoadNext(dest codec.ProtoMarshaler) (orm.RowID, error) {
+	if i.pos >= len(i.src) {
+		return nil, orm.ErrIteratorDone
+	}
+	if i.pos == i.failAt {
+		return nil, orm.ErrType
+	}
+	m := i.src[i.pos]
+	i.pos++
+	*dest.(*testdata.GroupMember) = *m
+	return orm.PrimaryKey(m), nil
+}
+
+func (i *closeTrackingIterator) Close() error {
+	i.closed = true
+	return nil
+}
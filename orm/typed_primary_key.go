@@ -0,0 +1,69 @@
+package orm
+
+import (
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TypedPrimaryKeyTable wraps a PrimaryKeyTable and provides methods that take and return
+// the concrete model type T, so that callers do not need to cast values or pass destination
+// objects. T must be a pointer to the model type of the underlying table, e.g. *GroupMember.
+type TypedPrimaryKeyTable[T PrimaryKeyed] struct {
+	table PrimaryKeyTable
+	model reflect.Type
+}
+
+// NewTypedPrimaryKeyTable creates a TypedPrimaryKeyTable for the given table. It returns an
+// ErrType when T is not a pointer to the model type of the table.
+func NewTypedPrimaryKeyTable[T PrimaryKeyed](table PrimaryKeyTable) (TypedPrimaryKeyTable[T], error) {
+	tp := reflect.TypeOf((*T)(nil)).Elem()
+	if tp.Kind() != reflect.Ptr || tp.Elem() != table.table.model {
+		return TypedPrimaryKeyTable[T]{}, errors.Wrapf(ErrType, "can not use %s with this table", tp)
+	}
+	return TypedPrimaryKeyTable[T]{table: table, model: tp.Elem()}, nil
+}
+
+// Get loads the object persisted for the given primary key. If none exists `ErrNotFound` is returned.
+func (a TypedPrimaryKeyTable[T]) Get(ctx HasKVStore, primKey RowID) (T, error) {
+	obj := reflect.New(a.model).Interface().(T)
+	if err := a.table.GetOne(ctx, primKey, obj); err != nil {
+		var empty T
+		return empty, err
+	}
+	return obj, nil
+}
+
+// Create persists the given object under its primary key. It checks if the
+// key already exists and may return an `ErrUniqueConstraint`.
+func (a TypedPrimaryKeyTable[T]) Create(ctx HasKVStore, obj T) error {
+	return a.table.Create(ctx, obj)
+}
+
+// Update updates the given object under its primary key. It expects the key to
+// exist already and fails with an `ErrNotFound` otherwise.
+func (a TypedPrimaryKeyTable[T]) Update(ctx HasKVStore, obj T) error {
+	return a.table.Update(ctx, obj)
+}
+
+// Set persists the given object under its primary key. It does not check if the
+// key already exists and overwrites the value if it does.
+func (a TypedPrimaryKeyTable[T]) Set(ctx HasKVStore, obj T) error {
+	return a.table.Set(ctx, obj)
+}
+
+// Delete removes the object. It expects the primary key to exist already and
+// fails with an `ErrNotFound` otherwise.
+func (a TypedPrimaryKeyTable[T]) Delete(ctx HasKVStore, obj T) error {
+	return a.table.Delete(ctx, obj)
+}
+
+// Has checks if a key exists.
+func (a TypedPrimaryKeyTable[T]) Has(ctx HasKVStore, primKey RowID) bool {
+	return a.table.Has(ctx, primKey)
+}
+
+// Table returns the underlying untyped PrimaryKeyTable, e.g. to scan or export it.
+func (a TypedPrimaryKeyTable[T]) Table() PrimaryKeyTable {
+	return a.table
+}
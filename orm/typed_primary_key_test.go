@@ -0,0 +1,72 @@
+package orm_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/orm/testdata"
+)
+
+func TestTypedPrimaryKeyTable(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	storeKey := sdk.NewKVStoreKey("test")
+	builder, err := orm.NewPrimaryKeyTableBuilder(0x1, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+	untyped := builder.Build()
+
+	tb, err := orm.NewTypedPrimaryKeyTable[*testdata.GroupMember](untyped)
+	require.NoError(t, err)
+
+	_, err = orm.NewTypedPrimaryKeyTable[*testdata.GroupInfo](untyped)
+	require.True(t, orm.ErrType.Is(err))
+
+	ctx := orm.NewMockContext()
+	m := &testdata.GroupMember{Group: []byte("group"), Member: []byte("member"), Weight: 1}
+	key := orm.PrimaryKey(m)
+
+	// not found
+	_, err = tb.Get(ctx, key)
+	require.True(t, orm.ErrNotFound.Is(err))
+	require.True(t, orm.ErrNotFound.Is(tb.Update(ctx, m)))
+	assert.False(t, tb.Has(ctx, key))
+
+	// create
+	require.NoError(t, tb.Create(ctx, m))
+	require.True(t, orm.ErrUniqueConstraint.Is(tb.Create(ctx, m)))
+	loaded, err := tb.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, m, loaded)
+	assert.Equal(t, uint64(1), loaded.Weight)
+
+	// the untyped table sees the same data
+	var untypedLoaded testdata.GroupMember
+	require.NoError(t, untyped.GetOne(ctx, key, &untypedLoaded))
+	assert.Equal(t, *m, untypedLoaded)
+
+	// update and set
+	m.Weight = 2
+	require.NoError(t, tb.Update(ctx, m))
+	loaded, err = tb.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), loaded.Weight)
+	m.Weight = 3
+	require.NoError(t, tb.Set(ctx, m))
+	loaded, err = tb.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), loaded.Weight)
+
+	// delete
+	require.NoError(t, tb.Delete(ctx, m))
+	assert.False(t, tb.Has(ctx, key))
+	assert.False(t, untyped.Has(ctx, key))
+	require.True(t, orm.ErrNotFound.Is(tb.Delete(ctx, m)))
+	assert.Equal(t, untyped, tb.Table())
+}
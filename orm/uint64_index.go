@@ -38,8 +38,9 @@ func NewUInt64Index(builder Indexable, prefix byte, indexer UInt64IndexerFunc) (
 	}, nil
 }
 
-// Has checks if a key exists. Panics on nil key.
-func (i UInt64Index) Has(ctx HasKVStore, key uint64) bool {
+// Has checks if at least one row is indexed under the key, without loading
+// any row.
+func (i UInt64Index) Has(ctx HasKVStore, key uint64) (bool, error) {
 	return i.multiKeyIndex.Has(ctx, EncodeSequence(key))
 }
 
@@ -55,6 +56,13 @@ func (i UInt64Index) GetPaginated(ctx HasKVStore, searchKey uint64, pageRequest
 	return i.multiKeyIndex.GetPaginated(ctx, EncodeSequence(searchKey), pageRequest)
 }
 
+// GetPaginatedReverse returns an Iterator over a single page of the objects for the searchKey
+// in descending RowID order, together with the PageResponse to use for loading the next page.
+// The pageRequest.Key is the rowID to start from while searchKey is a MultiKeyIndex key.
+func (i UInt64Index) GetPaginatedReverse(ctx HasKVStore, searchKey uint64, pageRequest *query.PageRequest) (Iterator, *query.PageResponse, error) {
+	return i.multiKeyIndex.GetPaginatedReverse(ctx, EncodeSequence(searchKey), pageRequest)
+}
+
 // PrefixScan returns an Iterator over a domain of keys in ascending order. End is exclusive.
 // Start is an MultiKeyIndex key or prefix. It must be less than end, or the Iterator is invalid and error is returned.
 // Iterator must be closed by caller.
@@ -43,7 +43,9 @@ func TestUInt64Index(t *testing.T) {
 	indexedKey := uint64('m')
 
 	// Has
-	assert.True(t, myIndex.Has(ctx, indexedKey))
+	exists, err := myIndex.Has(ctx, indexedKey)
+	require.NoError(t, err)
+	assert.True(t, exists)
 
 	// Get
 	it, err := myIndex.Get(ctx, indexedKey)
@@ -113,6 +115,107 @@ func TestUInt64Index(t *testing.T) {
 	require.Error(t, orm.ErrIteratorDone, err)
 }
 
+func TestUInt64IndexGetPaginatedReverse(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	storeKey := sdk.NewKVStoreKey("test")
+
+	const anyPrefix = 0x10
+	tableBuilder, err := orm.NewPrimaryKeyTableBuilder(anyPrefix, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+	myIndex, err := orm.NewUInt64Index(tableBuilder, GroupMemberByMemberIndexPrefix, func(val interface{}) ([]uint64, error) {
+		return []uint64{val.(*testdata.GroupMember).Weight}, nil
+	})
+	require.NoError(t, err)
+	myTable := tableBuilder.Build()
+
+	ctx := orm.NewMockContext()
+
+	members := make([]testdata.GroupMember, 5)
+	for i := range members {
+		members[i] = testdata.GroupMember{
+			Group:  sdk.AccAddress(orm.EncodeSequence(1)),
+			Member: sdk.AccAddress(orm.EncodeSequence(uint64(i))),
+			Weight: 1,
+		}
+	}
+	// a member with another weight must not be included
+	members[4].Weight = 2
+	for i := range members {
+		require.NoError(t, myTable.Create(ctx, &members[i]))
+	}
+	m0, m1, m2, m3 := members[0], members[1], members[2], members[3]
+
+	specs := map[string]struct {
+		pageReq    *query.PageRequest
+		expResult  []testdata.GroupMember
+		expNextKey []byte
+		expTotal   uint64
+		expErr     bool
+	}{
+		"nil page request": {
+			expResult: []testdata.GroupMember{m3, m2, m1, m0},
+			expTotal:  4,
+		},
+		"first page": {
+			pageReq:    &query.PageRequest{Limit: 2},
+			expResult:  []testdata.GroupMember{m3, m2},
+			expNextKey: orm.PrimaryKey(&m1),
+		},
+		"second page by key": {
+			pageReq:   &query.PageRequest{Key: orm.PrimaryKey(&m1), Limit: 2},
+			expResult: []testdata.GroupMember{m1, m0},
+		},
+		"offset with count total": {
+			pageReq:    &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true},
+			expResult:  []testdata.GroupMember{m2, m1},
+			expNextKey: orm.PrimaryKey(&m0),
+			expTotal:   4,
+		},
+		"both key and offset": {
+			pageReq: &query.PageRequest{Key: orm.PrimaryKey(&m1), Offset: 1},
+			expErr:  true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			it, res, err := myIndex.GetPaginatedReverse(ctx, 1, spec.pageReq)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var loaded []testdata.GroupMember
+			_, err = orm.ReadAll(it, &loaded)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expResult, loaded)
+			assert.Equal(t, spec.expNextKey, res.NextKey)
+			assert.Equal(t, spec.expTotal, res.Total)
+		})
+	}
+
+	t.Run("walk all pages by key", func(t *testing.T) {
+		var (
+			all     []testdata.GroupMember
+			nextKey []byte
+		)
+		for {
+			it, res, err := myIndex.GetPaginatedReverse(ctx, 1, &query.PageRequest{Key: nextKey, Limit: 3})
+			require.NoError(t, err)
+			var loaded []testdata.GroupMember
+			_, err = orm.ReadAll(it, &loaded)
+			require.NoError(t, err)
+			all = append(all, loaded...)
+			if res.NextKey == nil {
+				break
+			}
+			nextKey = res.NextKey
+		}
+		assert.Equal(t, []testdata.GroupMember{m3, m2, m1, m0}, all)
+	})
+}
+
 func TestUInt64MultiKeyAdapter(t *testing.T) {
 	specs := map[string]struct {
 		srcFunc orm.UInt64IndexerFunc
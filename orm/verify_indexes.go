@@ -0,0 +1,136 @@
+package orm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+)
+
+// IndexedTable is implemented by the tables of this package, PrimaryKeyTable and AutoUInt64Table.
+type IndexedTable interface {
+	rowTable() table
+}
+
+// VerifiableIndex is implemented by the indexes of this package, MultiKeyIndex, UniqueIndex and UInt64Index.
+type VerifiableIndex interface {
+	baseIndex() MultiKeyIndex
+}
+
+func (a PrimaryKeyTable) rowTable() table { return a.table }
+
+func (a AutoUInt64Table) rowTable() table { return a.table }
+
+func (i MultiKeyIndex) baseIndex() MultiKeyIndex { return i }
+
+func (i UInt64Index) baseIndex() MultiKeyIndex { return i.multiKeyIndex }
+
+// VerifyIndexes walks all rows of the table and checks that the entries stored for each of the given
+// indexes exactly match the keys returned by the index callback for the rows. All missing entries and
+// orphaned entries that don't belong to any row are reported within an ErrIndexOutOfSync. This is meant
+// to be used as an invariant, e.g. after a migration.
+//
+// WARNING: VerifyIndexes loads all index keys of the table into memory and can be very expensive in terms
+// of Gas. Please make sure you do not expose this as an endpoint to the public.
+func VerifyIndexes(ctx HasKVStore, tbl IndexedTable, indexes ...VerifiableIndex) error {
+	idxs := make([]MultiKeyIndex, len(indexes))
+	indexerFuncs := make([]IndexerFunc, len(indexes))
+	expected := make([]map[string]RowID, len(indexes))
+	for i := range indexes {
+		idxs[i] = indexes[i].baseIndex()
+		ixr, ok := idxs[i].indexer.(*Indexer)
+		if !ok {
+			return errors.Wrapf(ErrArgument, "index %02X: unsupported indexer %T", idxs[i].prefix, idxs[i].indexer)
+		}
+		// empty keys are never persisted
+		indexerFuncs[i] = pruneEmptyKeys(ixr.indexerFunc)
+		expected[i] = make(map[string]RowID)
+	}
+
+	err := tbl.rowTable().ExportStream(ctx, func(rowID RowID, obj proto.Message) error {
+		for i, idx := range idxs {
+			keys, err := indexerFuncs[i](obj)
+			if err != nil {
+				return errors.Wrapf(err, "index %02X: row %X", idx.prefix, rowID)
+			}
+			for _, key := range keys {
+				expected[i][string(idx.indexKeyCodec.BuildIndexKey(key, rowID))] = rowID
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var problems []string
+	for i, idx := range idxs {
+		store := prefix.NewStore(ctx.KVStore(idx.storeKey), []byte{idx.prefix})
+		it := store.Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			if _, ok := expected[i][string(it.Key())]; ok {
+				delete(expected[i], string(it.Key()))
+				continue
+			}
+			problems = append(problems, fmt.Sprintf("index %02X: orphaned entry %X", idx.prefix, it.Key()))
+		}
+		it.Close()
+
+		missing := make([]string, 0, len(expected[i]))
+		for key := range expected[i] {
+			missing = append(missing, key)
+		}
+		sort.Strings(missing)
+		for _, key := range missing {
+			problems = append(problems, fmt.Sprintf("index %02X: missing entry %X for row %X", idx.prefix, key, expected[i][key]))
+		}
+	}
+	if len(problems) != 0 {
+		return errors.Wrap(ErrIndexOutOfSync, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// RebuildIndex drops all entries of the given index and recreates them from the rows of the table, leaving
+// all other indexes of the table untouched. This can be used in a migration that adds an index to a table
+// with existing rows, or that changes the keys returned by the index callback.
+//
+// WARNING: RebuildIndex loads the whole table into memory and can be very expensive in terms of Gas. Please
+// make sure you do not expose this as an endpoint to the public.
+func RebuildIndex(ctx HasKVStore, tbl IndexedTable, index VerifiableIndex) error {
+	idx := index.baseIndex()
+	store := prefix.NewStore(ctx.KVStore(idx.storeKey), []byte{idx.prefix})
+
+	var (
+		rowIDs []RowID
+		values []proto.Message
+	)
+	err := tbl.rowTable().ExportStream(ctx, func(rowID RowID, obj proto.Message) error {
+		rowIDs = append(rowIDs, rowID)
+		values = append(values, obj)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var staleKeys [][]byte
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		staleKeys = append(staleKeys, copyBytes(it.Key()))
+	}
+	it.Close()
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+
+	for i, rowID := range rowIDs {
+		if err := idx.indexer.OnCreate(store, rowID, values[i]); err != nil {
+			return errors.Wrapf(err, "index %02X: row %X", idx.prefix, rowID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package orm_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/orm/testdata"
+)
+
+func TestVerifyIndexes(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	storeKey := sdk.NewKVStoreKey("test")
+
+	const (
+		tablePrefix byte = iota
+		groupIndexPrefix
+		weightIndexPrefix
+	)
+	builder, err := orm.NewPrimaryKeyTableBuilder(tablePrefix, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+	groupIdx, err := orm.NewIndex(builder, groupIndexPrefix, func(val interface{}) ([]orm.RowID, error) {
+		return []orm.RowID{[]byte(val.(*testdata.GroupMember).Group)}, nil
+	})
+	require.NoError(t, err)
+	weightIdx, err := orm.NewUInt64Index(builder, weightIndexPrefix, func(val interface{}) ([]uint64, error) {
+		return []uint64{val.(*testdata.GroupMember).Weight}, nil
+	})
+	require.NoError(t, err)
+	tb := builder.Build()
+
+	members := []testdata.GroupMember{
+		{Group: []byte("group-a"), Member: []byte("member-one"), Weight: 1},
+		{Group: []byte("group-a"), Member: []byte("member-two"), Weight: 2},
+		{Group: []byte("group-b"), Member: []byte("member-one"), Weight: 3},
+	}
+	keyCodec := orm.Max255DynamicLengthIndexKeyCodec{}
+	groupIndexKey := func(m testdata.GroupMember) []byte {
+		return keyCodec.BuildIndexKey(m.Group, orm.PrimaryKey(&m))
+	}
+	groupIndexStore := func(ctx orm.HasKVStore) sdk.KVStore {
+		return prefix.NewStore(ctx.KVStore(storeKey), []byte{groupIndexPrefix})
+	}
+
+	specs := map[string]struct {
+		empty     bool
+		malleate  func(ctx orm.HasKVStore)
+		expErrMsg []string
+	}{
+		"empty table": {
+			empty:    true,
+			malleate: func(ctx orm.HasKVStore) {},
+		},
+		"clean table": {
+			malleate: func(ctx orm.HasKVStore) {},
+		},
+		"orphaned entry": {
+			malleate: func(ctx orm.HasKVStore) {
+				m := testdata.GroupMember{Group: []byte("group-c"), Member: []byte("member-one")}
+				groupIndexStore(ctx).Set(groupIndexKey(m), []byte{})
+			},
+			expErrMsg: []string{"index 01: orphaned entry"},
+		},
+		"missing entry": {
+			malleate: func(ctx orm.HasKVStore) {
+				groupIndexStore(ctx).Delete(groupIndexKey(members[1]))
+			},
+			expErrMsg: []string{"index 01: missing entry"},
+		},
+		"corrupted entry": {
+			malleate: func(ctx orm.HasKVStore) {
+				store := groupIndexStore(ctx)
+				store.Delete(groupIndexKey(members[2]))
+				corrupted := members[2]
+				corrupted.Group = []byte("group-c")
+				store.Set(groupIndexKey(corrupted), []byte{})
+			},
+			expErrMsg: []string{"index 01: orphaned entry", "index 01: missing entry"},
+		},
+		"missing uint64 index entry": {
+			malleate: func(ctx orm.HasKVStore) {
+				store := prefix.NewStore(ctx.KVStore(storeKey), []byte{weightIndexPrefix})
+				store.Delete(keyCodec.BuildIndexKey(orm.EncodeSequence(1), orm.PrimaryKey(&members[0])))
+			},
+			expErrMsg: []string{"index 02: missing entry"},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx := orm.NewMockContext()
+			if !spec.empty {
+				for i := range members {
+					require.NoError(t, tb.Create(ctx, &members[i]))
+				}
+			}
+			spec.malleate(ctx)
+
+			err := orm.VerifyIndexes(ctx, tb, groupIdx, weightIdx)
+			if len(spec.expErrMsg) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.True(t, orm.ErrIndexOutOfSync.Is(err), err)
+			for _, expMsg := range spec.expErrMsg {
+				assert.Contains(t, err.Error(), expMsg)
+			}
+		})
+	}
+}
+
+func TestRebuildIndex(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	storeKey := sdk.NewKVStoreKey("test")
+
+	const (
+		tablePrefix byte = iota
+		groupIndexPrefix
+		weightIndexPrefix
+	)
+	builder, err := orm.NewPrimaryKeyTableBuilder(tablePrefix, storeKey, &testdata.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+	groupIdx, err := orm.NewIndex(builder, groupIndexPrefix, func(val interface{}) ([]orm.RowID, error) {
+		return []orm.RowID{[]byte(val.(*testdata.GroupMember).Group)}, nil
+	})
+	require.NoError(t, err)
+	weightIdx, err := orm.NewUInt64Index(builder, weightIndexPrefix, func(val interface{}) ([]uint64, error) {
+		return []uint64{val.(*testdata.GroupMember).Weight}, nil
+	})
+	require.NoError(t, err)
+	tb := builder.Build()
+
+	ctx := orm.NewMockContext()
+	members := []testdata.GroupMember{
+		{Group: []byte("group-a"), Member: []byte("member-one"), Weight: 1},
+		{Group: []byte("group-a"), Member: []byte("member-two"), Weight: 2},
+		{Group: []byte("group-b"), Member: []byte("member-one"), Weight: 3},
+	}
+	for i := range members {
+		require.NoError(t, tb.Create(ctx, &members[i]))
+	}
+
+	// drop an entry of each index and add an orphaned entry to the group index
+	keyCodec := orm.Max255DynamicLengthIndexKeyCodec{}
+	groupIndexStore := prefix.NewStore(ctx.KVStore(storeKey), []byte{groupIndexPrefix})
+	groupIndexStore.Delete(keyCodec.BuildIndexKey(members[1].Group, orm.PrimaryKey(&members[1])))
+	orphan := testdata.GroupMember{Group: []byte("group-c"), Member: []byte("member-one")}
+	groupIndexStore.Set(keyCodec.BuildIndexKey(orphan.Group, orm.PrimaryKey(&orphan)), []byte{})
+	weightIndexStore := prefix.NewStore(ctx.KVStore(storeKey), []byte{weightIndexPrefix})
+	weightIndexStore.Delete(keyCodec.BuildIndexKey(orm.EncodeSequence(1), orm.PrimaryKey(&members[0])))
+
+	require.NoError(t, orm.RebuildIndex(ctx, tb, groupIdx))
+	require.NoError(t, orm.VerifyIndexes(ctx, tb, groupIdx))
+
+	// other indexes are left as they are
+	err = orm.VerifyIndexes(ctx, tb, weightIdx)
+	require.True(t, orm.ErrIndexOutOfSync.Is(err), err)
+	assert.Contains(t, err.Error(), "index 02: missing entry")
+}
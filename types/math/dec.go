@@ -2,6 +2,7 @@ package math
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/cockroachdb/apd/v2"
 	"github.com/cosmos/cosmos-sdk/types/errors"
@@ -32,11 +33,28 @@ var dec128Context = apd.Context{
 	Traps:       apd.DefaultTraps,
 }
 
+// NewDecFromString parses a decimal string. Scientific notation like "1.5e3" or "2.5e-2" is accepted,
+// String then returns the expanded decimal ("1500" or "0.025"). Malformed exponents, exponents out of
+// the range supported by the arithmetic operations, NaN and infinite values are rejected.
 func NewDecFromString(s string) (Dec, error) {
 	d, _, err := apd.NewFromString(s)
 	if err != nil {
 		return Dec{}, ErrInvalidDecString.Wrap(err.Error())
 	}
+	if d.Form != apd.Finite {
+		return Dec{}, ErrInvalidDecString.Wrapf("expected a finite decimal, got %s", s)
+	}
+	// the adjusted exponent is the exponent of the most significant digit
+	adjExp := int64(d.Exponent) + d.NumDigits() - 1
+	if adjExp > int64(dec128Context.MaxExponent) || adjExp < int64(dec128Context.MinExponent) {
+		return Dec{}, ErrInvalidDecString.Wrapf("exponent out of range: %s", s)
+	}
+	// expand a positive exponent into the coefficient, so that e.g. "0e5" is formatted as "0"
+	if d.Exponent > 0 {
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(d.Exponent)), nil)
+		d.Coeff.Mul(&d.Coeff, scale)
+		d.Exponent = 0
+	}
 	return Dec{*d}, nil
 }
 
@@ -90,6 +108,13 @@ func NewDecFromInt64(x int64) Dec {
 	return res
 }
 
+// Copy returns a deep copy of x that doesn't share the underlying coefficient with x.
+func (x Dec) Copy() Dec {
+	var z Dec
+	z.dec.Set(&x.dec)
+	return z
+}
+
 // Add returns a new Dec with value `x+y` without mutating any argument and error if
 // there is an overflow.
 func (x Dec) Add(y Dec) (Dec, error) {
@@ -114,6 +139,42 @@ func (x Dec) Quo(y Dec) (Dec, error) {
 	return z, errors.Wrap(err, "decimal quotient error")
 }
 
+// Rounding is the rounding mode used by QuoWithPrec for results that don't fit the requested precision.
+type Rounding string
+
+const (
+	// RoundDown rounds towards zero, truncating the result.
+	RoundDown Rounding = apd.RoundDown
+	// RoundUp rounds away from zero.
+	RoundUp Rounding = apd.RoundUp
+	// RoundHalfUp rounds to the nearest value, and away from zero when halfway.
+	RoundHalfUp Rounding = apd.RoundHalfUp
+	// RoundHalfEven rounds to the nearest value, and to the even neighbour when halfway.
+	RoundHalfEven Rounding = apd.RoundHalfEven
+)
+
+// QuoWithPrec returns a new Dec with value `x/y` rounded with `rounding` to `prec` significant digits,
+// without mutating any argument. Note that prec counts significant digits, not decimal places: 100/3
+// with a prec of 4 is 33.33. Quo is QuoWithPrec with 34 significant digits rounded half up. Returns an
+// error if prec is zero, the rounding mode is unknown, y is zero or there is an overflow.
+func (x Dec) QuoWithPrec(y Dec, prec uint32, rounding Rounding) (Dec, error) {
+	if prec == 0 {
+		return Dec{}, fmt.Errorf("precision must be positive")
+	}
+	switch rounding {
+	case RoundDown, RoundUp, RoundHalfUp, RoundHalfEven:
+	default:
+		return Dec{}, fmt.Errorf("unknown rounding mode: %q", rounding)
+	}
+	ctx := dec128Context
+	ctx.Precision = prec
+	ctx.Rounding = string(rounding)
+
+	var z Dec
+	_, err := ctx.Quo(&z.dec, &x.dec, &y.dec)
+	return z, errors.Wrap(err, "decimal quotient error")
+}
+
 // QuoInteger returns a new integral Dec with value `x/y` (formatted as decimal128, with 34 digit precision)
 // without mutating any argument and error if there is an overflow.
 func (x Dec) QuoInteger(y Dec) (Dec, error) {
@@ -130,6 +191,28 @@ func (x Dec) Rem(y Dec) (Dec, error) {
 	return z, errors.Wrap(err, "decimal remainder error")
 }
 
+// QuoRem returns the integral quotient and the remainder of `x/y` (formatted as decimal128, with 34 digit
+// precision) without mutating any argument, such that `quo*y + rem == x`. Like Rem, the remainder has the
+// sign of x. Returns an error if y is zero or the integer part of x/y cannot fit in 34 digit precision.
+func (x Dec) QuoRem(y Dec) (quo Dec, rem Dec, err error) {
+	if y.IsZero() {
+		return Dec{}, Dec{}, fmt.Errorf("division by zero")
+	}
+	if _, err = dec128Context.QuoInteger(&quo.dec, &x.dec, &y.dec); err != nil {
+		return Dec{}, Dec{}, errors.Wrap(err, "decimal quotient error")
+	}
+
+	// the remainder is derived from the quotient with exact arithmetic instead of dividing a second time
+	var prod apd.Decimal
+	if _, err = exactContext.Mul(&prod, &quo.dec, &y.dec); err != nil {
+		return Dec{}, Dec{}, errors.Wrap(err, "decimal remainder error")
+	}
+	if _, err = exactContext.Sub(&rem.dec, &x.dec, &prod); err != nil {
+		return Dec{}, Dec{}, errors.Wrap(err, "decimal remainder error")
+	}
+	return quo, rem, nil
+}
+
 // Mul returns a new Dec with value `x*y` (formatted as decimal128, with 34 digit precision) without
 // mutating any argument and error if there is an overflow.
 func (x Dec) Mul(y Dec) (Dec, error) {
@@ -142,10 +225,70 @@ func (x Dec) Int64() (int64, error) {
 	return x.dec.Int64()
 }
 
+// Int returns the value of x as an int64 and true, or false if x is not an integer or doesn't fit in an int64.
+func (x Dec) Int() (int64, bool) {
+	i, err := x.dec.Int64()
+	return i, err == nil
+}
+
+// BigInt returns the value of x as a new *big.Int and error if x is not an integer.
+func (x Dec) BigInt() (*big.Int, error) {
+	y, _ := x.Reduce()
+	if y.dec.Exponent < 0 {
+		return nil, fmt.Errorf("%s is not an integer", x)
+	}
+
+	z := new(big.Int).Set(&y.dec.Coeff)
+	if y.dec.Exponent > 0 {
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(y.dec.Exponent)), nil)
+		z.Mul(z, scale)
+	}
+	if y.dec.Negative {
+		z.Neg(z)
+	}
+	return z, nil
+}
+
 func (x Dec) String() string {
 	return x.dec.Text('f')
 }
 
+// StringFixed returns the decimal representation of x with exactly `places`
+// decimal places, padding it with zeros or truncating (never rounding) it as needed.
+// places must not exceed apd.MaxExponent, which is the most decimal places a Dec can
+// have, otherwise it panics.
+func (x Dec) StringFixed(places uint32) string {
+	if places > apd.MaxExponent {
+		panic(fmt.Sprintf("%d decimal places exceed the maximum of %d", places, apd.MaxExponent))
+	}
+
+	// the precision must fit all the integral digits as well as the decimal places
+	precision := x.dec.NumDigits() + int64(places)
+	if x.dec.Exponent > 0 {
+		precision += int64(x.dec.Exponent)
+	}
+
+	var z apd.Decimal
+	ctx := apd.Context{
+		Precision:   uint32(precision),
+		MaxExponent: apd.MaxExponent,
+		MinExponent: apd.MinExponent,
+		Rounding:    apd.RoundDown,
+	}
+	// can't fail, as the precision fits the result and places is within the exponent range
+	_, err := ctx.Quantize(&z, &x.dec, -int32(places))
+	if err != nil {
+		panic(err)
+	}
+
+	// avoid formatting values truncated to zero as "-0"
+	if z.IsZero() {
+		z.Negative = false
+	}
+
+	return z.Text('f')
+}
+
 func (x Dec) Cmp(y Dec) int {
 	return x.dec.Cmp(&y.dec)
 }
@@ -154,6 +297,26 @@ func (x Dec) IsEqual(y Dec) bool {
 	return x.dec.Cmp(&y.dec) == 0
 }
 
+// GT returns true if x > y.
+func (x Dec) GT(y Dec) bool {
+	return x.Cmp(y) > 0
+}
+
+// GTE returns true if x >= y.
+func (x Dec) GTE(y Dec) bool {
+	return x.Cmp(y) >= 0
+}
+
+// LT returns true if x < y.
+func (x Dec) LT(y Dec) bool {
+	return x.Cmp(y) < 0
+}
+
+// LTE returns true if x <= y.
+func (x Dec) LTE(y Dec) bool {
+	return x.Cmp(y) <= 0
+}
+
 func (x Dec) IsZero() bool {
 	return x.dec.IsZero()
 }
@@ -175,6 +338,12 @@ func (x Dec) NumDecimalPlaces() uint32 {
 	return uint32(-exp)
 }
 
+// IsInteger returns true if x has no nonzero fractional part.
+func (x Dec) IsInteger() bool {
+	y, _ := x.Reduce()
+	return y.dec.Exponent >= 0
+}
+
 func (x Dec) Reduce() (Dec, int) {
 	y := Dec{}
 	_, n := y.dec.Reduce(&x.dec)
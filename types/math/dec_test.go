@@ -2,11 +2,13 @@ package math
 
 import (
 	"fmt"
+	"math/big"
 	"regexp"
 	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/cockroachdb/apd/v2"
 	"github.com/stretchr/testify/require"
 	"pgregory.net/rapid"
 )
@@ -32,6 +34,8 @@ func TestDec(t *testing.T) {
 	// Properties about subtraction
 	t.Run("TestSubRightIdentity", rapid.MakeCheck(testSubRightIdentity))
 	t.Run("TestSubZero", rapid.MakeCheck(testSubZero))
+	t.Run("TestSafeSubMatchesSafeSubBalance", rapid.MakeCheck(testSafeSubMatchesSafeSubBalance))
+	t.Run("TestSafeAddMatchesSafeAddBalance", rapid.MakeCheck(testSafeAddMatchesSafeAddBalance))
 
 	// Properties about multiplication
 	t.Run("TestMulLeftIdentity", rapid.MakeCheck(testMulLeftIdentity))
@@ -43,6 +47,7 @@ func TestDec(t *testing.T) {
 	// Properties about division
 	t.Run("TestDivisionBySelf", rapid.MakeCheck(testSelfQuo))
 	t.Run("TestDivisionByOne", rapid.MakeCheck(testQuoByOne))
+	t.Run("TestQuoRem", rapid.MakeCheck(testQuoRem))
 
 	// Properties combining operations
 	t.Run("TestSubAdd", rapid.MakeCheck(testSubAdd))
@@ -50,9 +55,14 @@ func TestDec(t *testing.T) {
 	t.Run("TestMulQuoA", rapid.MakeCheck(testMulQuoA))
 	t.Run("TestMulQuoB", rapid.MakeCheck(testMulQuoB))
 
+	// Properties about immutability
+	t.Run("TestOperationsDontMutateOperands", rapid.MakeCheck(testOperationsDontMutateOperands))
+	t.Run("TestCopyDoesNotAlias", rapid.MakeCheck(testCopyDoesNotAlias))
+
 	// Properties about comparision and equality
 	t.Run("TestCmpInverse", rapid.MakeCheck(testCmpInverse))
 	t.Run("TestEqualCommutative", rapid.MakeCheck(testEqualCommutative))
+	t.Run("TestComparisonsMatchCmp", rapid.MakeCheck(testComparisonsMatchCmp))
 
 	// Properties about tests on a single Dec
 	t.Run("TestIsZero", rapid.MakeCheck(testIsZero))
@@ -102,6 +112,20 @@ func TestDec(t *testing.T) {
 	res, err = SafeAddBalance(minusFivePointZero, five)
 	require.Error(t, err, "Expected ErrInvalidRequest")
 
+	res, failed := five.SafeSub(two)
+	require.False(t, failed)
+	require.True(t, res.IsEqual(three))
+
+	_, failed = two.SafeSub(five)
+	require.True(t, failed)
+
+	res, failed = three.SafeAdd(two)
+	require.False(t, failed)
+	require.True(t, res.IsEqual(five))
+
+	_, failed = minusFivePointZero.SafeAdd(five)
+	require.True(t, failed)
+
 	res, err = four.Quo(two)
 	require.NoError(t, err)
 	require.True(t, res.IsEqual(two))
@@ -114,6 +138,24 @@ func TestDec(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, res.IsEqual(one))
 
+	quo, rem, err := five.QuoRem(two)
+	require.NoError(t, err)
+	require.True(t, quo.IsEqual(two))
+	require.True(t, rem.IsEqual(one))
+
+	quo, rem, err = minusFivePointZero.QuoRem(two)
+	require.NoError(t, err)
+	require.True(t, quo.IsEqual(NewDecFromInt64(-2)))
+	require.True(t, rem.IsEqual(minusOne))
+
+	quo, rem, err = threePointFourNine.QuoRem(one)
+	require.NoError(t, err)
+	require.True(t, quo.IsEqual(three))
+	require.Equal(t, "0.49", rem.String())
+
+	_, _, err = five.QuoRem(zero)
+	require.Error(t, err)
+
 	x, err := four.Int64()
 	require.NoError(t, err)
 	require.Equal(t, int64(4), x)
@@ -132,6 +174,26 @@ func TestDec(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, res.IsEqual(minusFivePointZero))
 
+	onePointZero, err := NewDecFromString("1.0")
+	require.NoError(t, err)
+	for _, spec := range []struct {
+		x, y                   Dec
+		gt, gte, lt, lte, isEq bool
+	}{
+		{x: one, y: one, gte: true, lte: true, isEq: true},
+		{x: onePointZero, y: one, gte: true, lte: true, isEq: true},
+		{x: one, y: two, lt: true, lte: true},
+		{x: minusOne, y: zero, lt: true, lte: true},
+		{x: two, y: one, gt: true, gte: true},
+		{x: zero, y: minusFivePointZero, gt: true, gte: true},
+	} {
+		require.Equal(t, spec.gt, spec.x.GT(spec.y), "%s > %s", spec.x, spec.y)
+		require.Equal(t, spec.gte, spec.x.GTE(spec.y), "%s >= %s", spec.x, spec.y)
+		require.Equal(t, spec.lt, spec.x.LT(spec.y), "%s < %s", spec.x, spec.y)
+		require.Equal(t, spec.lte, spec.x.LTE(spec.y), "%s <= %s", spec.x, spec.y)
+		require.Equal(t, spec.isEq, spec.x.IsEqual(spec.y), "%s == %s", spec.x, spec.y)
+	}
+
 	require.True(t, zero.IsZero())
 	require.False(t, zero.IsPositive())
 	require.False(t, zero.IsNegative())
@@ -143,6 +205,17 @@ func TestDec(t *testing.T) {
 	require.False(t, minusOne.IsZero())
 	require.False(t, minusOne.IsPositive())
 	require.True(t, minusOne.IsNegative())
+
+	require.True(t, zero.IsInteger())
+	require.True(t, five.IsInteger())
+	require.True(t, minusFivePointZero.IsInteger())
+	require.False(t, onePointOneFive.IsInteger())
+	onePointZeroZero, err := NewDecFromString("1.00")
+	require.NoError(t, err)
+	require.True(t, onePointZeroZero.IsInteger())
+	zeroPointZeroOne, err := NewDecFromString("0.01")
+	require.NoError(t, err)
+	require.False(t, zeroPointZeroOne.IsInteger())
 }
 
 // TODO: Think a bit more about the probability distribution of Dec
@@ -327,6 +400,45 @@ func testMulLeftIdentity(t *rapid.T) {
 	require.True(t, a.IsEqual(b))
 }
 
+// Property: x.SafeSub(y) fails iff SafeSubBalance(x, y) errors and otherwise agrees with it
+func testSafeSubMatchesSafeSubBalance(t *rapid.T) {
+	a := genDec.Draw(t, "a").(Dec)
+	b := genDec.Draw(t, "b").(Dec)
+
+	c, failed := a.SafeSub(b)
+	d, err := SafeSubBalance(a, b)
+	require.Equal(t, err != nil, failed)
+	if !failed {
+		require.True(t, c.IsEqual(d))
+	}
+}
+
+// Property: x.SafeAdd(y) fails iff SafeAddBalance(x, y) errors and otherwise agrees with it
+func testSafeAddMatchesSafeAddBalance(t *rapid.T) {
+	a := genDec.Draw(t, "a").(Dec)
+	b := genDec.Draw(t, "b").(Dec)
+
+	c, failed := a.SafeAdd(b)
+	d, err := SafeAddBalance(a, b)
+	require.Equal(t, err != nil, failed)
+	if !failed {
+		require.True(t, c.IsEqual(d))
+	}
+}
+
+// Property: the comparison methods agree with Cmp
+func testComparisonsMatchCmp(t *rapid.T) {
+	a := genDec.Draw(t, "a").(Dec)
+	b := genDec.Draw(t, "b").(Dec)
+	c := a.Cmp(b)
+
+	require.Equal(t, c == 1, a.GT(b))
+	require.Equal(t, c != -1, a.GTE(b))
+	require.Equal(t, c == -1, a.LT(b))
+	require.Equal(t, c != 1, a.LTE(b))
+	require.Equal(t, c == 0, a.IsEqual(b))
+}
+
 // Property: a * 1 == a
 func testMulRightIdentity(t *rapid.T) {
 	a := genDec.Draw(t, "a").(Dec)
@@ -434,6 +546,39 @@ func testQuoByOne(t *rapid.T) {
 	require.True(t, a.IsEqual(b))
 }
 
+// Property: b != 0 => QuoRem(a, b) == (QuoInteger(a, b), Rem(a, b)) and quo * b + rem == a
+func testQuoRem(t *rapid.T) {
+	a := genDec.Draw(t, "a").(Dec)
+	b := genDec.Draw(t, "b").(Dec)
+
+	quo, rem, err := a.QuoRem(b)
+	expQuo, quoErr := a.QuoInteger(b)
+	expRem, remErr := a.Rem(b)
+	if b.IsZero() || quoErr != nil || remErr != nil {
+		require.Error(t, err)
+		return
+	}
+	require.NoError(t, err)
+	require.True(t, expQuo.IsEqual(quo), "quotient %s != %s", quo, expQuo)
+	require.True(t, expRem.IsEqual(rem), "remainder %s != %s", rem, expRem)
+
+	// |rem| < |b| and rem has the sign of a
+	var absRem, absB apd.Decimal
+	absRem.Abs(&rem.dec)
+	absB.Abs(&b.dec)
+	require.Equal(t, -1, absRem.Cmp(&absB))
+	require.False(t, rem.IsNegative() && !a.IsNegative())
+	require.False(t, rem.IsPositive() && !a.IsPositive())
+
+	// quo * b + rem == a, computed without rounding
+	var z apd.Decimal
+	_, err = exactContext.Mul(&z, &quo.dec, &b.dec)
+	require.NoError(t, err)
+	_, err = exactContext.Add(&z, &z, &rem.dec)
+	require.NoError(t, err)
+	require.Zero(t, z.Cmp(&a.dec), "%s * %s + %s != %s", quo, b, rem, a)
+}
+
 // Property: (a * b) / a == b
 func testMulQuoA(t *rapid.T) {
 	decNotZero := func(d Dec) bool { return !d.IsZero() }
@@ -464,6 +609,58 @@ func testMulQuoB(t *rapid.T) {
 	require.True(t, a.IsEqual(d))
 }
 
+// Property: op(a, b) doesn't change a or b, and changing the result doesn't change a or b,
+// for op in Add, Sub, Mul, Quo, QuoInteger, Rem, SafeSub, SafeAdd, SafeSubBalance, SafeAddBalance
+func testOperationsDontMutateOperands(t *rapid.T) {
+	a := genDec.Draw(t, "a").(Dec)
+	b := genDec.Draw(t, "b").(Dec)
+	aStr, bStr := a.String(), b.String()
+
+	ops := map[string]func(x, y Dec) (Dec, error){
+		"Add":            Dec.Add,
+		"Sub":            Dec.Sub,
+		"Mul":            Dec.Mul,
+		"Quo":            Dec.Quo,
+		"QuoInteger":     Dec.QuoInteger,
+		"Rem":            Dec.Rem,
+		"SafeSubBalance": SafeSubBalance,
+		"SafeAddBalance": SafeAddBalance,
+		"SafeSub": func(x, y Dec) (Dec, error) {
+			z, _ := x.SafeSub(y)
+			return z, nil
+		},
+		"SafeAdd": func(x, y Dec) (Dec, error) {
+			z, _ := x.SafeAdd(y)
+			return z, nil
+		},
+	}
+	for name, op := range ops {
+		// errors like division by zero are fine here, only the operands matter
+		c, _ := op(a, b)
+		c.dec.Coeff.SetInt64(42)
+		require.Equal(t, aStr, a.String(), "%s mutated the receiver", name)
+		require.Equal(t, bStr, b.String(), "%s mutated the argument", name)
+
+		// same Dec as receiver and argument
+		c, _ = op(a, a)
+		c.dec.Coeff.SetInt64(42)
+		require.Equal(t, aStr, a.String(), "%s mutated the operand", name)
+	}
+}
+
+// Property: Copy(a) == a, and changing Copy(a) doesn't change a
+func testCopyDoesNotAlias(t *rapid.T) {
+	a := genDec.Draw(t, "a").(Dec)
+	aStr := a.String()
+
+	b := a.Copy()
+	require.True(t, a.IsEqual(b))
+	require.Equal(t, aStr, b.String())
+
+	b.dec.Coeff.Add(&b.dec.Coeff, big.NewInt(1))
+	require.Equal(t, aStr, a.String())
+}
+
 // Property: Cmp(a, b) == -Cmp(b, a)
 func testCmpInverse(t *rapid.T) {
 	a := genDec.Draw(t, "a").(Dec)
@@ -550,3 +747,212 @@ func floatDecimalPlaces(t *rapid.T, f float64) uint32 {
 		return uint32(res)
 	}
 }
+
+func TestNewDecFromStringScientific(t *testing.T) {
+	specs := []struct {
+		dec         string
+		exp         string
+		expDecimals uint32
+		expErr      bool
+	}{
+		{"1.5e3", "1500", 0, false},
+		{"2.5e-2", "0.025", 3, false},
+		{"1E3", "1000", 0, false},
+		{"1e+3", "1000", 0, false},
+		{"-1.5e2", "-150", 0, false},
+		{"0e5", "0", 0, false},
+		{"1e", "", 0, true},
+		{"1.5e-", "", 0, true},
+		{"1e3.5", "", 0, true},
+		{"1e--3", "", 0, true},
+		{"1ee3", "", 0, true},
+		{"e3", "", 0, true},
+		{"1e1000000", "", 0, true},
+		{"1e-1000000", "", 0, true},
+		{"NaN", "", 0, true},
+		{"Infinity", "", 0, true},
+		{"-Inf", "", 0, true},
+	}
+	for _, spec := range specs {
+		t.Run(spec.dec, func(t *testing.T) {
+			dec, err := NewDecFromString(spec.dec)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.exp, dec.String())
+			require.Equal(t, spec.expDecimals, dec.NumDecimalPlaces())
+		})
+	}
+}
+
+func TestStringFixed(t *testing.T) {
+	specs := []struct {
+		dec    string
+		places uint32
+		exp    string
+	}{
+		{"0", 6, "0.000000"},
+		{"0", 0, "0"},
+		{"1", 2, "1.00"},
+		{"1.5", 3, "1.500"},
+		{"-1.5", 3, "-1.500"},
+		{"123.456789", 2, "123.45"},
+		{"123.459999", 2, "123.45"},
+		{"-123.459999", 2, "-123.45"},
+		{"0.999", 0, "0"},
+		{"-0.999", 0, "0"},
+		{"-0.001", 2, "0.00"},
+		{"1e3", 1, "1000.0"},
+		{"1.000000", 2, "1.00"},
+	}
+	for _, spec := range specs {
+		t.Run(fmt.Sprintf("%s/%d", spec.dec, spec.places), func(t *testing.T) {
+			dec, err := NewDecFromString(spec.dec)
+			require.NoError(t, err)
+			require.Equal(t, spec.exp, dec.StringFixed(spec.places))
+		})
+	}
+
+	// up to apd.MaxExponent places are supported, more can't be represented and panic
+	// instead of overflowing the exponent
+	require.Len(t, NewDecFromInt64(1).StringFixed(apd.MaxExponent), apd.MaxExponent+2)
+	for _, places := range []uint32{apd.MaxExponent + 1, 1 << 31, ^uint32(0)} {
+		require.Panics(t, func() { NewDecFromInt64(1).StringFixed(places) }, places)
+	}
+
+	// reducing the number of places truncates the value so it never exceeds the original
+	rapid.Check(t, func(t *rapid.T) {
+		dec := genDec.Draw(t, "dec").(Dec)
+		places := rapid.Uint32Range(0, 10).Draw(t, "places").(uint32)
+
+		fixed, err := NewDecFromString(dec.StringFixed(places))
+		require.NoError(t, err)
+		require.Equal(t, places, fixed.NumDecimalPlaces())
+
+		diff, err := dec.Sub(fixed)
+		require.NoError(t, err)
+		if dec.IsNegative() {
+			require.False(t, diff.IsPositive())
+		} else {
+			require.False(t, diff.IsNegative())
+		}
+	})
+}
+
+func TestQuoWithPrec(t *testing.T) {
+	specs := []struct {
+		x, y     string
+		prec     uint32
+		rounding Rounding
+		exp      string
+		expErr   bool
+	}{
+		{"1", "3", 4, RoundHalfUp, "0.3333", false},
+		{"2", "3", 4, RoundHalfUp, "0.6667", false},
+		{"-2", "3", 2, RoundHalfUp, "-0.67", false},
+		{"100", "3", 4, RoundHalfUp, "33.33", false},
+		{"1", "4", 1, RoundHalfUp, "0.3", false},
+		{"2", "3", 4, RoundDown, "0.6666", false},
+		{"-2", "3", 2, RoundDown, "-0.66", false},
+		{"1", "3", 4, RoundUp, "0.3334", false},
+		{"-1", "3", 4, RoundUp, "-0.3334", false},
+		{"1", "4", 1, RoundHalfEven, "0.2", false},
+		{"3", "4", 1, RoundHalfEven, "0.8", false},
+		{"1", "0", 4, RoundHalfUp, "", true},
+		{"1", "3", 0, RoundHalfUp, "", true},
+		{"1", "3", 4, Rounding("sideways"), "", true},
+	}
+	for _, spec := range specs {
+		t.Run(fmt.Sprintf("%s/%s/%d/%s", spec.x, spec.y, spec.prec, spec.rounding), func(t *testing.T) {
+			x, err := NewDecFromString(spec.x)
+			require.NoError(t, err)
+			y, err := NewDecFromString(spec.y)
+			require.NoError(t, err)
+
+			z, err := x.QuoWithPrec(y, spec.prec, spec.rounding)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.exp, z.String())
+		})
+	}
+
+	// the default precision of Quo is unaffected
+	one, three := NewDecFromInt64(1), NewDecFromInt64(3)
+	z, err := one.Quo(three)
+	require.NoError(t, err)
+	require.Equal(t, "0.3333333333333333333333333333333333", z.String())
+
+	// with the precision and rounding of Quo, the results are the same
+	rapid.Check(t, func(t *rapid.T) {
+		x := genDec.Draw(t, "x").(Dec)
+		y := genDec.Draw(t, "y").(Dec)
+
+		exp, expErr := x.Quo(y)
+		got, err := x.QuoWithPrec(y, 34, RoundHalfUp)
+		require.Equal(t, expErr != nil, err != nil)
+		if err == nil {
+			require.True(t, exp.IsEqual(got))
+		}
+	})
+}
+
+func TestIntConversions(t *testing.T) {
+	specs := []struct {
+		dec      string
+		expBig   string
+		expInt   int64
+		expIntOk bool
+	}{
+		{"0", "0", 0, true},
+		{"-0", "0", 0, true},
+		{"42", "42", 42, true},
+		{"-42", "-42", -42, true},
+		{"42.000", "42", 42, true},
+		{"1e3", "1000", 1000, true},
+		{"-1.5e2", "-150", -150, true},
+		{"9223372036854775807", "9223372036854775807", 9223372036854775807, true},
+		{"9223372036854775808", "9223372036854775808", 0, false},
+		{"1e40", "10000000000000000000000000000000000000000", 0, false},
+		{"1.5", "", 0, false},
+		{"-0.001", "", 0, false},
+	}
+	for _, spec := range specs {
+		t.Run(spec.dec, func(t *testing.T) {
+			dec, err := NewDecFromString(spec.dec)
+			require.NoError(t, err)
+
+			b, err := dec.BigInt()
+			if spec.expBig == "" {
+				require.Error(t, err)
+				require.False(t, dec.IsInteger())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, spec.expBig, b.String())
+			}
+
+			i, ok := dec.Int()
+			require.Equal(t, spec.expIntOk, ok)
+			if ok {
+				require.Equal(t, spec.expInt, i)
+			}
+		})
+	}
+
+	rapid.Check(t, func(t *rapid.T) {
+		i := rapid.Int64().Draw(t, "i").(int64)
+		dec := NewDecFromInt64(i)
+
+		b, err := dec.BigInt()
+		require.NoError(t, err)
+		require.Zero(t, big.NewInt(i).Cmp(b))
+
+		got, ok := dec.Int()
+		require.True(t, ok)
+		require.Equal(t, i, got)
+	})
+}
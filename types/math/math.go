@@ -70,3 +70,29 @@ func SafeAddBalance(x Dec, y Dec) (Dec, error) {
 
 	return z, nil
 }
+
+// SafeSub subtracts the value of y from x and returns the result with arbitrary precision.
+// Unlike SafeSubBalance it doesn't allocate an error and instead returns true if the
+// subtraction failed or the result is negative, mirroring sdk.Coins.SafeSub.
+func (x Dec) SafeSub(y Dec) (Dec, bool) {
+	var z Dec
+	_, err := exactContext.Sub(&z.dec, &x.dec, &y.dec)
+	if err != nil {
+		return z, true
+	}
+
+	return z, z.IsNegative()
+}
+
+// SafeAdd adds the value of x+y and returns the result with arbitrary precision.
+// Unlike SafeAddBalance it doesn't allocate an error and instead returns true if
+// either x or y is negative or the addition failed.
+func (x Dec) SafeAdd(y Dec) (Dec, bool) {
+	var z Dec
+	if x.IsNegative() || y.IsNegative() {
+		return z, true
+	}
+
+	_, err := exactContext.Add(&z.dec, &x.dec, &y.dec)
+	return z, err != nil
+}
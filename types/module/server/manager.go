@@ -32,6 +32,9 @@ type Manager struct {
 	exportGenesisHandlers      map[string]module.ExportGenesisHandler
 	registerInvariantsHandler  map[string]RegisterInvariantsHandler
 	weightedOperationsHandlers map[string]WeightedOperationsHandler
+	endBlockers                []endBlocker
+	consensusVersions          map[string]uint64
+	migrationHandlers          map[string]map[uint64]MigrationHandler
 }
 
 // RegisterInvariants registers all module routes and module querier routes
@@ -59,6 +62,8 @@ func NewManager(baseApp *baseapp.BaseApp, cdc *codec.ProtoCodec) *Manager {
 		},
 		requiredServices:           map[reflect.Type]bool{},
 		weightedOperationsHandlers: map[string]WeightedOperationsHandler{},
+		consensusVersions:          map[string]uint64{},
+		migrationHandlers:          map[string]map[uint64]MigrationHandler{},
 	}
 }
 
@@ -132,11 +137,12 @@ func (mm *Manager) RegisterModules(modules []module.Module) error {
 		}
 
 		cfg := &configurator{
-			msgServer:        msgRegistrar,
-			queryServer:      queryRegistrar,
-			key:              key,
-			cdc:              mm.cdc,
-			requiredServices: map[reflect.Type]bool{},
+			msgServer:         msgRegistrar,
+			queryServer:       queryRegistrar,
+			key:               key,
+			cdc:               mm.cdc,
+			requiredServices:  map[reflect.Type]bool{},
+			migrationHandlers: map[uint64]MigrationHandler{},
 		}
 
 		serverMod.RegisterServices(cfg)
@@ -148,6 +154,15 @@ func (mm *Manager) RegisterModules(modules []module.Module) error {
 			mm.weightedOperationsHandlers[name] = cfg.weightedOperationHandler
 		}
 
+		if cfg.endBlockHandler != nil {
+			mm.endBlockers = append(mm.endBlockers, endBlocker{moduleName: name, handler: cfg.endBlockHandler})
+		}
+
+		if versioned, ok := mod.(hasConsensusVersion); ok {
+			mm.consensusVersions[name] = versioned.ConsensusVersion()
+		}
+		mm.migrationHandlers[name] = cfg.migrationHandlers
+
 		for typ := range cfg.requiredServices {
 			mm.requiredServices[typ] = true
 		}
@@ -258,8 +273,87 @@ func exportGenesis(ctx sdk.Context, cdc codec.Codec, exportGenesisHandlers map[s
 	return genesisData, nil
 }
 
+// GetVersionMap returns the consensus versions of all modules that define one.
+func (mm *Manager) GetVersionMap() sdkmodule.VersionMap {
+	vm := make(sdkmodule.VersionMap, len(mm.consensusVersions))
+	for name, version := range mm.consensusVersions {
+		vm[name] = version
+	}
+	return vm
+}
+
+// RunMigrations runs the registered migration handlers of all modules, one version at a time,
+// from the version found in fromVM up to the current consensus version of the module, and returns
+// the resulting version map. Modules missing from fromVM are expected to be initialized with
+// InitGenesis instead, so they are not migrated and only recorded with their current version.
+func (mm *Manager) RunMigrations(ctx sdk.Context, fromVM sdkmodule.VersionMap) (sdkmodule.VersionMap, error) {
+	updatedVM := mm.GetVersionMap()
+	for _, m := range mm.modules {
+		name := m.Name()
+		toVersion, ok := mm.consensusVersions[name]
+		if !ok {
+			continue
+		}
+		fromVersion, ok := fromVM[name]
+		if !ok {
+			continue
+		}
+		if err := runMigrations(ctx, name, mm.migrationHandlers[name], fromVersion, toVersion); err != nil {
+			return nil, err
+		}
+	}
+	return updatedVM, nil
+}
+
+func runMigrations(ctx sdk.Context, moduleName string, handlers map[uint64]MigrationHandler, fromVersion, toVersion uint64) error {
+	if fromVersion > toVersion {
+		return fmt.Errorf("%s: cannot migrate down from version %d to %d", moduleName, fromVersion, toVersion)
+	}
+	for v := fromVersion; v < toVersion; v++ {
+		handler, ok := handlers[v]
+		if !ok {
+			return fmt.Errorf("%s: no migration registered from version %d", moduleName, v)
+		}
+		if err := handler(types.Context{Context: ctx}); err != nil {
+			return fmt.Errorf("%s migration from version %d: %w", moduleName, v, err)
+		}
+	}
+	return nil
+}
+
+// EndBlock runs the end block handlers of all modules in the order the modules were registered.
+func (mm *Manager) EndBlock(ctx sdk.Context) {
+	if err := endBlock(ctx, mm.endBlockers); err != nil {
+		panic(err)
+	}
+}
+
+func endBlock(ctx sdk.Context, endBlockers []endBlocker) error {
+	for _, e := range endBlockers {
+		if err := e.handler(types.Context{Context: ctx}); err != nil {
+			return fmt.Errorf("%s end block: %w", e.moduleName, err)
+		}
+	}
+	return nil
+}
+
 type RegisterInvariantsHandler func(ir sdk.InvariantRegistry)
 
+// EndBlockHandler is run by the Manager at the end of every block.
+type EndBlockHandler func(ctx types.Context) error
+
+// MigrationHandler migrates the state of a module in place from one consensus version to the next.
+type MigrationHandler func(ctx types.Context) error
+
+type hasConsensusVersion interface {
+	ConsensusVersion() uint64
+}
+
+type endBlocker struct {
+	moduleName string
+	handler    EndBlockHandler
+}
+
 type configurator struct {
 	sdkmodule.Configurator
 	msgServer                 gogogrpc.Server
@@ -271,6 +365,8 @@ type configurator struct {
 	exportGenesisHandler      module.ExportGenesisHandler
 	weightedOperationHandler  WeightedOperationsHandler
 	registerInvariantsHandler RegisterInvariantsHandler
+	endBlockHandler           EndBlockHandler
+	migrationHandlers         map[uint64]MigrationHandler
 }
 
 var _ Configurator = &configurator{}
@@ -296,6 +392,20 @@ func (c *configurator) RegisterGenesisHandlers(initGenesisHandler module.InitGen
 	c.exportGenesisHandler = exportGenesisHandler
 }
 
+func (c *configurator) RegisterEndBlockHandler(handler EndBlockHandler) {
+	c.endBlockHandler = handler
+}
+
+// RegisterMigrationHandler registers the handler migrating the module state from fromVersion to
+// fromVersion+1. It is run by Manager.RunMigrations.
+func (c *configurator) RegisterMigrationHandler(fromVersion uint64, handler MigrationHandler) error {
+	if _, found := c.migrationHandlers[fromVersion]; found {
+		return fmt.Errorf("migration from version %d already registered for module %s", fromVersion, c.key.moduleName)
+	}
+	c.migrationHandlers[fromVersion] = handler
+	return nil
+}
+
 func (c *configurator) ModuleKey() RootModuleKey {
 	return c.key
 }
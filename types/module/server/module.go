@@ -23,4 +23,6 @@ type Configurator interface {
 	RegisterInvariantsHandler(registry RegisterInvariantsHandler)
 	RegisterGenesisHandlers(module.InitGenesisHandler, module.ExportGenesisHandler)
 	RegisterWeightedOperationsHandler(WeightedOperationsHandler)
+	RegisterEndBlockHandler(EndBlockHandler)
+	RegisterMigrationHandler(fromVersion uint64, handler MigrationHandler) error
 }
@@ -88,6 +88,7 @@ func (ff FixtureFactory) Setup() testutil.Fixture {
 		cdc:                   cdc,
 		initGenesisHandlers:   mm.initGenesisHandlers,
 		exportGenesisHandlers: mm.exportGenesisHandlers,
+		endBlockers:           mm.endBlockers,
 		t:                     ff.t,
 		signers:               ff.signers,
 	}
@@ -99,6 +100,7 @@ type fixture struct {
 	cdc                   *codec.ProtoCodec
 	initGenesisHandlers   map[string]module.InitGenesisHandler
 	exportGenesisHandlers map[string]module.ExportGenesisHandler
+	endBlockers           []endBlocker
 	t                     *testing.T
 	signers               []sdk.AccAddress
 }
@@ -127,6 +129,10 @@ func (f fixture) ExportGenesis(ctx sdk.Context) (map[string]json.RawMessage, err
 	return exportGenesis(ctx, f.cdc, f.exportGenesisHandlers)
 }
 
+func (f fixture) EndBlock(ctx sdk.Context) error {
+	return endBlock(ctx, f.endBlockers)
+}
+
 func (f fixture) Codec() *codec.ProtoCodec {
 	return f.cdc
 }
@@ -49,6 +49,9 @@ type Fixture interface {
 	// ExportGenesis returns raw encoded JSON genesis state for all modules.
 	ExportGenesis(ctx sdk.Context) (map[string]json.RawMessage, error)
 
+	// EndBlock runs the end block handlers of all modules.
+	EndBlock(ctx sdk.Context) error
+
 	// Codec is the app ProtoCodec.
 	Codec() *codec.ProtoCodec
 
@@ -2,12 +2,15 @@ package client
 
 import (
 	"fmt"
+	"io/ioutil"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	gocid "github.com/ipfs/go-cid"
 	"github.com/spf13/cobra"
+
+	"github.com/regen-network/regen-ledger/x/data"
 )
 
 // QueryCmd returns the parent command for all x/data CLI query commands
@@ -40,6 +43,8 @@ $ regen query data by-cid bafzbeigai3eoy2ccc7ybwjfz5r3rdxqrinwi4rwytly24tdbh6yk7
 
 	cmd.AddCommand(
 		queryByCidCmd,
+		QueryAnchorCmd(),
+		QueryVerifyCmd(),
 	)
 
 	flags.AddQueryFlagsToCmd(cmd)
@@ -80,3 +85,99 @@ func QueryByCidCmd() *cobra.Command {
 
 	return cmd
 }
+
+// QueryAnchorCmd creates a CLI command for Query/Anchor.
+func QueryAnchorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "anchor [iri]",
+		Short: "Query for the timestamp at which data was anchored",
+		Long: strings.TrimSpace(`Query for the timestamp at which data was anchored.
+The response includes the ABCI query path and store key which can be used to query the anchor with a Merkle proof.
+
+Example:
+$ regen query data anchor regen:113gdjFKcVCt13Za6vN7TtbgMM6LMSjRnu89BMCxeuHdkJ1hWUmy.json`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := data.NewQueryClient(clientCtx)
+			res, err := queryClient.Anchor(cmd.Context(), &data.QueryAnchorRequest{Iri: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryVerifyCmd creates a CLI command which checks that some content matches
+// the hash encoded in an IRI.
+func QueryVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [iri] [file]",
+		Short: "Verify that content matches the hash of an IRI",
+		Long: strings.TrimSpace(`Verify that content matches the hash of a raw data IRI.
+If a file is passed, its content is verified. Otherwise, the content stored on chain for the IRI is fetched and verified.
+The command exits with an error if the content doesn't match.
+
+Example:
+$ regen query data verify regen:113gdjFKcVCt13Za6vN7TtbgMM6LMSjRnu89BMCxeuHdkJ1hWUmy.json ./data.json
+$ regen query data verify regen:113gdjFKcVCt13Za6vN7TtbgMM6LMSjRnu89BMCxeuHdkJ1hWUmy.json`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			iri := args[0]
+			hash, err := data.ParseIRI(iri)
+			if err != nil {
+				return err
+			}
+
+			raw := hash.GetRaw()
+			if raw == nil {
+				return fmt.Errorf("%s is not a raw data IRI", iri)
+			}
+
+			var content []byte
+			var source string
+			if len(args) == 2 {
+				source = args[1]
+				content, err = ioutil.ReadFile(source)
+				if err != nil {
+					return err
+				}
+			} else {
+				source = "stored content"
+				queryClient := data.NewQueryClient(clientCtx)
+				res, err := queryClient.Data(cmd.Context(), &data.QueryDataRequest{Iri: iri})
+				if err != nil {
+					return err
+				}
+				content = res.Content
+			}
+
+			err = raw.Verify(content)
+			if err != nil {
+				_ = clientCtx.PrintString(fmt.Sprintf("mismatch: %s does not match %s\n", source, iri))
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("match: %s matches %s\n", source, iri))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
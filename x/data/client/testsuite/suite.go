@@ -1,13 +1,23 @@
 package testsuite
 
 import (
-	"testing"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/gogo/protobuf/types"
-	gocid "github.com/ipfs/go-cid"
-	"github.com/regen-network/regen-ledger/types/testutil/network"
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/regen-network/regen-ledger/types/testutil/cli"
+	"github.com/regen-network/regen-ledger/types/testutil/network"
+	"github.com/regen-network/regen-ledger/x/data"
+	"github.com/regen-network/regen-ledger/x/data/client"
 )
 
 type IntegrationTestSuite struct {
@@ -15,187 +25,285 @@ type IntegrationTestSuite struct {
 
 	cfg     network.Config
 	network *network.Network
+}
 
-	testContent        []byte
-	storedCid          gocid.Cid
-	storedCidTimestamp *types.Timestamp
-	signer             sdk.AccAddress
+func NewIntegrationTestSuite(cfg network.Config) *IntegrationTestSuite {
+	return &IntegrationTestSuite{cfg: cfg}
 }
 
-// TODO call NewIntegrationTestSuite to set cfg field of IntegrationTestSuite
-// remove cfg := network.DefaultConfig()
-// replace cfg.NumValidators = 2 with s.cfg.NumValidators = 2
-// replace s.network = network.New(s.T(), cfg) with s.network = network.New(s.T(), s.cfg)
-// remove TestIntegrationTestSuite func
 func (s *IntegrationTestSuite) SetupSuite() {
-	//s.T().Log("setting up integration test suite")
-	//
-	//cfg := network.DefaultConfig()
-	//cfg.NumValidators = 2
-	//
-	//s.cfg = cfg
-	//s.network = network.New(s.T(), cfg)
-	//
-	//_, err := s.network.WaitForHeight(1)
-	//s.Require().NoError(err)
-	//
-	//val := s.network.Validators[0]
-	//clientCtx := val.ClientCtx
-	//
-	//testContent := []byte("xyzabc12345")
-	//cid := s.getCid(testContent)
-	//
-	//commonFlags := []string{
-	//	fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-	//	fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
-	//	fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
-	//}
-	//
-	//args := append(
-	//	[]string{
-	//		val.Address.String(),
-	//		cid.String(),
-	//	},
-	//	commonFlags...,
-	//)
-	//
-	//_, err = clitestutil.ExecTestCLICmd(clientCtx, dataclient.MsgAnchorDataCmd(), args)
-	//
-	//s.Require().NoError(s.network.WaitForNextBlock())
-	//s.testContent = testContent
-	//s.storedCid = cid
-	//
-	//// create a new account
-	//info, _, err := val.ClientCtx.Keyring.NewMnemonic("NewValidator", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
-	//s.Require().NoError(err)
-	//
-	//account := sdk.AccAddress(info.GetPubKey().Address())
-	//_, err = banktestutil.MsgSendExec(
-	//	val.ClientCtx,
-	//	val.Address,
-	//	account,
-	//	sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(200))), fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-	//	fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
-	//	fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
-	//)
-	//
-	//s.Require().NoError(err)
-	//
-	//// add a signer
-	//args = append(
-	//	[]string{
-	//		account.String(),
-	//		cid.String(),
-	//	},
-	//	commonFlags...,
-	//)
-	//_, err = clitestutil.ExecTestCLICmd(clientCtx, dataclient.MsgSignDataCmd(), args)
-	//
-	//s.signer = account
+	s.T().Log("setting up integration test suite")
+
+	s.cfg.NumValidators = 1
+	s.network = network.New(s.T(), s.cfg)
+
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
 }
 
 func (s *IntegrationTestSuite) TearDownSuite() {
-	//s.T().Log("tearing down integration test suite")
-	//s.network.Cleanup()
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) writeFile(name string, content []byte) string {
+	fileName := filepath.Join(s.T().TempDir(), name)
+	s.Require().NoError(ioutil.WriteFile(fileName, content, 0600))
+	return fileName
 }
 
 func (s *IntegrationTestSuite) TestTxAnchorData() {
-	//val := s.network.Validators[0]
-	//clientCtx := val.ClientCtx
-	//
-	//testContent := []byte("xyzabc123")
-	//cid := s.getCid(testContent)
-	//
-	//var commonFlags = []string{
-	//	fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-	//	fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
-	//	fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
-	//}
-	//
-	//testCases := []struct {
-	//	name         string
-	//	args         []string
-	//	expectErr    bool
-	//	expectErrMsg string
-	//	respType     proto.Message
-	//	expectedCode uint32
-	//}{
-	//	{
-	//		"wrong sender",
-	//		append(
-	//			[]string{
-	//				"wrongSender",
-	//				cid.String(),
-	//			},
-	//			commonFlags...,
-	//		),
-	//		true,
-	//		"The specified item could not be found in the keyring",
-	//		nil,
-	//		0,
-	//	},
-	//	{
-	//		"wrong cid",
-	//		append(
-	//			[]string{
-	//				val.Address.String(),
-	//				"wrongCid",
-	//			},
-	//			commonFlags...,
-	//		),
-	//		true,
-	//		"selected encoding not supported",
-	//		nil,
-	//		0,
-	//	},
-	//	{
-	//		"correct data",
-	//		append(
-	//			[]string{
-	//				val.Address.String(),
-	//				cid.String(),
-	//			},
-	//			commonFlags...,
-	//		),
-	//		false,
-	//		"",
-	//		&sdk.TxResponse{},
-	//		0,
-	//	},
-	//	{
-	//		"duplicate data",
-	//		append(
-	//			[]string{
-	//				val.Address.String(),
-	//				cid.String(),
-	//			},
-	//			commonFlags...,
-	//		),
-	//		false,
-	//		"",
-	//		&sdk.TxResponse{},
-	//		18,
-	//	},
-	//}
-	//
-	//for _, tc := range testCases {
-	//	tc := tc
-	//
-	//	s.Run(tc.name, func() {
-	//		cmd := dataclient.MsgAnchorDataCmd()
-	//
-	//		out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
-	//		if tc.expectErr {
-	//			s.Require().Contains(out.String(), tc.expectErrMsg)
-	//		} else {
-	//			s.Require().NoError(err, out.String())
-	//			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
-	//
-	//			txResp := tc.respType.(*sdk.TxResponse)
-	//			s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
-	//		}
-	//	})
-	//}
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	queryClient := data.NewQueryClient(clientCtx)
+
+	var commonFlags = []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	anchoredContent := []byte("anchored content")
+	anchoredFile := s.writeFile("anchored.txt", anchoredContent)
+	storedContent := []byte(`{"stored": "content"}`)
+	storedFile := s.writeFile("stored.json", storedContent)
+	storedHash := sha3.Sum256(storedContent)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectErrMsg string
+		expectedCode uint32
+	}{
+		{
+			"missing file",
+			append([]string{filepath.Join(s.T().TempDir(), "missing.txt")}, commonFlags...),
+			true,
+			"no such file or directory",
+			0,
+		},
+		{
+			"directory",
+			append([]string{s.T().TempDir()}, commonFlags...),
+			true,
+			"is a directory",
+			0,
+		},
+		{
+			"unsupported hash",
+			append([]string{anchoredFile, fmt.Sprintf("--%s=sha2-256", client.FlagHash)}, commonFlags...),
+			true,
+			"unsupported hash sha2-256",
+			0,
+		},
+		{
+			"anchor file",
+			append([]string{anchoredFile}, commonFlags...),
+			false,
+			"",
+			0,
+		},
+		{
+			"file already anchored",
+			append([]string{anchoredFile}, commonFlags...),
+			false,
+			"",
+			18,
+		},
+		{
+			"anchor and store file",
+			append([]string{storedFile, fmt.Sprintf("--%s=sha3-256", client.FlagHash), fmt.Sprintf("--%s", client.FlagStore)}, commonFlags...),
+			false,
+			"",
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := cli.ExecTestCLICmd(clientCtx, client.MsgAnchorDataCmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err, out.String())
+
+				var txResp sdk.TxResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+
+	// the anchored file is anchored without its content
+	anchoredHash, err := data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256.Digest(anchoredContent)
+	s.Require().NoError(err)
+	res, err := queryClient.ByHash(context.Background(), &data.QueryByHashRequest{
+		Hash: &data.ContentHash{Sum: &data.ContentHash_Raw_{Raw: &data.ContentHash_Raw{
+			Hash:            anchoredHash,
+			DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+			MediaType:       data.MediaType_MEDIA_TYPE_TEXT_PLAIN,
+		}}},
+	})
+	s.Require().NoError(err)
+	s.Require().Nil(res.Entry.Content)
+
+	// the stored file is anchored with its content
+	res, err = queryClient.ByHash(context.Background(), &data.QueryByHashRequest{
+		Hash: &data.ContentHash{Sum: &data.ContentHash_Raw_{Raw: &data.ContentHash_Raw{
+			Hash:            storedHash[:],
+			DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_SHA3_256,
+			MediaType:       data.MediaType_MEDIA_TYPE_JSON,
+		}}},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(storedContent, res.Entry.Content.GetRawData())
+}
+
+func (s *IntegrationTestSuite) TestQueryVerify() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	content := []byte(`{"verified": "content"}`)
+	file := s.writeFile("verified.json", content)
+	tamperedFile := s.writeFile("tampered.json", []byte(`{"verified": "tampered"}`))
+
+	hash, err := data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256.Digest(content)
+	s.Require().NoError(err)
+	iri, err := data.ContentHash_Raw{
+		Hash:            hash,
+		DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		MediaType:       data.MediaType_MEDIA_TYPE_JSON,
+	}.ToIRI()
+	s.Require().NoError(err)
+
+	// store the content so that it can be fetched from chain
+	out, err := cli.ExecTestCLICmd(clientCtx, client.MsgAnchorDataCmd(), []string{
+		file,
+		fmt.Sprintf("--%s", client.FlagStore),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	})
+	s.Require().NoError(err, out.String())
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectErrMsg string
+		expectedOut  string
+	}{
+		{
+			"invalid iri",
+			[]string{"foo", file},
+			true,
+			"can't parse IRI",
+			"",
+		},
+		{
+			"matching file",
+			[]string{iri, file},
+			false,
+			"",
+			"match:",
+		},
+		{
+			"tampered file",
+			[]string{iri, tamperedFile},
+			true,
+			data.ErrHashVerificationFailed.Error(),
+			"mismatch:",
+		},
+		{
+			"missing file",
+			[]string{iri, filepath.Join(s.T().TempDir(), "missing.json")},
+			true,
+			"no such file or directory",
+			"",
+		},
+		{
+			"stored content",
+			[]string{iri},
+			false,
+			"",
+			"match: stored content",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := cli.ExecTestCLICmd(clientCtx, client.QueryVerifyCmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err, out.String())
+			}
+			s.Require().Contains(out.String(), tc.expectedOut)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestQueryAnchor() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	content := []byte(`{"anchored": "content"}`)
+	file := s.writeFile("anchored.json", content)
+
+	hash, err := data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256.Digest(content)
+	s.Require().NoError(err)
+	iri, err := data.ContentHash_Raw{
+		Hash:            hash,
+		DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		MediaType:       data.MediaType_MEDIA_TYPE_JSON,
+	}.ToIRI()
+	s.Require().NoError(err)
+
+	_, err = cli.ExecTestCLICmd(clientCtx, client.QueryAnchorCmd(), []string{iri})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "is not anchored")
+
+	out, err := cli.ExecTestCLICmd(clientCtx, client.MsgAnchorDataCmd(), []string{
+		file,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	})
+	s.Require().NoError(err, out.String())
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	out, err = cli.ExecTestCLICmd(clientCtx, client.QueryAnchorCmd(), []string{iri, fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err, out.String())
+	var res data.QueryAnchorResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().NotNil(res.Timestamp)
+
+	// the anchor can be queried with a proof at the returned path and key
+	proofRes, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:  res.ProofPath,
+		Data:  res.ProofKey,
+		Prove: true,
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(proofRes.ProofOps)
+	var timestamp gogotypes.Timestamp
+	s.Require().NoError(timestamp.Unmarshal(proofRes.Value))
+	s.Require().Equal(*res.Timestamp, timestamp)
 }
 
 func (s *IntegrationTestSuite) TestGetAnchorDataByCID() {
@@ -425,13 +533,3 @@ func (s *IntegrationTestSuite) TestTxStoreData() {
 	//	})
 	//}
 }
-
-//func (s *IntegrationTestSuite) getCid(content []byte) gocid.Cid {
-//	mh, err := multihash.Sum(content, multihash.SHA2_256, -1)
-//	s.Require().NoError(err)
-//	return gocid.NewCidV1(gocid.Raw, mh)
-//}
-
-func TestIntegrationTestSuite(t *testing.T) {
-	suite.Run(t, new(IntegrationTestSuite))
-}
@@ -2,10 +2,18 @@ package client
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
+
+	"github.com/regen-network/regen-ledger/x/data"
 )
 
 // TxCmd returns a root CLI command handler for all x/data transaction commands.
@@ -27,46 +35,115 @@ func TxCmd(name string) *cobra.Command {
 	return cmd
 }
 
+const (
+	FlagHash  = "hash"
+	FlagStore = "store"
+)
+
+// digestAlgorithms maps the --hash flag values to the supported digest algorithms.
+var digestAlgorithms = map[string]data.DigestAlgorithm{
+	"blake2b-256": data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+	"blake2b-512": data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_512,
+	"sha3-256":    data.DigestAlgorithm_DIGEST_ALGORITHM_SHA3_256,
+}
+
 // MsgAnchorDataCmd creates a CLI command for Msg/AnchorData.
 func MsgAnchorDataCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use: "anchor [sender] [cid]",
-		Short: "Anchors a piece of data to the blockchain based on its secure " +
+		Use: "anchor [file]",
+		Short: "Anchors a local file to the blockchain based on its secure " +
 			"hash, effectively providing a tamper resistant timestamp.",
-		Args: cobra.ExactArgs(2),
+		Long: strings.TrimSpace(`Anchors a local file to the blockchain based on its secure hash, effectively providing a tamper resistant timestamp.
+
+The hash of the file is computed locally with the digest algorithm selected with --hash
+(blake2b-256, blake2b-512 or sha3-256) and its media type is derived from the file extension.
+With --store, the content of the file is also stored on-chain in the same transaction.
+
+Example:
+$ regen tx data anchor report.pdf --hash blake2b-256 --store --from mykey`),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return fmt.Errorf("not implemented")
-			//err := cmd.Flags().Set(flags.FlagFrom, args[0])
-			//if err != nil {
-			//	return err
-			//}
-			//
-			//clientCtx, err := sdkclient.GetClientTxContext(cmd)
-			//if err != nil {
-			//	return err
-			//}
-			//
-			//cid, err := gocid.Decode(args[1])
-			//if err != nil {
-			//	return err
-			//}
-			//
-			//msg := data.MsgAnchorDataRequest{
-			//	Sender: clientCtx.GetFromAddress().String(),
-			//	Cid:    cid.Bytes(),
-			//}
-			//svcMsgClientConn := &client.ServiceMsgClientConn{}
-			//msgClient := data.NewMsgClient(svcMsgClientConn)
-			//_, err = msgClient.AnchorData(cmd.Context(), &msg)
-			//if err != nil {
-			//	return err
-			//}
-			//
-			//return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), svcMsgClientConn.Msgs...)
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			hashName, err := cmd.Flags().GetString(FlagHash)
+			if err != nil {
+				return err
+			}
+
+			digestAlgorithm, ok := digestAlgorithms[hashName]
+			if !ok {
+				return fmt.Errorf("unsupported hash %s", hashName)
+			}
+
+			store, err := cmd.Flags().GetBool(FlagStore)
+			if err != nil {
+				return err
+			}
+
+			fileName := args[0]
+			info, err := os.Stat(fileName)
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return fmt.Errorf("%s is a directory", fileName)
+			}
+			if store && uint64(info.Size()) > data.MaxDataSizeLimit {
+				return fmt.Errorf("%s is %d bytes which exceeds the maximum data size of %d bytes", fileName, info.Size(), data.MaxDataSizeLimit)
+			}
+
+			content, err := ioutil.ReadFile(fileName)
+			if err != nil {
+				return err
+			}
+
+			hash, err := digestAlgorithm.Digest(content)
+			if err != nil {
+				return err
+			}
+
+			mediaType := data.MediaType_MEDIA_TYPE_UNSPECIFIED
+			if ext := strings.TrimPrefix(filepath.Ext(fileName), "."); ext != "" {
+				if mt, err := data.ExtensionToMediaType(strings.ToLower(ext)); err == nil {
+					mediaType = mt
+				}
+			}
+
+			contentHash := &data.ContentHash_Raw{
+				Hash:            hash,
+				DigestAlgorithm: digestAlgorithm,
+				MediaType:       mediaType,
+			}
+
+			sender := clientCtx.GetFromAddress().String()
+			msgs := []sdk.Msg{&data.MsgAnchorData{
+				Sender: sender,
+				Hash:   &data.ContentHash{Sum: &data.ContentHash_Raw_{Raw: contentHash}},
+			}}
+			if store {
+				msgs = append(msgs, &data.MsgStoreRawData{
+					Sender:      sender,
+					ContentHash: contentHash,
+					Content:     content,
+				})
+			}
+
+			for _, msg := range msgs {
+				if err = msg.ValidateBasic(); err != nil {
+					return fmt.Errorf("message validation failed: %w", err)
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagHash, "blake2b-256", "the digest algorithm used to hash the file: blake2b-256, blake2b-512 or sha3-256")
+	cmd.Flags().Bool(FlagStore, false, "also store the content of the file on-chain")
 
 	return cmd
 }
@@ -169,31 +169,89 @@ func (m *EventStoreRawData) GetIri() string {
 	return ""
 }
 
+// EventDeleteStoredContent is an event emitted when data stored on-chain is deleted.
+type EventDeleteStoredContent struct {
+	// iri is the data IRI
+	Iri string `protobuf:"bytes,1,opt,name=iri,proto3" json:"iri,omitempty"`
+	// sender is the address of the account which deleted the content.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *EventDeleteStoredContent) Reset()         { *m = EventDeleteStoredContent{} }
+func (m *EventDeleteStoredContent) String() string { return proto.CompactTextString(m) }
+func (*EventDeleteStoredContent) ProtoMessage()    {}
+func (*EventDeleteStoredContent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f405832eebe356f, []int{3}
+}
+func (m *EventDeleteStoredContent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeleteStoredContent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeleteStoredContent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDeleteStoredContent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeleteStoredContent.Merge(m, src)
+}
+func (m *EventDeleteStoredContent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeleteStoredContent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeleteStoredContent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeleteStoredContent proto.InternalMessageInfo
+
+func (m *EventDeleteStoredContent) GetIri() string {
+	if m != nil {
+		return m.Iri
+	}
+	return ""
+}
+
+func (m *EventDeleteStoredContent) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventAnchorData)(nil), "regen.data.v1alpha2.EventAnchorData")
 	proto.RegisterType((*EventSignData)(nil), "regen.data.v1alpha2.EventSignData")
 	proto.RegisterType((*EventStoreRawData)(nil), "regen.data.v1alpha2.EventStoreRawData")
+	proto.RegisterType((*EventDeleteStoredContent)(nil), "regen.data.v1alpha2.EventDeleteStoredContent")
 }
 
 func init() { proto.RegisterFile("regen/data/v1alpha2/events.proto", fileDescriptor_2f405832eebe356f) }
 
 var fileDescriptor_2f405832eebe356f = []byte{
-	// 227 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x4a, 0x4d, 0x4f,
-	0xcd, 0xd3, 0x4f, 0x49, 0x2c, 0x49, 0xd4, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34, 0xd2,
-	0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x06, 0xab,
-	0xd0, 0x03, 0xa9, 0xd0, 0x83, 0xa9, 0x90, 0x92, 0xc7, 0xa6, 0xad, 0xa4, 0xb2, 0x20, 0x15, 0xaa,
-	0x4b, 0x49, 0x99, 0x8b, 0xdf, 0x15, 0x64, 0x8a, 0x63, 0x5e, 0x72, 0x46, 0x7e, 0x91, 0x4b, 0x62,
-	0x49, 0xa2, 0x90, 0x00, 0x17, 0x73, 0x66, 0x51, 0xa6, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10,
-	0x88, 0xa9, 0x64, 0xcd, 0xc5, 0x0b, 0x56, 0x14, 0x9c, 0x99, 0x9e, 0x87, 0x5d, 0x89, 0x90, 0x04,
-	0x17, 0x7b, 0x71, 0x66, 0x7a, 0x5e, 0x6a, 0x51, 0xb1, 0x04, 0x93, 0x02, 0xb3, 0x06, 0x67, 0x10,
-	0x8c, 0xab, 0xa4, 0xca, 0x25, 0x08, 0xd1, 0x5c, 0x92, 0x5f, 0x94, 0x1a, 0x94, 0x58, 0x8e, 0xdd,
-	0x00, 0x27, 0xb7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
-	0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x49, 0xcf,
-	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0x7b, 0x47, 0x37, 0x2f, 0xb5, 0xa4,
-	0x3c, 0xbf, 0x28, 0x1b, 0xca, 0xcb, 0x49, 0x4d, 0x49, 0x4f, 0x2d, 0xd2, 0xaf, 0x00, 0xfb, 0x32,
-	0x89, 0x0d, 0xec, 0x2f, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x13, 0x87, 0x5e, 0x31,
-	0x01, 0x00, 0x00,
+	// 258 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xc1, 0x4a, 0xc4, 0x30,
+	0x14, 0x45, 0x9b, 0x19, 0x18, 0x99, 0x80, 0xa8, 0x15, 0x24, 0xb8, 0x88, 0xa5, 0x22, 0xcc, 0x42,
+	0x1b, 0xd4, 0xa5, 0x2b, 0xb5, 0xfa, 0x01, 0x75, 0xe7, 0x2e, 0x33, 0x7d, 0xa4, 0xc1, 0x9a, 0x94,
+	0xf4, 0x39, 0xa3, 0x7f, 0xe1, 0x67, 0xb9, 0x9c, 0xa5, 0x4b, 0x69, 0x7f, 0x44, 0x1a, 0xdb, 0x5d,
+	0xdd, 0xe5, 0x86, 0x73, 0xdf, 0x85, 0x43, 0x23, 0x07, 0x0a, 0x8c, 0xc8, 0x25, 0x4a, 0xb1, 0xbe,
+	0x94, 0x65, 0x55, 0xc8, 0x2b, 0x01, 0x6b, 0x30, 0x58, 0x27, 0x95, 0xb3, 0x68, 0xc3, 0x43, 0x4f,
+	0x24, 0x1d, 0x91, 0x0c, 0xc4, 0xf1, 0xc9, 0x58, 0x0d, 0x3f, 0x2a, 0xe8, 0x5b, 0xf1, 0x29, 0xdd,
+	0x7b, 0xe8, 0xae, 0xdc, 0x9a, 0x55, 0x61, 0x5d, 0x2a, 0x51, 0x86, 0xfb, 0x74, 0xaa, 0x9d, 0x66,
+	0x24, 0x22, 0x8b, 0x79, 0xd6, 0x3d, 0xe3, 0x1b, 0xba, 0xeb, 0xa1, 0x27, 0xad, 0xcc, 0x38, 0x12,
+	0x32, 0xba, 0x53, 0x6b, 0x65, 0xc0, 0xd5, 0x6c, 0x12, 0x4d, 0x17, 0xf3, 0x6c, 0x88, 0xf1, 0x19,
+	0x3d, 0xf8, 0x2b, 0xa3, 0x75, 0x90, 0xc9, 0xcd, 0x3f, 0x1b, 0x29, 0x65, 0x1e, 0x4b, 0xa1, 0x04,
+	0x04, 0x0f, 0xe7, 0xf7, 0xd6, 0x20, 0x18, 0x1c, 0x99, 0x3b, 0xa2, 0xb3, 0x1a, 0x4c, 0x0e, 0x8e,
+	0x4d, 0xfc, 0x67, 0x9f, 0xee, 0x1e, 0xbf, 0x1a, 0x4e, 0xb6, 0x0d, 0x27, 0x3f, 0x0d, 0x27, 0x9f,
+	0x2d, 0x0f, 0xb6, 0x2d, 0x0f, 0xbe, 0x5b, 0x1e, 0x3c, 0x9f, 0x2b, 0x8d, 0xc5, 0xdb, 0x32, 0x59,
+	0xd9, 0x57, 0xe1, 0xa5, 0x5c, 0x18, 0xc0, 0x8d, 0x75, 0x2f, 0x7d, 0x2a, 0x21, 0x57, 0xe0, 0xc4,
+	0xbb, 0x77, 0xb5, 0x9c, 0x79, 0x3b, 0xd7, 0xbf, 0x03, 0x00, 0x1a, 0xd7, 0xd2, 0x9e, 0x77, 0x01,
+	0x00, 0x00,
 }
 
 func (m *EventAnchorData) Marshal() (dAtA []byte, err error) {
@@ -295,6 +353,43 @@ func (m *EventStoreRawData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventDeleteStoredContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeleteStoredContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeleteStoredContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Iri) > 0 {
+		i -= len(m.Iri)
+		copy(dAtA[i:], m.Iri)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Iri)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -351,6 +446,23 @@ func (m *EventStoreRawData) Size() (n int) {
 	return n
 }
 
+func (m *EventDeleteStoredContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Iri)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -644,6 +756,123 @@ func (m *EventStoreRawData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventDeleteStoredContent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeleteStoredContent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeleteStoredContent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Iri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,91 @@
+package data
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate performs basic validation of the data module genesis state,
+// it returns an error if a content entry is invalid, duplicated, or has
+// stored content which doesn't match its hash.
+func (s *GenesisState) Validate() error {
+	if err := s.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(s.Entries))
+	for _, entry := range s.Entries {
+		if entry == nil || entry.Hash == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("empty content entry")
+		}
+
+		iri, err := entry.Hash.ToIRI()
+		if err != nil {
+			return err
+		}
+
+		if seen[iri] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate content entry %s", iri)
+		}
+		seen[iri] = true
+
+		if err := entry.validate(iri); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *GenesisContentEntry) validate(iri string) error {
+	if e.Timestamp == nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("missing timestamp for %s", iri)
+	}
+
+	seenSigners := make(map[string]bool, len(e.Signers))
+	for _, signer := range e.Signers {
+		if signer == nil || signer.Timestamp == nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("missing signer timestamp for %s", iri)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(signer.Signer); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid signer for %s: %s", iri, err.Error())
+		}
+
+		if seenSigners[signer.Signer] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate signer %s for %s", signer.Signer, iri)
+		}
+		seenSigners[signer.Signer] = true
+	}
+
+	content := e.Content.GetRawData()
+	if e.Storer != "" {
+		if _, err := sdk.AccAddressFromBech32(e.Storer); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid storer for %s: %s", iri, err.Error())
+		}
+
+		if content == nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("storer set without stored content for %s", iri)
+		}
+	}
+
+	if content == nil {
+		return nil
+	}
+
+	raw := e.Hash.GetRaw()
+	if raw == nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("only raw data can be stored, got content for %s", iri)
+	}
+
+	return sdkerrors.Wrap(raw.Verify(content), fmt.Sprintf("content for %s", iri))
+}
+
+// DefaultGenesisState returns a default data module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
@@ -5,6 +5,7 @@ package data
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
 	io "io"
@@ -27,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// entries are the content entries
 	Entries []*GenesisContentEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// params are the data module parameters
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +72,13 @@ func (m *GenesisState) GetEntries() []*GenesisContentEntry {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // GenesisContentEntry is a genesis content entry
 type GenesisContentEntry struct {
 	// hash is the ContentHash
@@ -79,6 +89,8 @@ type GenesisContentEntry struct {
 	Signers []*SignerEntry `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 	// content is the actual content if stored on-chain
 	Content *Content `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// storer is the address of the account which stored the content, if any
+	Storer string `protobuf:"bytes,5,opt,name=storer,proto3" json:"storer,omitempty"`
 }
 
 func (m *GenesisContentEntry) Reset()         { *m = GenesisContentEntry{} }
@@ -142,6 +154,13 @@ func (m *GenesisContentEntry) GetContent() *Content {
 	return nil
 }
 
+func (m *GenesisContentEntry) GetStorer() string {
+	if m != nil {
+		return m.Storer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "regen.data.v1alpha2.GenesisState")
 	proto.RegisterType((*GenesisContentEntry)(nil), "regen.data.v1alpha2.GenesisContentEntry")
@@ -150,27 +169,30 @@ func init() {
 func init() { proto.RegisterFile("regen/data/v1alpha2/genesis.proto", fileDescriptor_599f0156c5393123) }
 
 var fileDescriptor_599f0156c5393123 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x3f, 0x4f, 0x02, 0x31,
-	0x18, 0xc6, 0xa9, 0x10, 0x89, 0xc5, 0xe9, 0x58, 0x2e, 0xc4, 0x14, 0x64, 0x62, 0xd0, 0x36, 0xa2,
-	0x31, 0xc6, 0x11, 0xe3, 0x9f, 0xf9, 0x70, 0x72, 0x2b, 0xf0, 0xda, 0xbb, 0x08, 0xed, 0xa5, 0x7d,
-	0x51, 0xf9, 0x16, 0x7e, 0x2c, 0x47, 0x46, 0x47, 0x03, 0x9f, 0xc2, 0xcd, 0xd8, 0xeb, 0xe9, 0x72,
-	0x71, 0x6c, 0xf2, 0xfb, 0xbd, 0xcf, 0xf3, 0xa4, 0xf4, 0xd0, 0x82, 0x02, 0x2d, 0x66, 0x12, 0xa5,
-	0x78, 0x3e, 0x91, 0xf3, 0x3c, 0x95, 0x43, 0xa1, 0x40, 0x83, 0xcb, 0x1c, 0xcf, 0xad, 0x41, 0x13,
-	0xb5, 0x3d, 0xc2, 0x7f, 0x10, 0x5e, 0x22, 0x9d, 0x6e, 0x95, 0x87, 0xab, 0x1c, 0x82, 0xd5, 0xe9,
-	0x2a, 0x63, 0xd4, 0x1c, 0x84, 0x7f, 0x4d, 0x96, 0x8f, 0x02, 0xb3, 0x05, 0x38, 0x94, 0x8b, 0xbc,
-	0x00, 0xfa, 0x09, 0xdd, 0xbf, 0x2d, 0x72, 0xc6, 0x28, 0x11, 0xa2, 0x11, 0x6d, 0x82, 0x46, 0x9b,
-	0x81, 0x8b, 0x49, 0xaf, 0x3e, 0x68, 0x0d, 0x07, 0xbc, 0x22, 0x98, 0x07, 0xe7, 0xca, 0x68, 0x04,
-	0x8d, 0xd7, 0x1a, 0xed, 0x2a, 0x29, 0xc5, 0xfe, 0x17, 0xa1, 0xed, 0x0a, 0x20, 0x3a, 0xa3, 0x8d,
-	0x54, 0xba, 0x34, 0x26, 0x3d, 0x32, 0x68, 0x0d, 0x7b, 0x95, 0x87, 0x83, 0x70, 0x27, 0x5d, 0x9a,
-	0x78, 0x3a, 0xba, 0xa0, 0x7b, 0xbf, 0xa5, 0xe3, 0x1d, 0xaf, 0x76, 0x78, 0x31, 0x8b, 0x97, 0xb3,
-	0xf8, 0x7d, 0x49, 0x24, 0x7f, 0x70, 0x74, 0x49, 0x9b, 0x2e, 0x53, 0x1a, 0xac, 0x8b, 0xeb, 0x7e,
-	0x4b, 0x75, 0xe4, 0xd8, 0x33, 0x61, 0x43, 0x10, 0xa2, 0x73, 0xda, 0x9c, 0x16, 0x55, 0xe2, 0x86,
-	0xcf, 0x3c, 0xf8, 0xaf, 0x6e, 0x52, 0xc2, 0xa3, 0x9b, 0xf7, 0x0d, 0x23, 0xeb, 0x0d, 0x23, 0x9f,
-	0x1b, 0x46, 0xde, 0xb6, 0xac, 0xb6, 0xde, 0xb2, 0xda, 0xc7, 0x96, 0xd5, 0x1e, 0x8e, 0x54, 0x86,
-	0xe9, 0x72, 0xc2, 0xa7, 0x66, 0x21, 0xfc, 0xa9, 0x63, 0x0d, 0xf8, 0x62, 0xec, 0x53, 0x78, 0xcd,
-	0x61, 0xa6, 0xc0, 0x8a, 0x57, 0xff, 0x9b, 0x93, 0x5d, 0x3f, 0xed, 0xf4, 0x3b, 0x00, 0x00, 0xff,
-	0xff, 0x38, 0xed, 0xc8, 0x95, 0x1a, 0x02, 0x00, 0x00,
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x3d, 0x8f, 0xd3, 0x30,
+	0x18, 0xc7, 0xe3, 0xb6, 0xb4, 0xaa, 0xcb, 0xe4, 0x22, 0x14, 0x15, 0x94, 0x86, 0x4e, 0x19, 0xc0,
+	0x16, 0x05, 0x21, 0x60, 0x2c, 0xe2, 0x65, 0x44, 0x29, 0x13, 0x9b, 0xdb, 0x3e, 0x38, 0x11, 0x8d,
+	0x1d, 0xd9, 0x2e, 0x5c, 0x3f, 0xc4, 0x49, 0x77, 0xdf, 0xaa, 0x63, 0xc7, 0x9b, 0x4e, 0xa7, 0xf6,
+	0x8b, 0x9c, 0xea, 0x38, 0x77, 0x4b, 0xee, 0xb6, 0x3c, 0xca, 0xef, 0xff, 0xf2, 0x3c, 0x32, 0x7e,
+	0xa5, 0x41, 0x80, 0x64, 0x2b, 0x6e, 0x39, 0xfb, 0xf7, 0x96, 0xaf, 0xcb, 0x8c, 0x4f, 0x99, 0x00,
+	0x09, 0x26, 0x37, 0xb4, 0xd4, 0xca, 0x2a, 0x32, 0x74, 0x08, 0x3d, 0x21, 0xb4, 0x46, 0x46, 0xcf,
+	0x84, 0x12, 0xca, 0xfd, 0x67, 0xa7, 0xaf, 0x0a, 0x1d, 0x8d, 0x9b, 0xdc, 0xec, 0xb6, 0x04, 0x53,
+	0x03, 0x42, 0x29, 0xb1, 0x06, 0xe6, 0xa6, 0xc5, 0xe6, 0x0f, 0xb3, 0x79, 0x01, 0xc6, 0xf2, 0xa2,
+	0xac, 0x80, 0xc9, 0x39, 0xc2, 0x4f, 0xbf, 0x57, 0xf1, 0x73, 0xcb, 0x2d, 0x90, 0x19, 0xee, 0x81,
+	0xb4, 0x3a, 0x07, 0x13, 0xa2, 0xb8, 0x9d, 0x0c, 0xa6, 0x09, 0x6d, 0xe8, 0x43, 0xbd, 0xe6, 0x8b,
+	0x92, 0x16, 0xa4, 0xfd, 0x2a, 0xad, 0xde, 0xa6, 0xb5, 0x90, 0x7c, 0xc2, 0xdd, 0x92, 0x6b, 0x5e,
+	0x98, 0xb0, 0x15, 0xa3, 0x64, 0x30, 0x7d, 0xd1, 0x68, 0xf1, 0xd3, 0x21, 0xb3, 0xce, 0xee, 0x7a,
+	0x1c, 0xa4, 0x5e, 0x30, 0xb9, 0x6c, 0xe1, 0x61, 0x83, 0x37, 0x79, 0x8f, 0x3b, 0x19, 0x37, 0x59,
+	0x88, 0x9c, 0x61, 0xdc, 0x68, 0xe8, 0x05, 0x3f, 0xb8, 0xc9, 0x52, 0x47, 0x93, 0x8f, 0xb8, 0x7f,
+	0xb7, 0xb0, 0xef, 0x32, 0xa2, 0xd5, 0x49, 0x68, 0x7d, 0x12, 0xfa, 0xab, 0x26, 0xd2, 0x7b, 0x98,
+	0x7c, 0xc6, 0x3d, 0x93, 0x0b, 0x09, 0xda, 0x84, 0xed, 0xb8, 0xfd, 0x60, 0xe4, 0xdc, 0x31, 0x7e,
+	0x7d, 0x2f, 0x20, 0x1f, 0x70, 0x6f, 0x59, 0x55, 0x09, 0x3b, 0x2e, 0xf3, 0xe5, 0x63, 0x75, 0xd3,
+	0x1a, 0x26, 0xcf, 0x71, 0xd7, 0x58, 0xa5, 0x41, 0x87, 0x4f, 0x62, 0x94, 0xf4, 0x53, 0x3f, 0xcd,
+	0xbe, 0xed, 0x0e, 0x11, 0xda, 0x1f, 0x22, 0x74, 0x73, 0x88, 0xd0, 0xc5, 0x31, 0x0a, 0xf6, 0xc7,
+	0x28, 0xb8, 0x3a, 0x46, 0xc1, 0xef, 0xd7, 0x22, 0xb7, 0xd9, 0x66, 0x41, 0x97, 0xaa, 0x60, 0x2e,
+	0xe2, 0x8d, 0x04, 0xfb, 0x5f, 0xe9, 0xbf, 0x7e, 0x5a, 0xc3, 0x4a, 0x80, 0x66, 0x67, 0xee, 0x85,
+	0x2c, 0xba, 0x6e, 0xe5, 0x77, 0xb7, 0x03, 0x00, 0x82, 0x49, 0xae, 0xa1, 0x84, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -193,6 +215,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Entries) > 0 {
 		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -230,6 +262,13 @@ func (m *GenesisContentEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Storer) > 0 {
+		i -= len(m.Storer)
+		copy(dAtA[i:], m.Storer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Storer)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Content != nil {
 		{
 			size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
@@ -306,6 +345,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -333,6 +374,10 @@ func (m *GenesisContentEntry) Size() (n int) {
 		l = m.Content.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = len(m.Storer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -405,6 +450,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -600,6 +678,38 @@ func (m *GenesisContentEntry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
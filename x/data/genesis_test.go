@@ -0,0 +1,112 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	content := []byte("xyzabc123")
+	digest := blake2b.Sum256(content)
+	rawHash := &ContentHash{Sum: &ContentHash_Raw_{Raw: &ContentHash_Raw{
+		Hash:            digest[:],
+		DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+	}}}
+	graphHash := &ContentHash{Sum: &ContentHash_Graph_{Graph: &ContentHash_Graph{
+		Hash:                      digest[:],
+		DigestAlgorithm:           DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		CanonicalizationAlgorithm: GraphCanonicalizationAlgorithm_GRAPH_CANONICALIZATION_ALGORITHM_URDNA2015,
+	}}}
+	timestamp := &gogotypes.Timestamp{Seconds: 100}
+	rawContent := &Content{Sum: &Content_RawData{RawData: content}}
+
+	tests := []struct {
+		name    string
+		entries []*GenesisContentEntry
+		wantErr string
+	}{
+		{
+			"valid",
+			[]*GenesisContentEntry{
+				{Hash: rawHash, Timestamp: timestamp, Content: rawContent, Storer: addr.String()},
+				{Hash: graphHash, Timestamp: timestamp, Signers: []*SignerEntry{{Signer: addr.String(), Timestamp: timestamp}}},
+			},
+			"",
+		},
+		{
+			"missing hash",
+			[]*GenesisContentEntry{{Timestamp: timestamp}},
+			"empty content entry",
+		},
+		{
+			"missing timestamp",
+			[]*GenesisContentEntry{{Hash: rawHash}},
+			"missing timestamp",
+		},
+		{
+			"duplicate entry",
+			[]*GenesisContentEntry{
+				{Hash: rawHash, Timestamp: timestamp},
+				{Hash: rawHash, Timestamp: timestamp},
+			},
+			"duplicate content entry",
+		},
+		{
+			"invalid signer",
+			[]*GenesisContentEntry{
+				{Hash: graphHash, Timestamp: timestamp, Signers: []*SignerEntry{{Signer: "foo", Timestamp: timestamp}}},
+			},
+			"invalid signer",
+		},
+		{
+			"duplicate signer",
+			[]*GenesisContentEntry{
+				{Hash: graphHash, Timestamp: timestamp, Signers: []*SignerEntry{
+					{Signer: addr.String(), Timestamp: timestamp},
+					{Signer: addr.String(), Timestamp: timestamp},
+				}},
+			},
+			"duplicate signer",
+		},
+		{
+			"invalid storer",
+			[]*GenesisContentEntry{{Hash: rawHash, Timestamp: timestamp, Content: rawContent, Storer: "foo"}},
+			"invalid storer",
+		},
+		{
+			"storer without content",
+			[]*GenesisContentEntry{{Hash: rawHash, Timestamp: timestamp, Storer: addr.String()}},
+			"storer set without stored content",
+		},
+		{
+			"content for graph data",
+			[]*GenesisContentEntry{{Hash: graphHash, Timestamp: timestamp, Content: rawContent}},
+			"only raw data can be stored",
+		},
+		{
+			"content not matching hash",
+			[]*GenesisContentEntry{
+				{Hash: rawHash, Timestamp: timestamp, Content: &Content{Sum: &Content_RawData{RawData: []byte("foo")}}},
+			},
+			"hash verification failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			genesisState := DefaultGenesisState()
+			genesisState.Entries = tt.entries
+			err := genesisState.Validate()
+			if len(tt.wantErr) != 0 {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
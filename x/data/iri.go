@@ -2,6 +2,7 @@ package data
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -81,6 +82,89 @@ func (chg ContentHash_Graph) ToIRI() (string, error) {
 	return fmt.Sprintf("regen:%s.rdf", hashStr), nil
 }
 
+// ParseIRI parses an IRI produced by ContentHash.ToIRI back into a ContentHash.
+func ParseIRI(iri string) (*ContentHash, error) {
+	const regenPrefix = "regen:"
+
+	if !strings.HasPrefix(iri, regenPrefix) {
+		return nil, fmt.Errorf("can't parse IRI %s without %s prefix", iri, regenPrefix)
+	}
+
+	hashExt := strings.TrimPrefix(iri, regenPrefix)
+	i := strings.LastIndexByte(hashExt, '.')
+	if i < 0 {
+		return nil, fmt.Errorf("can't parse IRI %s without extension", iri)
+	}
+
+	hashStr, ext := hashExt[:i], hashExt[i+1:]
+	bz, version, err := base58.CheckDecode(hashStr)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse IRI %s: %s", iri, err)
+	}
+
+	if version != iriVersion0 {
+		return nil, fmt.Errorf("invalid version %d in IRI %s", version, iri)
+	}
+
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("can't parse IRI %s without hash", iri)
+	}
+
+	var ch ContentHash
+	switch bz[0] {
+	case IriPrefixRaw:
+		if len(bz) < 2 {
+			return nil, fmt.Errorf("can't parse IRI %s without digest algorithm", iri)
+		}
+
+		mediaType, err := ExtensionToMediaType(ext)
+		if err != nil {
+			return nil, err
+		}
+
+		ch.Sum = &ContentHash_Raw_{Raw: &ContentHash_Raw{
+			Hash:            bz[2:],
+			DigestAlgorithm: DigestAlgorithm(bz[1]),
+			MediaType:       mediaType,
+		}}
+	case IriPrefixGraph:
+		if len(bz) < 4 {
+			return nil, fmt.Errorf("can't parse IRI %s without graph parameters", iri)
+		}
+
+		if ext != "rdf" {
+			return nil, fmt.Errorf("invalid extension .%s for graph IRI %s, expected .rdf", ext, iri)
+		}
+
+		ch.Sum = &ContentHash_Graph_{Graph: &ContentHash_Graph{
+			Hash:                      bz[4:],
+			CanonicalizationAlgorithm: GraphCanonicalizationAlgorithm(bz[1]),
+			MerkleTree:                GraphMerkleTree(bz[2]),
+			DigestAlgorithm:           DigestAlgorithm(bz[3]),
+		}}
+	default:
+		return nil, fmt.Errorf("unknown IRI prefix %d in IRI %s", bz[0], iri)
+	}
+
+	err = ch.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ch, nil
+}
+
+// ExtensionToMediaType converts a file extension to a media type based on the mediaTypeExtensions map.
+func ExtensionToMediaType(ext string) (MediaType, error) {
+	for mt, mtExt := range mediaTypeExtensions {
+		if mtExt == ext {
+			return mt, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown media type extension .%s", ext)
+}
+
 // ToExtension converts the media type to a file extension based on the mediaTypeExtensions map.
 func (mt MediaType) ToExtension() (string, error) {
 	ext, ok := mediaTypeExtensions[mt]
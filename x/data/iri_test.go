@@ -196,3 +196,83 @@ func TestMediaType_ToExtension(t *testing.T) {
 	_, err := MediaType(-1).ToExtension()
 	require.Error(t, err)
 }
+
+func TestParseIRI(t *testing.T) {
+	hash1 := []byte("abcdefghijklmnopqrstuvwxyz123456")
+
+	tests := []struct {
+		name    string
+		iri     string
+		want    *ContentHash
+		wantErr string
+	}{
+		{
+			"raw",
+			"regen:113gdjFKcVCt13Za6vN7TtbgMM6LMSjRnu89BMCxeuHdkJ1hWUmy.pdf",
+			&ContentHash{Sum: &ContentHash_Raw_{Raw: &ContentHash_Raw{
+				Hash:            hash1,
+				DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+				MediaType:       MediaType_MEDIA_TYPE_PDF,
+			}}},
+			"",
+		},
+		{
+			"graph",
+			"regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf",
+			&ContentHash{Sum: &ContentHash_Graph_{Graph: &ContentHash_Graph{
+				Hash:                      hash1,
+				DigestAlgorithm:           DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+				CanonicalizationAlgorithm: GraphCanonicalizationAlgorithm_GRAPH_CANONICALIZATION_ALGORITHM_URDNA2015,
+				MerkleTree:                GraphMerkleTree_GRAPH_MERKLE_TREE_NONE_UNSPECIFIED,
+			}}},
+			"",
+		},
+		{
+			"missing prefix",
+			"113gdjFKcVCt13Za6vN7TtbgMM6LMSjRnu89BMCxeuHdkJ1hWUmy.pdf",
+			nil,
+			"without regen: prefix",
+		},
+		{
+			"missing extension",
+			"regen:113gdjFKcVCt13Za6vN7TtbgMM6LMSjRnu89BMCxeuHdkJ1hWUmy",
+			nil,
+			"without extension",
+		},
+		{
+			"bad checksum",
+			"regen:113gdjFKcVCt13Za6vN7TtbgMM6LMSjRnu89BMCxeuHdkJ1hWUmz.pdf",
+			nil,
+			"checksum error",
+		},
+		{
+			"unknown extension",
+			"regen:113gdjFKcVCt13Za6vN7TtbgMM6LMSjRnu89BMCxeuHdkJ1hWUmy.abc",
+			nil,
+			"unknown media type extension .abc",
+		},
+		{
+			"graph with wrong extension",
+			"regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.pdf",
+			nil,
+			"expected .rdf",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseIRI(tt.iri)
+			if len(tt.wantErr) != 0 {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+
+			iri, err := got.ToIRI()
+			require.NoError(t, err)
+			require.Equal(t, tt.iri, iri)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package data
+
+import "time"
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "data"
+
+	DefaultParamspace = ModuleName
+)
+
+// ChunkedUploadTimeout is the time within which all the chunks of a
+// Msg/StoreRawDataChunk upload must be received before it is discarded.
+const ChunkedUploadTimeout = 24 * time.Hour
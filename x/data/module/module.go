@@ -3,11 +3,16 @@ package module
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"math/rand"
 
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	restmodule "github.com/regen-network/regen-ledger/types/module/client/grpc_gateway"
@@ -18,17 +23,35 @@ import (
 	"github.com/regen-network/regen-ledger/x/data"
 	"github.com/regen-network/regen-ledger/x/data/client"
 	"github.com/regen-network/regen-ledger/x/data/server"
+	"github.com/regen-network/regen-ledger/x/data/simulation"
 )
 
-type Module struct{}
+type Module struct {
+	paramSpace    paramtypes.Subspace
+	accountKeeper data.AccountKeeper
+	bankKeeper    data.BankKeeper
+}
+
+func NewModule(paramSpace paramtypes.Subspace, accountKeeper data.AccountKeeper, bankKeeper data.BankKeeper) Module {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(data.ParamKeyTable())
+	}
+
+	return Module{
+		paramSpace:    paramSpace,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+	}
+}
 
 var _ module.AppModuleBasic = Module{}
+var _ module.AppModuleSimulation = Module{}
 var _ servermodule.Module = Module{}
 var _ restmodule.Module = Module{}
 var _ climodule.Module = Module{}
 
 func (a Module) Name() string {
-	return "data"
+	return data.ModuleName
 }
 
 func (a Module) RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -36,7 +59,7 @@ func (a Module) RegisterInterfaces(registry types.InterfaceRegistry) {
 }
 
 func (a Module) RegisterServices(configurator servermodule.Configurator) {
-	server.RegisterServices(configurator)
+	server.RegisterServices(configurator, a.paramSpace, a.accountKeeper, a.bankKeeper)
 }
 
 //nolint:errcheck
@@ -44,10 +67,17 @@ func (a Module) RegisterGRPCGatewayRoutes(clientCtx sdkclient.Context, mux *runt
 	data.RegisterQueryHandlerClient(context.Background(), mux, data.NewQueryClient(clientCtx))
 }
 
-func (a Module) DefaultGenesis(codec.JSONCodec) json.RawMessage { return nil }
+func (a Module) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(data.DefaultGenesisState())
+}
+
+func (a Module) ValidateGenesis(cdc codec.JSONCodec, _ sdkclient.TxEncodingConfig, bz json.RawMessage) error {
+	var genesisState data.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genesisState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", data.ModuleName, err)
+	}
 
-func (a Module) ValidateGenesis(codec.JSONCodec, sdkclient.TxEncodingConfig, json.RawMessage) error {
-	return nil
+	return genesisState.Validate()
 }
 
 func (a Module) GetQueryCmd() *cobra.Command {
@@ -59,7 +89,36 @@ func (a Module) GetTxCmd() *cobra.Command {
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (Module) ConsensusVersion() uint64 { return 1 }
+func (Module) ConsensusVersion() uint64 { return 2 }
+
+// AppModuleSimulation functions
+
+// GenerateGenesisState creates a randomized GenesisState of the data module.
+func (Module) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// ProposalContents returns all the data content functions used to
+// simulate proposals.
+func (Module) ProposalContents(simState module.SimulationState) []simtypes.WeightedProposalContent {
+	return nil
+}
+
+// RandomizedParams creates randomized data param changes for the simulator.
+func (Module) RandomizedParams(r *rand.Rand) []simtypes.ParamChange {
+	return simulation.ParamChanges(r)
+}
+
+// RegisterStoreDecoder registers a decoder for data module's types
+func (Module) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+}
+
+// WeightedOperations returns all the data module operations with their respective weights.
+// NOTE: This is no longer needed for the modules which uses ADR-33, data module `WeightedOperations`
+// registered in the `x/data/server` package.
+func (Module) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return nil
+}
 
 /**** DEPRECATED ****/
 func (a Module) RegisterRESTRoutes(sdkclient.Context, *mux.Router) {}
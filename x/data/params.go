@@ -0,0 +1,176 @@
+package data
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	KeyStorageGasPerByte = []byte("StorageGasPerByte")
+	KeyDigestGasCosts    = []byte("DigestGasCosts")
+	KeyMaxDataSize       = []byte("MaxDataSize")
+	KeyDeletionAuthority = []byte("DeletionAuthority")
+)
+
+// DefaultStorageGasPerByte is the default gas consumed per byte of content
+// stored on-chain.
+const DefaultStorageGasPerByte uint64 = 10
+
+const (
+	// DefaultMaxDataSize is the default maximum size in bytes of content
+	// stored on-chain.
+	DefaultMaxDataSize uint64 = 256 * 1024
+
+	// MaxDataSizeLimit is the upper bound for the MaxDataSize param.
+	MaxDataSizeLimit uint64 = 16 * 1024 * 1024
+)
+
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyStorageGasPerByte, &p.StorageGasPerByte, validateStorageGasPerByte),
+		paramtypes.NewParamSetPair(KeyDigestGasCosts, &p.DigestGasCosts, validateDigestGasCosts),
+		paramtypes.NewParamSetPair(KeyMaxDataSize, &p.MaxDataSize, validateMaxDataSize),
+		paramtypes.NewParamSetPair(KeyDeletionAuthority, &p.DeletionAuthority, validateDeletionAuthority),
+	}
+}
+
+// Validate will run each param field's validate method
+func (p Params) Validate() error {
+	if err := validateStorageGasPerByte(p.StorageGasPerByte); err != nil {
+		return err
+	}
+
+	if err := validateDigestGasCosts(p.DigestGasCosts); err != nil {
+		return err
+	}
+
+	if err := validateMaxDataSize(p.MaxDataSize); err != nil {
+		return err
+	}
+
+	if err := validateDeletionAuthority(p.DeletionAuthority); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetDigestGasPerByte returns the gas consumed per byte of content for
+// computing its digest with the given digest algorithm.
+func (p Params) GetDigestGasPerByte(digestAlgorithm DigestAlgorithm) (uint64, error) {
+	for _, cost := range p.DigestGasCosts {
+		if cost.DigestAlgorithm == digestAlgorithm {
+			return cost.GasPerByte, nil
+		}
+	}
+
+	return 0, sdkerrors.ErrInvalidRequest.Wrapf("unsupported %T %s", digestAlgorithm, digestAlgorithm)
+}
+
+func validateStorageGasPerByte(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateDigestGasCosts(i interface{}) error {
+	costs, ok := i.([]*DigestGasCost)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[DigestAlgorithm]bool)
+	for _, cost := range costs {
+		if cost == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("empty digest gas cost")
+		}
+
+		if _, ok := DigestalgorithmLength[cost.DigestAlgorithm]; !ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("unsupported %T %s", cost.DigestAlgorithm, cost.DigestAlgorithm)
+		}
+
+		if seen[cost.DigestAlgorithm] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate digest gas cost for %s", cost.DigestAlgorithm)
+		}
+		seen[cost.DigestAlgorithm] = true
+	}
+
+	return nil
+}
+
+func validateMaxDataSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("max data size must be positive")
+	}
+
+	if v > MaxDataSizeLimit {
+		return sdkerrors.ErrInvalidRequest.Wrapf("max data size %d exceeds limit of %d", v, MaxDataSizeLimit)
+	}
+
+	return nil
+}
+
+func validateDeletionAuthority(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("invalid parameter type: %T", i)
+	}
+
+	// an empty authority leaves deletion to the storers only
+	if v == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid deletion authority address: %s", err.Error())
+	}
+
+	return nil
+}
+
+// NewParams creates a new Params object
+func NewParams(storageGasPerByte uint64, digestGasCosts []*DigestGasCost, maxDataSize uint64, deletionAuthority string) Params {
+	return Params{
+		StorageGasPerByte: storageGasPerByte,
+		DigestGasCosts:    digestGasCosts,
+		MaxDataSize:       maxDataSize,
+		DeletionAuthority: deletionAuthority,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(
+		DefaultStorageGasPerByte,
+		[]*DigestGasCost{
+			{
+				DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+				GasPerByte:      2,
+			},
+			{
+				DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_512,
+				GasPerByte:      3,
+			},
+			{
+				DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_SHA3_256,
+				GasPerByte:      4,
+			},
+		},
+		DefaultMaxDataSize,
+		"",
+	)
+}
@@ -0,0 +1,102 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultParams(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+}
+
+func TestParamsValidate(t *testing.T) {
+	_, _, authority := testdata.KeyTestPubAddr()
+
+	tests := []struct {
+		name    string
+		params  Params
+		wantErr string
+	}{
+		{
+			"default",
+			DefaultParams(),
+			"",
+		},
+		{
+			"no digest gas costs",
+			NewParams(0, nil, DefaultMaxDataSize, ""),
+			"",
+		},
+		{
+			"nil digest gas cost",
+			NewParams(1, []*DigestGasCost{nil}, DefaultMaxDataSize, ""),
+			"empty digest gas cost",
+		},
+		{
+			"unsupported digest algorithm",
+			NewParams(1, []*DigestGasCost{
+				{DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_UNSPECIFIED, GasPerByte: 1},
+			}, DefaultMaxDataSize, ""),
+			"unsupported data.DigestAlgorithm DIGEST_ALGORITHM_UNSPECIFIED",
+		},
+		{
+			"duplicate digest algorithm",
+			NewParams(1, []*DigestGasCost{
+				{DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_SHA3_256, GasPerByte: 1},
+				{DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_SHA3_256, GasPerByte: 2},
+			}, DefaultMaxDataSize, ""),
+			"duplicate digest gas cost for DIGEST_ALGORITHM_SHA3_256",
+		},
+		{
+			"zero max data size",
+			NewParams(1, nil, 0, ""),
+			"max data size must be positive",
+		},
+		{
+			"max data size at limit",
+			NewParams(1, nil, MaxDataSizeLimit, ""),
+			"",
+		},
+		{
+			"max data size above limit",
+			NewParams(1, nil, MaxDataSizeLimit+1, ""),
+			"exceeds limit",
+		},
+		{
+			"deletion authority",
+			NewParams(1, nil, DefaultMaxDataSize, authority.String()),
+			"",
+		},
+		{
+			"invalid deletion authority",
+			NewParams(1, nil, DefaultMaxDataSize, "foo"),
+			"invalid deletion authority address",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if len(tt.wantErr) != 0 {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsGetDigestGasPerByte(t *testing.T) {
+	params := DefaultParams()
+
+	for _, cost := range params.DigestGasCosts {
+		gas, err := params.GetDigestGasPerByte(cost.DigestAlgorithm)
+		require.NoError(t, err)
+		require.Equal(t, cost.GasPerByte, gas)
+	}
+
+	_, err := params.GetDigestGasPerByte(DigestAlgorithm_DIGEST_ALGORITHM_UNSPECIFIED)
+	require.Error(t, err)
+}
@@ -232,32 +232,24 @@ func (m *QueryBySignerResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// ContentEntry describes data referenced and possibly stored on chain
-type ContentEntry struct {
-	// hash is the content hash
-	Hash *ContentHash `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// iri is the content IRI
-	Iri string `protobuf:"bytes,2,opt,name=iri,proto3" json:"iri,omitempty"`
-	// timestamp is the anchor Timestamp
-	Timestamp *types.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// signers are the signers, if any
-	Signers []*SignerEntry `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
-	// content is the actual content if stored on-chain
-	Content *Content `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+// QueryDataRequest is the Query/Data request type.
+type QueryDataRequest struct {
+	// iri is the IRI of the raw data to query.
+	Iri string `protobuf:"bytes,1,opt,name=iri,proto3" json:"iri,omitempty"`
 }
 
-func (m *ContentEntry) Reset()         { *m = ContentEntry{} }
-func (m *ContentEntry) String() string { return proto.CompactTextString(m) }
-func (*ContentEntry) ProtoMessage()    {}
-func (*ContentEntry) Descriptor() ([]byte, []int) {
+func (m *QueryDataRequest) Reset()         { *m = QueryDataRequest{} }
+func (m *QueryDataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataRequest) ProtoMessage()    {}
+func (*QueryDataRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_bf7739eaec65300f, []int{4}
 }
-func (m *ContentEntry) XXX_Unmarshal(b []byte) error {
+func (m *QueryDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContentEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContentEntry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDataRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -267,574 +259,2813 @@ func (m *ContentEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ContentEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContentEntry.Merge(m, src)
+func (m *QueryDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataRequest.Merge(m, src)
 }
-func (m *ContentEntry) XXX_Size() int {
+func (m *QueryDataRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContentEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContentEntry.DiscardUnknown(m)
+func (m *QueryDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContentEntry proto.InternalMessageInfo
+var xxx_messageInfo_QueryDataRequest proto.InternalMessageInfo
 
-func (m *ContentEntry) GetHash() *ContentHash {
+func (m *QueryDataRequest) GetIri() string {
 	if m != nil {
-		return m.Hash
+		return m.Iri
 	}
-	return nil
+	return ""
 }
 
-func (m *ContentEntry) GetIri() string {
-	if m != nil {
-		return m.Iri
+// QueryDataResponse is the Query/Data response type.
+type QueryDataResponse struct {
+	// content is the raw data stored on-chain.
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// timestamp is the anchor Timestamp.
+	Timestamp *types.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// digest_algorithm is the digest algorithm that was used to hash the data,
+	// which allows clients to re-verify the content against its IRI.
+	DigestAlgorithm DigestAlgorithm `protobuf:"varint,3,opt,name=digest_algorithm,json=digestAlgorithm,proto3,enum=regen.data.v1alpha2.DigestAlgorithm" json:"digest_algorithm,omitempty"`
+}
+
+func (m *QueryDataResponse) Reset()         { *m = QueryDataResponse{} }
+func (m *QueryDataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataResponse) ProtoMessage()    {}
+func (*QueryDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf7739eaec65300f, []int{5}
+}
+func (m *QueryDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataResponse.Merge(m, src)
+}
+func (m *QueryDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataResponse.DiscardUnknown(m)
 }
 
-func (m *ContentEntry) GetTimestamp() *types.Timestamp {
+var xxx_messageInfo_QueryDataResponse proto.InternalMessageInfo
+
+func (m *QueryDataResponse) GetContent() []byte {
 	if m != nil {
-		return m.Timestamp
+		return m.Content
 	}
 	return nil
 }
 
-func (m *ContentEntry) GetSigners() []*SignerEntry {
+func (m *QueryDataResponse) GetTimestamp() *types.Timestamp {
 	if m != nil {
-		return m.Signers
+		return m.Timestamp
 	}
 	return nil
 }
 
-func (m *ContentEntry) GetContent() *Content {
+func (m *QueryDataResponse) GetDigestAlgorithm() DigestAlgorithm {
 	if m != nil {
-		return m.Content
+		return m.DigestAlgorithm
 	}
-	return nil
+	return DigestAlgorithm_DIGEST_ALGORITHM_UNSPECIFIED
 }
 
-func init() {
-	proto.RegisterType((*QueryByHashRequest)(nil), "regen.data.v1alpha2.QueryByHashRequest")
-	proto.RegisterType((*QueryByHashResponse)(nil), "regen.data.v1alpha2.QueryByHashResponse")
-	proto.RegisterType((*QueryBySignerRequest)(nil), "regen.data.v1alpha2.QueryBySignerRequest")
-	proto.RegisterType((*QueryBySignerResponse)(nil), "regen.data.v1alpha2.QueryBySignerResponse")
-	proto.RegisterType((*ContentEntry)(nil), "regen.data.v1alpha2.ContentEntry")
+// QueryAnchorRequest is the Query/Anchor request type.
+type QueryAnchorRequest struct {
+	// iri is the hash-based identifier for the anchored content.
+	Iri string `protobuf:"bytes,1,opt,name=iri,proto3" json:"iri,omitempty"`
 }
 
-func init() { proto.RegisterFile("regen/data/v1alpha2/query.proto", fileDescriptor_bf7739eaec65300f) }
-
-var fileDescriptor_bf7739eaec65300f = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x5d, 0xda, 0xb5, 0xa5, 0x86, 0x03, 0xf2, 0x00, 0x55, 0x55, 0x95, 0x95, 0x08, 0xe8, 0x98,
-	0x98, 0xad, 0x16, 0x04, 0x08, 0x6e, 0x43, 0x0c, 0xc4, 0x01, 0x41, 0xe0, 0xc4, 0x05, 0x39, 0xdd,
-	0x47, 0x12, 0xd1, 0xda, 0x59, 0xec, 0x16, 0x22, 0xc4, 0x01, 0xc4, 0x0f, 0x98, 0xc4, 0x19, 0x7e,
-	0x0f, 0xc7, 0x49, 0x5c, 0x38, 0xa2, 0x96, 0x1f, 0x82, 0x62, 0x3b, 0x6c, 0x85, 0xa8, 0x9b, 0xb8,
-	0xd9, 0xed, 0xfb, 0xde, 0x7b, 0x7e, 0xdf, 0x0b, 0x5a, 0x4f, 0x21, 0x04, 0x4e, 0x77, 0x99, 0x62,
-	0x74, 0xda, 0x67, 0xa3, 0x24, 0x62, 0x03, 0xba, 0x37, 0x81, 0x34, 0x23, 0x49, 0x2a, 0x94, 0xc0,
-	0x6b, 0x1a, 0x40, 0x72, 0x00, 0x29, 0x00, 0xed, 0x4e, 0x28, 0x44, 0x38, 0x02, 0xca, 0x92, 0x98,
-	0x32, 0xce, 0x85, 0x62, 0x2a, 0x16, 0x5c, 0x9a, 0x91, 0xf6, 0xba, 0xfd, 0x57, 0xdf, 0x82, 0xc9,
-	0x2b, 0xaa, 0xe2, 0x31, 0x48, 0xc5, 0xc6, 0x89, 0x05, 0x6c, 0x0e, 0x85, 0x1c, 0x0b, 0x49, 0x03,
-	0x26, 0xc1, 0x88, 0xd1, 0x69, 0x3f, 0x00, 0xc5, 0xfa, 0x34, 0x61, 0x61, 0xcc, 0x35, 0x5b, 0x41,
-	0x56, 0x66, 0x50, 0x65, 0x09, 0x58, 0x35, 0xef, 0x11, 0xc2, 0x4f, 0x73, 0x8a, 0xed, 0xec, 0x21,
-	0x93, 0x91, 0x0f, 0x7b, 0x13, 0x90, 0x0a, 0xdf, 0x40, 0xab, 0x11, 0x93, 0x51, 0xcb, 0xe9, 0x3a,
-	0x1b, 0xa7, 0x07, 0x5d, 0x52, 0xf2, 0x0a, 0x72, 0x4f, 0x70, 0x05, 0x5c, 0xe9, 0x31, 0x8d, 0xf6,
-	0x1e, 0xa3, 0xb5, 0x05, 0x2e, 0x99, 0x08, 0x2e, 0x01, 0xdf, 0x42, 0x35, 0xe0, 0x2a, 0xcd, 0x2c,
-	0xdb, 0xc5, 0x65, 0x6c, 0xf7, 0x73, 0xa0, 0x6f, 0xf0, 0xde, 0x14, 0x9d, 0xb3, 0x7c, 0xcf, 0xe2,
-	0x90, 0x43, 0x5a, 0xb8, 0xbb, 0x80, 0xea, 0x52, 0xff, 0xa0, 0x19, 0x9b, 0xbe, 0xbd, 0xe1, 0x1d,
-	0x84, 0x0e, 0x03, 0x68, 0x55, 0xb4, 0xda, 0x15, 0x62, 0xd2, 0x22, 0x79, 0x5a, 0xc4, 0xac, 0xc6,
-	0xa6, 0x45, 0x9e, 0xb0, 0x10, 0x2c, 0xa7, 0x7f, 0x64, 0xd2, 0xfb, 0xe2, 0xa0, 0xf3, 0x7f, 0x09,
-	0xdb, 0xa7, 0xdc, 0x45, 0x8d, 0xdc, 0x5a, 0x0c, 0xb2, 0xe5, 0x74, 0xab, 0x27, 0x7b, 0x4c, 0x31,
-	0x81, 0x1f, 0x2c, 0xd8, 0xab, 0x6a, 0x7b, 0xbd, 0x63, 0xed, 0x19, 0xe5, 0x05, 0x7f, 0x9f, 0x2a,
-	0xe8, 0xcc, 0x51, 0x89, 0xff, 0x5b, 0x17, 0x3e, 0x8b, 0xaa, 0x71, 0x1a, 0xeb, 0x9c, 0x9a, 0x7e,
-	0x7e, 0xc4, 0xb7, 0x51, 0xf3, 0x4f, 0xd9, 0xac, 0xc1, 0x36, 0x31, 0x75, 0x24, 0x45, 0x1d, 0xc9,
-	0xf3, 0x02, 0xe1, 0x1f, 0x82, 0xf1, 0x1d, 0xd4, 0x30, 0x4b, 0x90, 0xad, 0x55, 0x1d, 0x4c, 0xb9,
-	0x09, 0x13, 0xa7, 0xcd, 0xc5, 0x0e, 0xe0, 0x9b, 0xa8, 0x31, 0x34, 0xe6, 0x5a, 0x35, 0xad, 0xd9,
-	0x59, 0xf6, 0x00, 0xbf, 0x00, 0x0f, 0xbe, 0x56, 0x50, 0x4d, 0xaf, 0x09, 0x7f, 0x70, 0x50, 0xdd,
-	0x94, 0x0e, 0xf7, 0x4a, 0x67, 0xff, 0xad, 0x78, 0x7b, 0xe3, 0x78, 0xa0, 0x89, 0xde, 0xbb, 0xf4,
-	0xf1, 0xfb, 0xaf, 0xcf, 0x15, 0x17, 0x77, 0x68, 0xd9, 0xc7, 0x14, 0x64, 0x2f, 0x75, 0x9a, 0xfb,
-	0x0e, 0x3a, 0x55, 0xf4, 0x05, 0x5f, 0x5d, 0x46, 0xbe, 0x50, 0xe6, 0xf6, 0xe6, 0x49, 0xa0, 0xd6,
-	0xc9, 0x96, 0x76, 0xd2, 0xc3, 0x97, 0x4b, 0x9d, 0xd8, 0x3c, 0xe9, 0x3b, 0x73, 0x78, 0xbf, 0xbd,
-	0xf3, 0x6d, 0xe6, 0x3a, 0x07, 0x33, 0xd7, 0xf9, 0x39, 0x73, 0x9d, 0xfd, 0xb9, 0xbb, 0x72, 0x30,
-	0x77, 0x57, 0x7e, 0xcc, 0xdd, 0x95, 0x17, 0xd7, 0xc2, 0x58, 0x45, 0x93, 0x80, 0x0c, 0xc5, 0xd8,
-	0x50, 0x6d, 0x71, 0x50, 0x6f, 0x44, 0xfa, 0xda, 0xde, 0x46, 0xb0, 0x1b, 0x42, 0x4a, 0xdf, 0x6a,
-	0x85, 0xa0, 0xae, 0x77, 0x7f, 0xfd, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x72, 0x2b, 0x42, 0x67,
-	0xee, 0x04, 0x00, 0x00,
+func (m *QueryAnchorRequest) Reset()         { *m = QueryAnchorRequest{} }
+func (m *QueryAnchorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAnchorRequest) ProtoMessage()    {}
+func (*QueryAnchorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf7739eaec65300f, []int{6}
+}
+func (m *QueryAnchorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnchorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnchorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnchorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnchorRequest.Merge(m, src)
+}
+func (m *QueryAnchorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnchorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnchorRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryAnchorRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ByHash queries data based on its ContentHash.
-	ByHash(ctx context.Context, in *QueryByHashRequest, opts ...grpc.CallOption) (*QueryByHashResponse, error)
-	// BySigner queries data based on signers.
-	BySigner(ctx context.Context, in *QueryBySignerRequest, opts ...grpc.CallOption) (*QueryBySignerResponse, error)
+func (m *QueryAnchorRequest) GetIri() string {
+	if m != nil {
+		return m.Iri
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryAnchorResponse is the Query/Anchor response type.
+type QueryAnchorResponse struct {
+	// timestamp is the timestamp of the block at which the data was anchored.
+	Timestamp *types.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// proof_path is the ABCI query path at which the anchor can be queried with
+	// a Merkle proof of its inclusion in the application state, by setting
+	// prove to true in the ABCI query.
+	ProofPath string `protobuf:"bytes,2,opt,name=proof_path,json=proofPath,proto3" json:"proof_path,omitempty"`
+	// proof_key is the store key of the anchor to query at proof_path. The
+	// value stored at this key is the protobuf encoded timestamp.
+	ProofKey []byte `protobuf:"bytes,3,opt,name=proof_key,json=proofKey,proto3" json:"proof_key,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAnchorResponse) Reset()         { *m = QueryAnchorResponse{} }
+func (m *QueryAnchorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAnchorResponse) ProtoMessage()    {}
+func (*QueryAnchorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf7739eaec65300f, []int{7}
+}
+func (m *QueryAnchorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnchorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnchorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnchorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnchorResponse.Merge(m, src)
+}
+func (m *QueryAnchorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnchorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnchorResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ByHash(ctx context.Context, in *QueryByHashRequest, opts ...grpc.CallOption) (*QueryByHashResponse, error) {
-	out := new(QueryByHashResponse)
-	err := c.cc.Invoke(ctx, "/regen.data.v1alpha2.Query/ByHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAnchorResponse proto.InternalMessageInfo
+
+func (m *QueryAnchorResponse) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) BySigner(ctx context.Context, in *QueryBySignerRequest, opts ...grpc.CallOption) (*QueryBySignerResponse, error) {
-	out := new(QueryBySignerResponse)
-	err := c.cc.Invoke(ctx, "/regen.data.v1alpha2.Query/BySigner", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAnchorResponse) GetProofPath() string {
+	if m != nil {
+		return m.ProofPath
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ByHash queries data based on its ContentHash.
-	ByHash(context.Context, *QueryByHashRequest) (*QueryByHashResponse, error)
-	// BySigner queries data based on signers.
-	BySigner(context.Context, *QueryBySignerRequest) (*QueryBySignerResponse, error)
+func (m *QueryAnchorResponse) GetProofKey() []byte {
+	if m != nil {
+		return m.ProofKey
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QuerySignersRequest is the Query/Signers request type.
+type QuerySignersRequest struct {
+	// iri is the IRI of the signed data.
+	Iri string `protobuf:"bytes,1,opt,name=iri,proto3" json:"iri,omitempty"`
+	// pagination is the PageRequest to use for pagination.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) ByHash(ctx context.Context, req *QueryByHashRequest) (*QueryByHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ByHash not implemented")
+func (m *QuerySignersRequest) Reset()         { *m = QuerySignersRequest{} }
+func (m *QuerySignersRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignersRequest) ProtoMessage()    {}
+func (*QuerySignersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf7739eaec65300f, []int{8}
 }
-func (*UnimplementedQueryServer) BySigner(ctx context.Context, req *QueryBySignerRequest) (*QueryBySignerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BySigner not implemented")
+func (m *QuerySignersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QuerySignersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignersRequest.Merge(m, src)
+}
+func (m *QuerySignersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignersRequest.DiscardUnknown(m)
 }
 
-func _Query_ByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryByHashRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ByHash(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/regen.data.v1alpha2.Query/ByHash",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ByHash(ctx, req.(*QueryByHashRequest))
+var xxx_messageInfo_QuerySignersRequest proto.InternalMessageInfo
+
+func (m *QuerySignersRequest) GetIri() string {
+	if m != nil {
+		return m.Iri
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BySigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBySignerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BySigner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/regen.data.v1alpha2.Query/BySigner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BySigner(ctx, req.(*QueryBySignerRequest))
+func (m *QuerySignersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "regen.data.v1alpha2.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ByHash",
-			Handler:    _Query_ByHash_Handler,
-		},
-		{
-			MethodName: "BySigner",
-			Handler:    _Query_BySigner_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "regen/data/v1alpha2/query.proto",
+// QuerySignersResponse is the Query/Signers response type.
+type QuerySignersResponse struct {
+	// signers are the signers of the data along with the time at which they signed.
+	Signers []*SignerEntry `protobuf:"bytes,1,rep,name=signers,proto3" json:"signers,omitempty"`
+	// pagination is the pagination PageResponse.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryByHashRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySignersResponse) Reset()         { *m = QuerySignersResponse{} }
+func (m *QuerySignersResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignersResponse) ProtoMessage()    {}
+func (*QuerySignersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf7739eaec65300f, []int{9}
+}
+func (m *QuerySignersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryByHashRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySignersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignersResponse.Merge(m, src)
+}
+func (m *QuerySignersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Hash != nil {
-		{
-			size, err := m.Hash.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QuerySignersResponse proto.InternalMessageInfo
+
+func (m *QuerySignersResponse) GetSigners() []*SignerEntry {
+	if m != nil {
+		return m.Signers
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryByHashResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySignersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryByHashResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAnchoredDataRequest is the Query/AnchoredData request type.
+type QueryAnchoredDataRequest struct {
+	// pagination is the PageRequest to use for pagination.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Entry != nil {
-		{
-			size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAnchoredDataRequest) Reset()         { *m = QueryAnchoredDataRequest{} }
+func (m *QueryAnchoredDataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAnchoredDataRequest) ProtoMessage()    {}
+func (*QueryAnchoredDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf7739eaec65300f, []int{10}
+}
+func (m *QueryAnchoredDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnchoredDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnchoredDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAnchoredDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnchoredDataRequest.Merge(m, src)
+}
+func (m *QueryAnchoredDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnchoredDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnchoredDataRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBySignerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAnchoredDataRequest proto.InternalMessageInfo
+
+func (m *QueryAnchoredDataRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBySignerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAnchoredDataResponse is the Query/AnchoredData response type.
+type QueryAnchoredDataResponse struct {
+	// entries are the anchored data entries.
+	Entries []*AnchoredDataEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// pagination is the pagination PageResponse.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBySignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAnchoredDataResponse) Reset()         { *m = QueryAnchoredDataResponse{} }
+func (m *QueryAnchoredDataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAnchoredDataResponse) ProtoMessage()    {}
+func (*QueryAnchoredDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf7739eaec65300f, []int{11}
+}
+func (m *QueryAnchoredDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnchoredDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnchoredDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryAnchoredDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnchoredDataResponse.Merge(m, src)
+}
+func (m *QueryAnchoredDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnchoredDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnchoredDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnchoredDataResponse proto.InternalMessageInfo
+
+func (m *QueryAnchoredDataResponse) GetEntries() []*AnchoredDataEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryBySignerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAnchoredDataResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBySignerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AnchoredDataEntry describes data anchored on-chain.
+type AnchoredDataEntry struct {
+	// hash is the content hash
+	Hash *ContentHash `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// iri is the content IRI
+	Iri string `protobuf:"bytes,2,opt,name=iri,proto3" json:"iri,omitempty"`
+	// timestamp is the anchor Timestamp
+	Timestamp *types.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (m *QueryBySignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *AnchoredDataEntry) Reset()         { *m = AnchoredDataEntry{} }
+func (m *AnchoredDataEntry) String() string { return proto.CompactTextString(m) }
+func (*AnchoredDataEntry) ProtoMessage()    {}
+func (*AnchoredDataEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf7739eaec65300f, []int{12}
+}
+func (m *AnchoredDataEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AnchoredDataEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AnchoredDataEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *AnchoredDataEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnchoredDataEntry.Merge(m, src)
+}
+func (m *AnchoredDataEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AnchoredDataEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnchoredDataEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnchoredDataEntry proto.InternalMessageInfo
+
+func (m *AnchoredDataEntry) GetHash() *ContentHash {
+	if m != nil {
+		return m.Hash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ContentEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AnchoredDataEntry) GetIri() string {
+	if m != nil {
+		return m.Iri
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ContentEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AnchoredDataEntry) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
 }
 
-func (m *ContentEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Content != nil {
-		{
-			size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// ContentEntry describes data referenced and possibly stored on chain
+type ContentEntry struct {
+	// hash is the content hash
+	Hash *ContentHash `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// iri is the content IRI
+	Iri string `protobuf:"bytes,2,opt,name=iri,proto3" json:"iri,omitempty"`
+	// timestamp is the anchor Timestamp
+	Timestamp *types.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// signers are the signers, if any
+	Signers []*SignerEntry `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+	// content is the actual content if stored on-chain
+	Content *Content `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (m *ContentEntry) Reset()         { *m = ContentEntry{} }
+func (m *ContentEntry) String() string { return proto.CompactTextString(m) }
+func (*ContentEntry) ProtoMessage()    {}
+func (*ContentEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf7739eaec65300f, []int{13}
+}
+func (m *ContentEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContentEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContentEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *ContentEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContentEntry.Merge(m, src)
+}
+func (m *ContentEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContentEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContentEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContentEntry proto.InternalMessageInfo
+
+func (m *ContentEntry) GetHash() *ContentHash {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *ContentEntry) GetIri() string {
+	if m != nil {
+		return m.Iri
+	}
+	return ""
+}
+
+func (m *ContentEntry) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *ContentEntry) GetSigners() []*SignerEntry {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *ContentEntry) GetContent() *Content {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryByHashRequest)(nil), "regen.data.v1alpha2.QueryByHashRequest")
+	proto.RegisterType((*QueryByHashResponse)(nil), "regen.data.v1alpha2.QueryByHashResponse")
+	proto.RegisterType((*QueryBySignerRequest)(nil), "regen.data.v1alpha2.QueryBySignerRequest")
+	proto.RegisterType((*QueryBySignerResponse)(nil), "regen.data.v1alpha2.QueryBySignerResponse")
+	proto.RegisterType((*QueryDataRequest)(nil), "regen.data.v1alpha2.QueryDataRequest")
+	proto.RegisterType((*QueryDataResponse)(nil), "regen.data.v1alpha2.QueryDataResponse")
+	proto.RegisterType((*QueryAnchorRequest)(nil), "regen.data.v1alpha2.QueryAnchorRequest")
+	proto.RegisterType((*QueryAnchorResponse)(nil), "regen.data.v1alpha2.QueryAnchorResponse")
+	proto.RegisterType((*QuerySignersRequest)(nil), "regen.data.v1alpha2.QuerySignersRequest")
+	proto.RegisterType((*QuerySignersResponse)(nil), "regen.data.v1alpha2.QuerySignersResponse")
+	proto.RegisterType((*QueryAnchoredDataRequest)(nil), "regen.data.v1alpha2.QueryAnchoredDataRequest")
+	proto.RegisterType((*QueryAnchoredDataResponse)(nil), "regen.data.v1alpha2.QueryAnchoredDataResponse")
+	proto.RegisterType((*AnchoredDataEntry)(nil), "regen.data.v1alpha2.AnchoredDataEntry")
+	proto.RegisterType((*ContentEntry)(nil), "regen.data.v1alpha2.ContentEntry")
+}
+
+func init() { proto.RegisterFile("regen/data/v1alpha2/query.proto", fileDescriptor_bf7739eaec65300f) }
+
+var fileDescriptor_bf7739eaec65300f = []byte{
+	// 878 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x51, 0x4f, 0x2b, 0x45,
+	0x14, 0x66, 0x5a, 0x68, 0x6f, 0x8f, 0x8d, 0x72, 0x87, 0xab, 0xa9, 0x2b, 0xb7, 0x85, 0x0d, 0xb4,
+	0x85, 0xc8, 0x6e, 0xa8, 0x46, 0x8d, 0xbe, 0x08, 0x22, 0x18, 0x4d, 0x14, 0x57, 0x9f, 0x7c, 0x69,
+	0xa6, 0xed, 0xb0, 0xbb, 0xa1, 0xdd, 0x59, 0x76, 0xa7, 0x68, 0x43, 0x30, 0xd1, 0xf8, 0xc6, 0x83,
+	0x24, 0xea, 0x93, 0xf1, 0xd5, 0xdf, 0xe0, 0x4f, 0xf0, 0x91, 0xc4, 0x98, 0xf8, 0x68, 0xc0, 0x1f,
+	0x62, 0x3a, 0x33, 0x4b, 0x77, 0x61, 0x69, 0x0b, 0x9a, 0xdc, 0xb7, 0xce, 0xf4, 0x3b, 0xe7, 0x7c,
+	0xe7, 0xdb, 0x6f, 0xce, 0x81, 0x4a, 0x40, 0x6d, 0xea, 0x99, 0x1d, 0xc2, 0x89, 0x79, 0xbc, 0x49,
+	0xba, 0xbe, 0x43, 0x1a, 0xe6, 0x51, 0x9f, 0x06, 0x03, 0xc3, 0x0f, 0x18, 0x67, 0x78, 0x41, 0x00,
+	0x8c, 0x21, 0xc0, 0x88, 0x00, 0xda, 0xa2, 0xcd, 0x98, 0xdd, 0xa5, 0x26, 0xf1, 0x5d, 0x93, 0x78,
+	0x1e, 0xe3, 0x84, 0xbb, 0xcc, 0x0b, 0x65, 0x88, 0x56, 0x51, 0xff, 0x8a, 0x53, 0xab, 0x7f, 0x60,
+	0x72, 0xb7, 0x47, 0x43, 0x4e, 0x7a, 0xbe, 0x02, 0xac, 0xb7, 0x59, 0xd8, 0x63, 0xa1, 0xd9, 0x22,
+	0x21, 0x95, 0xc5, 0xcc, 0xe3, 0xcd, 0x16, 0xe5, 0x64, 0xd3, 0xf4, 0x89, 0xed, 0x7a, 0x22, 0x5b,
+	0x94, 0x2c, 0x8d, 0x20, 0x1f, 0xf8, 0x54, 0x55, 0xd3, 0x3f, 0x04, 0xfc, 0xe9, 0x30, 0xc5, 0xf6,
+	0xe0, 0x03, 0x12, 0x3a, 0x16, 0x3d, 0xea, 0xd3, 0x90, 0xe3, 0xd7, 0x61, 0xd6, 0x21, 0xa1, 0x53,
+	0x42, 0x4b, 0xa8, 0xfe, 0x5c, 0x63, 0xc9, 0x48, 0xe9, 0xc2, 0x78, 0x8f, 0x79, 0x9c, 0x7a, 0x5c,
+	0x84, 0x09, 0xb4, 0xfe, 0x31, 0x2c, 0x24, 0x72, 0x85, 0x3e, 0xf3, 0x42, 0x8a, 0xdf, 0x84, 0x39,
+	0xea, 0xf1, 0x60, 0xa0, 0xb2, 0x2d, 0x8f, 0xcb, 0xf6, 0xfe, 0x10, 0x68, 0x49, 0xbc, 0x7e, 0x0c,
+	0x4f, 0x54, 0xbe, 0xcf, 0x5c, 0xdb, 0xa3, 0x41, 0xc4, 0xee, 0x25, 0xc8, 0x85, 0xe2, 0x42, 0x64,
+	0x2c, 0x58, 0xea, 0x84, 0x77, 0x01, 0x46, 0x02, 0x94, 0x32, 0xa2, 0x5a, 0xd5, 0x90, 0x6a, 0x19,
+	0x43, 0xb5, 0x0c, 0xf9, 0x69, 0x94, 0x5a, 0xc6, 0x3e, 0xb1, 0xa9, 0xca, 0x69, 0xc5, 0x22, 0xf5,
+	0x5f, 0x10, 0xbc, 0x78, 0xa3, 0xb0, 0x6a, 0xe5, 0x1d, 0xc8, 0x0f, 0xa9, 0xb9, 0x34, 0x2c, 0xa1,
+	0xa5, 0xec, 0x74, 0xcd, 0x44, 0x11, 0x78, 0x2f, 0x41, 0x2f, 0x2b, 0xe8, 0xd5, 0x26, 0xd2, 0x93,
+	0x95, 0x13, 0xfc, 0x56, 0x60, 0x5e, 0xd0, 0xdb, 0x21, 0x9c, 0x44, 0x9a, 0xcc, 0x43, 0xd6, 0x0d,
+	0x5c, 0x25, 0xc8, 0xf0, 0xa7, 0xfe, 0x1b, 0x82, 0xc7, 0x31, 0x98, 0xea, 0xa0, 0x04, 0xf9, 0xb6,
+	0x64, 0x27, 0xb0, 0x45, 0x2b, 0x3a, 0xe2, 0xb7, 0xa0, 0x70, 0xed, 0x34, 0x25, 0x9e, 0x66, 0x48,
+	0x2f, 0x1a, 0x91, 0x17, 0x8d, 0xcf, 0x23, 0x84, 0x35, 0x02, 0xe3, 0x4f, 0x60, 0xbe, 0xe3, 0xda,
+	0x34, 0xe4, 0x4d, 0xd2, 0xb5, 0x59, 0xe0, 0x72, 0xa7, 0x27, 0xda, 0x7b, 0xbe, 0xb1, 0x92, 0x2a,
+	0xcf, 0x8e, 0x00, 0x6f, 0x45, 0x58, 0xeb, 0x85, 0x4e, 0xf2, 0x42, 0xaf, 0x2a, 0x53, 0x6e, 0x79,
+	0x6d, 0x87, 0x05, 0x77, 0xb7, 0x78, 0x86, 0x60, 0x21, 0x01, 0x54, 0x4d, 0x26, 0x5a, 0x41, 0xf7,
+	0x69, 0xe5, 0x29, 0x80, 0x1f, 0x30, 0x76, 0xd0, 0xf4, 0x09, 0x77, 0x84, 0x0a, 0x05, 0xab, 0x20,
+	0x6e, 0xf6, 0x09, 0x77, 0xf0, 0x2b, 0x20, 0x0f, 0xcd, 0x43, 0x3a, 0x10, 0x2d, 0x16, 0xad, 0x47,
+	0xe2, 0xe2, 0x23, 0x3a, 0xd0, 0x99, 0x22, 0x23, 0x3d, 0x13, 0xde, 0x49, 0xfb, 0x7f, 0xf3, 0xe9,
+	0xcf, 0x08, 0x9e, 0x24, 0x2b, 0xaa, 0xfe, 0xdf, 0x86, 0xbc, 0x7c, 0x12, 0x91, 0x4d, 0xd3, 0x5f,
+	0xb0, 0x0c, 0x53, 0x2e, 0x55, 0x01, 0x78, 0x2f, 0x85, 0xdc, 0x83, 0x5c, 0xda, 0x82, 0x52, 0xec,
+	0xdb, 0xd0, 0x4e, 0xdc, 0xad, 0x49, 0x05, 0xd0, 0x83, 0x15, 0xf8, 0x15, 0xc1, 0xcb, 0x29, 0x45,
+	0x94, 0x0c, 0xef, 0xde, 0x7c, 0xad, 0xd5, 0x54, 0x19, 0xe2, 0xb1, 0x63, 0x9f, 0xec, 0x7f, 0x10,
+	0xe3, 0x27, 0x04, 0x8f, 0x6f, 0xd5, 0x79, 0xd8, 0x98, 0x8d, 0x0c, 0x95, 0x19, 0x19, 0x2a, 0xe1,
+	0xf7, 0xec, 0x3d, 0xfc, 0xae, 0x7f, 0x97, 0x81, 0x62, 0x7c, 0x5a, 0x3d, 0x7b, 0x4a, 0x71, 0xf3,
+	0xce, 0xde, 0xd7, 0xbc, 0x6f, 0x8c, 0xa6, 0xdb, 0x9c, 0xa8, 0xb9, 0x38, 0xae, 0x81, 0xeb, 0xd9,
+	0xd7, 0xf8, 0x33, 0x07, 0x73, 0xc2, 0x47, 0xf8, 0x1b, 0x04, 0x39, 0xb9, 0xbf, 0x70, 0x2d, 0x35,
+	0xf6, 0xf6, 0xb6, 0xd4, 0xea, 0x93, 0x81, 0xd2, 0x12, 0xfa, 0xca, 0xb7, 0x7f, 0xfc, 0xf3, 0x43,
+	0xa6, 0x8c, 0x17, 0xcd, 0xb4, 0xbd, 0xdc, 0x1a, 0x34, 0x85, 0x9a, 0xe7, 0x08, 0x1e, 0x45, 0xab,
+	0x07, 0xaf, 0x8d, 0x4b, 0x9e, 0xd8, 0x8b, 0xda, 0xfa, 0x34, 0x50, 0xc5, 0x64, 0x43, 0x30, 0xa9,
+	0xe1, 0xd5, 0x54, 0x26, 0x4a, 0x4f, 0xf3, 0x44, 0xfe, 0x38, 0xc5, 0x5f, 0xc3, 0xec, 0xd0, 0xb6,
+	0x78, 0xf5, 0xee, 0x12, 0xb1, 0xf7, 0xad, 0x55, 0x27, 0xc1, 0x14, 0x8b, 0x9a, 0x60, 0xb1, 0x8c,
+	0x2b, 0xa9, 0x2c, 0xc4, 0xe9, 0xc4, 0x0d, 0xdc, 0x53, 0x7c, 0x86, 0x20, 0x27, 0xdf, 0xcf, 0xb8,
+	0xcf, 0x92, 0xd8, 0x17, 0x5a, 0x7d, 0x32, 0x50, 0xd1, 0x30, 0x04, 0x8d, 0x3a, 0xae, 0x4e, 0xa0,
+	0x61, 0x12, 0x49, 0xe1, 0x7b, 0x04, 0x79, 0x35, 0x73, 0xf1, 0x98, 0x2a, 0xc9, 0x45, 0xa0, 0xad,
+	0x4d, 0x81, 0x54, 0x84, 0x4c, 0x41, 0x68, 0x0d, 0xd7, 0x26, 0x11, 0x8a, 0x8c, 0xff, 0x23, 0x82,
+	0x62, 0x7c, 0xbe, 0xe0, 0x8d, 0x49, 0xcd, 0x27, 0x06, 0xb2, 0x66, 0x4c, 0x0b, 0x57, 0x04, 0x57,
+	0x05, 0xc1, 0x0a, 0x7e, 0x9a, 0x4a, 0x90, 0xa8, 0x90, 0xed, 0xdd, 0xdf, 0x2f, 0xcb, 0xe8, 0xe2,
+	0xb2, 0x8c, 0xfe, 0xbe, 0x2c, 0xa3, 0xf3, 0xab, 0xf2, 0xcc, 0xc5, 0x55, 0x79, 0xe6, 0xaf, 0xab,
+	0xf2, 0xcc, 0x17, 0xaf, 0xda, 0x2e, 0x77, 0xfa, 0x2d, 0xa3, 0xcd, 0x7a, 0x32, 0xc5, 0x86, 0x47,
+	0xf9, 0x97, 0x2c, 0x38, 0x54, 0xa7, 0x2e, 0xed, 0xd8, 0x34, 0x30, 0xbf, 0x12, 0x99, 0x5b, 0x39,
+	0x31, 0x32, 0x5e, 0xfb, 0x77, 0x00, 0xe8, 0x18, 0x87, 0x2a, 0x70, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ByHash queries data based on its ContentHash.
+	ByHash(ctx context.Context, in *QueryByHashRequest, opts ...grpc.CallOption) (*QueryByHashResponse, error)
+	// BySigner queries data based on signers.
+	BySigner(ctx context.Context, in *QueryBySignerRequest, opts ...grpc.CallOption) (*QueryBySignerResponse, error)
+	// Data queries raw data stored on-chain based on its IRI.
+	Data(ctx context.Context, in *QueryDataRequest, opts ...grpc.CallOption) (*QueryDataResponse, error)
+	// Anchor queries the timestamp at which data was anchored based on its IRI.
+	Anchor(ctx context.Context, in *QueryAnchorRequest, opts ...grpc.CallOption) (*QueryAnchorResponse, error)
+	// Signers queries the signers of data based on its IRI.
+	Signers(ctx context.Context, in *QuerySignersRequest, opts ...grpc.CallOption) (*QuerySignersResponse, error)
+	// AnchoredData queries all data anchored on-chain.
+	AnchoredData(ctx context.Context, in *QueryAnchoredDataRequest, opts ...grpc.CallOption) (*QueryAnchoredDataResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ByHash(ctx context.Context, in *QueryByHashRequest, opts ...grpc.CallOption) (*QueryByHashResponse, error) {
+	out := new(QueryByHashResponse)
+	err := c.cc.Invoke(ctx, "/regen.data.v1alpha2.Query/ByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BySigner(ctx context.Context, in *QueryBySignerRequest, opts ...grpc.CallOption) (*QueryBySignerResponse, error) {
+	out := new(QueryBySignerResponse)
+	err := c.cc.Invoke(ctx, "/regen.data.v1alpha2.Query/BySigner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Data(ctx context.Context, in *QueryDataRequest, opts ...grpc.CallOption) (*QueryDataResponse, error) {
+	out := new(QueryDataResponse)
+	err := c.cc.Invoke(ctx, "/regen.data.v1alpha2.Query/Data", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Anchor(ctx context.Context, in *QueryAnchorRequest, opts ...grpc.CallOption) (*QueryAnchorResponse, error) {
+	out := new(QueryAnchorResponse)
+	err := c.cc.Invoke(ctx, "/regen.data.v1alpha2.Query/Anchor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Signers(ctx context.Context, in *QuerySignersRequest, opts ...grpc.CallOption) (*QuerySignersResponse, error) {
+	out := new(QuerySignersResponse)
+	err := c.cc.Invoke(ctx, "/regen.data.v1alpha2.Query/Signers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AnchoredData(ctx context.Context, in *QueryAnchoredDataRequest, opts ...grpc.CallOption) (*QueryAnchoredDataResponse, error) {
+	out := new(QueryAnchoredDataResponse)
+	err := c.cc.Invoke(ctx, "/regen.data.v1alpha2.Query/AnchoredData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ByHash queries data based on its ContentHash.
+	ByHash(context.Context, *QueryByHashRequest) (*QueryByHashResponse, error)
+	// BySigner queries data based on signers.
+	BySigner(context.Context, *QueryBySignerRequest) (*QueryBySignerResponse, error)
+	// Data queries raw data stored on-chain based on its IRI.
+	Data(context.Context, *QueryDataRequest) (*QueryDataResponse, error)
+	// Anchor queries the timestamp at which data was anchored based on its IRI.
+	Anchor(context.Context, *QueryAnchorRequest) (*QueryAnchorResponse, error)
+	// Signers queries the signers of data based on its IRI.
+	Signers(context.Context, *QuerySignersRequest) (*QuerySignersResponse, error)
+	// AnchoredData queries all data anchored on-chain.
+	AnchoredData(context.Context, *QueryAnchoredDataRequest) (*QueryAnchoredDataResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ByHash(ctx context.Context, req *QueryByHashRequest) (*QueryByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ByHash not implemented")
+}
+func (*UnimplementedQueryServer) BySigner(ctx context.Context, req *QueryBySignerRequest) (*QueryBySignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BySigner not implemented")
+}
+func (*UnimplementedQueryServer) Data(ctx context.Context, req *QueryDataRequest) (*QueryDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Data not implemented")
+}
+func (*UnimplementedQueryServer) Anchor(ctx context.Context, req *QueryAnchorRequest) (*QueryAnchorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Anchor not implemented")
+}
+func (*UnimplementedQueryServer) Signers(ctx context.Context, req *QuerySignersRequest) (*QuerySignersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signers not implemented")
+}
+func (*UnimplementedQueryServer) AnchoredData(ctx context.Context, req *QueryAnchoredDataRequest) (*QueryAnchoredDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnchoredData not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.data.v1alpha2.Query/ByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ByHash(ctx, req.(*QueryByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BySigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBySignerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BySigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.data.v1alpha2.Query/BySigner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BySigner(ctx, req.(*QueryBySignerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Data_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Data(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.data.v1alpha2.Query/Data",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Data(ctx, req.(*QueryDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Anchor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnchorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Anchor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.data.v1alpha2.Query/Anchor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Anchor(ctx, req.(*QueryAnchorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Signers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Signers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.data.v1alpha2.Query/Signers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Signers(ctx, req.(*QuerySignersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AnchoredData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnchoredDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AnchoredData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.data.v1alpha2.Query/AnchoredData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AnchoredData(ctx, req.(*QueryAnchoredDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "regen.data.v1alpha2.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ByHash",
+			Handler:    _Query_ByHash_Handler,
+		},
+		{
+			MethodName: "BySigner",
+			Handler:    _Query_BySigner_Handler,
+		},
+		{
+			MethodName: "Data",
+			Handler:    _Query_Data_Handler,
+		},
+		{
+			MethodName: "Anchor",
+			Handler:    _Query_Anchor_Handler,
+		},
+		{
+			MethodName: "Signers",
+			Handler:    _Query_Signers_Handler,
+		},
+		{
+			MethodName: "AnchoredData",
+			Handler:    _Query_AnchoredData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "regen/data/v1alpha2/query.proto",
+}
+
+func (m *QueryByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Hash != nil {
+		{
+			size, err := m.Hash.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryByHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryByHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Entry != nil {
+		{
+			size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBySignerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBySignerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBySignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBySignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBySignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBySignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Iri) > 0 {
+		i -= len(m.Iri)
+		copy(dAtA[i:], m.Iri)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Iri)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DigestAlgorithm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DigestAlgorithm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnchorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnchorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnchorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Iri) > 0 {
+		i -= len(m.Iri)
+		copy(dAtA[i:], m.Iri)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Iri)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnchorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnchorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnchorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofKey) > 0 {
+		i -= len(m.ProofKey)
+		copy(dAtA[i:], m.ProofKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProofKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProofPath) > 0 {
+		i -= len(m.ProofPath)
+		copy(dAtA[i:], m.ProofPath)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProofPath)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Iri) > 0 {
+		i -= len(m.Iri)
+		copy(dAtA[i:], m.Iri)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Iri)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnchoredDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnchoredDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnchoredDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnchoredDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnchoredDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnchoredDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AnchoredDataEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnchoredDataEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnchoredDataEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Iri) > 0 {
+		i -= len(m.Iri)
+		copy(dAtA[i:], m.Iri)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Iri)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Hash != nil {
+		{
+			size, err := m.Hash.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContentEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContentEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContentEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Content != nil {
+		{
+			size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Iri) > 0 {
+		i -= len(m.Iri)
+		copy(dAtA[i:], m.Iri)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Iri)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Hash != nil {
+		{
+			size, err := m.Hash.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryByHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Entry != nil {
+		l = m.Entry.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBySignerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBySignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Iri)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DigestAlgorithm != 0 {
+		n += 1 + sovQuery(uint64(m.DigestAlgorithm))
+	}
+	return n
+}
+
+func (m *QueryAnchorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Iri)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAnchorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProofPath)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProofKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Iri)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAnchoredDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAnchoredDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AnchoredDataEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Iri)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContentEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Iri)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Content != nil {
+		l = m.Content.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryByHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryByHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hash == nil {
+				m.Hash = &ContentHash{}
+			}
+			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryByHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryByHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Entry == nil {
+				m.Entry = &ContentEntry{}
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBySignerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBySignerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBySignerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBySignerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBySignerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBySignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &ContentEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Iri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
+			if m.Content == nil {
+				m.Content = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DigestAlgorithm", wireType)
+			}
+			m.DigestAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DigestAlgorithm |= DigestAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnchorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnchorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnchorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Iri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnchorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			i--
-			dAtA[i] = 0x22
 		}
-	}
-	if m.Timestamp != nil {
-		{
-			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnchorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnchorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofKey = append(m.ProofKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofKey == nil {
+				m.ProofKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.Iri) > 0 {
-		i -= len(m.Iri)
-		copy(dAtA[i:], m.Iri)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Iri)))
-		i--
-		dAtA[i] = 0x12
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if m.Hash != nil {
-		{
-			size, err := m.Hash.MarshalToSizedBuffer(dAtA[:i])
+	return nil
+}
+func (m *QuerySignersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Iri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryByHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Hash != nil {
-		l = m.Hash.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryByHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Entry != nil {
-		l = m.Entry.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryBySignerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryBySignerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *ContentEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Hash != nil {
-		l = m.Hash.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Iri)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Timestamp != nil {
-		l = m.Timestamp.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Signers) > 0 {
-		for _, e := range m.Signers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Content != nil {
-		l = m.Content.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryByHashRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySignersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -857,15 +3088,15 @@ func (m *QueryByHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryByHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -892,10 +3123,44 @@ func (m *QueryByHashRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hash == nil {
-				m.Hash = &ContentHash{}
+			m.Signers = append(m.Signers, &SignerEntry{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -923,7 +3188,7 @@ func (m *QueryByHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryByHashResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAnchoredDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -946,15 +3211,15 @@ func (m *QueryByHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryByHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAnchoredDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAnchoredDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -981,10 +3246,10 @@ func (m *QueryByHashResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Entry == nil {
-				m.Entry = &ContentEntry{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1012,7 +3277,7 @@ func (m *QueryByHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBySignerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAnchoredDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1035,17 +3300,17 @@ func (m *QueryBySignerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBySignerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAnchoredDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBySignerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAnchoredDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1055,23 +3320,25 @@ func (m *QueryBySignerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, &AnchoredDataEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1103,7 +3370,7 @@ func (m *QueryBySignerRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1133,7 +3400,7 @@ func (m *QueryBySignerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBySignerResponse) Unmarshal(dAtA []byte) error {
+func (m *AnchoredDataEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1156,15 +3423,15 @@ func (m *QueryBySignerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBySignerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AnchoredDataEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBySignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AnchoredDataEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1191,14 +3458,48 @@ func (m *QueryBySignerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, &ContentEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Hash == nil {
+				m.Hash = &ContentHash{}
+			}
+			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Iri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1225,10 +3526,10 @@ func (m *QueryBySignerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,8 +1,6 @@
 package data
 
 import (
-	"bytes"
-	"crypto"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,7 +8,7 @@ import (
 )
 
 var (
-	_, _, _ sdk.Msg = &MsgAnchorData{}, &MsgSignData{}, &MsgStoreRawData{}
+	_, _, _, _, _, _ sdk.Msg = &MsgAnchorData{}, &MsgAnchorDataBatch{}, &MsgSignData{}, &MsgStoreRawData{}, &MsgStoreRawDataChunk{}, &MsgDeleteStoredContent{}
 )
 
 func (m *MsgAnchorData) ValidateBasic() error {
@@ -26,6 +24,40 @@ func (m *MsgAnchorData) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+func (m *MsgAnchorDataBatch) ValidateBasic() error {
+	if len(m.Hashes) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "hashes cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(m.Hashes))
+	for _, hash := range m.Hashes {
+		if hash == nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "hash cannot be nil")
+		}
+
+		iri, err := hash.ToIRI()
+		if err != nil {
+			return err
+		}
+
+		if seen[iri] {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("duplicate hash %s", iri))
+		}
+		seen[iri] = true
+	}
+
+	return nil
+}
+
+func (m *MsgAnchorDataBatch) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
 func (m *MsgSignData) ValidateBasic() error {
 	return m.Hash.Validate()
 }
@@ -50,27 +82,74 @@ func (m *MsgStoreRawData) ValidateBasic() error {
 		return err
 	}
 
-	digestAlgorithm := m.ContentHash.DigestAlgorithm
-	switch digestAlgorithm {
-	case DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256:
-		hash := crypto.BLAKE2b_256.New()
-		_, err = hash.Write(m.Content)
-		if err != nil {
-			return sdkerrors.Wrap(ErrHashVerificationFailed, err.Error())
-		}
+	// check the size before hashing the content, the MaxDataSize param is
+	// checked by the handler
+	if uint64(len(m.Content)) > MaxDataSizeLimit {
+		return sdkerrors.ErrInvalidRequest.Wrapf("content size %d exceeds the maximum of %d bytes", len(m.Content), MaxDataSizeLimit)
+	}
 
-		digest := hash.Sum(nil)
-		if !bytes.Equal(m.ContentHash.Hash, digest) {
-			return ErrHashVerificationFailed
-		}
+	return m.ContentHash.Verify(m.Content)
+}
 
-		return nil
-	default:
-		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("unsupported %T %s", digestAlgorithm, digestAlgorithm))
+func (m *MsgStoreRawData) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
 	}
+
+	return []sdk.AccAddress{addr}
 }
 
-func (m *MsgStoreRawData) GetSigners() []sdk.AccAddress {
+func (m *MsgStoreRawDataChunk) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err.Error())
+	}
+
+	if m.ContentHash == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "content hash cannot be empty")
+	}
+
+	if err := m.ContentHash.Validate(); err != nil {
+		return err
+	}
+
+	if m.Total == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "total cannot be zero")
+	}
+
+	if m.Index >= m.Total {
+		return sdkerrors.ErrInvalidRequest.Wrapf("index %d is out of range for %d chunks", m.Index, m.Total)
+	}
+
+	if len(m.Chunk) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "chunk cannot be empty")
+	}
+
+	return nil
+}
+
+func (m *MsgStoreRawDataChunk) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgDeleteStoredContent) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err.Error())
+	}
+
+	if m.ContentHash == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "content hash cannot be empty")
+	}
+
+	return m.ContentHash.Validate()
+}
+
+func (m *MsgDeleteStoredContent) GetSigners() []sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(m.Sender)
 	if err != nil {
 		panic(err)
@@ -1,10 +1,12 @@
 package data
 
 import (
-	"crypto"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/sha3"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 
@@ -76,6 +78,74 @@ func TestMsgAnchorDataRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgAnchorDataBatchRequest_GetSigners(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	msg := &MsgAnchorDataBatch{Sender: addr.String()}
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+
+	msg = &MsgAnchorDataBatch{Sender: ""}
+	require.Panics(t, func() {
+		msg.GetSigners()
+	})
+}
+
+func TestMsgAnchorDataBatchRequest_ValidateBasic(t *testing.T) {
+	rawHash := func(b byte, n int) *ContentHash {
+		hash := make([]byte, n)
+		hash[0] = b
+		return &ContentHash{Sum: &ContentHash_Raw_{Raw: &ContentHash_Raw{
+			Hash:            hash,
+			DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+			MediaType:       MediaType_MEDIA_TYPE_UNSPECIFIED,
+		}}}
+	}
+
+	tests := []struct {
+		name    string
+		hashes  []*ContentHash
+		wantErr string
+	}{
+		{
+			name:    "good",
+			hashes:  []*ContentHash{rawHash(1, 32), rawHash(2, 32)},
+			wantErr: "",
+		},
+		{
+			name:    "empty",
+			hashes:  nil,
+			wantErr: "hashes cannot be empty: invalid request",
+		},
+		{
+			name:    "nil hash",
+			hashes:  []*ContentHash{rawHash(1, 32), nil},
+			wantErr: "hash cannot be nil: invalid request",
+		},
+		{
+			name:    "bad hash",
+			hashes:  []*ContentHash{rawHash(1, 32), rawHash(2, 31)},
+			wantErr: "expected 32 bytes for DIGEST_ALGORITHM_BLAKE2B_256, got 31: unknown request",
+		},
+		{
+			name:    "duplicate",
+			hashes:  []*ContentHash{rawHash(1, 32), rawHash(2, 32), rawHash(1, 32)},
+			wantErr: "duplicate hash",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &MsgAnchorDataBatch{Hashes: tt.hashes}
+			err := m.ValidateBasic()
+			if len(tt.wantErr) != 0 {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestMsgSignDataRequest_GetSigners(t *testing.T) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	_, _, addr2 := testdata.KeyTestPubAddr()
@@ -159,10 +229,9 @@ func TestMsgStoreRawDataRequest_GetSigners(t *testing.T) {
 
 func TestMsgStoreRawDataRequest_ValidateBasic(t *testing.T) {
 	data := []byte("sdf,gh8934tfgno2t09sdghk13y89w87ybdufgbh208phsnbdouguy209367wnb0")
-	hash := crypto.BLAKE2b_256.New()
-	_, err := hash.Write(data)
-	require.NoError(t, err)
-	digest := hash.Sum(nil)
+	digest := blake2b.Sum256(data)
+	blake2b512Digest := blake2b.Sum512(data)
+	sha3Digest := sha3.Sum256(data)
 
 	type fields struct {
 		Sender  string
@@ -179,7 +248,7 @@ func TestMsgStoreRawDataRequest_ValidateBasic(t *testing.T) {
 			fields{
 				Sender: "",
 				Hash: &ContentHash_Raw{
-					Hash:            digest,
+					Hash:            digest[:],
 					DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
 					MediaType:       MediaType_MEDIA_TYPE_UNSPECIFIED,
 				},
@@ -187,6 +256,58 @@ func TestMsgStoreRawDataRequest_ValidateBasic(t *testing.T) {
 			},
 			"",
 		},
+		{
+			"good blake2b-512",
+			fields{
+				Sender: "",
+				Hash: &ContentHash_Raw{
+					Hash:            blake2b512Digest[:],
+					DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_512,
+					MediaType:       MediaType_MEDIA_TYPE_UNSPECIFIED,
+				},
+				Content: data,
+			},
+			"",
+		},
+		{
+			"good sha3-256",
+			fields{
+				Sender: "",
+				Hash: &ContentHash_Raw{
+					Hash:            sha3Digest[:],
+					DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_SHA3_256,
+					MediaType:       MediaType_MEDIA_TYPE_UNSPECIFIED,
+				},
+				Content: data,
+			},
+			"",
+		},
+		{
+			"wrong digest algorithm",
+			fields{
+				Sender: "",
+				Hash: &ContentHash_Raw{
+					Hash:            sha3Digest[:],
+					DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+					MediaType:       MediaType_MEDIA_TYPE_UNSPECIFIED,
+				},
+				Content: data,
+			},
+			"hash verification failed",
+		},
+		{
+			"unsupported digest algorithm",
+			fields{
+				Sender: "",
+				Hash: &ContentHash_Raw{
+					Hash:            digest[:],
+					DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_UNSPECIFIED,
+					MediaType:       MediaType_MEDIA_TYPE_UNSPECIFIED,
+				},
+				Content: data,
+			},
+			"invalid or unknown data.DigestAlgorithm DIGEST_ALGORITHM_UNSPECIFIED: unknown request",
+		},
 		{
 			"bad",
 			fields{
@@ -200,6 +321,19 @@ func TestMsgStoreRawDataRequest_ValidateBasic(t *testing.T) {
 			},
 			"hash verification failed",
 		},
+		{
+			"content above the max data size limit",
+			fields{
+				Sender: "",
+				Hash: &ContentHash_Raw{
+					Hash:            make([]byte, 32),
+					DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+					MediaType:       MediaType_MEDIA_TYPE_UNSPECIFIED,
+				},
+				Content: make([]byte, MaxDataSizeLimit+1),
+			},
+			fmt.Sprintf("content size %d exceeds the maximum of %d bytes: invalid request", MaxDataSizeLimit+1, MaxDataSizeLimit),
+		},
 	}
 
 	for _, tt := range tests {
@@ -218,3 +352,157 @@ func TestMsgStoreRawDataRequest_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgStoreRawDataChunkRequest_GetSigners(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	msg := &MsgStoreRawDataChunk{Sender: addr.String()}
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+
+	msg = &MsgStoreRawDataChunk{Sender: ""}
+	require.Panics(t, func() {
+		msg.GetSigners()
+	})
+}
+
+func TestMsgStoreRawDataChunkRequest_ValidateBasic(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	hash := &ContentHash_Raw{
+		Hash:            make([]byte, 32),
+		DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+	}
+
+	tests := []struct {
+		name    string
+		msg     MsgStoreRawDataChunk
+		wantErr string
+	}{
+		{
+			"good",
+			MsgStoreRawDataChunk{Sender: addr.String(), ContentHash: hash, Index: 1, Total: 2, Chunk: []byte("abc")},
+			"",
+		},
+		{
+			"bad sender",
+			MsgStoreRawDataChunk{Sender: "foo", ContentHash: hash, Index: 0, Total: 2, Chunk: []byte("abc")},
+			"invalid sender address",
+		},
+		{
+			"missing hash",
+			MsgStoreRawDataChunk{Sender: addr.String(), Index: 0, Total: 2, Chunk: []byte("abc")},
+			"content hash cannot be empty",
+		},
+		{
+			"bad hash",
+			MsgStoreRawDataChunk{
+				Sender: addr.String(),
+				ContentHash: &ContentHash_Raw{
+					Hash:            make([]byte, 31),
+					DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+				},
+				Index: 0,
+				Total: 2,
+				Chunk: []byte("abc"),
+			},
+			"expected 32 bytes",
+		},
+		{
+			"zero total",
+			MsgStoreRawDataChunk{Sender: addr.String(), ContentHash: hash, Index: 0, Total: 0, Chunk: []byte("abc")},
+			"total cannot be zero",
+		},
+		{
+			"index out of range",
+			MsgStoreRawDataChunk{Sender: addr.String(), ContentHash: hash, Index: 2, Total: 2, Chunk: []byte("abc")},
+			"index 2 is out of range for 2 chunks",
+		},
+		{
+			"empty chunk",
+			MsgStoreRawDataChunk{Sender: addr.String(), ContentHash: hash, Index: 0, Total: 2},
+			"chunk cannot be empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if len(tt.wantErr) != 0 {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgDeleteStoredContentRequest_GetSigners(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	msg := &MsgDeleteStoredContent{Sender: addr.String()}
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+
+	msg = &MsgDeleteStoredContent{Sender: ""}
+	require.Panics(t, func() {
+		msg.GetSigners()
+	})
+}
+
+func TestMsgDeleteStoredContentRequest_ValidateBasic(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	tests := []struct {
+		name    string
+		msg     MsgDeleteStoredContent
+		wantErr string
+	}{
+		{
+			"good",
+			MsgDeleteStoredContent{
+				Sender: addr.String(),
+				ContentHash: &ContentHash_Raw{
+					Hash:            make([]byte, 32),
+					DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+				},
+			},
+			"",
+		},
+		{
+			"bad sender",
+			MsgDeleteStoredContent{
+				Sender: "foo",
+				ContentHash: &ContentHash_Raw{
+					Hash:            make([]byte, 32),
+					DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+				},
+			},
+			"invalid sender address",
+		},
+		{
+			"missing hash",
+			MsgDeleteStoredContent{Sender: addr.String()},
+			"content hash cannot be empty",
+		},
+		{
+			"bad hash",
+			MsgDeleteStoredContent{
+				Sender: addr.String(),
+				ContentHash: &ContentHash_Raw{
+					Hash:            make([]byte, 31),
+					DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+				},
+			},
+			"expected 32 bytes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if len(tt.wantErr) != 0 {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
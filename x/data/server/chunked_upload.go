@@ -0,0 +1,69 @@
+package server
+
+import (
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/data"
+)
+
+// getChunkedUpload returns the chunked upload in progress for iri or nil if
+// there is none.
+func (s serverImpl) getChunkedUpload(ctx types.Context, iri string) (*data.ChunkedUpload, error) {
+	bz := ctx.KVStore(s.storeKey).Get(ChunkedUploadKey(iri))
+	if bz == nil {
+		return nil, nil
+	}
+
+	var upload data.ChunkedUpload
+	err := upload.Unmarshal(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return &upload, nil
+}
+
+func (s serverImpl) setChunkedUpload(ctx types.Context, iri string, upload *data.ChunkedUpload) error {
+	bz, err := upload.Marshal()
+	if err != nil {
+		return err
+	}
+
+	expiresAt, err := gogotypes.TimestampFromProto(upload.ExpiresAt)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(s.storeKey)
+	store.Set(ChunkedUploadKey(iri), bz)
+	store.Set(ChunkedUploadExpiryKey(expiresAt, iri), []byte{})
+	return nil
+}
+
+// deleteChunkedUpload deletes a chunked upload along with its staged chunks.
+func (s serverImpl) deleteChunkedUpload(ctx types.Context, iri string, upload *data.ChunkedUpload) error {
+	expiresAt, err := gogotypes.TimestampFromProto(upload.ExpiresAt)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(s.storeKey)
+	for i := uint32(0); i < upload.Received; i++ {
+		store.Delete(ChunkKey(iri, i))
+	}
+	store.Delete(ChunkedUploadKey(iri))
+	store.Delete(ChunkedUploadExpiryKey(expiresAt, iri))
+	return nil
+}
+
+// chunkedUploadExpired returns true if the upload has expired as of the block
+// time, even if it hasn't been discarded yet by EndBlock.
+func chunkedUploadExpired(ctx types.Context, upload *data.ChunkedUpload) (bool, error) {
+	expiresAt, err := gogotypes.TimestampFromProto(upload.ExpiresAt)
+	if err != nil {
+		return false, err
+	}
+
+	return !ctx.BlockTime().Before(expiresAt), nil
+}
@@ -0,0 +1,38 @@
+package server
+
+import (
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types"
+)
+
+// EndBlock discards the chunked uploads which have expired before all their
+// chunks were received.
+func (s serverImpl) EndBlock(ctx types.Context) error {
+	// An upload has expired when it expires at or before the block time.
+	store := ctx.KVStore(s.storeKey)
+	start := []byte{ChunkedUploadExpiryPrefix}
+	end := ChunkedUploadExpiryPrefixUntil(ctx.BlockTime().Add(time.Nanosecond))
+	it := store.Iterator(start, end)
+	var iris []string
+	for ; it.Valid(); it.Next() {
+		iris = append(iris, ParseChunkedUploadExpiryKey(it.Key()))
+	}
+	it.Close()
+
+	for _, iri := range iris {
+		upload, err := s.getChunkedUpload(ctx, iri)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "chunked upload %s", iri)
+		}
+		if upload == nil {
+			continue
+		}
+		if err := s.deleteChunkedUpload(ctx, iri, upload); err != nil {
+			return sdkerrors.Wrapf(err, "chunked upload %s", iri)
+		}
+	}
+	return nil
+}
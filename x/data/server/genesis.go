@@ -0,0 +1,139 @@
+package server
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/data"
+)
+
+// InitGenesis performs genesis initialization for the data module. It
+// returns no validator updates.
+func (s serverImpl) InitGenesis(ctx types.Context, cdc codec.Codec, bz json.RawMessage) ([]abci.ValidatorUpdate, error) {
+	var genesisState data.GenesisState
+	cdc.MustUnmarshalJSON(bz, &genesisState)
+
+	s.paramSpace.SetParamSet(ctx.Context, &genesisState.Params)
+
+	store := ctx.KVStore(s.storeKey)
+	for _, entry := range genesisState.Entries {
+		if err := importContentEntry(store, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	return []abci.ValidatorUpdate{}, nil
+}
+
+// importContentEntry sets the anchor, signers and stored content of a genesis content entry.
+func importContentEntry(store sdk.KVStore, entry *data.GenesisContentEntry) error {
+	iri, err := entry.Hash.ToIRI()
+	if err != nil {
+		return err
+	}
+
+	bz, err := entry.Timestamp.Marshal()
+	if err != nil {
+		return errors.Wrap(err, iri)
+	}
+	store.Set(AnchorKey(iri), bz)
+
+	for _, signer := range entry.Signers {
+		bz, err := signer.Timestamp.Marshal()
+		if err != nil {
+			return errors.Wrap(err, iri)
+		}
+		store.Set(IRISignerKey(iri, signer.Signer), bz)
+		store.Set(SignerIRIKey(signer.Signer, iri), bz)
+	}
+
+	if content := entry.Content.GetRawData(); content != nil {
+		store.Set(DataKey(iri), content)
+	}
+
+	if entry.Storer != "" {
+		storer, err := sdk.AccAddressFromBech32(entry.Storer)
+		if err != nil {
+			return errors.Wrap(err, iri)
+		}
+		store.Set(StorerKey(iri), storer)
+	}
+
+	return nil
+}
+
+// ExportGenesis will dump the data module state into a serializable GenesisState.
+func (s serverImpl) ExportGenesis(ctx types.Context, cdc codec.Codec) (json.RawMessage, error) {
+	// Get Params from the store and put them in the genesis state
+	var params data.Params
+	s.paramSpace.GetParamSet(ctx.Context, &params)
+
+	store := ctx.KVStore(s.storeKey)
+	var entries []*data.GenesisContentEntry
+	anchorStore := prefix.NewStore(store, []byte{AnchorTablePrefix})
+	iterator := anchorStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		entry, err := exportContentEntry(store, string(iterator.Key()), iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	gs := &data.GenesisState{
+		Entries: entries,
+		Params:  params,
+	}
+
+	return cdc.MustMarshalJSON(gs), nil
+}
+
+// exportContentEntry builds the genesis content entry for the anchored data with the given IRI.
+func exportContentEntry(store sdk.KVStore, iri string, timestampBz []byte) (*data.GenesisContentEntry, error) {
+	anchored, err := anchoredDataEntry(iri, timestampBz)
+	if err != nil {
+		return nil, errors.Wrap(err, iri)
+	}
+
+	var signers []*data.SignerEntry
+	signerStore := prefix.NewStore(store, IRISignerIndexPrefix(iri))
+	iterator := signerStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var timestamp gogotypes.Timestamp
+		if err := timestamp.Unmarshal(iterator.Value()); err != nil {
+			return nil, errors.Wrap(err, iri)
+		}
+
+		signers = append(signers, &data.SignerEntry{
+			Signer:    string(iterator.Key()),
+			Timestamp: &timestamp,
+		})
+	}
+
+	var content *data.Content
+	if bz := store.Get(DataKey(iri)); bz != nil {
+		content = &data.Content{Sum: &data.Content_RawData{RawData: bz}}
+	}
+
+	var storer string
+	if bz := store.Get(StorerKey(iri)); bz != nil {
+		storer = sdk.AccAddress(bz).String()
+	}
+
+	return &data.GenesisContentEntry{
+		Hash:      anchored.Hash,
+		Timestamp: anchored.Timestamp,
+		Signers:   signers,
+		Content:   content,
+		Storer:    storer,
+	}, nil
+}
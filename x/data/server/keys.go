@@ -1,50 +1,96 @@
 package server
 
 import (
-	"encoding/base64"
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
-	AnchorTablePrefix byte = 0x0
-	CIDSignerPrefix   byte = 0x1
-	SignerCIDPrefix   byte = 0x2
-	DataTablePrefix   byte = 0x3
+	AnchorTablePrefix         byte = 0x0
+	IRISignerPrefix           byte = 0x1
+	SignerIRIPrefix           byte = 0x2
+	DataTablePrefix           byte = 0x3
+	StorerTablePrefix         byte = 0x4
+	ChunkedUploadPrefix       byte = 0x5
+	ChunkPrefix               byte = 0x6
+	ChunkedUploadExpiryPrefix byte = 0x7
 )
 
-func AnchorKey(cid []byte) []byte {
-	return append([]byte{AnchorTablePrefix}, cid...)
-}
-
-func CIDBase64String(cid []byte) string {
-	return base64.StdEncoding.EncodeToString(cid)
+func AnchorKey(iri string) []byte {
+	return append([]byte{AnchorTablePrefix}, iri...)
 }
 
-func CIDSignerKey(cidStr string, signer string) []byte {
-	key := CIDSignerIndexPrefix(cidStr)
+func IRISignerKey(iri string, signer string) []byte {
+	key := IRISignerIndexPrefix(iri)
 	key = append(key, signer...)
 	return key
 }
 
-func CIDSignerIndexPrefix(cidStr string) []byte {
-	key := []byte{CIDSignerPrefix}
-	key = append(key, cidStr...)
+func IRISignerIndexPrefix(iri string) []byte {
+	key := []byte{IRISignerPrefix}
+	key = append(key, iri...)
 	key = append(key, 0)
 	return key
 }
 
-func SignerCIDKey(signer string, cid []byte) []byte {
-	key := SignerCIDIndexPrefix(signer)
-	key = append(key, cid...)
+func SignerIRIKey(signer string, iri string) []byte {
+	key := SignerIRIIndexPrefix(signer)
+	key = append(key, iri...)
 	return key
 }
 
-func SignerCIDIndexPrefix(signer string) []byte {
-	key := []byte{SignerCIDPrefix}
+func SignerIRIIndexPrefix(signer string) []byte {
+	key := []byte{SignerIRIPrefix}
 	key = append(key, signer...)
 	key = append(key, 0)
 	return key
 }
 
-func DataKey(cid []byte) []byte {
-	return append([]byte{DataTablePrefix}, cid...)
+func DataKey(iri string) []byte {
+	return append([]byte{DataTablePrefix}, iri...)
+}
+
+func StorerKey(iri string) []byte {
+	return append([]byte{StorerTablePrefix}, iri...)
+}
+
+func ChunkedUploadKey(iri string) []byte {
+	return append([]byte{ChunkedUploadPrefix}, iri...)
+}
+
+func ChunkKey(iri string, index uint32) []byte {
+	key := ChunksPrefix(iri)
+	key = append(key, make([]byte, 4)...)
+	binary.BigEndian.PutUint32(key[len(key)-4:], index)
+	return key
+}
+
+func ChunksPrefix(iri string) []byte {
+	key := []byte{ChunkPrefix}
+	key = append(key, iri...)
+	key = append(key, 0)
+	return key
+}
+
+func ChunkedUploadExpiryKey(expiresAt time.Time, iri string) []byte {
+	key := ChunkedUploadExpiryPrefixUntil(expiresAt)
+	key = append(key, iri...)
+	return key
+}
+
+// ChunkedUploadExpiryPrefixUntil returns the prefix of the expiry keys of the
+// chunked uploads expiring at the given time, which is also the exclusive end
+// of the range of those expiring earlier.
+func ChunkedUploadExpiryPrefixUntil(expiresAt time.Time) []byte {
+	key := []byte{ChunkedUploadExpiryPrefix}
+	key = append(key, sdk.FormatTimeBytes(expiresAt)...)
+	return key
+}
+
+// ParseChunkedUploadExpiryKey returns the IRI of a key created with
+// ChunkedUploadExpiryKey.
+func ParseChunkedUploadExpiryKey(key []byte) string {
+	return string(key[1+len(sdk.FormatTimeBytes(time.Time{})):])
 }
@@ -0,0 +1,25 @@
+package server
+
+import (
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/data"
+)
+
+// migrateV1ToV2 migrates the data state from consensus version 1 to 2.
+func (s serverImpl) migrateV1ToV2(ctx types.Context) error {
+	s.migrateParams(ctx)
+	return nil
+}
+
+// migrateParams sets all params that have no value in the param store yet to
+// their defaults, so that loading the params doesn't panic. The data module had
+// no params in version 1. Notably no DeletionAuthority is set, which leaves
+// deleting stored content to its storers.
+func (s serverImpl) migrateParams(ctx types.Context) {
+	defaults := data.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !s.paramSpace.Has(ctx.Context, pair.Key) {
+			s.paramSpace.Set(ctx.Context, pair.Key, pair.Value)
+		}
+	}
+}
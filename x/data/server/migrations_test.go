@@ -0,0 +1,46 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/data"
+)
+
+func TestMigrateParams(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tkey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(tkey, sdk.StoreTypeTransient, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := types.Context{Context: sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())}
+
+	paramSpace := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), paramsKey, tkey, data.ModuleName).
+		WithKeyTable(data.ParamKeyTable())
+	s := serverImpl{paramSpace: paramSpace}
+
+	// a value that is already set is kept
+	paramSpace.Set(ctx.Context, data.KeyMaxDataSize, uint64(1024))
+
+	s.migrateParams(ctx)
+
+	var params data.Params
+	paramSpace.GetParamSet(ctx.Context, &params)
+	expParams := data.DefaultParams()
+	expParams.MaxDataSize = 1024
+	require.Equal(t, expParams, params)
+	require.Equal(t, "", params.DeletionAuthority)
+}
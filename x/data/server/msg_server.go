@@ -1,165 +1,396 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/data"
 )
 
 var _ data.MsgServer = serverImpl{}
 
 func (s serverImpl) AnchorData(goCtx context.Context, request *data.MsgAnchorData) (*data.MsgAnchorDataResponse, error) {
-	return nil, fmt.Errorf("not implemented")
-	//cidBz := request.Cid
-	//key := AnchorKey(cidBz)
-	//store := ctx.KVStore(s.storeKey)
-	//if store.Has(key) {
-	//	return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("CID f%x is already anchored", cidBz))
-	//}
-	//
-	//timestamp, err := blockTimestamp(ctx)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//
-	//err = s.anchorCid(ctx, timestamp, cidBz)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//
-	//return &data.MsgAnchorDataResponse{Timestamp: timestamp}, nil
+	ctx := types.UnwrapSDKContext(goCtx)
+	iri, err := request.Hash.ToIRI()
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(s.storeKey)
+	if store.Has(AnchorKey(iri)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("%s is already anchored", iri))
+	}
+
+	timestamp, err := blockTimestamp(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.anchor(ctx, timestamp, iri)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.MsgAnchorDataResponse{Timestamp: timestamp}, nil
+}
+
+func (s serverImpl) AnchorDataBatch(goCtx context.Context, request *data.MsgAnchorDataBatch) (*data.MsgAnchorDataBatchResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+	timestamp, err := blockTimestamp(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hash := range request.Hashes {
+		iri, err := hash.ToIRI()
+		if err != nil {
+			return nil, err
+		}
+
+		// content which is already anchored is skipped so that a batch
+		// overlapping with previously anchored data doesn't fail as a whole
+		err = s.anchorIfNeeded(ctx, timestamp, iri)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data.MsgAnchorDataBatchResponse{Timestamp: timestamp}, nil
 }
 
-//func blockTimestamp(ctx types.Context) (*gogotypes.Timestamp, error) {
-//	timestamp, err := gogotypes.TimestampProto(ctx.BlockTime())
-//	if err != nil {
-//		return nil, sdkerrors.Wrap(err, "invalid block time")
-//	}
-//
-//	return timestamp, err
-//}
-//
-//func (s serverImpl) anchorCidIfNeeded(ctx types.Context, timestamp *gogotypes.Timestamp, cid []byte) error {
-//	store := ctx.KVStore(s.storeKey)
-//	key := AnchorKey(cid)
-//	if store.Has(key) {
-//		return nil
-//	}
-//
-//	return s.anchorCid(ctx, timestamp, cid)
-//}
-//
-//func (s serverImpl) anchorCid(ctx types.Context, timestamp *gogotypes.Timestamp, cidBytes []byte) error {
-//	bz, err := timestamp.Marshal()
-//	if err != nil {
-//		return err
-//	}
-//
-//	store := ctx.KVStore(s.storeKey)
-//	key := AnchorKey(cidBytes)
-//	store.Set(key, bz)
-//
-//	return ctx.EventManager().EmitTypedEvent(&data.EventAnchorData{Cid: cidBytes})
-//}
-
-//var emptyBz = []byte{0}
+func blockTimestamp(ctx types.Context) (*gogotypes.Timestamp, error) {
+	timestamp, err := gogotypes.TimestampProto(ctx.BlockTime())
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid block time")
+	}
+
+	return timestamp, err
+}
+
+func (s serverImpl) anchorIfNeeded(ctx types.Context, timestamp *gogotypes.Timestamp, iri string) error {
+	store := ctx.KVStore(s.storeKey)
+	if store.Has(AnchorKey(iri)) {
+		return nil
+	}
+
+	return s.anchor(ctx, timestamp, iri)
+}
+
+func (s serverImpl) anchor(ctx types.Context, timestamp *gogotypes.Timestamp, iri string) error {
+	bz, err := timestamp.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(s.storeKey)
+	store.Set(AnchorKey(iri), bz)
+
+	return ctx.EventManager().EmitTypedEvent(&data.EventAnchorData{Iri: iri})
+}
 
 func (s serverImpl) SignData(goCtx context.Context, request *data.MsgSignData) (*data.MsgSignDataResponse, error) {
-	return nil, fmt.Errorf("not implemented")
-	//cidBz := request.Cid
-	//
-	//timestamp, err := blockTimestamp(ctx)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//
-	//err = s.anchorCidIfNeeded(ctx, timestamp, cidBz)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//
-	//cidStr := CIDBase64String(cidBz)
-	//store := ctx.KVStore(s.storeKey)
-	//
-	//for _, signer := range request.Signers {
-	//	key := CIDSignerKey(cidStr, signer)
-	//	if store.Has(key) {
-	//		continue
-	//	}
-	//
-	//	store.Set(key, emptyBz)
-	//	// set reverse lookup key
-	//	store.Set(SignerCIDKey(signer, cidBz), emptyBz)
-	//}
-	//
-	//err = ctx.EventManager().EmitTypedEvent(&data.EventSignData{
-	//	Cid:     cidBz,
-	//	Signers: request.Signers,
-	//})
-	//if err != nil {
-	//	return nil, err
-	//}
-	//
-	//return &data.MsgSignDataResponse{}, nil
+	ctx := types.UnwrapSDKContext(goCtx)
+	iri, err := request.Hash.ToIRI()
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp, err := blockTimestamp(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.anchorIfNeeded(ctx, timestamp, iri)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := timestamp.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(s.storeKey)
+	for _, signer := range request.Signers {
+		key := IRISignerKey(iri, signer)
+		if store.Has(key) {
+			continue
+		}
+
+		store.Set(key, bz)
+		// set reverse lookup key
+		store.Set(SignerIRIKey(signer, iri), bz)
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&data.EventSignData{
+		Iri:     iri,
+		Signers: request.Signers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.MsgSignDataResponse{}, nil
 }
 
 func (s serverImpl) StoreRawData(goCtx context.Context, request *data.MsgStoreRawData) (*data.MsgStoreRawDataResponse, error) {
-	return nil, fmt.Errorf("not implemented")
-	//cidBz := request.Cid
-	//
-	//timestamp, err := blockTimestamp(ctx)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//
-	//err = s.anchorCidIfNeeded(ctx, timestamp, cidBz)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//
-	//key := DataKey(cidBz)
-	//store := ctx.KVStore(s.storeKey)
-	//if store.Has(key) {
-	//	return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("CID %s already has stored data", cidBz))
-	//}
-	//
-	//cid, err := gocid.Cast(cidBz)
-	//if err != nil {
-	//	return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("bad CID f%x", cidBz))
-	//}
-	//
-	//mh := cid.Hash()
-	//
-	//decodedMultihash, err := multihash.Decode(mh)
-	//if err != nil {
-	//	return nil, sdkerrors.Wrap(err, "can't retrieve multihash")
-	//}
-	//
-	//switch decodedMultihash.Name {
-	//case "sha2-256":
-	//	// TODO: gas
-	//case "blake2b-256":
-	//	// TODO: gas
-	//default:
-	//	return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("unsupported hash function %s", decodedMultihash.Name))
-	//}
-	//
-	//reqMh, err := multihash.Sum(request.Content, decodedMultihash.Code, -1)
-	//if err != nil {
-	//	return nil, sdkerrors.Wrap(err, "unable to perform multihash")
-	//}
-	//
-	//if !bytes.Equal(mh, reqMh) {
-	//	return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "multihash verification failed, please check that cid and content are correct")
-	//}
-	//
-	//store.Set(key, request.Content)
-	//
-	//err = ctx.EventManager().EmitTypedEvent(&data.EventStoreData{Cid: cidBz})
-	//if err != nil {
-	//	return nil, err
-	//}
-	//
-	//return &data.MsgStoreDataResponse{}, nil
+	ctx := types.UnwrapSDKContext(goCtx)
+	var params data.Params
+	s.paramSpace.GetParamSet(ctx.Context, &params)
+	if uint64(len(request.Content)) > params.MaxDataSize {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("content size %d exceeds the maximum of %d bytes", len(request.Content), params.MaxDataSize)
+	}
+
+	iri, err := request.ContentHash.ToIRI()
+	if err != nil {
+		return nil, err
+	}
+
+	// the content has already been verified against its hash in ValidateBasic
+	alreadyStored, err := s.storeRawData(ctx, params, request.Sender, request.ContentHash, iri, request.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.MsgStoreRawDataResponse{AlreadyStored: alreadyStored}, nil
+}
+
+// storeRawData anchors and stores content which has been verified against its
+// content hash, returning true if it was already stored.
+func (s serverImpl) storeRawData(ctx types.Context, params data.Params, sender string, contentHash *data.ContentHash_Raw, iri string, content []byte) (bool, error) {
+	timestamp, err := blockTimestamp(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	err = s.anchorIfNeeded(ctx, timestamp, iri)
+	if err != nil {
+		return false, err
+	}
+
+	digestGasPerByte, err := params.GetDigestGasPerByte(contentHash.DigestAlgorithm)
+	if err != nil {
+		return false, err
+	}
+
+	contentLength := uint64(len(content))
+	ctx.GasMeter().ConsumeGas(digestGasPerByte*contentLength, "data digest")
+
+	key := DataKey(iri)
+	store := ctx.KVStore(s.storeKey)
+	if existing := store.Get(key); existing != nil {
+		// this shouldn't be possible given that the content was verified
+		// against its hash but we check to be defensive
+		if !bytes.Equal(existing, content) {
+			return false, sdkerrors.ErrInvalidRequest.Wrapf("%s already has different stored data", iri)
+		}
+
+		return true, nil
+	}
+
+	ctx.GasMeter().ConsumeGas(params.StorageGasPerByte*contentLength, "data storage")
+
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return false, err
+	}
+
+	store.Set(key, content)
+	store.Set(StorerKey(iri), senderAddr)
+
+	err = ctx.EventManager().EmitTypedEvent(&data.EventStoreRawData{Iri: iri})
+	if err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+func (s serverImpl) StoreRawDataChunk(goCtx context.Context, request *data.MsgStoreRawDataChunk) (*data.MsgStoreRawDataChunkResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+	var params data.Params
+	s.paramSpace.GetParamSet(ctx.Context, &params)
+
+	iri, err := request.ContentHash.ToIRI()
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(s.storeKey)
+	if store.Has(DataKey(iri)) {
+		return &data.MsgStoreRawDataChunkResponse{AlreadyStored: true}, nil
+	}
+
+	upload, err := s.getChunkedUpload(ctx, iri)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Index == 0 {
+		// the first chunk (re)starts the upload, unless it's in progress by
+		// another sender
+		if upload != nil {
+			expired, err := chunkedUploadExpired(ctx, upload)
+			if err != nil {
+				return nil, err
+			}
+			if upload.Sender != request.Sender && !expired {
+				return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is already being uploaded by %s", iri, upload.Sender)
+			}
+
+			err = s.deleteChunkedUpload(ctx, iri, upload)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		expiresAt, err := gogotypes.TimestampProto(ctx.BlockTime().Add(data.ChunkedUploadTimeout))
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid block time")
+		}
+		upload = &data.ChunkedUpload{
+			Sender:    request.Sender,
+			Total:     request.Total,
+			ExpiresAt: expiresAt,
+		}
+	} else {
+		if upload == nil {
+			return nil, sdkerrors.ErrNotFound.Wrapf("%s has no upload in progress", iri)
+		}
+
+		expired, err := chunkedUploadExpired(ctx, upload)
+		if err != nil {
+			return nil, err
+		}
+		if expired {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("upload of %s has expired", iri)
+		}
+
+		if upload.Sender != request.Sender {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is being uploaded by %s", iri, upload.Sender)
+		}
+
+		if request.Total != upload.Total {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("expected a total of %d chunks, got %d", upload.Total, request.Total)
+		}
+
+		if request.Index != upload.Received {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("expected chunk %d, got %d", upload.Received, request.Index)
+		}
+	}
+
+	size := upload.ReceivedBytes + uint64(len(request.Chunk))
+	if size > params.MaxDataSize {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("content size %d exceeds the maximum of %d bytes", size, params.MaxDataSize)
+	}
+
+	// staged chunks are charged like stored content as they are written to
+	// state too
+	ctx.GasMeter().ConsumeGas(params.StorageGasPerByte*uint64(len(request.Chunk)), "data chunk storage")
+
+	store.Set(ChunkKey(iri, request.Index), request.Chunk)
+	upload.Received++
+	upload.ReceivedBytes = size
+
+	if upload.Received < upload.Total {
+		err = s.setChunkedUpload(ctx, iri, upload)
+		if err != nil {
+			return nil, err
+		}
+
+		return &data.MsgStoreRawDataChunkResponse{}, nil
+	}
+
+	content := make([]byte, 0, upload.ReceivedBytes)
+	for i := uint32(0); i < upload.Total; i++ {
+		content = append(content, store.Get(ChunkKey(iri, i))...)
+	}
+
+	// if the content doesn't match its hash, the transaction fails leaving
+	// the staged chunks as they were, so the last chunk can be resent or the
+	// upload restarted until it expires
+	err = request.ContentHash.Verify(content)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.deleteChunkedUpload(ctx, iri, upload)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.storeRawData(ctx, params, request.Sender, request.ContentHash, iri, content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.MsgStoreRawDataChunkResponse{Complete: true}, nil
+}
+
+func (s serverImpl) DeleteStoredContent(goCtx context.Context, request *data.MsgDeleteStoredContent) (*data.MsgDeleteStoredContentResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+	iri, err := request.ContentHash.ToIRI()
+	if err != nil {
+		return nil, err
+	}
+
+	key := DataKey(iri)
+	store := ctx.KVStore(s.storeKey)
+	if !store.Has(key) {
+		return nil, sdkerrors.ErrNotFound.Wrapf("%s has no stored data", iri)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(request.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	authority, err := s.getDeletionAuthority(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	storer := sdk.AccAddress(store.Get(StorerKey(iri)))
+	if !sender.Equals(storer) {
+		if authority == nil {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not the storer of %s and no deletion authority is set", request.Sender, iri)
+		}
+		if !sender.Equals(authority) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is neither the storer of %s nor the deletion authority", request.Sender, iri)
+		}
+	}
+
+	// the anchor and signers are preserved, only the content is deleted
+	store.Delete(key)
+	store.Delete(StorerKey(iri))
+
+	err = ctx.EventManager().EmitTypedEvent(&data.EventDeleteStoredContent{
+		Iri:    iri,
+		Sender: request.Sender,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.MsgDeleteStoredContentResponse{}, nil
+}
+
+// getDeletionAuthority returns the address of the account which can delete
+// any stored content, or nil if the DeletionAuthority param is not set. In
+// that case only storers can delete their content, as the governance module
+// account can't sign messages itself.
+func (s serverImpl) getDeletionAuthority(ctx types.Context) (sdk.AccAddress, error) {
+	var params data.Params
+	s.paramSpace.GetParamSet(ctx.Context, &params)
+	if params.DeletionAuthority == "" {
+		return nil, nil
+	}
+
+	return sdk.AccAddressFromBech32(params.DeletionAuthority)
 }
@@ -0,0 +1,21 @@
+package server
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/regen-network/regen-ledger/x/data"
+	"github.com/regen-network/regen-ledger/x/data/simulation"
+)
+
+// WeightedOperations returns all the data module operations with their respective weights.
+func (s serverImpl) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	queryClient := data.NewQueryClient(s.key)
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.Cdc,
+		s.accountKeeper, s.bankKeeper, queryClient, codec.NewProtoCodec(interfaceRegistry),
+	)
+}
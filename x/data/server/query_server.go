@@ -4,44 +4,50 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/data"
 )
 
 var _ data.QueryServer = serverImpl{}
 
 func (s serverImpl) ByHash(goCtx context.Context, request *data.QueryByHashRequest) (*data.QueryByHashResponse, error) {
-	return nil, fmt.Errorf("not implemented")
-	//cid := request.Cid
-	//
-	//var timestamp gogotypes.Timestamp
-	//store := ctx.KVStore(s.storeKey)
-	//bz := store.Get(AnchorKey(cid))
-	//if len(bz) == 0 {
-	//	return nil, status.Error(codes.NotFound, "CID not found")
-	//}
-	//err := timestamp.Unmarshal(bz)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//
-	//var signers []string
-	//cidSignerPrefixKey := CIDSignerIndexPrefix(CIDBase64String(cid))
-	//prefixStore := prefix.NewStore(store, cidSignerPrefixKey)
-	//iterator := prefixStore.Iterator(nil, nil)
-	//
-	//for iterator.Valid() {
-	//	signer := string(iterator.Key())
-	//	signers = append(signers, signer)
-	//	iterator.Next()
-	//}
-	//
-	//content := store.Get(DataKey(cid))
-	//
-	//return &data.QueryByCidResponse{
-	//	Timestamp: &timestamp,
-	//	Signers:   signers,
-	//	Content:   content,
-	//}, err
+	if request == nil || request.Hash == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	iri, err := request.Hash.ToIRI()
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := types.UnwrapSDKContext(goCtx)
+	store := ctx.KVStore(s.storeKey)
+	bz := store.Get(AnchorKey(iri))
+	if bz == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("%s is not anchored", iri))
+	}
+
+	entry, err := exportContentEntry(store, iri, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.QueryByHashResponse{
+		Entry: &data.ContentEntry{
+			Hash:      entry.Hash,
+			Iri:       iri,
+			Timestamp: entry.Timestamp,
+			Signers:   entry.Signers,
+			Content:   entry.Content,
+		},
+	}, nil
 }
 
 func (s serverImpl) BySigner(goCtx context.Context, request *data.QueryBySignerRequest) (*data.QueryBySignerResponse, error) {
@@ -62,3 +68,155 @@ func (s serverImpl) BySigner(goCtx context.Context, request *data.QueryBySignerR
 	//	Pagination: pageRes,
 	//}, nil
 }
+
+func (s serverImpl) Data(goCtx context.Context, request *data.QueryDataRequest) (*data.QueryDataResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := data.ParseIRI(request.Iri)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := types.UnwrapSDKContext(goCtx)
+	store := ctx.KVStore(s.storeKey)
+	bz := store.Get(AnchorKey(request.Iri))
+	if bz == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("%s is not anchored", request.Iri))
+	}
+
+	var timestamp gogotypes.Timestamp
+	err = timestamp.Unmarshal(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	content := store.Get(DataKey(request.Iri))
+	if content == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("%s has no stored data", request.Iri))
+	}
+
+	return &data.QueryDataResponse{
+		Content:         content,
+		Timestamp:       &timestamp,
+		DigestAlgorithm: hash.GetRaw().DigestAlgorithm,
+	}, nil
+}
+
+// Anchor returns the timestamp at which data was anchored. As gRPC queries
+// can't return Merkle proofs, the ABCI store query path and key of the anchor
+// are returned for clients which need to prove the timestamp.
+func (s serverImpl) Anchor(goCtx context.Context, request *data.QueryAnchorRequest) (*data.QueryAnchorResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	_, err := data.ParseIRI(request.Iri)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := types.UnwrapSDKContext(goCtx)
+	key := AnchorKey(request.Iri)
+	bz := ctx.KVStore(s.storeKey).Get(key)
+	if bz == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("%s is not anchored", request.Iri))
+	}
+
+	var timestamp gogotypes.Timestamp
+	err = timestamp.Unmarshal(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.QueryAnchorResponse{
+		Timestamp: &timestamp,
+		ProofPath: fmt.Sprintf("/store/%s/key", s.storeKey.Name()),
+		ProofKey:  key,
+	}, nil
+}
+
+func (s serverImpl) Signers(goCtx context.Context, request *data.QuerySignersRequest) (*data.QuerySignersResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	_, err := data.ParseIRI(request.Iri)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := types.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(s.storeKey), IRISignerIndexPrefix(request.Iri))
+
+	var signers []*data.SignerEntry
+	pageRes, err := query.Paginate(store, request.Pagination, func(key []byte, value []byte) error {
+		var timestamp gogotypes.Timestamp
+		err := timestamp.Unmarshal(value)
+		if err != nil {
+			return err
+		}
+
+		signers = append(signers, &data.SignerEntry{
+			Signer:    string(key),
+			Timestamp: &timestamp,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.QuerySignersResponse{
+		Signers:    signers,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (s serverImpl) AnchoredData(goCtx context.Context, request *data.QueryAnchoredDataRequest) (*data.QueryAnchoredDataResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := types.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(s.storeKey), []byte{AnchorTablePrefix})
+
+	var entries []*data.AnchoredDataEntry
+	pageRes, err := query.Paginate(store, request.Pagination, func(key []byte, value []byte) error {
+		entry, err := anchoredDataEntry(string(key), value)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.QueryAnchoredDataResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+func anchoredDataEntry(iri string, timestampBz []byte) (*data.AnchoredDataEntry, error) {
+	hash, err := data.ParseIRI(iri)
+	if err != nil {
+		return nil, err
+	}
+
+	var timestamp gogotypes.Timestamp
+	err = timestamp.Unmarshal(timestampBz)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.AnchoredDataEntry{
+		Hash:      hash,
+		Iri:       iri,
+		Timestamp: &timestamp,
+	}, nil
+}
@@ -2,20 +2,38 @@ package server
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	servermodule "github.com/regen-network/regen-ledger/types/module/server"
 	"github.com/regen-network/regen-ledger/x/data"
 )
 
 type serverImpl struct {
-	storeKey sdk.StoreKey
+	key        servermodule.RootModuleKey
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
+
+	accountKeeper data.AccountKeeper
+	bankKeeper    data.BankKeeper
 }
 
-func newServer(storeKey sdk.StoreKey) serverImpl {
-	return serverImpl{storeKey: storeKey}
+func newServer(key servermodule.RootModuleKey, paramSpace paramtypes.Subspace, accountKeeper data.AccountKeeper, bankKeeper data.BankKeeper) serverImpl {
+	return serverImpl{
+		key:           key,
+		storeKey:      key,
+		paramSpace:    paramSpace,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+	}
 }
 
-func RegisterServices(configurator servermodule.Configurator) {
-	impl := newServer(configurator.ModuleKey())
+func RegisterServices(configurator servermodule.Configurator, paramSpace paramtypes.Subspace, accountKeeper data.AccountKeeper, bankKeeper data.BankKeeper) {
+	impl := newServer(configurator.ModuleKey(), paramSpace, accountKeeper, bankKeeper)
 	data.RegisterMsgServer(configurator.MsgServer(), impl)
 	data.RegisterQueryServer(configurator.QueryServer(), impl)
+	configurator.RegisterGenesisHandlers(impl.InitGenesis, impl.ExportGenesis)
+	configurator.RegisterWeightedOperationsHandler(impl.WeightedOperations)
+	configurator.RegisterEndBlockHandler(impl.EndBlock)
+	if err := configurator.RegisterMigrationHandler(1, impl.migrateV1ToV2); err != nil {
+		panic(err)
+	}
 }
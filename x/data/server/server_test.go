@@ -3,17 +3,33 @@ package server_test
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/regen-network/regen-ledger/types/module"
 	"github.com/regen-network/regen-ledger/types/module/server"
+	datatypes "github.com/regen-network/regen-ledger/x/data"
 	datamodule "github.com/regen-network/regen-ledger/x/data/module"
 	"github.com/regen-network/regen-ledger/x/data/server/testsuite"
 )
 
 func TestServer(t *testing.T) {
-	ff := server.NewFixtureFactory(t, 2)
-	ff.SetModules([]module.Module{datamodule.Module{}})
-	s := testsuite.NewIntegrationTestSuite(ff)
+	ff := server.NewFixtureFactory(t, 3)
+	baseApp := ff.BaseApp()
+	cdc := ff.Codec()
+	amino := codec.NewLegacyAmino()
+
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tkey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	baseApp.MountStore(paramsKey, sdk.StoreTypeIAVL)
+	baseApp.MountStore(tkey, sdk.StoreTypeTransient)
+
+	dataSubspace := paramstypes.NewSubspace(cdc, amino, paramsKey, tkey, datatypes.ModuleName)
+
+	ff.SetModules([]module.Module{datamodule.NewModule(dataSubspace, nil, nil)})
+	s := testsuite.NewIntegrationTestSuite(ff, dataSubspace)
 	suite.Run(t, s)
 }
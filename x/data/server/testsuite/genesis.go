@@ -0,0 +1,97 @@
+package testsuite
+
+import (
+	"encoding/json"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/data"
+)
+
+func (s *IntegrationTestSuite) TestInitExportGenesis() {
+	require := s.Require()
+
+	sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(time.Now().UTC()).CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	content := []byte("xyzabc123")
+	digest := blake2b.Sum256(content)
+	rawHash := &data.ContentHash_Raw{
+		Hash:            digest[:],
+		DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		MediaType:       data.MediaType_MEDIA_TYPE_TEXT_PLAIN,
+	}
+	rawIRI, err := rawHash.ToIRI()
+	require.NoError(err)
+	graphHash := &data.ContentHash_Graph{
+		Hash:                      make([]byte, 32),
+		DigestAlgorithm:           data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		CanonicalizationAlgorithm: data.GraphCanonicalizationAlgorithm_GRAPH_CANONICALIZATION_ALGORITHM_URDNA2015,
+	}
+	graphIRI, err := graphHash.ToIRI()
+	require.NoError(err)
+
+	_, err = s.msgClient.StoreRawData(ctx, &data.MsgStoreRawData{
+		Sender:      s.addr1.String(),
+		ContentHash: rawHash,
+		Content:     content,
+	})
+	require.NoError(err)
+	_, err = s.msgClient.SignData(ctx, &data.MsgSignData{
+		Signers: []string{s.addr1.String(), s.addr2.String()},
+		Hash:    graphHash,
+	})
+	require.NoError(err)
+
+	exported := s.exportGenesisState(ctx)
+	require.Len(exported.Entries, 2)
+	require.Equal(data.DefaultParams(), exported.Params)
+	require.NoError(exported.Validate())
+
+	// import into a fresh context and check the state round-trips
+	sdkCtx, _ = s.fixture.Context().(types.Context).CacheContext()
+	ctx = types.Context{Context: sdkCtx}
+	require.NoError(s.importGenesisState(ctx, exported))
+
+	dataRes, err := s.queryClient.Data(ctx, &data.QueryDataRequest{Iri: rawIRI})
+	require.NoError(err)
+	require.Equal(content, dataRes.Content)
+
+	signersRes, err := s.queryClient.Signers(ctx, &data.QuerySignersRequest{Iri: graphIRI})
+	require.NoError(err)
+	require.Len(signersRes.Signers, 2)
+
+	anchoredRes, err := s.queryClient.AnchoredData(ctx, &data.QueryAnchoredDataRequest{})
+	require.NoError(err)
+	require.Len(anchoredRes.Entries, 2)
+
+	reexported := s.exportGenesisState(ctx)
+	require.Equal(exported, reexported)
+}
+
+func (s *IntegrationTestSuite) exportGenesisState(ctx types.Context) data.GenesisState {
+	require := s.Require()
+	cdc := s.fixture.Codec()
+	exported, err := s.fixture.ExportGenesis(ctx.Context)
+	require.NoError(err)
+
+	var exportedGenesisState data.GenesisState
+	err = cdc.UnmarshalJSON(exported[data.ModuleName], &exportedGenesisState)
+	require.NoError(err)
+
+	return exportedGenesisState
+}
+
+func (s *IntegrationTestSuite) importGenesisState(ctx types.Context, genesisState data.GenesisState) error {
+	cdc := s.fixture.Codec()
+	genesisBytes, err := cdc.MarshalJSON(&genesisState)
+	if err != nil {
+		return err
+	}
+
+	genesisData := map[string]json.RawMessage{data.ModuleName: genesisBytes}
+	_, err = s.fixture.InitGenesis(ctx.Context, genesisData)
+	return err
+}
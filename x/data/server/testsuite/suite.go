@@ -1,11 +1,19 @@
 package testsuite
 
 import (
+	"bytes"
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/blake2b"
 
+	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/types/testutil"
 	"github.com/regen-network/regen-ledger/x/data"
 )
@@ -16,6 +24,8 @@ type IntegrationTestSuite struct {
 	fixtureFactory testutil.FixtureFactory
 	fixture        testutil.Fixture
 
+	paramSpace paramstypes.Subspace
+
 	ctx         context.Context
 	msgClient   data.MsgClient
 	queryClient data.QueryClient
@@ -23,16 +33,22 @@ type IntegrationTestSuite struct {
 	addr2       sdk.AccAddress
 }
 
-func NewIntegrationTestSuite(fixtureFactory testutil.FixtureFactory) *IntegrationTestSuite {
-	return &IntegrationTestSuite{fixtureFactory: fixtureFactory}
+func NewIntegrationTestSuite(fixtureFactory testutil.FixtureFactory, paramSpace paramstypes.Subspace) *IntegrationTestSuite {
+	return &IntegrationTestSuite{
+		fixtureFactory: fixtureFactory,
+		paramSpace:     paramSpace,
+	}
 }
 
 func (s *IntegrationTestSuite) SetupSuite() {
 	s.fixture = s.fixtureFactory.Setup()
 	s.ctx = s.fixture.Context()
+
+	params := data.DefaultParams()
+	s.paramSpace.SetParamSet(s.ctx.(types.Context).Context, &params)
 	s.msgClient = data.NewMsgClient(s.fixture.TxConn())
 	s.queryClient = data.NewQueryClient(s.fixture.QueryConn())
-	s.Require().GreaterOrEqual(len(s.fixture.Signers()), 2)
+	s.Require().GreaterOrEqual(len(s.fixture.Signers()), 3)
 	s.addr1 = s.fixture.Signers()[0]
 	s.addr2 = s.fixture.Signers()[1]
 }
@@ -41,6 +57,877 @@ func (s *IntegrationTestSuite) TearDownSuite() {
 	s.fixture.Teardown()
 }
 
+func (s *IntegrationTestSuite) TestAnchorData() {
+	require := s.Require()
+
+	sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(time.Now().UTC()).CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	hash := &data.ContentHash{Sum: &data.ContentHash_Raw_{Raw: &data.ContentHash_Raw{
+		Hash:            make([]byte, 32),
+		DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		MediaType:       data.MediaType_MEDIA_TYPE_UNSPECIFIED,
+	}}}
+
+	res, err := s.msgClient.AnchorData(ctx, &data.MsgAnchorData{
+		Sender: s.addr1.String(),
+		Hash:   hash,
+	})
+	require.NoError(err)
+	require.NotNil(res.Timestamp)
+
+	// the same content can't be anchored twice, even by another sender
+	_, err = s.msgClient.AnchorData(ctx, &data.MsgAnchorData{
+		Sender: s.addr2.String(),
+		Hash:   hash,
+	})
+	require.Error(err)
+	require.Contains(err.Error(), "already anchored")
+}
+
+func (s *IntegrationTestSuite) TestAnchorDataBatch() {
+	require := s.Require()
+
+	sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(time.Now().UTC()).CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	rawHash := func(b byte) *data.ContentHash {
+		hash := make([]byte, 32)
+		hash[0] = b
+		return &data.ContentHash{Sum: &data.ContentHash_Raw_{Raw: &data.ContentHash_Raw{
+			Hash:            hash,
+			DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+			MediaType:       data.MediaType_MEDIA_TYPE_UNSPECIFIED,
+		}}}
+	}
+	hash1, hash2, hash3 := rawHash(1), rawHash(2), rawHash(3)
+	iri2, err := hash2.ToIRI()
+	require.NoError(err)
+	iri3, err := hash3.ToIRI()
+	require.NoError(err)
+
+	anchorEvents := func() []string {
+		var iris []string
+		for _, event := range sdkCtx.EventManager().ABCIEvents() {
+			if event.Type != proto.MessageName(&data.EventAnchorData{}) {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(event)
+			require.NoError(err)
+			iris = append(iris, msg.(*data.EventAnchorData).Iri)
+		}
+		return iris
+	}
+
+	_, err = s.msgClient.AnchorData(ctx, &data.MsgAnchorData{
+		Sender: s.addr1.String(),
+		Hash:   hash1,
+	})
+	require.NoError(err)
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	ctx = types.Context{Context: sdkCtx}
+
+	// a batch partially overlapping with already anchored data succeeds
+	// and only emits events for the newly anchored data
+	res, err := s.msgClient.AnchorDataBatch(ctx, &data.MsgAnchorDataBatch{
+		Sender: s.addr1.String(),
+		Hashes: []*data.ContentHash{hash1, hash2, hash3},
+	})
+	require.NoError(err)
+	require.NotNil(res.Timestamp)
+	require.Equal([]string{iri2, iri3}, anchorEvents())
+
+	// all data in the batch is now anchored
+	for _, hash := range []*data.ContentHash{hash1, hash2, hash3} {
+		_, err = s.msgClient.AnchorData(ctx, &data.MsgAnchorData{
+			Sender: s.addr1.String(),
+			Hash:   hash,
+		})
+		require.Error(err)
+		require.Contains(err.Error(), "already anchored")
+	}
+
+	// a batch with only anchored data is a no-op
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	ctx = types.Context{Context: sdkCtx}
+	_, err = s.msgClient.AnchorDataBatch(ctx, &data.MsgAnchorDataBatch{
+		Sender: s.addr2.String(),
+		Hashes: []*data.ContentHash{hash2, hash3},
+	})
+	require.NoError(err)
+	require.Empty(anchorEvents())
+}
+
+func (s *IntegrationTestSuite) TestStoreRawData() {
+	require := s.Require()
+
+	sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(time.Now().UTC()).CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	content := []byte("xyzabc123")
+	digest := blake2b.Sum256(content)
+	rawHash := &data.ContentHash_Raw{
+		Hash:            digest[:],
+		DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		MediaType:       data.MediaType_MEDIA_TYPE_TEXT_PLAIN,
+	}
+
+	_, err := s.msgClient.StoreRawData(ctx, &data.MsgStoreRawData{
+		Sender:      s.addr1.String(),
+		ContentHash: rawHash,
+		Content:     content,
+	})
+	require.NoError(err)
+
+	// storing content anchors it as well
+	_, err = s.msgClient.AnchorData(ctx, &data.MsgAnchorData{
+		Sender: s.addr2.String(),
+		Hash:   &data.ContentHash{Sum: &data.ContentHash_Raw_{Raw: rawHash}},
+	})
+	require.Error(err)
+	require.Contains(err.Error(), "already anchored")
+}
+
+func (s *IntegrationTestSuite) TestQueryData() {
+	require := s.Require()
+
+	sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(time.Now().UTC()).CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	content := []byte("xyzabc123")
+	digest := blake2b.Sum256(content)
+	rawHash := &data.ContentHash_Raw{
+		Hash:            digest[:],
+		DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		MediaType:       data.MediaType_MEDIA_TYPE_TEXT_PLAIN,
+	}
+	iri, err := rawHash.ToIRI()
+	require.NoError(err)
+
+	// missing
+	_, err = s.queryClient.Data(ctx, &data.QueryDataRequest{Iri: iri})
+	require.Error(err)
+	require.Contains(err.Error(), "is not anchored")
+
+	// invalid IRI
+	_, err = s.queryClient.Data(ctx, &data.QueryDataRequest{Iri: "foo"})
+	require.Error(err)
+
+	// anchored but not stored
+	anchorRes, err := s.msgClient.AnchorData(ctx, &data.MsgAnchorData{
+		Sender: s.addr1.String(),
+		Hash:   &data.ContentHash{Sum: &data.ContentHash_Raw_{Raw: rawHash}},
+	})
+	require.NoError(err)
+	_, err = s.queryClient.Data(ctx, &data.QueryDataRequest{Iri: iri})
+	require.Error(err)
+	require.Contains(err.Error(), "has no stored data")
+
+	// stored
+	_, err = s.msgClient.StoreRawData(ctx, &data.MsgStoreRawData{
+		Sender:      s.addr1.String(),
+		ContentHash: rawHash,
+		Content:     content,
+	})
+	require.NoError(err)
+	res, err := s.queryClient.Data(ctx, &data.QueryDataRequest{Iri: iri})
+	require.NoError(err)
+	require.Equal(content, res.Content)
+	require.Equal(anchorRes.Timestamp, res.Timestamp)
+	require.Equal(data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256, res.DigestAlgorithm)
+}
+
+func (s *IntegrationTestSuite) TestQueryAnchor() {
+	require := s.Require()
+
+	sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(time.Now().UTC()).CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	digest := blake2b.Sum256([]byte("anchored content"))
+	hash := &data.ContentHash{Sum: &data.ContentHash_Raw_{Raw: &data.ContentHash_Raw{
+		Hash:            digest[:],
+		DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+	}}}
+	iri, err := hash.ToIRI()
+	require.NoError(err)
+
+	// not anchored
+	_, err = s.queryClient.Anchor(ctx, &data.QueryAnchorRequest{Iri: iri})
+	require.Error(err)
+	require.Contains(err.Error(), "is not anchored")
+
+	// invalid IRI
+	_, err = s.queryClient.Anchor(ctx, &data.QueryAnchorRequest{Iri: "foo"})
+	require.Error(err)
+
+	// anchored
+	anchorRes, err := s.msgClient.AnchorData(ctx, &data.MsgAnchorData{
+		Sender: s.addr1.String(),
+		Hash:   hash,
+	})
+	require.NoError(err)
+	res, err := s.queryClient.Anchor(ctx, &data.QueryAnchorRequest{Iri: iri})
+	require.NoError(err)
+	require.Equal(anchorRes.Timestamp, res.Timestamp)
+	require.Equal("/store/data/key", res.ProofPath)
+	require.NotEmpty(res.ProofKey)
+}
+
+func (s *IntegrationTestSuite) TestQueryByHash() {
+	require := s.Require()
+
+	sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(time.Now().UTC()).CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	content := []byte("by hash content")
+	digest := blake2b.Sum256(content)
+	rawHash := &data.ContentHash_Raw{
+		Hash:            digest[:],
+		DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		MediaType:       data.MediaType_MEDIA_TYPE_TEXT_PLAIN,
+	}
+	hash := &data.ContentHash{Sum: &data.ContentHash_Raw_{Raw: rawHash}}
+	iri, err := rawHash.ToIRI()
+	require.NoError(err)
+
+	// missing
+	_, err = s.queryClient.ByHash(ctx, &data.QueryByHashRequest{Hash: hash})
+	require.Error(err)
+	require.Contains(err.Error(), "is not anchored")
+
+	// empty request
+	_, err = s.queryClient.ByHash(ctx, &data.QueryByHashRequest{})
+	require.Error(err)
+
+	// anchored
+	anchorRes, err := s.msgClient.AnchorData(ctx, &data.MsgAnchorData{
+		Sender: s.addr1.String(),
+		Hash:   hash,
+	})
+	require.NoError(err)
+	res, err := s.queryClient.ByHash(ctx, &data.QueryByHashRequest{Hash: hash})
+	require.NoError(err)
+	require.Equal(iri, res.Entry.Iri)
+	require.Equal(hash, res.Entry.Hash)
+	require.Equal(anchorRes.Timestamp, res.Entry.Timestamp)
+	require.Nil(res.Entry.Content)
+
+	// stored
+	_, err = s.msgClient.StoreRawData(ctx, &data.MsgStoreRawData{
+		Sender:      s.addr1.String(),
+		ContentHash: rawHash,
+		Content:     content,
+	})
+	require.NoError(err)
+	res, err = s.queryClient.ByHash(ctx, &data.QueryByHashRequest{Hash: hash})
+	require.NoError(err)
+	require.Equal(content, res.Entry.Content.GetRawData())
+}
+
+func (s *IntegrationTestSuite) TestQuerySigners() {
+	require := s.Require()
+
+	sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(time.Now().UTC()).CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	graphHash := &data.ContentHash_Graph{
+		Hash:                      make([]byte, 32),
+		DigestAlgorithm:           data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		CanonicalizationAlgorithm: data.GraphCanonicalizationAlgorithm_GRAPH_CANONICALIZATION_ALGORITHM_URDNA2015,
+	}
+	iri, err := graphHash.ToIRI()
+	require.NoError(err)
+
+	res, err := s.queryClient.Signers(ctx, &data.QuerySignersRequest{Iri: iri})
+	require.NoError(err)
+	require.Empty(res.Signers)
+
+	_, err = s.msgClient.SignData(ctx, &data.MsgSignData{
+		Signers: []string{s.addr1.String()},
+		Hash:    graphHash,
+	})
+	require.NoError(err)
+
+	// signing again with an overlapping set of signers doesn't duplicate signers
+	_, err = s.msgClient.SignData(ctx, &data.MsgSignData{
+		Signers: []string{s.addr1.String(), s.addr2.String()},
+		Hash:    graphHash,
+	})
+	require.NoError(err)
+
+	res, err = s.queryClient.Signers(ctx, &data.QuerySignersRequest{Iri: iri})
+	require.NoError(err)
+	require.Len(res.Signers, 2)
+	var signers []string
+	for _, entry := range res.Signers {
+		signers = append(signers, entry.Signer)
+		require.NotNil(entry.Timestamp)
+	}
+	require.ElementsMatch([]string{s.addr1.String(), s.addr2.String()}, signers)
+
+	// pagination
+	res, err = s.queryClient.Signers(ctx, &data.QuerySignersRequest{
+		Iri:        iri,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(res.Signers, 1)
+	require.Equal(uint64(2), res.Pagination.Total)
+	first := res.Signers[0].Signer
+
+	res, err = s.queryClient.Signers(ctx, &data.QuerySignersRequest{
+		Iri:        iri,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Len(res.Signers, 1)
+	require.NotEqual(first, res.Signers[0].Signer)
+	require.Nil(res.Pagination.NextKey)
+
+	// invalid IRI
+	_, err = s.queryClient.Signers(ctx, &data.QuerySignersRequest{Iri: "foo"})
+	require.Error(err)
+}
+
+func (s *IntegrationTestSuite) TestSignDataTimestamps() {
+	require := s.Require()
+
+	firstTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	secondTime := firstTime.Add(time.Hour)
+	sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(firstTime).CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	graphHash := &data.ContentHash_Graph{
+		Hash:                      make([]byte, 32),
+		DigestAlgorithm:           data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		CanonicalizationAlgorithm: data.GraphCanonicalizationAlgorithm_GRAPH_CANONICALIZATION_ALGORITHM_URDNA2015,
+		MerkleTree:                data.GraphMerkleTree_GRAPH_MERKLE_TREE_NONE_UNSPECIFIED,
+	}
+	graphHash.Hash[0] = 1
+	iri, err := graphHash.ToIRI()
+	require.NoError(err)
+
+	_, err = s.msgClient.SignData(ctx, &data.MsgSignData{
+		Signers: []string{s.addr1.String()},
+		Hash:    graphHash,
+	})
+	require.NoError(err)
+
+	// re-signing keeps the original timestamp while new signers get the
+	// current block time
+	ctx = types.Context{Context: sdkCtx.WithBlockTime(secondTime)}
+	_, err = s.msgClient.SignData(ctx, &data.MsgSignData{
+		Signers: []string{s.addr1.String(), s.addr2.String()},
+		Hash:    graphHash,
+	})
+	require.NoError(err)
+
+	timestamps := func(entries []*data.SignerEntry) map[string]time.Time {
+		res := make(map[string]time.Time, len(entries))
+		for _, entry := range entries {
+			t, err := gogotypes.TimestampFromProto(entry.Timestamp)
+			require.NoError(err)
+			res[entry.Signer] = t
+		}
+		return res
+	}
+	expected := map[string]time.Time{
+		s.addr1.String(): firstTime,
+		s.addr2.String(): secondTime,
+	}
+
+	signersRes, err := s.queryClient.Signers(ctx, &data.QuerySignersRequest{Iri: iri})
+	require.NoError(err)
+	require.Equal(expected, timestamps(signersRes.Signers))
+
+	byHashRes, err := s.queryClient.ByHash(ctx, &data.QueryByHashRequest{
+		Hash: &data.ContentHash{Sum: &data.ContentHash_Graph_{Graph: graphHash}},
+	})
+	require.NoError(err)
+	require.Equal(expected, timestamps(byHashRes.Entry.Signers))
+
+	// the anchor timestamp is the time of the first signature
+	anchorTime, err := gogotypes.TimestampFromProto(byHashRes.Entry.Timestamp)
+	require.NoError(err)
+	require.Equal(firstTime, anchorTime)
+}
+
+func (s *IntegrationTestSuite) TestStoreRawDataDigestAlgorithms() {
+	require := s.Require()
+
+	sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(time.Now().UTC()).CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	content := []byte("xyzabc123")
+	for _, digestAlgorithm := range []data.DigestAlgorithm{
+		data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_512,
+		data.DigestAlgorithm_DIGEST_ALGORITHM_SHA3_256,
+	} {
+		digest, err := digestAlgorithm.Digest(content)
+		require.NoError(err)
+		rawHash := &data.ContentHash_Raw{
+			Hash:            digest,
+			DigestAlgorithm: digestAlgorithm,
+			MediaType:       data.MediaType_MEDIA_TYPE_TEXT_PLAIN,
+		}
+		iri, err := rawHash.ToIRI()
+		require.NoError(err)
+
+		_, err = s.msgClient.StoreRawData(ctx, &data.MsgStoreRawData{
+			Sender:      s.addr1.String(),
+			ContentHash: rawHash,
+			Content:     content,
+		})
+		require.NoError(err)
+
+		res, err := s.queryClient.Data(ctx, &data.QueryDataRequest{Iri: iri})
+		require.NoError(err)
+		require.Equal(content, res.Content)
+		require.Equal(digestAlgorithm, res.DigestAlgorithm)
+	}
+
+	// an unsupported digest algorithm is rejected
+	_, err := s.msgClient.StoreRawData(ctx, &data.MsgStoreRawData{
+		Sender: s.addr1.String(),
+		ContentHash: &data.ContentHash_Raw{
+			Hash:            make([]byte, 32),
+			DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_UNSPECIFIED,
+		},
+		Content: content,
+	})
+	require.Error(err)
+}
+
+func (s *IntegrationTestSuite) TestStoreRawDataGas() {
+	require := s.Require()
+
+	storeGas := func(digestAlgorithm data.DigestAlgorithm, content []byte) uint64 {
+		sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(time.Now().UTC()).CacheContext()
+		sdkCtx = sdkCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+		digest, err := digestAlgorithm.Digest(content)
+		require.NoError(err)
+		_, err = s.msgClient.StoreRawData(types.Context{Context: sdkCtx}, &data.MsgStoreRawData{
+			Sender: s.addr1.String(),
+			ContentHash: &data.ContentHash_Raw{
+				Hash:            digest,
+				DigestAlgorithm: digestAlgorithm,
+			},
+			Content: content,
+		})
+		require.NoError(err)
+
+		return sdkCtx.GasMeter().GasConsumed()
+	}
+
+	small := []byte("abcdefghij")
+	large := []byte("abcdefghijklmnopqrst")
+	blake2b256 := data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256
+	sha3256 := data.DigestAlgorithm_DIGEST_ALGORITHM_SHA3_256
+
+	// gas grows with the size of the content
+	require.Greater(storeGas(blake2b256, large), storeGas(blake2b256, small))
+
+	// gas differs by digest algorithm according to the params
+	var params data.Params
+	s.paramSpace.GetParamSet(s.fixture.Context().(types.Context).Context, &params)
+	blake2b256Gas, err := params.GetDigestGasPerByte(blake2b256)
+	require.NoError(err)
+	sha3256Gas, err := params.GetDigestGasPerByte(sha3256)
+	require.NoError(err)
+	require.Equal(
+		(sha3256Gas-blake2b256Gas)*uint64(len(small)),
+		storeGas(sha3256, small)-storeGas(blake2b256, small),
+	)
+}
+
+func (s *IntegrationTestSuite) TestStoreRawDataMaxDataSize() {
+	require := s.Require()
+
+	sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(time.Now().UTC()).CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	params := data.DefaultParams()
+	params.MaxDataSize = 10
+	s.paramSpace.SetParamSet(sdkCtx, &params)
+
+	rawHash := func(content []byte) *data.ContentHash_Raw {
+		digest := blake2b.Sum256(content)
+		return &data.ContentHash_Raw{
+			Hash:            digest[:],
+			DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		}
+	}
+
+	// content at the maximum size can be stored
+	content := []byte("abcdefghij")
+	_, err := s.msgClient.StoreRawData(ctx, &data.MsgStoreRawData{
+		Sender:      s.addr1.String(),
+		ContentHash: rawHash(content),
+		Content:     content,
+	})
+	require.NoError(err)
+
+	// content above the maximum size is rejected
+	content = []byte("abcdefghijk")
+	_, err = s.msgClient.StoreRawData(ctx, &data.MsgStoreRawData{
+		Sender:      s.addr1.String(),
+		ContentHash: rawHash(content),
+		Content:     content,
+	})
+	require.Error(err)
+	require.Contains(err.Error(), "content size 11 exceeds the maximum of 10 bytes")
+
+	// anchoring is unaffected
+	_, err = s.msgClient.AnchorData(ctx, &data.MsgAnchorData{
+		Sender: s.addr1.String(),
+		Hash:   &data.ContentHash{Sum: &data.ContentHash_Raw_{Raw: rawHash(content)}},
+	})
+	require.NoError(err)
+}
+
+func (s *IntegrationTestSuite) TestQueryAnchoredData() {
+	require := s.Require()
+
+	sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(time.Now().UTC()).CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	var hashes []*data.ContentHash
+	iris := make(map[string]bool)
+	for i := byte(1); i <= 5; i++ {
+		hash := make([]byte, 32)
+		hash[0] = i
+		contentHash := &data.ContentHash{Sum: &data.ContentHash_Raw_{Raw: &data.ContentHash_Raw{
+			Hash:            hash,
+			DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		}}}
+		iri, err := contentHash.ToIRI()
+		require.NoError(err)
+		hashes = append(hashes, contentHash)
+		iris[iri] = true
+	}
+
+	res, err := s.msgClient.AnchorDataBatch(ctx, &data.MsgAnchorDataBatch{
+		Sender: s.addr1.String(),
+		Hashes: hashes,
+	})
+	require.NoError(err)
+
+	// page through all anchored data two entries at a time
+	seen := make(map[string]bool)
+	var nextKey []byte
+	for pages := 0; ; pages++ {
+		require.Less(pages, 3)
+		queryRes, err := s.queryClient.AnchoredData(ctx, &data.QueryAnchoredDataRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+		})
+		require.NoError(err)
+		require.LessOrEqual(len(queryRes.Entries), 2)
+
+		for _, entry := range queryRes.Entries {
+			require.False(seen[entry.Iri])
+			seen[entry.Iri] = true
+			require.Equal(res.Timestamp, entry.Timestamp)
+
+			iri, err := entry.Hash.ToIRI()
+			require.NoError(err)
+			require.Equal(entry.Iri, iri)
+		}
+
+		nextKey = queryRes.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	require.Equal(iris, seen)
+
+	// total count
+	queryRes, err := s.queryClient.AnchoredData(ctx, &data.QueryAnchoredDataRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(queryRes.Entries, 1)
+	require.Equal(uint64(5), queryRes.Pagination.Total)
+}
+
+func (s *IntegrationTestSuite) TestDeleteStoredContent() {
+	require := s.Require()
+
+	sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(time.Now().UTC()).CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	storer, authority, other := s.fixture.Signers()[0], s.fixture.Signers()[1], s.fixture.Signers()[2]
+	params := data.DefaultParams()
+	params.DeletionAuthority = authority.String()
+	s.paramSpace.SetParamSet(sdkCtx, &params)
+
+	store := func(content []byte) (*data.ContentHash_Raw, string) {
+		digest := blake2b.Sum256(content)
+		rawHash := &data.ContentHash_Raw{
+			Hash:            digest[:],
+			DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		}
+		iri, err := rawHash.ToIRI()
+		require.NoError(err)
+
+		_, err = s.msgClient.StoreRawData(ctx, &data.MsgStoreRawData{
+			Sender:      storer.String(),
+			ContentHash: rawHash,
+			Content:     content,
+		})
+		require.NoError(err)
+
+		return rawHash, iri
+	}
+
+	assertDeleted := func(iri string, anchorTimestamp *gogotypes.Timestamp) {
+		_, err := s.queryClient.Data(ctx, &data.QueryDataRequest{Iri: iri})
+		require.Error(err)
+		require.Contains(err.Error(), "has no stored data")
+
+		// the anchor is preserved
+		res, err := s.queryClient.AnchoredData(ctx, &data.QueryAnchoredDataRequest{})
+		require.NoError(err)
+		var found bool
+		for _, entry := range res.Entries {
+			if entry.Iri == iri {
+				found = true
+				require.Equal(anchorTimestamp, entry.Timestamp)
+			}
+		}
+		require.True(found)
+	}
+
+	hash1, iri1 := store([]byte("content1"))
+	hash2, iri2 := store([]byte("content2"))
+	dataRes, err := s.queryClient.Data(ctx, &data.QueryDataRequest{Iri: iri1})
+	require.NoError(err)
+
+	// only the storer or the deletion authority can delete content
+	_, err = s.msgClient.DeleteStoredContent(ctx, &data.MsgDeleteStoredContent{
+		Sender:      other.String(),
+		ContentHash: hash1,
+	})
+	require.Error(err)
+	require.Contains(err.Error(), "unauthorized")
+
+	// the storer can delete content
+	_, err = s.msgClient.DeleteStoredContent(ctx, &data.MsgDeleteStoredContent{
+		Sender:      storer.String(),
+		ContentHash: hash1,
+	})
+	require.NoError(err)
+	assertDeleted(iri1, dataRes.Timestamp)
+
+	// content can't be deleted twice
+	_, err = s.msgClient.DeleteStoredContent(ctx, &data.MsgDeleteStoredContent{
+		Sender:      storer.String(),
+		ContentHash: hash1,
+	})
+	require.Error(err)
+	require.Contains(err.Error(), "has no stored data")
+
+	// the deletion authority can delete content
+	_, err = s.msgClient.DeleteStoredContent(ctx, &data.MsgDeleteStoredContent{
+		Sender:      authority.String(),
+		ContentHash: hash2,
+	})
+	require.NoError(err)
+	assertDeleted(iri2, dataRes.Timestamp)
+
+	// without a deletion authority, which is the default, only the storer can
+	// delete content
+	params = data.DefaultParams()
+	require.Empty(params.DeletionAuthority)
+	s.paramSpace.SetParamSet(sdkCtx, &params)
+	hash3, iri3 := store([]byte("content3"))
+	for _, sender := range []sdk.AccAddress{authority, other} {
+		_, err = s.msgClient.DeleteStoredContent(ctx, &data.MsgDeleteStoredContent{
+			Sender:      sender.String(),
+			ContentHash: hash3,
+		})
+		require.Error(err)
+		require.Contains(err.Error(), "no deletion authority is set")
+	}
+	_, err = s.msgClient.DeleteStoredContent(ctx, &data.MsgDeleteStoredContent{
+		Sender:      storer.String(),
+		ContentHash: hash3,
+	})
+	require.NoError(err)
+	assertDeleted(iri3, dataRes.Timestamp)
+}
+
+func (s *IntegrationTestSuite) TestStoreRawDataIdempotent() {
+	require := s.Require()
+
+	sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(time.Now().UTC()).CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	content := []byte("xyzabc123")
+	digest := blake2b.Sum256(content)
+	rawHash := &data.ContentHash_Raw{
+		Hash:            digest[:],
+		DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+	}
+	msg := &data.MsgStoreRawData{
+		Sender:      s.addr1.String(),
+		ContentHash: rawHash,
+		Content:     content,
+	}
+
+	res, err := s.msgClient.StoreRawData(ctx, msg)
+	require.NoError(err)
+	require.False(res.AlreadyStored)
+
+	// storing the same content again succeeds without emitting an event
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	ctx = types.Context{Context: sdkCtx}
+	res, err = s.msgClient.StoreRawData(ctx, msg)
+	require.NoError(err)
+	require.True(res.AlreadyStored)
+	for _, event := range sdkCtx.EventManager().Events() {
+		require.NotEqual(proto.MessageName(&data.EventStoreRawData{}), event.Type)
+	}
+
+	// stored content which doesn't match the request is rejected, this can
+	// only happen through genesis as the request content is verified
+	sdkCtx, _ = s.fixture.Context().(types.Context).WithBlockTime(time.Now().UTC()).CacheContext()
+	ctx = types.Context{Context: sdkCtx}
+	genesisState := data.DefaultGenesisState()
+	genesisState.Entries = []*data.GenesisContentEntry{{
+		Hash:      &data.ContentHash{Sum: &data.ContentHash_Raw_{Raw: rawHash}},
+		Timestamp: &gogotypes.Timestamp{Seconds: 100},
+		Content:   &data.Content{Sum: &data.Content_RawData{RawData: []byte("tampered")}},
+	}}
+	require.NoError(s.importGenesisState(ctx, *genesisState))
+
+	_, err = s.msgClient.StoreRawData(ctx, msg)
+	require.Error(err)
+	require.Contains(err.Error(), "already has different stored data")
+}
+
+func (s *IntegrationTestSuite) TestStoreRawDataChunk() {
+	require := s.Require()
+
+	blockTime := time.Now().UTC()
+	sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(blockTime).CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	chunks := [][]byte{[]byte("The quick brown "), []byte("fox jumps over "), []byte("the lazy dog")}
+	digest := blake2b.Sum256(bytes.Join(chunks, nil))
+	rawHash := &data.ContentHash_Raw{
+		Hash:            digest[:],
+		DigestAlgorithm: data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		MediaType:       data.MediaType_MEDIA_TYPE_TEXT_PLAIN,
+	}
+	iri, err := rawHash.ToIRI()
+	require.NoError(err)
+
+	storeChunk := func(ctx context.Context, sender sdk.AccAddress, index, total uint32, chunk []byte) (*data.MsgStoreRawDataChunkResponse, error) {
+		return s.msgClient.StoreRawDataChunk(ctx, &data.MsgStoreRawDataChunk{
+			Sender:      sender.String(),
+			ContentHash: rawHash,
+			Index:       index,
+			Total:       total,
+			Chunk:       chunk,
+		})
+	}
+
+	// chunks can't be sent before the upload is started
+	_, err = storeChunk(ctx, s.addr1, 1, 3, chunks[1])
+	require.Error(err)
+	require.Contains(err.Error(), "has no upload in progress")
+
+	res, err := storeChunk(ctx, s.addr1, 0, 3, chunks[0])
+	require.NoError(err)
+	require.False(res.Complete)
+
+	// chunks must be sent in order
+	_, err = storeChunk(ctx, s.addr1, 2, 3, chunks[2])
+	require.Error(err)
+	require.Contains(err.Error(), "expected chunk 1, got 2")
+
+	// the total can't change
+	_, err = storeChunk(ctx, s.addr1, 1, 4, chunks[1])
+	require.Error(err)
+	require.Contains(err.Error(), "expected a total of 3 chunks, got 4")
+
+	// another sender can neither continue nor restart the upload
+	_, err = storeChunk(ctx, s.addr2, 1, 3, chunks[1])
+	require.Error(err)
+	require.Contains(err.Error(), "is being uploaded by")
+	_, err = storeChunk(ctx, s.addr2, 0, 3, chunks[0])
+	require.Error(err)
+	require.Contains(err.Error(), "is already being uploaded by")
+
+	res, err = storeChunk(ctx, s.addr1, 1, 3, chunks[1])
+	require.NoError(err)
+	require.False(res.Complete)
+
+	// the content isn't stored until all chunks are received
+	_, err = s.queryClient.Data(ctx, &data.QueryDataRequest{Iri: iri})
+	require.Error(err)
+
+	// a last chunk which doesn't match the content hash is rejected
+	_, err = storeChunk(ctx, s.addr1, 2, 3, []byte("the lazy cat"))
+	require.Error(err)
+	require.Contains(err.Error(), "hash verification failed")
+
+	res, err = storeChunk(ctx, s.addr1, 2, 3, chunks[2])
+	require.NoError(err)
+	require.True(res.Complete)
+
+	dataRes, err := s.queryClient.Data(ctx, &data.QueryDataRequest{Iri: iri})
+	require.NoError(err)
+	require.Equal(bytes.Join(chunks, nil), dataRes.Content)
+
+	// the upload is over once the content is stored
+	res, err = storeChunk(ctx, s.addr1, 0, 3, chunks[0])
+	require.NoError(err)
+	require.True(res.AlreadyStored)
+
+	s.Run("expiry", func() {
+		sdkCtx, _ := s.fixture.Context().(types.Context).WithBlockTime(blockTime).CacheContext()
+		ctx := types.Context{Context: sdkCtx}
+
+		_, err := storeChunk(ctx, s.addr1, 0, 3, chunks[0])
+		require.NoError(err)
+
+		// the upload is kept until it expires
+		sdkCtx = sdkCtx.WithBlockTime(blockTime.Add(data.ChunkedUploadTimeout - time.Nanosecond))
+		require.NoError(s.fixture.EndBlock(sdkCtx))
+		ctx = types.Context{Context: sdkCtx}
+		_, err = storeChunk(ctx, s.addr1, 1, 3, chunks[1])
+		require.NoError(err)
+
+		// chunks can't be added once it has expired
+		sdkCtx = sdkCtx.WithBlockTime(blockTime.Add(data.ChunkedUploadTimeout))
+		ctx = types.Context{Context: sdkCtx}
+		_, err = storeChunk(ctx, s.addr1, 2, 3, chunks[2])
+		require.Error(err)
+		require.Contains(err.Error(), "has expired")
+
+		// and it is discarded at the end of the block
+		require.NoError(s.fixture.EndBlock(sdkCtx))
+		_, err = storeChunk(ctx, s.addr1, 2, 3, chunks[2])
+		require.Error(err)
+		require.Contains(err.Error(), "has no upload in progress")
+
+		// an expired upload can be restarted by another sender before it is
+		// discarded
+		_, err = storeChunk(ctx, s.addr1, 0, 3, chunks[0])
+		require.NoError(err)
+		sdkCtx = sdkCtx.WithBlockTime(blockTime.Add(2 * data.ChunkedUploadTimeout))
+		ctx = types.Context{Context: sdkCtx}
+		_, err = storeChunk(ctx, s.addr2, 0, 3, chunks[0])
+		require.NoError(err)
+		require.NoError(s.fixture.EndBlock(sdkCtx))
+		_, err = storeChunk(ctx, s.addr2, 1, 3, chunks[1])
+		require.NoError(err)
+	})
+}
+
 func (s *IntegrationTestSuite) TestScenario() {
 	//testContent := []byte("xyzabc123")
 	//mh, err := multihash.Sum(testContent, multihash.SHA2_256, -1)
@@ -0,0 +1,46 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/regen-network/regen-ledger/x/data"
+)
+
+const (
+	StorageGasPerByte = "storage_gas_per_byte"
+	MaxDataSize       = "max_data_size"
+)
+
+func genStorageGasPerByte(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 100))
+}
+
+func genMaxDataSize(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, MaxSimContentSize, int(data.DefaultMaxDataSize)+1))
+}
+
+// RandomizedGenState generates a random GenesisState for the data module.
+func RandomizedGenState(simState *module.SimulationState) {
+	var storageGasPerByte uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, StorageGasPerByte, &storageGasPerByte, simState.Rand,
+		func(r *rand.Rand) { storageGasPerByte = genStorageGasPerByte(r) },
+	)
+
+	var maxDataSize uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxDataSize, &maxDataSize, simState.Rand,
+		func(r *rand.Rand) { maxDataSize = genMaxDataSize(r) },
+	)
+
+	params := data.DefaultParams()
+	params.StorageGasPerByte = storageGasPerByte
+	params.MaxDataSize = maxDataSize
+
+	dataGenesis := data.GenesisState{Params: params}
+
+	simState.GenState[data.ModuleName] = simState.Cdc.MustMarshalJSON(&dataGenesis)
+}
@@ -0,0 +1,224 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/data"
+)
+
+// data message types
+var (
+	TypeMsgAnchorData   = sdk.MsgTypeURL(&data.MsgAnchorData{})
+	TypeMsgSignData     = sdk.MsgTypeURL(&data.MsgSignData{})
+	TypeMsgStoreRawData = sdk.MsgTypeURL(&data.MsgStoreRawData{})
+)
+
+// Simulation operation weights constants
+const (
+	OpMsgAnchorData   = "op_weight_msg_anchor_data"
+	OpMsgSignData     = "op_weight_msg_sign_data"
+	OpMsgStoreRawData = "op_weight_msg_store_raw_data"
+)
+
+const (
+	WeightAnchorData   = 100
+	WeightSignData     = 100
+	WeightStoreRawData = 50
+
+	// MaxSimContentSize is the maximum size in bytes of the random content
+	// generated for MsgStoreRawData, which is always within the bounds of
+	// the randomized MaxDataSize param.
+	MaxSimContentSize = 1024
+)
+
+var (
+	simDigestAlgorithms = []data.DigestAlgorithm{
+		data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_512,
+		data.DigestAlgorithm_DIGEST_ALGORITHM_SHA3_256,
+	}
+
+	simMediaTypes = []data.MediaType{
+		data.MediaType_MEDIA_TYPE_TEXT_PLAIN,
+		data.MediaType_MEDIA_TYPE_JSON,
+		data.MediaType_MEDIA_TYPE_CSV,
+		data.MediaType_MEDIA_TYPE_XML,
+		data.MediaType_MEDIA_TYPE_PDF,
+	}
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak data.AccountKeeper,
+	bk data.BankKeeper, qryClient data.QueryClient, protoCdc *codec.ProtoCodec) simulation.WeightedOperations {
+	var (
+		weightMsgAnchorData   int
+		weightMsgSignData     int
+		weightMsgStoreRawData int
+	)
+
+	appParams.GetOrGenerate(cdc, OpMsgAnchorData, &weightMsgAnchorData, nil,
+		func(_ *rand.Rand) {
+			weightMsgAnchorData = WeightAnchorData
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpMsgSignData, &weightMsgSignData, nil,
+		func(_ *rand.Rand) {
+			weightMsgSignData = WeightSignData
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpMsgStoreRawData, &weightMsgStoreRawData, nil,
+		func(_ *rand.Rand) {
+			weightMsgStoreRawData = WeightStoreRawData
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgAnchorData,
+			SimulateMsgAnchorData(ak, bk, qryClient, protoCdc),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSignData,
+			SimulateMsgSignData(ak, bk, protoCdc),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgStoreRawData,
+			SimulateMsgStoreRawData(ak, bk, protoCdc),
+		),
+	}
+}
+
+// SimulateMsgAnchorData generates a MsgAnchorData with a random raw content hash
+func SimulateMsgAnchorData(ak data.AccountKeeper, bk data.BankKeeper, qryClient data.QueryClient, protoCdc *codec.ProtoCodec) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, sdkCtx sdk.Context, accounts []simtypes.Account, chainID string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		acc, _ := simtypes.RandomAcc(r, accounts)
+
+		_, contentHash, err := randomRawData(r)
+		if err != nil {
+			return simtypes.NoOpMsg(data.ModuleName, TypeMsgAnchorData, "unable to generate content hash"), nil, err
+		}
+
+		hash := &data.ContentHash{Sum: &data.ContentHash_Raw_{Raw: contentHash}}
+
+		// anchoring already anchored content fails so we skip it
+		ctx := regentypes.Context{Context: sdkCtx}
+		_, err = qryClient.ByHash(ctx, &data.QueryByHashRequest{Hash: hash})
+		if err == nil {
+			return simtypes.NoOpMsg(data.ModuleName, TypeMsgAnchorData, "content already anchored"), nil, nil
+		}
+		if !sdkerrors.ErrNotFound.Is(err) {
+			return simtypes.NoOpMsg(data.ModuleName, TypeMsgAnchorData, "fail to query content"), nil, err
+		}
+
+		msg := &data.MsgAnchorData{
+			Sender: acc.Address.String(),
+			Hash:   hash,
+		}
+
+		return deliverTx(r, app, sdkCtx, ak, bk, acc, msg, TypeMsgAnchorData, chainID, protoCdc)
+	}
+}
+
+// SimulateMsgSignData generates a MsgSignData with a random graph content hash
+func SimulateMsgSignData(ak data.AccountKeeper, bk data.BankKeeper, protoCdc *codec.ProtoCodec) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, sdkCtx sdk.Context, accounts []simtypes.Account, chainID string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		acc, _ := simtypes.RandomAcc(r, accounts)
+
+		msg := &data.MsgSignData{
+			Signers: []string{acc.Address.String()},
+			Hash: &data.ContentHash_Graph{
+				Hash:                      []byte(simtypes.RandStringOfLength(r, 32)),
+				DigestAlgorithm:           data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+				CanonicalizationAlgorithm: data.GraphCanonicalizationAlgorithm_GRAPH_CANONICALIZATION_ALGORITHM_URDNA2015,
+			},
+		}
+
+		return deliverTx(r, app, sdkCtx, ak, bk, acc, msg, TypeMsgSignData, chainID, protoCdc)
+	}
+}
+
+// SimulateMsgStoreRawData generates a MsgStoreRawData with random content and its matching hash
+func SimulateMsgStoreRawData(ak data.AccountKeeper, bk data.BankKeeper, protoCdc *codec.ProtoCodec) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, sdkCtx sdk.Context, accounts []simtypes.Account, chainID string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		acc, _ := simtypes.RandomAcc(r, accounts)
+
+		content, contentHash, err := randomRawData(r)
+		if err != nil {
+			return simtypes.NoOpMsg(data.ModuleName, TypeMsgStoreRawData, "unable to generate content hash"), nil, err
+		}
+
+		msg := &data.MsgStoreRawData{
+			Sender:      acc.Address.String(),
+			ContentHash: contentHash,
+			Content:     content,
+		}
+
+		return deliverTx(r, app, sdkCtx, ak, bk, acc, msg, TypeMsgStoreRawData, chainID, protoCdc)
+	}
+}
+
+// randomRawData generates random content along with its raw content hash
+// computed using a random digest algorithm.
+func randomRawData(r *rand.Rand) ([]byte, *data.ContentHash_Raw, error) {
+	content := []byte(simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, MaxSimContentSize)))
+	digestAlgorithm := simDigestAlgorithms[r.Intn(len(simDigestAlgorithms))]
+
+	hash, err := digestAlgorithm.Digest(content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return content, &data.ContentHash_Raw{
+		Hash:            hash,
+		DigestAlgorithm: digestAlgorithm,
+		MediaType:       simMediaTypes[r.Intn(len(simMediaTypes))],
+	}, nil
+}
+
+func deliverTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak data.AccountKeeper, bk data.BankKeeper,
+	acc simtypes.Account, msg sdk.Msg, msgType, chainID string, protoCdc *codec.ProtoCodec) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, acc.Address)
+
+	spendableCoins := bk.SpendableCoins(ctx, account.GetAddress())
+	fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+	if err != nil {
+		return simtypes.NoOpMsg(data.ModuleName, msgType, "fee error"), nil, err
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		acc.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(data.ModuleName, msgType, "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(data.ModuleName, msgType, "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, "", protoCdc), nil, nil
+}
@@ -0,0 +1,36 @@
+package simulation
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/x/data"
+)
+
+func TestRandomRawDataPassesVerification(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 100; i++ {
+		content, contentHash, err := randomRawData(r)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(content), MaxSimContentSize)
+
+		msg := &data.MsgStoreRawData{
+			Sender:      addr.String(),
+			ContentHash: contentHash,
+			Content:     content,
+		}
+		require.NoError(t, msg.ValidateBasic())
+
+		_, err = contentHash.ToIRI()
+		require.NoError(t, err)
+
+		// tampered content fails verification
+		msg.Content = append([]byte{'x'}, content...)
+		require.ErrorIs(t, msg.ValidateBasic(), data.ErrHashVerificationFailed)
+	}
+}
@@ -0,0 +1,28 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/regen-network/regen-ledger/x/data"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation.
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(data.ModuleName, string(data.KeyStorageGasPerByte),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", genStorageGasPerByte(r))
+			},
+		),
+		simulation.NewSimParamChange(data.ModuleName, string(data.KeyMaxDataSize),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", genMaxDataSize(r))
+			},
+		),
+	}
+}
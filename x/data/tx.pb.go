@@ -132,6 +132,107 @@ func (m *MsgAnchorDataResponse) GetTimestamp() *types.Timestamp {
 	return nil
 }
 
+// MsgAnchorDataBatch is the Msg/AnchorDataBatch request type.
+type MsgAnchorDataBatch struct {
+	// sender is the address of the sender of the transaction.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// hashes are the hash-based identifiers for the anchored content.
+	Hashes []*ContentHash `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (m *MsgAnchorDataBatch) Reset()         { *m = MsgAnchorDataBatch{} }
+func (m *MsgAnchorDataBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgAnchorDataBatch) ProtoMessage()    {}
+func (*MsgAnchorDataBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff31907a513a4b24, []int{2}
+}
+func (m *MsgAnchorDataBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAnchorDataBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAnchorDataBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAnchorDataBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAnchorDataBatch.Merge(m, src)
+}
+func (m *MsgAnchorDataBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAnchorDataBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAnchorDataBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAnchorDataBatch proto.InternalMessageInfo
+
+func (m *MsgAnchorDataBatch) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgAnchorDataBatch) GetHashes() []*ContentHash {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+// MsgAnchorDataBatchResponse is the Msg/AnchorDataBatch response type.
+type MsgAnchorDataBatchResponse struct {
+	// timestamp is the timestamp of the block at which the data was anchored.
+	Timestamp *types.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *MsgAnchorDataBatchResponse) Reset()         { *m = MsgAnchorDataBatchResponse{} }
+func (m *MsgAnchorDataBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAnchorDataBatchResponse) ProtoMessage()    {}
+func (*MsgAnchorDataBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff31907a513a4b24, []int{3}
+}
+func (m *MsgAnchorDataBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAnchorDataBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAnchorDataBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAnchorDataBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAnchorDataBatchResponse.Merge(m, src)
+}
+func (m *MsgAnchorDataBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAnchorDataBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAnchorDataBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAnchorDataBatchResponse proto.InternalMessageInfo
+
+func (m *MsgAnchorDataBatchResponse) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
 // MsgSignData is the Msg/SignData request type.
 type MsgSignData struct {
 	// signers are the addresses of the accounts signing the data.
@@ -148,7 +249,7 @@ func (m *MsgSignData) Reset()         { *m = MsgSignData{} }
 func (m *MsgSignData) String() string { return proto.CompactTextString(m) }
 func (*MsgSignData) ProtoMessage()    {}
 func (*MsgSignData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff31907a513a4b24, []int{2}
+	return fileDescriptor_ff31907a513a4b24, []int{4}
 }
 func (m *MsgSignData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -185,7 +286,7 @@ func (m *MsgSignDataResponse) Reset()         { *m = MsgSignDataResponse{} }
 func (m *MsgSignDataResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSignDataResponse) ProtoMessage()    {}
 func (*MsgSignDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff31907a513a4b24, []int{3}
+	return fileDescriptor_ff31907a513a4b24, []int{5}
 }
 func (m *MsgSignDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -230,7 +331,7 @@ func (m *MsgStoreRawData) Reset()         { *m = MsgStoreRawData{} }
 func (m *MsgStoreRawData) String() string { return proto.CompactTextString(m) }
 func (*MsgStoreRawData) ProtoMessage()    {}
 func (*MsgStoreRawData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff31907a513a4b24, []int{4}
+	return fileDescriptor_ff31907a513a4b24, []int{6}
 }
 func (m *MsgStoreRawData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,13 +383,16 @@ func (m *MsgStoreRawData) GetContent() []byte {
 
 // MsgStoreRawData is the Msg/StoreRawData response type.
 type MsgStoreRawDataResponse struct {
+	// already_stored is true if the content was already stored on-chain, in
+	// which case the request was a no-op.
+	AlreadyStored bool `protobuf:"varint,1,opt,name=already_stored,json=alreadyStored,proto3" json:"already_stored,omitempty"`
 }
 
 func (m *MsgStoreRawDataResponse) Reset()         { *m = MsgStoreRawDataResponse{} }
 func (m *MsgStoreRawDataResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgStoreRawDataResponse) ProtoMessage()    {}
 func (*MsgStoreRawDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff31907a513a4b24, []int{5}
+	return fileDescriptor_ff31907a513a4b24, []int{7}
 }
 func (m *MsgStoreRawDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,48 +421,305 @@ func (m *MsgStoreRawDataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStoreRawDataResponse proto.InternalMessageInfo
 
+func (m *MsgStoreRawDataResponse) GetAlreadyStored() bool {
+	if m != nil {
+		return m.AlreadyStored
+	}
+	return false
+}
+
+// MsgStoreRawDataChunk is the Msg/StoreRawDataChunk request type.
+type MsgStoreRawDataChunk struct {
+	// sender is the address of the sender of the transaction.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// content_hash is the hash-based identifier of the complete data.
+	ContentHash *ContentHash_Raw `protobuf:"bytes,2,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// index is the zero-based index of the chunk.
+	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// total is the total number of chunks of the data.
+	Total uint32 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// chunk is the content of the chunk.
+	Chunk []byte `protobuf:"bytes,5,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (m *MsgStoreRawDataChunk) Reset()         { *m = MsgStoreRawDataChunk{} }
+func (m *MsgStoreRawDataChunk) String() string { return proto.CompactTextString(m) }
+func (*MsgStoreRawDataChunk) ProtoMessage()    {}
+func (*MsgStoreRawDataChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff31907a513a4b24, []int{8}
+}
+func (m *MsgStoreRawDataChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStoreRawDataChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStoreRawDataChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStoreRawDataChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStoreRawDataChunk.Merge(m, src)
+}
+func (m *MsgStoreRawDataChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStoreRawDataChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStoreRawDataChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStoreRawDataChunk proto.InternalMessageInfo
+
+func (m *MsgStoreRawDataChunk) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStoreRawDataChunk) GetContentHash() *ContentHash_Raw {
+	if m != nil {
+		return m.ContentHash
+	}
+	return nil
+}
+
+func (m *MsgStoreRawDataChunk) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *MsgStoreRawDataChunk) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *MsgStoreRawDataChunk) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+// MsgStoreRawDataChunkResponse is the Msg/StoreRawDataChunk response type.
+type MsgStoreRawDataChunkResponse struct {
+	// complete is true if this was the last chunk and the data has been stored.
+	Complete bool `protobuf:"varint,1,opt,name=complete,proto3" json:"complete,omitempty"`
+	// already_stored is true if the data was already stored on-chain, in which
+	// case the request was a no-op.
+	AlreadyStored bool `protobuf:"varint,2,opt,name=already_stored,json=alreadyStored,proto3" json:"already_stored,omitempty"`
+}
+
+func (m *MsgStoreRawDataChunkResponse) Reset()         { *m = MsgStoreRawDataChunkResponse{} }
+func (m *MsgStoreRawDataChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStoreRawDataChunkResponse) ProtoMessage()    {}
+func (*MsgStoreRawDataChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff31907a513a4b24, []int{9}
+}
+func (m *MsgStoreRawDataChunkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStoreRawDataChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStoreRawDataChunkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStoreRawDataChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStoreRawDataChunkResponse.Merge(m, src)
+}
+func (m *MsgStoreRawDataChunkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStoreRawDataChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStoreRawDataChunkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStoreRawDataChunkResponse proto.InternalMessageInfo
+
+func (m *MsgStoreRawDataChunkResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+func (m *MsgStoreRawDataChunkResponse) GetAlreadyStored() bool {
+	if m != nil {
+		return m.AlreadyStored
+	}
+	return false
+}
+
+// MsgDeleteStoredContent is the Msg/DeleteStoredContent request type.
+type MsgDeleteStoredContent struct {
+	// sender is the address of the account deleting the content. It must be
+	// either the account which stored the content or the deletion authority.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// content_hash is the hash-based identifier for the stored content.
+	ContentHash *ContentHash_Raw `protobuf:"bytes,2,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+}
+
+func (m *MsgDeleteStoredContent) Reset()         { *m = MsgDeleteStoredContent{} }
+func (m *MsgDeleteStoredContent) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteStoredContent) ProtoMessage()    {}
+func (*MsgDeleteStoredContent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff31907a513a4b24, []int{10}
+}
+func (m *MsgDeleteStoredContent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteStoredContent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteStoredContent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteStoredContent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteStoredContent.Merge(m, src)
+}
+func (m *MsgDeleteStoredContent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteStoredContent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteStoredContent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteStoredContent proto.InternalMessageInfo
+
+func (m *MsgDeleteStoredContent) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgDeleteStoredContent) GetContentHash() *ContentHash_Raw {
+	if m != nil {
+		return m.ContentHash
+	}
+	return nil
+}
+
+// MsgDeleteStoredContentResponse is the Msg/DeleteStoredContent response type.
+type MsgDeleteStoredContentResponse struct {
+}
+
+func (m *MsgDeleteStoredContentResponse) Reset()         { *m = MsgDeleteStoredContentResponse{} }
+func (m *MsgDeleteStoredContentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteStoredContentResponse) ProtoMessage()    {}
+func (*MsgDeleteStoredContentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff31907a513a4b24, []int{11}
+}
+func (m *MsgDeleteStoredContentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteStoredContentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteStoredContentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteStoredContentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteStoredContentResponse.Merge(m, src)
+}
+func (m *MsgDeleteStoredContentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteStoredContentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteStoredContentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteStoredContentResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAnchorData)(nil), "regen.data.v1alpha2.MsgAnchorData")
 	proto.RegisterType((*MsgAnchorDataResponse)(nil), "regen.data.v1alpha2.MsgAnchorDataResponse")
+	proto.RegisterType((*MsgAnchorDataBatch)(nil), "regen.data.v1alpha2.MsgAnchorDataBatch")
+	proto.RegisterType((*MsgAnchorDataBatchResponse)(nil), "regen.data.v1alpha2.MsgAnchorDataBatchResponse")
 	proto.RegisterType((*MsgSignData)(nil), "regen.data.v1alpha2.MsgSignData")
 	proto.RegisterType((*MsgSignDataResponse)(nil), "regen.data.v1alpha2.MsgSignDataResponse")
 	proto.RegisterType((*MsgStoreRawData)(nil), "regen.data.v1alpha2.MsgStoreRawData")
 	proto.RegisterType((*MsgStoreRawDataResponse)(nil), "regen.data.v1alpha2.MsgStoreRawDataResponse")
+	proto.RegisterType((*MsgStoreRawDataChunk)(nil), "regen.data.v1alpha2.MsgStoreRawDataChunk")
+	proto.RegisterType((*MsgStoreRawDataChunkResponse)(nil), "regen.data.v1alpha2.MsgStoreRawDataChunkResponse")
+	proto.RegisterType((*MsgDeleteStoredContent)(nil), "regen.data.v1alpha2.MsgDeleteStoredContent")
+	proto.RegisterType((*MsgDeleteStoredContentResponse)(nil), "regen.data.v1alpha2.MsgDeleteStoredContentResponse")
 }
 
 func init() { proto.RegisterFile("regen/data/v1alpha2/tx.proto", fileDescriptor_ff31907a513a4b24) }
 
 var fileDescriptor_ff31907a513a4b24 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0x13, 0x31,
-	0x10, 0xc6, 0xe3, 0xa6, 0x2a, 0x64, 0x12, 0x84, 0xb4, 0xa5, 0xb0, 0xac, 0xd0, 0x76, 0xb5, 0xaa,
-	0xd0, 0x0a, 0x15, 0x5b, 0x04, 0x0e, 0xa8, 0x37, 0xfe, 0x88, 0x72, 0xc9, 0x01, 0x83, 0x38, 0x20,
-	0x10, 0x72, 0x12, 0xe3, 0x5d, 0x91, 0xd8, 0x2b, 0xdb, 0x25, 0xe5, 0x0d, 0x38, 0x70, 0xe0, 0x0d,
-	0xe0, 0x71, 0x38, 0xf6, 0xc8, 0x11, 0x25, 0x2f, 0x82, 0xe2, 0xc4, 0x69, 0x16, 0xa5, 0x2c, 0xb7,
-	0x7c, 0x9e, 0xcf, 0xf3, 0xfd, 0xc6, 0x93, 0x85, 0x5b, 0x9a, 0x0b, 0x2e, 0xc9, 0x90, 0x59, 0x46,
-	0x3e, 0xdd, 0x63, 0xa3, 0x32, 0x67, 0x5d, 0x62, 0x4f, 0x71, 0xa9, 0x95, 0x55, 0xc1, 0xae, 0xab,
-	0xe2, 0x79, 0x15, 0xfb, 0x6a, 0x74, 0x4d, 0x28, 0xa1, 0x5c, 0x9d, 0xcc, 0x7f, 0x2d, 0xac, 0xd1,
-	0xbe, 0x50, 0x4a, 0x8c, 0x38, 0x71, 0xaa, 0x7f, 0xf2, 0x81, 0xd8, 0x62, 0xcc, 0x8d, 0x65, 0xe3,
-	0xd2, 0x1b, 0x36, 0x26, 0x7d, 0x2e, 0xb9, 0x59, 0x18, 0xd2, 0x77, 0x70, 0xa5, 0x67, 0xc4, 0x23,
-	0x39, 0xc8, 0x95, 0x7e, 0xca, 0x2c, 0x0b, 0xae, 0xc3, 0x8e, 0xe1, 0x72, 0xc8, 0x75, 0x88, 0x12,
-	0x94, 0xb5, 0xe8, 0x52, 0x05, 0x0f, 0x60, 0x3b, 0x67, 0x26, 0x0f, 0xb7, 0x12, 0x94, 0xb5, 0xbb,
-	0x09, 0xde, 0x00, 0x89, 0x9f, 0x28, 0x69, 0xb9, 0xb4, 0xcf, 0x99, 0xc9, 0xa9, 0x73, 0xa7, 0x2f,
-	0x60, 0xaf, 0xd2, 0x9e, 0x72, 0x53, 0x2a, 0x69, 0x78, 0xf0, 0x10, 0x5a, 0x2b, 0x56, 0x97, 0xd4,
-	0xee, 0x46, 0x78, 0x31, 0x0d, 0xf6, 0xd3, 0xe0, 0x57, 0xde, 0x41, 0xcf, 0xcd, 0x69, 0x01, 0xed,
-	0x9e, 0x11, 0x2f, 0x0b, 0x21, 0x1d, 0x6f, 0x08, 0x97, 0x4c, 0x21, 0x24, 0xd7, 0x26, 0x44, 0x49,
-	0x33, 0x6b, 0x51, 0x2f, 0x83, 0xa3, 0x0a, 0xf1, 0xed, 0x3a, 0x62, 0x7c, 0xac, 0x59, 0xb9, 0xe4,
-	0x3e, 0xda, 0xfe, 0xf2, 0x63, 0xbf, 0x91, 0xee, 0xc1, 0xee, 0x5a, 0x94, 0x67, 0x4f, 0xbf, 0x22,
-	0xb8, 0x3a, 0x3f, 0xb7, 0x4a, 0x73, 0xca, 0x26, 0xff, 0x7c, 0xb6, 0x63, 0xe8, 0x0c, 0x16, 0x19,
-	0xef, 0xd7, 0x60, 0x0e, 0x6a, 0x61, 0x28, 0x9b, 0xd0, 0xf6, 0xe0, 0xfc, 0x60, 0x3e, 0xe7, 0x52,
-	0x86, 0xcd, 0x04, 0x65, 0x1d, 0xea, 0x65, 0x7a, 0x13, 0x6e, 0xfc, 0x45, 0xe3, 0x49, 0xbb, 0xdf,
-	0xb7, 0xa0, 0xd9, 0x33, 0x22, 0x78, 0x0b, 0xb0, 0xb6, 0xe2, 0x74, 0x63, 0x7a, 0x65, 0x4f, 0xd1,
-	0x9d, 0x7a, 0xcf, 0x6a, 0x97, 0xaf, 0xe1, 0xf2, 0x6a, 0x1d, 0xc9, 0x45, 0xf7, 0xbc, 0x23, 0xca,
-	0xea, 0x1c, 0xab, 0xbe, 0x7d, 0xe8, 0x54, 0xde, 0xf8, 0xe0, 0xc2, 0x9b, 0x6b, 0xae, 0xe8, 0xf0,
-	0x7f, 0x5c, 0x3e, 0xe3, 0xf1, 0xb3, 0x9f, 0xd3, 0x18, 0x9d, 0x4d, 0x63, 0xf4, 0x7b, 0x1a, 0xa3,
-	0x6f, 0xb3, 0xb8, 0x71, 0x36, 0x8b, 0x1b, 0xbf, 0x66, 0x71, 0xe3, 0xcd, 0xa1, 0x28, 0x6c, 0x7e,
-	0xd2, 0xc7, 0x03, 0x35, 0x26, 0xae, 0xe3, 0x5d, 0xc9, 0xed, 0x44, 0xe9, 0x8f, 0x4b, 0x35, 0xe2,
-	0x43, 0xc1, 0x35, 0x39, 0x75, 0x1f, 0x57, 0x7f, 0xc7, 0xfd, 0x69, 0xef, 0xff, 0x09, 0x00, 0x00,
-	0xff, 0xff, 0x47, 0x93, 0xfb, 0xc7, 0xdb, 0x03, 0x00, 0x00,
+	// 657 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x9b, 0xb4, 0x5f, 0x7b, 0xd3, 0x7e, 0x15, 0xee, 0x0f, 0x96, 0x55, 0xb9, 0x96, 0x55,
+	0x20, 0x40, 0xb1, 0xd5, 0x94, 0x45, 0xd5, 0x15, 0xb4, 0x15, 0x65, 0x93, 0x05, 0x06, 0x75, 0x81,
+	0x40, 0xd5, 0xc4, 0x9e, 0x8e, 0xad, 0x3a, 0x1e, 0xe3, 0x99, 0x92, 0xf6, 0x0d, 0x58, 0xb0, 0xe0,
+	0x11, 0x78, 0x0d, 0xde, 0x80, 0x65, 0x97, 0x2c, 0x51, 0xf3, 0x12, 0x2c, 0x91, 0xc7, 0x3f, 0x4d,
+	0x52, 0x87, 0x04, 0x89, 0xee, 0x72, 0xee, 0x9c, 0x7b, 0xcf, 0xb9, 0x27, 0x99, 0x09, 0xac, 0xc5,
+	0x98, 0xe0, 0xd0, 0x72, 0x11, 0x47, 0xd6, 0xc7, 0x2d, 0x14, 0x44, 0x1e, 0x6a, 0x5a, 0xfc, 0xdc,
+	0x8c, 0x62, 0xca, 0xa9, 0xbc, 0x24, 0x4e, 0xcd, 0xe4, 0xd4, 0xcc, 0x4f, 0xd5, 0x65, 0x42, 0x09,
+	0x15, 0xe7, 0x56, 0xf2, 0x29, 0xa5, 0xaa, 0xeb, 0x84, 0x52, 0x12, 0x60, 0x4b, 0xa0, 0xf6, 0xd9,
+	0x89, 0xc5, 0xfd, 0x0e, 0x66, 0x1c, 0x75, 0xa2, 0x9c, 0x50, 0xaa, 0x74, 0x11, 0x61, 0x96, 0x12,
+	0x8c, 0xf7, 0xb0, 0xd0, 0x62, 0xe4, 0x79, 0xe8, 0x78, 0x34, 0x3e, 0x40, 0x1c, 0xc9, 0xab, 0x30,
+	0xc3, 0x70, 0xe8, 0xe2, 0x58, 0x91, 0x74, 0xa9, 0x31, 0x67, 0x67, 0x48, 0x7e, 0x0a, 0x35, 0x0f,
+	0x31, 0x4f, 0x99, 0xd2, 0xa5, 0x46, 0xbd, 0xa9, 0x9b, 0x25, 0x26, 0xcd, 0x7d, 0x1a, 0x72, 0x1c,
+	0xf2, 0x97, 0x88, 0x79, 0xb6, 0x60, 0x1b, 0xaf, 0x60, 0x65, 0x60, 0xbc, 0x8d, 0x59, 0x44, 0x43,
+	0x86, 0xe5, 0x1d, 0x98, 0x2b, 0xbc, 0x0a, 0xa5, 0x7a, 0x53, 0x35, 0xd3, 0x6d, 0xcc, 0x7c, 0x1b,
+	0xf3, 0x4d, 0xce, 0xb0, 0xaf, 0xc9, 0xc6, 0x09, 0xc8, 0x03, 0x23, 0xf7, 0x10, 0x77, 0xbc, 0x91,
+	0xb6, 0x77, 0x60, 0x26, 0x31, 0x82, 0x99, 0x32, 0xa5, 0x57, 0x27, 0x32, 0x9e, 0xf1, 0x8d, 0x23,
+	0x50, 0x6f, 0xea, 0xfc, 0x03, 0xff, 0x3e, 0xd4, 0x5b, 0x8c, 0xbc, 0xf6, 0x49, 0x28, 0xf2, 0x56,
+	0xe0, 0x3f, 0xe6, 0x93, 0x10, 0xc7, 0x4c, 0x91, 0xf4, 0x6a, 0x63, 0xce, 0xce, 0xa1, 0xbc, 0x3b,
+	0x90, 0xf8, 0xfd, 0x71, 0xc6, 0xcd, 0xc3, 0x18, 0x45, 0x59, 0xee, 0xbb, 0xb5, 0x4f, 0x5f, 0xd7,
+	0x2b, 0xc6, 0x0a, 0x2c, 0xf5, 0x49, 0xe5, 0xde, 0x8d, 0xcf, 0x12, 0x2c, 0x26, 0x75, 0x4e, 0x63,
+	0x6c, 0xa3, 0xee, 0x1f, 0xbf, 0xf6, 0x43, 0x98, 0x77, 0x52, 0x8d, 0xe3, 0x3e, 0x33, 0x1b, 0x63,
+	0xcd, 0xd8, 0xa8, 0x6b, 0xd7, 0x9d, 0xeb, 0x42, 0xb2, 0x67, 0x06, 0x95, 0xaa, 0x2e, 0x35, 0xe6,
+	0xed, 0x1c, 0x1a, 0xcf, 0xe0, 0xee, 0x90, 0x9b, 0x22, 0xe5, 0x7b, 0xf0, 0x3f, 0x0a, 0x62, 0x8c,
+	0xdc, 0x8b, 0x63, 0x96, 0x9c, 0xbb, 0xc2, 0xdd, 0xac, 0xbd, 0x90, 0x55, 0x45, 0x93, 0x6b, 0x7c,
+	0x93, 0x60, 0x79, 0x68, 0xc4, 0xbe, 0x77, 0x16, 0x9e, 0xde, 0xfe, 0x56, 0xcb, 0x30, 0xed, 0x87,
+	0x2e, 0x3e, 0x17, 0x3b, 0x2d, 0xd8, 0x29, 0x48, 0xaa, 0x9c, 0x72, 0x14, 0x28, 0xb5, 0xb4, 0x2a,
+	0x40, 0x52, 0x75, 0x12, 0x57, 0xca, 0xb4, 0xd8, 0x3f, 0x05, 0x06, 0x82, 0xb5, 0x32, 0xeb, 0x45,
+	0x04, 0x2a, 0xcc, 0x3a, 0xb4, 0x13, 0x05, 0x98, 0xe3, 0x6c, 0xf9, 0x02, 0x97, 0xc4, 0x33, 0x55,
+	0x16, 0xcf, 0x05, 0xac, 0xb6, 0x18, 0x39, 0xc0, 0x49, 0x4f, 0x5a, 0xca, 0x76, 0xba, 0xf5, 0x7c,
+	0x0c, 0x1d, 0xb4, 0x72, 0xe9, 0x7c, 0xbf, 0xe6, 0xaf, 0x1a, 0x54, 0x5b, 0x8c, 0xc8, 0xef, 0x00,
+	0xfa, 0x5e, 0x21, 0xa3, 0x54, 0x6a, 0xe0, 0x3e, 0xaa, 0x8f, 0xc6, 0x73, 0x8a, 0x14, 0x4f, 0x61,
+	0x71, 0xf8, 0xc5, 0x78, 0x30, 0xbe, 0x5d, 0x10, 0x55, 0x6b, 0x42, 0x62, 0x21, 0x76, 0x04, 0xb3,
+	0xc5, 0xf5, 0xd6, 0x47, 0x35, 0xe7, 0x0c, 0xb5, 0x31, 0x8e, 0x51, 0xcc, 0x6d, 0xc3, 0xfc, 0xc0,
+	0x9d, 0xdd, 0x18, 0xd9, 0xd9, 0xc7, 0x52, 0x37, 0x27, 0x61, 0x15, 0x1a, 0x1f, 0xe0, 0xce, 0xcd,
+	0x6b, 0xf4, 0x70, 0x92, 0x11, 0x82, 0xaa, 0x6e, 0x4d, 0x4c, 0x2d, 0x24, 0xbb, 0xb0, 0x54, 0xf6,
+	0xdb, 0x7c, 0x3c, 0x6a, 0x52, 0x09, 0x59, 0xdd, 0xfe, 0x0b, 0x72, 0x2e, 0xbc, 0xf7, 0xe2, 0xfb,
+	0x95, 0x26, 0x5d, 0x5e, 0x69, 0xd2, 0xcf, 0x2b, 0x4d, 0xfa, 0xd2, 0xd3, 0x2a, 0x97, 0x3d, 0xad,
+	0xf2, 0xa3, 0xa7, 0x55, 0xde, 0x6e, 0x12, 0x9f, 0x7b, 0x67, 0x6d, 0xd3, 0xa1, 0x1d, 0x4b, 0x0c,
+	0x7e, 0x12, 0x62, 0xde, 0xa5, 0xf1, 0x69, 0x86, 0x02, 0xec, 0x12, 0x1c, 0x5b, 0xe7, 0xe2, 0x8f,
+	0xb5, 0x3d, 0x23, 0x1e, 0xfc, 0xed, 0xdf, 0x03, 0x00, 0x80, 0x67, 0xbd, 0xee, 0xd7, 0x07, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -381,6 +742,11 @@ type MsgClient interface {
 	// SignData should be used to create a digital signature attesting to the
 	// veracity of some piece of data.
 	AnchorData(ctx context.Context, in *MsgAnchorData, opts ...grpc.CallOption) (*MsgAnchorDataResponse, error)
+	// AnchorDataBatch anchors multiple pieces of data in a single message.
+	// Content which is already anchored is skipped rather than causing the
+	// whole batch to fail. An EventAnchorData is emitted for each newly
+	// anchored piece of data.
+	AnchorDataBatch(ctx context.Context, in *MsgAnchorDataBatch, opts ...grpc.CallOption) (*MsgAnchorDataBatchResponse, error)
 	// SignData allows for signing of an arbitrary piece of data on the
 	// blockchain. By "signing" data the signers are making a statement about the
 	// veracity of the data itself. It is like signing a legal document, meaning
@@ -399,17 +765,35 @@ type MsgClient interface {
 	// SignData implicitly calls AnchorData if the data was not already anchored.
 	//
 	// SignData can be called multiple times for the same content hash with different
-	// signers and those signers will be appended to the list of signers.
+	// signers and those signers will be appended to the list of signers. The time
+	// of the block at which each signer first signed is recorded, signing again
+	// doesn't change it.
 	SignData(ctx context.Context, in *MsgSignData, opts ...grpc.CallOption) (*MsgSignDataResponse, error)
 	// StoreRawData stores a piece of raw data corresponding to an ContentHash.Raw on the blockchain.
 	//
 	// StoreRawData implicitly calls AnchorData if the data was not already anchored.
 	//
+	// StoreRawData is idempotent, storing data which is already stored succeeds
+	// without any changes.
+	//
 	// The sender in StoreRawData is not attesting to the veracity of the underlying
 	// data. They can simply be a intermediary providing storage services.
 	// SignData should be used to create a digital signature attesting to the
 	// veracity of some piece of data.
 	StoreRawData(ctx context.Context, in *MsgStoreRawData, opts ...grpc.CallOption) (*MsgStoreRawDataResponse, error)
+	// StoreRawDataChunk stores a piece of raw data which is too large for a
+	// single transaction by sending it in multiple chunks.
+	//
+	// Chunks are staged until all of them have been received, at which point the
+	// data is verified against its content hash and stored as with StoreRawData.
+	// Chunks must be sent in order and by the same sender. Sending the first
+	// chunk again restarts the upload. Uploads which aren't complete within
+	// ChunkedUploadTimeout are discarded.
+	StoreRawDataChunk(ctx context.Context, in *MsgStoreRawDataChunk, opts ...grpc.CallOption) (*MsgStoreRawDataChunkResponse, error)
+	// DeleteStoredContent deletes raw data stored on-chain while preserving
+	// its anchor and signers. Content can only be deleted by the account which
+	// stored it or by the data module's deletion authority.
+	DeleteStoredContent(ctx context.Context, in *MsgDeleteStoredContent, opts ...grpc.CallOption) (*MsgDeleteStoredContentResponse, error)
 }
 
 type msgClient struct {
@@ -429,6 +813,15 @@ func (c *msgClient) AnchorData(ctx context.Context, in *MsgAnchorData, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) AnchorDataBatch(ctx context.Context, in *MsgAnchorDataBatch, opts ...grpc.CallOption) (*MsgAnchorDataBatchResponse, error) {
+	out := new(MsgAnchorDataBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.data.v1alpha2.Msg/AnchorDataBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SignData(ctx context.Context, in *MsgSignData, opts ...grpc.CallOption) (*MsgSignDataResponse, error) {
 	out := new(MsgSignDataResponse)
 	err := c.cc.Invoke(ctx, "/regen.data.v1alpha2.Msg/SignData", in, out, opts...)
@@ -447,6 +840,24 @@ func (c *msgClient) StoreRawData(ctx context.Context, in *MsgStoreRawData, opts
 	return out, nil
 }
 
+func (c *msgClient) StoreRawDataChunk(ctx context.Context, in *MsgStoreRawDataChunk, opts ...grpc.CallOption) (*MsgStoreRawDataChunkResponse, error) {
+	out := new(MsgStoreRawDataChunkResponse)
+	err := c.cc.Invoke(ctx, "/regen.data.v1alpha2.Msg/StoreRawDataChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteStoredContent(ctx context.Context, in *MsgDeleteStoredContent, opts ...grpc.CallOption) (*MsgDeleteStoredContentResponse, error) {
+	out := new(MsgDeleteStoredContentResponse)
+	err := c.cc.Invoke(ctx, "/regen.data.v1alpha2.Msg/DeleteStoredContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AnchorData "anchors" a piece of data to the blockchain based on its secure
@@ -457,6 +868,11 @@ type MsgServer interface {
 	// SignData should be used to create a digital signature attesting to the
 	// veracity of some piece of data.
 	AnchorData(context.Context, *MsgAnchorData) (*MsgAnchorDataResponse, error)
+	// AnchorDataBatch anchors multiple pieces of data in a single message.
+	// Content which is already anchored is skipped rather than causing the
+	// whole batch to fail. An EventAnchorData is emitted for each newly
+	// anchored piece of data.
+	AnchorDataBatch(context.Context, *MsgAnchorDataBatch) (*MsgAnchorDataBatchResponse, error)
 	// SignData allows for signing of an arbitrary piece of data on the
 	// blockchain. By "signing" data the signers are making a statement about the
 	// veracity of the data itself. It is like signing a legal document, meaning
@@ -475,17 +891,35 @@ type MsgServer interface {
 	// SignData implicitly calls AnchorData if the data was not already anchored.
 	//
 	// SignData can be called multiple times for the same content hash with different
-	// signers and those signers will be appended to the list of signers.
+	// signers and those signers will be appended to the list of signers. The time
+	// of the block at which each signer first signed is recorded, signing again
+	// doesn't change it.
 	SignData(context.Context, *MsgSignData) (*MsgSignDataResponse, error)
 	// StoreRawData stores a piece of raw data corresponding to an ContentHash.Raw on the blockchain.
 	//
 	// StoreRawData implicitly calls AnchorData if the data was not already anchored.
 	//
+	// StoreRawData is idempotent, storing data which is already stored succeeds
+	// without any changes.
+	//
 	// The sender in StoreRawData is not attesting to the veracity of the underlying
 	// data. They can simply be a intermediary providing storage services.
 	// SignData should be used to create a digital signature attesting to the
 	// veracity of some piece of data.
 	StoreRawData(context.Context, *MsgStoreRawData) (*MsgStoreRawDataResponse, error)
+	// StoreRawDataChunk stores a piece of raw data which is too large for a
+	// single transaction by sending it in multiple chunks.
+	//
+	// Chunks are staged until all of them have been received, at which point the
+	// data is verified against its content hash and stored as with StoreRawData.
+	// Chunks must be sent in order and by the same sender. Sending the first
+	// chunk again restarts the upload. Uploads which aren't complete within
+	// ChunkedUploadTimeout are discarded.
+	StoreRawDataChunk(context.Context, *MsgStoreRawDataChunk) (*MsgStoreRawDataChunkResponse, error)
+	// DeleteStoredContent deletes raw data stored on-chain while preserving
+	// its anchor and signers. Content can only be deleted by the account which
+	// stored it or by the data module's deletion authority.
+	DeleteStoredContent(context.Context, *MsgDeleteStoredContent) (*MsgDeleteStoredContentResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -495,12 +929,21 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) AnchorData(ctx context.Context, req *MsgAnchorData) (*MsgAnchorDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnchorData not implemented")
 }
+func (*UnimplementedMsgServer) AnchorDataBatch(ctx context.Context, req *MsgAnchorDataBatch) (*MsgAnchorDataBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnchorDataBatch not implemented")
+}
 func (*UnimplementedMsgServer) SignData(ctx context.Context, req *MsgSignData) (*MsgSignDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignData not implemented")
 }
 func (*UnimplementedMsgServer) StoreRawData(ctx context.Context, req *MsgStoreRawData) (*MsgStoreRawDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StoreRawData not implemented")
 }
+func (*UnimplementedMsgServer) StoreRawDataChunk(ctx context.Context, req *MsgStoreRawDataChunk) (*MsgStoreRawDataChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreRawDataChunk not implemented")
+}
+func (*UnimplementedMsgServer) DeleteStoredContent(ctx context.Context, req *MsgDeleteStoredContent) (*MsgDeleteStoredContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStoredContent not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -524,6 +967,24 @@ func _Msg_AnchorData_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AnchorDataBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAnchorDataBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AnchorDataBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.data.v1alpha2.Msg/AnchorDataBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AnchorDataBatch(ctx, req.(*MsgAnchorDataBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SignData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSignData)
 	if err := dec(in); err != nil {
@@ -560,15 +1021,55 @@ func _Msg_StoreRawData_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "regen.data.v1alpha2.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AnchorData",
-			Handler:    _Msg_AnchorData_Handler,
-		},
-		{
+func _Msg_StoreRawDataChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStoreRawDataChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StoreRawDataChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.data.v1alpha2.Msg/StoreRawDataChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StoreRawDataChunk(ctx, req.(*MsgStoreRawDataChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteStoredContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteStoredContent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteStoredContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.data.v1alpha2.Msg/DeleteStoredContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteStoredContent(ctx, req.(*MsgDeleteStoredContent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "regen.data.v1alpha2.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AnchorData",
+			Handler:    _Msg_AnchorData_Handler,
+		},
+		{
+			MethodName: "AnchorDataBatch",
+			Handler:    _Msg_AnchorDataBatch_Handler,
+		},
+		{
 			MethodName: "SignData",
 			Handler:    _Msg_SignData_Handler,
 		},
@@ -576,6 +1077,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StoreRawData",
 			Handler:    _Msg_StoreRawData_Handler,
 		},
+		{
+			MethodName: "StoreRawDataChunk",
+			Handler:    _Msg_StoreRawDataChunk_Handler,
+		},
+		{
+			MethodName: "DeleteStoredContent",
+			Handler:    _Msg_DeleteStoredContent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/data/v1alpha2/tx.proto",
@@ -658,6 +1167,85 @@ func (m *MsgAnchorDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAnchorDataBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnchorDataBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnchorDataBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hashes) > 0 {
+		for iNdEx := len(m.Hashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAnchorDataBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnchorDataBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnchorDataBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSignData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -790,6 +1378,183 @@ func (m *MsgStoreRawDataResponse) MarshalTo(dAtA []byte) (int, error) {
 }
 
 func (m *MsgStoreRawDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AlreadyStored {
+		i--
+		if m.AlreadyStored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStoreRawDataChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreRawDataChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreRawDataChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Total != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContentHash != nil {
+		{
+			size, err := m.ContentHash.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStoreRawDataChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreRawDataChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreRawDataChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AlreadyStored {
+		i--
+		if m.AlreadyStored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Complete {
+		i--
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteStoredContent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteStoredContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteStoredContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContentHash != nil {
+		{
+			size, err := m.ContentHash.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteStoredContentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteStoredContentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteStoredContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -838,33 +1603,65 @@ func (m *MsgAnchorDataResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgSignData) Size() (n int) {
+func (m *MsgAnchorDataBatch) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Hashes) > 0 {
+		for _, e := range m.Hashes {
+			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	if m.Hash != nil {
-		l = m.Hash.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
 	return n
 }
 
-func (m *MsgSignDataResponse) Size() (n int) {
+func (m *MsgAnchorDataBatchResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSignData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSignDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
 
 func (m *MsgStoreRawData) Size() (n int) {
 	if m == nil {
@@ -884,25 +1681,688 @@ func (m *MsgStoreRawData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgStoreRawDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AlreadyStored {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgStoreRawDataChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContentHash != nil {
+		l = m.ContentHash.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	if m.Total != 0 {
+		n += 1 + sovTx(uint64(m.Total))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStoreRawDataChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Complete {
+		n += 2
+	}
+	if m.AlreadyStored {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgDeleteStoredContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContentHash != nil {
+		l = m.ContentHash.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteStoredContentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAnchorData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnchorData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnchorData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hash == nil {
+				m.Hash = &ContentHash{}
+			}
+			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAnchorDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnchorDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnchorDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAnchorDataBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnchorDataBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnchorDataBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hashes = append(m.Hashes, &ContentHash{})
+			if err := m.Hashes[len(m.Hashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAnchorDataBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnchorDataBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnchorDataBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hash == nil {
+				m.Hash = &ContentHash_Graph{}
+			}
+			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgStoreRawDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAnchorData) Unmarshal(dAtA []byte) error {
+func (m *MsgStoreRawData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -925,10 +2385,10 @@ func (m *MsgAnchorData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAnchorData: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStoreRawData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAnchorData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStoreRawData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -965,7 +2425,7 @@ func (m *MsgAnchorData) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -992,13 +2452,47 @@ func (m *MsgAnchorData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hash == nil {
-				m.Hash = &ContentHash{}
+			if m.ContentHash == nil {
+				m.ContentHash = &ContentHash_Raw{}
 			}
-			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContentHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
+			if m.Content == nil {
+				m.Content = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1023,7 +2517,7 @@ func (m *MsgAnchorData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAnchorDataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgStoreRawDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1046,17 +2540,17 @@ func (m *MsgAnchorDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAnchorDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStoreRawDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAnchorDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStoreRawDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlreadyStored", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1066,28 +2560,12 @@ func (m *MsgAnchorDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Timestamp == nil {
-				m.Timestamp = &types.Timestamp{}
-			}
-			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.AlreadyStored = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1112,7 +2590,7 @@ func (m *MsgAnchorDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSignData) Unmarshal(dAtA []byte) error {
+func (m *MsgStoreRawDataChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1135,15 +2613,15 @@ func (m *MsgSignData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSignData: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStoreRawDataChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSignData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStoreRawDataChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1171,11 +2649,11 @@ func (m *MsgSignData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1202,13 +2680,85 @@ func (m *MsgSignData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hash == nil {
-				m.Hash = &ContentHash_Graph{}
+			if m.ContentHash == nil {
+				m.ContentHash = &ContentHash_Raw{}
 			}
-			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContentHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1233,7 +2783,7 @@ func (m *MsgSignData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSignDataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgStoreRawDataChunkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1256,12 +2806,52 @@ func (m *MsgSignDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSignDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStoreRawDataChunkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSignDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStoreRawDataChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlreadyStored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AlreadyStored = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1286,7 +2876,7 @@ func (m *MsgSignDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStoreRawData) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteStoredContent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1309,10 +2899,10 @@ func (m *MsgStoreRawData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreRawData: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteStoredContent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreRawData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteStoredContent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1383,40 +2973,6 @@ func (m *MsgStoreRawData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
-			if m.Content == nil {
-				m.Content = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1441,7 +2997,7 @@ func (m *MsgStoreRawData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStoreRawDataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteStoredContentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1464,10 +3020,10 @@ func (m *MsgStoreRawDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreRawDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteStoredContentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreRawDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteStoredContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1,9 +1,15 @@
 package data
 
 import (
+	"bytes"
+	"crypto"
 	"fmt"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	// register the hash functions used by digestAlgorithmHashes
+	_ "golang.org/x/crypto/blake2b"
+	_ "golang.org/x/crypto/sha3"
 )
 
 func (ch ContentHash) Validate() error {
@@ -26,6 +32,21 @@ func (chr ContentHash_Raw) Validate() error {
 	return chr.DigestAlgorithm.Validate(chr.Hash)
 }
 
+// Verify checks that the digest of content matches the hash, returning
+// ErrHashVerificationFailed if it doesn't.
+func (chr ContentHash_Raw) Verify(content []byte) error {
+	digest, err := chr.DigestAlgorithm.Digest(content)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(chr.Hash, digest) {
+		return ErrHashVerificationFailed
+	}
+
+	return nil
+}
+
 func (chg ContentHash_Graph) Validate() error {
 	err := chg.CanonicalizationAlgorithm.Validate()
 	if err != nil {
@@ -64,6 +85,30 @@ func (x DigestAlgorithm) Validate(hash []byte) error {
 
 var DigestalgorithmLength = map[DigestAlgorithm]int{
 	DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256: 256,
+	DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_512: 512,
+	DigestAlgorithm_DIGEST_ALGORITHM_SHA3_256:    256,
+}
+
+// Digest computes the digest of content using the digest algorithm.
+func (x DigestAlgorithm) Digest(content []byte) ([]byte, error) {
+	h, ok := digestAlgorithmHashes[x]
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("unsupported %T %s", x, x))
+	}
+
+	hash := h.New()
+	_, err := hash.Write(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return hash.Sum(nil), nil
+}
+
+var digestAlgorithmHashes = map[DigestAlgorithm]crypto.Hash{
+	DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256: crypto.BLAKE2b_256,
+	DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_512: crypto.BLAKE2b_512,
+	DigestAlgorithm_DIGEST_ALGORITHM_SHA3_256:    crypto.SHA3_256,
 }
 
 func (x GraphCanonicalizationAlgorithm) Validate() error {
@@ -175,16 +175,24 @@ const (
 	DigestAlgorithm_DIGEST_ALGORITHM_UNSPECIFIED DigestAlgorithm = 0
 	// BLAKE2b-256
 	DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256 DigestAlgorithm = 1
+	// BLAKE2b-512
+	DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_512 DigestAlgorithm = 2
+	// SHA3-256
+	DigestAlgorithm_DIGEST_ALGORITHM_SHA3_256 DigestAlgorithm = 3
 )
 
 var DigestAlgorithm_name = map[int32]string{
 	0: "DIGEST_ALGORITHM_UNSPECIFIED",
 	1: "DIGEST_ALGORITHM_BLAKE2B_256",
+	2: "DIGEST_ALGORITHM_BLAKE2B_512",
+	3: "DIGEST_ALGORITHM_SHA3_256",
 }
 
 var DigestAlgorithm_value = map[string]int32{
 	"DIGEST_ALGORITHM_UNSPECIFIED": 0,
 	"DIGEST_ALGORITHM_BLAKE2B_256": 1,
+	"DIGEST_ALGORITHM_BLAKE2B_512": 2,
+	"DIGEST_ALGORITHM_SHA3_256":    3,
 }
 
 func (x DigestAlgorithm) String() string {
@@ -550,6 +558,222 @@ func (m *SignerEntry) GetTimestamp() *types.Timestamp {
 	return nil
 }
 
+// ChunkedUpload is the staging state of raw data being stored in chunks with
+// Msg/StoreRawDataChunk.
+type ChunkedUpload struct {
+	// sender is the address of the account uploading the data.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// total is the total number of chunks of the data.
+	Total uint32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// received is the number of chunks received so far.
+	Received uint32 `protobuf:"varint,3,opt,name=received,proto3" json:"received,omitempty"`
+	// received_bytes is the size in bytes of the chunks received so far.
+	ReceivedBytes uint64 `protobuf:"varint,4,opt,name=received_bytes,json=receivedBytes,proto3" json:"received_bytes,omitempty"`
+	// expires_at is the time at which the upload is discarded if it isn't
+	// complete.
+	ExpiresAt *types.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *ChunkedUpload) Reset()         { *m = ChunkedUpload{} }
+func (m *ChunkedUpload) String() string { return proto.CompactTextString(m) }
+func (*ChunkedUpload) ProtoMessage()    {}
+func (*ChunkedUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e68eefb44eeab1df, []int{3}
+}
+func (m *ChunkedUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkedUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChunkedUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChunkedUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkedUpload.Merge(m, src)
+}
+func (m *ChunkedUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkedUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkedUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkedUpload proto.InternalMessageInfo
+
+func (m *ChunkedUpload) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ChunkedUpload) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ChunkedUpload) GetReceived() uint32 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+func (m *ChunkedUpload) GetReceivedBytes() uint64 {
+	if m != nil {
+		return m.ReceivedBytes
+	}
+	return 0
+}
+
+func (m *ChunkedUpload) GetExpiresAt() *types.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+// Params defines the updatable global parameters of the data module for use
+// with the x/params module.
+type Params struct {
+	// storage_gas_per_byte is the gas consumed per byte of content stored on-chain.
+	StorageGasPerByte uint64 `protobuf:"varint,1,opt,name=storage_gas_per_byte,json=storageGasPerByte,proto3" json:"storage_gas_per_byte,omitempty"`
+	// digest_gas_costs are the gas costs per byte of content for computing its
+	// digest with each supported digest algorithm.
+	DigestGasCosts []*DigestGasCost `protobuf:"bytes,2,rep,name=digest_gas_costs,json=digestGasCosts,proto3" json:"digest_gas_costs,omitempty"`
+	// max_data_size is the maximum size in bytes of content which can be stored on-chain.
+	MaxDataSize uint64 `protobuf:"varint,3,opt,name=max_data_size,json=maxDataSize,proto3" json:"max_data_size,omitempty"`
+	// deletion_authority is the address of the account which can delete any
+	// content stored on-chain. If empty, stored content can only be deleted
+	// by the account which stored it.
+	DeletionAuthority string `protobuf:"bytes,4,opt,name=deletion_authority,json=deletionAuthority,proto3" json:"deletion_authority,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e68eefb44eeab1df, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetStorageGasPerByte() uint64 {
+	if m != nil {
+		return m.StorageGasPerByte
+	}
+	return 0
+}
+
+func (m *Params) GetDigestGasCosts() []*DigestGasCost {
+	if m != nil {
+		return m.DigestGasCosts
+	}
+	return nil
+}
+
+func (m *Params) GetMaxDataSize() uint64 {
+	if m != nil {
+		return m.MaxDataSize
+	}
+	return 0
+}
+
+func (m *Params) GetDeletionAuthority() string {
+	if m != nil {
+		return m.DeletionAuthority
+	}
+	return ""
+}
+
+// DigestGasCost is the gas cost for computing a digest with a DigestAlgorithm.
+type DigestGasCost struct {
+	// digest_algorithm is the digest algorithm the gas cost applies to.
+	DigestAlgorithm DigestAlgorithm `protobuf:"varint,1,opt,name=digest_algorithm,json=digestAlgorithm,proto3,enum=regen.data.v1alpha2.DigestAlgorithm" json:"digest_algorithm,omitempty"`
+	// gas_per_byte is the gas consumed per byte of content for computing its digest.
+	GasPerByte uint64 `protobuf:"varint,2,opt,name=gas_per_byte,json=gasPerByte,proto3" json:"gas_per_byte,omitempty"`
+}
+
+func (m *DigestGasCost) Reset()         { *m = DigestGasCost{} }
+func (m *DigestGasCost) String() string { return proto.CompactTextString(m) }
+func (*DigestGasCost) ProtoMessage()    {}
+func (*DigestGasCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e68eefb44eeab1df, []int{5}
+}
+func (m *DigestGasCost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DigestGasCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DigestGasCost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DigestGasCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DigestGasCost.Merge(m, src)
+}
+func (m *DigestGasCost) XXX_Size() int {
+	return m.Size()
+}
+func (m *DigestGasCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_DigestGasCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DigestGasCost proto.InternalMessageInfo
+
+func (m *DigestGasCost) GetDigestAlgorithm() DigestAlgorithm {
+	if m != nil {
+		return m.DigestAlgorithm
+	}
+	return DigestAlgorithm_DIGEST_ALGORITHM_UNSPECIFIED
+}
+
+func (m *DigestGasCost) GetGasPerByte() uint64 {
+	if m != nil {
+		return m.GasPerByte
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("regen.data.v1alpha2.MediaType", MediaType_name, MediaType_value)
 	proto.RegisterEnum("regen.data.v1alpha2.GraphCanonicalizationAlgorithm", GraphCanonicalizationAlgorithm_name, GraphCanonicalizationAlgorithm_value)
@@ -560,60 +784,78 @@ func init() {
 	proto.RegisterType((*ContentHash_Graph)(nil), "regen.data.v1alpha2.ContentHash.Graph")
 	proto.RegisterType((*Content)(nil), "regen.data.v1alpha2.Content")
 	proto.RegisterType((*SignerEntry)(nil), "regen.data.v1alpha2.SignerEntry")
+	proto.RegisterType((*ChunkedUpload)(nil), "regen.data.v1alpha2.ChunkedUpload")
+	proto.RegisterType((*Params)(nil), "regen.data.v1alpha2.Params")
+	proto.RegisterType((*DigestGasCost)(nil), "regen.data.v1alpha2.DigestGasCost")
 }
 
 func init() { proto.RegisterFile("regen/data/v1alpha2/types.proto", fileDescriptor_e68eefb44eeab1df) }
 
 var fileDescriptor_e68eefb44eeab1df = []byte{
-	// 754 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0x41, 0x73, 0xf2, 0x44,
-	0x18, 0xc7, 0x49, 0x81, 0xf7, 0x95, 0xc5, 0x79, 0x59, 0xb7, 0xbe, 0x95, 0xa2, 0x93, 0x56, 0x74,
-	0x3a, 0x0e, 0xd3, 0x86, 0x96, 0x5a, 0xa7, 0x1e, 0x74, 0x26, 0x40, 0x08, 0x69, 0x49, 0xc8, 0x2c,
-	0x69, 0xad, 0xbd, 0x64, 0xb6, 0xb0, 0x86, 0x4c, 0x49, 0xc2, 0x2c, 0x41, 0xac, 0x47, 0x6f, 0xde,
-	0xfc, 0x16, 0x8e, 0xdf, 0xc4, 0x63, 0x8f, 0x1e, 0x9d, 0xd6, 0x0f, 0xe2, 0xb0, 0x40, 0x8b, 0x2b,
-	0x6d, 0x6f, 0xef, 0x6d, 0xf7, 0xd9, 0xdf, 0xff, 0xff, 0xfc, 0x27, 0xfb, 0xec, 0x04, 0x6c, 0x31,
-	0xea, 0xd1, 0xb0, 0xdc, 0x23, 0x31, 0x29, 0xff, 0x78, 0x40, 0x06, 0xc3, 0x3e, 0xa9, 0x94, 0xe3,
-	0x9b, 0x21, 0x1d, 0x29, 0x43, 0x16, 0xc5, 0x11, 0x5a, 0xe7, 0x80, 0x32, 0x05, 0x94, 0x05, 0x50,
-	0xd8, 0xf2, 0xa2, 0xc8, 0x1b, 0xd0, 0x32, 0x47, 0xae, 0xc6, 0x3f, 0x94, 0x63, 0x3f, 0xa0, 0xa3,
-	0x98, 0x04, 0xc3, 0x99, 0xaa, 0x20, 0x8b, 0x40, 0x6f, 0xcc, 0x48, 0xec, 0x47, 0xe1, 0xec, 0xbc,
-	0xf8, 0x4f, 0x0a, 0x64, 0x6b, 0x51, 0x18, 0xd3, 0x30, 0x6e, 0x92, 0x51, 0x1f, 0x1d, 0x83, 0x24,
-	0x23, 0x93, 0xbc, 0xb4, 0x2d, 0x7d, 0x91, 0xad, 0x7c, 0xae, 0xac, 0xe8, 0xa9, 0x2c, 0xe1, 0x0a,
-	0x26, 0x93, 0x66, 0x02, 0x4f, 0x25, 0xe8, 0x5b, 0x90, 0xf6, 0x18, 0x19, 0xf6, 0xf3, 0x6b, 0x5c,
-	0xbb, 0xf3, 0xa2, 0x56, 0x9f, 0xd2, 0xcd, 0x04, 0x9e, 0xc9, 0x0a, 0x7f, 0x48, 0x20, 0x89, 0xc9,
-	0x04, 0x21, 0x90, 0xea, 0x93, 0x51, 0x9f, 0x47, 0x78, 0x1f, 0xf3, 0x35, 0x6a, 0x03, 0xd8, 0xf3,
-	0x3d, 0x3a, 0x8a, 0x5d, 0x32, 0xf0, 0x22, 0xe6, 0xc7, 0xfd, 0x80, 0xb7, 0x79, 0xf3, 0x44, 0xc4,
-	0x3a, 0x87, 0xd5, 0x05, 0x8b, 0x73, 0xbd, 0xff, 0x16, 0xd0, 0x37, 0x00, 0x04, 0xb4, 0xe7, 0x13,
-	0x77, 0xfa, 0x85, 0xf3, 0x49, 0x6e, 0x25, 0xaf, 0xb4, 0x32, 0xa7, 0x98, 0x73, 0x33, 0xa4, 0x38,
-	0x13, 0x2c, 0x96, 0x85, 0xdf, 0xd7, 0x40, 0x9a, 0xc7, 0x7f, 0x37, 0x69, 0x19, 0x28, 0x74, 0x49,
-	0x18, 0x85, 0x7e, 0x97, 0x0c, 0xfc, 0x9f, 0xf9, 0xf5, 0x2d, 0x59, 0xcf, 0xd2, 0x1f, 0xae, 0xb4,
-	0xe6, 0x21, 0x6b, 0x82, 0xf6, 0xb1, 0xd3, 0x66, 0xf7, 0xa9, 0x23, 0xa4, 0x81, 0x6c, 0x40, 0xd9,
-	0xf5, 0x80, 0xba, 0x31, 0xa3, 0x34, 0x9f, 0x7a, 0x26, 0x3f, 0x6f, 0x62, 0x72, 0xd8, 0x61, 0x94,
-	0x62, 0x10, 0x3c, 0xac, 0xab, 0x69, 0x90, 0x1c, 0x8d, 0x83, 0xe2, 0x1e, 0x78, 0x3d, 0xbf, 0x7a,
-	0xf4, 0x31, 0x78, 0x8f, 0x91, 0x89, 0x3b, 0xb5, 0x98, 0x7d, 0xb5, 0x66, 0x02, 0xbf, 0x66, 0x64,
-	0x52, 0x27, 0x31, 0x59, 0xe0, 0x2e, 0xc8, 0x76, 0x7c, 0x2f, 0xa4, 0x4c, 0x0b, 0x63, 0x76, 0x83,
-	0x36, 0xc0, 0xab, 0x11, 0xdf, 0x72, 0x41, 0x06, 0xcf, 0x77, 0xe8, 0x18, 0x64, 0x1e, 0xe6, 0x7d,
-	0x3e, 0x76, 0x05, 0x65, 0x36, 0xf0, 0xca, 0x62, 0xe0, 0x15, 0x67, 0x41, 0xe0, 0x47, 0xb8, 0xf4,
-	0x6b, 0x12, 0x64, 0x1e, 0x6e, 0x16, 0x15, 0xc0, 0x86, 0xa9, 0xd5, 0x0d, 0xd5, 0x75, 0xbe, 0xb7,
-	0x35, 0xf7, 0xcc, 0xea, 0xd8, 0x5a, 0xcd, 0x68, 0x18, 0x5a, 0x1d, 0x26, 0xd0, 0x26, 0x78, 0xbb,
-	0x74, 0xe6, 0x68, 0x17, 0x8e, 0x6b, 0xb7, 0x54, 0xc3, 0x82, 0x12, 0x5a, 0x07, 0xb9, 0xa5, 0xa3,
-	0x93, 0x4e, 0xdb, 0x82, 0x6b, 0x08, 0x81, 0x37, 0x4b, 0xc5, 0x5a, 0xe7, 0x1c, 0x26, 0x85, 0xda,
-	0x85, 0xd9, 0x82, 0x29, 0xa1, 0x66, 0xd7, 0x1b, 0x30, 0x2d, 0x18, 0x3a, 0x46, 0xa3, 0x01, 0xa1,
-	0x00, 0x9e, 0xd8, 0x3a, 0xfc, 0x40, 0x14, 0x5b, 0x3a, 0x44, 0x42, 0xad, 0x73, 0xae, 0xc3, 0x75,
-	0xc1, 0xf0, 0x3b, 0xad, 0x6a, 0xc3, 0x0f, 0x85, 0xa2, 0x7a, 0x6e, 0x34, 0xe0, 0x5b, 0x41, 0xad,
-	0x1b, 0x0d, 0xb8, 0x21, 0x82, 0xd3, 0x36, 0x1f, 0x09, 0x45, 0xd3, 0xd6, 0x74, 0xb8, 0x2d, 0xa8,
-	0x4d, 0xfb, 0x4b, 0xf8, 0xe9, 0xff, 0x7b, 0x9b, 0xb0, 0x28, 0x80, 0x6d, 0x5d, 0x87, 0x9f, 0x95,
-	0x7e, 0x91, 0x80, 0xfc, 0xfc, 0x9c, 0xa2, 0x7d, 0xb0, 0xab, 0x63, 0xd5, 0x6e, 0xba, 0x35, 0xd5,
-	0x6a, 0x5b, 0x46, 0x4d, 0x6d, 0x19, 0x97, 0xaa, 0x63, 0xb4, 0x2d, 0x57, 0x6d, 0xe9, 0x6d, 0x6c,
-	0x38, 0x4d, 0x53, 0xb8, 0x36, 0x05, 0x94, 0x5e, 0x56, 0xe0, 0xba, 0xa5, 0x56, 0xf6, 0x0f, 0x8e,
-	0xa0, 0x54, 0xfa, 0x1a, 0xe4, 0x84, 0x31, 0x46, 0x3b, 0xa0, 0x38, 0xb3, 0x30, 0x35, 0x7c, 0xda,
-	0xd2, 0x5c, 0x07, 0x6b, 0x9a, 0x6b, 0xb5, 0x2d, 0x61, 0x42, 0x4a, 0x67, 0x20, 0x27, 0xbc, 0x60,
-	0xb4, 0x0d, 0x3e, 0xa9, 0x1b, 0xba, 0xd6, 0x71, 0x9e, 0xcc, 0xb7, 0x8a, 0xa8, 0xb6, 0xd4, 0x53,
-	0xad, 0x52, 0x75, 0x2b, 0x47, 0x5f, 0x41, 0xa9, 0xda, 0xf8, 0xf3, 0x4e, 0x96, 0x6e, 0xef, 0x64,
-	0xe9, 0xef, 0x3b, 0x59, 0xfa, 0xed, 0x5e, 0x4e, 0xdc, 0xde, 0xcb, 0x89, 0xbf, 0xee, 0xe5, 0xc4,
-	0xe5, 0xae, 0xe7, 0xc7, 0xfd, 0xf1, 0x95, 0xd2, 0x8d, 0x82, 0x32, 0x7f, 0x8f, 0x7b, 0x21, 0x8d,
-	0x27, 0x11, 0xbb, 0x9e, 0xef, 0x06, 0xb4, 0xe7, 0x51, 0x56, 0xfe, 0x89, 0xff, 0x4c, 0xae, 0x5e,
-	0xf1, 0x97, 0x70, 0xf8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x65, 0x79, 0xe8, 0x04, 0x61, 0x06,
-	0x00, 0x00,
+	// 1004 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0xc7, 0x45, 0xbd, 0x38, 0xf1, 0x28, 0xb2, 0xe9, 0xb5, 0xe3, 0x47, 0xd6, 0xd3, 0x2a, 0xae,
+	0xda, 0x06, 0x81, 0x11, 0x4b, 0xb1, 0x5c, 0x17, 0xc9, 0xa1, 0x05, 0x28, 0x89, 0xa2, 0x98, 0xe8,
+	0x0d, 0x2b, 0xc5, 0x4d, 0x73, 0x21, 0xd6, 0xe2, 0x96, 0x22, 0x2c, 0x92, 0xc2, 0x72, 0x15, 0xd9,
+	0x3e, 0xe6, 0xd6, 0x5b, 0x4f, 0xfd, 0x0a, 0x45, 0x3f, 0x43, 0xbf, 0x40, 0x8f, 0x39, 0x16, 0x3d,
+	0x15, 0x76, 0x3f, 0x48, 0xc1, 0xd5, 0x4b, 0x64, 0xfa, 0xed, 0x50, 0xa0, 0xb7, 0x9d, 0x99, 0xdf,
+	0xcc, 0x7f, 0x34, 0x3b, 0x4b, 0x08, 0x1e, 0x31, 0x6a, 0x51, 0xb7, 0x60, 0x12, 0x4e, 0x0a, 0xef,
+	0xf6, 0xc8, 0x60, 0xd8, 0x27, 0xc5, 0x02, 0x3f, 0x1d, 0x52, 0x3f, 0x3f, 0x64, 0x1e, 0xf7, 0xd0,
+	0xba, 0x00, 0xf2, 0x01, 0x90, 0x9f, 0x01, 0x99, 0x47, 0x96, 0xe7, 0x59, 0x03, 0x5a, 0x10, 0xc8,
+	0xd1, 0xe8, 0x87, 0x02, 0xb7, 0x1d, 0xea, 0x73, 0xe2, 0x0c, 0x27, 0x59, 0x99, 0x6c, 0x18, 0x30,
+	0x47, 0x8c, 0x70, 0xdb, 0x73, 0x27, 0xf1, 0xdc, 0xdf, 0x71, 0x48, 0x96, 0x3d, 0x97, 0x53, 0x97,
+	0xd7, 0x88, 0xdf, 0x47, 0xcf, 0x21, 0xc6, 0xc8, 0x38, 0x2d, 0x6d, 0x4b, 0x4f, 0x92, 0xc5, 0x2f,
+	0xf2, 0xd7, 0x68, 0xe6, 0x17, 0xf0, 0x3c, 0x26, 0xe3, 0x5a, 0x04, 0x07, 0x29, 0xe8, 0x5b, 0x48,
+	0x58, 0x8c, 0x0c, 0xfb, 0xe9, 0xa8, 0xc8, 0x7d, 0x7c, 0x67, 0xae, 0x16, 0xd0, 0xb5, 0x08, 0x9e,
+	0xa4, 0x65, 0x7e, 0x95, 0x20, 0x86, 0xc9, 0x18, 0x21, 0x88, 0xf7, 0x89, 0xdf, 0x17, 0x2d, 0x3c,
+	0xc0, 0xe2, 0x8c, 0x5a, 0x20, 0x9b, 0xb6, 0x45, 0x7d, 0x6e, 0x90, 0x81, 0xe5, 0x31, 0x9b, 0xf7,
+	0x1d, 0x21, 0xb3, 0x72, 0x43, 0x8b, 0x15, 0x01, 0x2b, 0x33, 0x16, 0xaf, 0x9a, 0x97, 0x1d, 0xe8,
+	0x1b, 0x00, 0x87, 0x9a, 0x36, 0x31, 0x82, 0x09, 0xa7, 0x63, 0xa2, 0x54, 0xf6, 0xda, 0x52, 0x8d,
+	0x00, 0xeb, 0x9e, 0x0e, 0x29, 0x5e, 0x76, 0x66, 0xc7, 0xcc, 0x2f, 0x51, 0x48, 0x88, 0xf6, 0xff,
+	0x9b, 0x6e, 0x19, 0x64, 0x7a, 0xc4, 0xf5, 0x5c, 0xbb, 0x47, 0x06, 0xf6, 0x99, 0xb8, 0xbe, 0x85,
+	0xd2, 0x93, 0xee, 0xf7, 0xaf, 0x2d, 0x2d, 0x9a, 0x2c, 0x87, 0x72, 0x3f, 0x2a, 0x6d, 0xf5, 0x6e,
+	0x0a, 0x21, 0x15, 0x92, 0x0e, 0x65, 0xc7, 0x03, 0x6a, 0x70, 0x46, 0x69, 0x3a, 0x7e, 0x4b, 0xff,
+	0x42, 0xa4, 0x21, 0xe0, 0x2e, 0xa3, 0x14, 0x83, 0x33, 0x3f, 0x97, 0x12, 0x10, 0xf3, 0x47, 0x4e,
+	0x6e, 0x17, 0xee, 0x4d, 0xaf, 0x1e, 0xfd, 0x1f, 0xee, 0x33, 0x32, 0x36, 0x82, 0x12, 0x93, 0xa9,
+	0xd5, 0x22, 0xf8, 0x1e, 0x23, 0xe3, 0x0a, 0xe1, 0x64, 0x86, 0x1b, 0x90, 0xec, 0xd8, 0x96, 0x4b,
+	0x99, 0xea, 0x72, 0x76, 0x8a, 0x36, 0x61, 0xc9, 0x17, 0xa6, 0x48, 0x58, 0xc6, 0x53, 0x0b, 0x3d,
+	0x87, 0xe5, 0xf9, 0xbe, 0x4f, 0xd7, 0x2e, 0x93, 0x9f, 0x2c, 0x7c, 0x7e, 0xb6, 0xf0, 0xf9, 0xee,
+	0x8c, 0xc0, 0x1f, 0xe1, 0xdc, 0x6f, 0x12, 0xa4, 0xca, 0xfd, 0x91, 0x7b, 0x4c, 0xcd, 0xd7, 0xc3,
+	0x81, 0x47, 0x4c, 0xa1, 0x41, 0x5d, 0x73, 0x41, 0x43, 0x58, 0x68, 0x03, 0x12, 0xdc, 0xe3, 0x64,
+	0x20, 0xea, 0xa7, 0xf0, 0xc4, 0x40, 0x19, 0xb8, 0xcf, 0x68, 0x8f, 0xda, 0xef, 0xa8, 0x29, 0xe6,
+	0x9f, 0xc2, 0x73, 0x1b, 0x7d, 0x09, 0x2b, 0xb3, 0xb3, 0x71, 0x74, 0xca, 0xa9, 0x2f, 0x86, 0x17,
+	0xc7, 0xa9, 0x99, 0xb7, 0x14, 0x38, 0xd1, 0x0b, 0x00, 0x7a, 0x32, 0xb4, 0x19, 0xf5, 0x0d, 0xc2,
+	0xd3, 0x89, 0xbb, 0xbb, 0x9f, 0xd2, 0x0a, 0xcf, 0xfd, 0x29, 0xc1, 0x52, 0x9b, 0x30, 0xe2, 0xf8,
+	0xa8, 0x00, 0x1b, 0x3e, 0xf7, 0x18, 0xb1, 0xa8, 0x61, 0x11, 0xdf, 0x18, 0x52, 0x26, 0x34, 0xc5,
+	0x8f, 0x88, 0xe3, 0xb5, 0x69, 0x4c, 0x23, 0x7e, 0x9b, 0xb2, 0x40, 0x17, 0xd5, 0xe7, 0xcb, 0x19,
+	0xf0, 0x3d, 0xcf, 0xe7, 0x7e, 0x3a, 0xba, 0x1d, 0x7b, 0x92, 0x2c, 0xe6, 0x6e, 0x59, 0x4e, 0x8d,
+	0xf8, 0x65, 0xcf, 0xe7, 0x78, 0xc5, 0x5c, 0x34, 0x7d, 0x94, 0x83, 0x94, 0x43, 0x4e, 0xc4, 0x65,
+	0x1a, 0xbe, 0x7d, 0x36, 0x79, 0x4a, 0x71, 0x9c, 0x74, 0xc8, 0x49, 0x70, 0x9f, 0x1d, 0xfb, 0x8c,
+	0xa2, 0x5d, 0x40, 0x26, 0x1d, 0xd0, 0xc9, 0xd6, 0x8e, 0x78, 0x3f, 0x58, 0xb0, 0x53, 0x31, 0x93,
+	0x65, 0xbc, 0x36, 0x8b, 0x28, 0xb3, 0x40, 0xee, 0xbd, 0x04, 0xa9, 0x4b, 0xa2, 0xd7, 0xbe, 0x27,
+	0xe9, 0xdf, 0xbc, 0xa7, 0x6d, 0x78, 0x70, 0x69, 0x58, 0x51, 0xd1, 0x34, 0x58, 0xf3, 0x29, 0xed,
+	0xfc, 0x18, 0x83, 0xe5, 0xf9, 0xcb, 0x47, 0x19, 0xd8, 0x6c, 0xa8, 0x15, 0x5d, 0x31, 0xba, 0xdf,
+	0xb7, 0x55, 0xe3, 0x75, 0xb3, 0xd3, 0x56, 0xcb, 0x7a, 0x55, 0x57, 0x2b, 0x72, 0x04, 0x6d, 0xc1,
+	0xc3, 0x85, 0x58, 0x57, 0x7d, 0xd3, 0x35, 0xda, 0x75, 0x45, 0x6f, 0xca, 0x12, 0x5a, 0x87, 0xd5,
+	0x85, 0xd0, 0xcb, 0x4e, 0xab, 0x29, 0x47, 0x11, 0x82, 0x95, 0x05, 0x67, 0xb9, 0x73, 0x28, 0xc7,
+	0x42, 0xbe, 0x37, 0x8d, 0xba, 0x1c, 0x0f, 0xf9, 0xda, 0x95, 0xaa, 0x9c, 0x08, 0x15, 0xec, 0xea,
+	0xd5, 0xaa, 0x2c, 0x87, 0xc0, 0x97, 0x6d, 0x4d, 0x5e, 0x0b, 0x27, 0x37, 0x35, 0x19, 0x85, 0x7c,
+	0x9d, 0x43, 0x4d, 0x5e, 0x0f, 0x15, 0xfc, 0x4e, 0x2d, 0xb5, 0xe5, 0x8d, 0x90, 0x53, 0x39, 0xd4,
+	0xab, 0xf2, 0xc3, 0x50, 0xb6, 0xa6, 0x57, 0xe5, 0xcd, 0x30, 0x18, 0xc8, 0xfc, 0x2f, 0xe4, 0x6c,
+	0xb4, 0x55, 0x4d, 0xde, 0x0e, 0x65, 0x37, 0xda, 0x5f, 0xc9, 0x9f, 0x5d, 0xd5, 0x6e, 0xc8, 0xb9,
+	0x10, 0xd8, 0xd2, 0x34, 0xf9, 0xf3, 0x9d, 0xf7, 0x12, 0x64, 0x6f, 0xff, 0x8e, 0xa1, 0x67, 0xf0,
+	0x54, 0xc3, 0x4a, 0xbb, 0x66, 0x94, 0x95, 0x66, 0xab, 0xa9, 0x97, 0x95, 0xba, 0xfe, 0x56, 0xe9,
+	0xea, 0xad, 0xa6, 0xa1, 0xd4, 0xb5, 0x16, 0xd6, 0xbb, 0xb5, 0x46, 0xe8, 0xda, 0xf2, 0xb0, 0x73,
+	0x77, 0x06, 0xae, 0x34, 0x95, 0xe2, 0xb3, 0xbd, 0x03, 0x59, 0xda, 0x79, 0x01, 0xab, 0xa1, 0xcf,
+	0x1c, 0x7a, 0x0c, 0xb9, 0x49, 0x89, 0x86, 0x8a, 0x5f, 0xd5, 0x55, 0xa3, 0x8b, 0x55, 0xd5, 0x68,
+	0xb6, 0x9a, 0xa1, 0x0d, 0xd9, 0xf9, 0x59, 0x82, 0xd5, 0xca, 0x95, 0x0d, 0xfc, 0xa4, 0xa2, 0x6b,
+	0x6a, 0xa7, 0x7b, 0x63, 0x83, 0xd7, 0x11, 0xa5, 0xba, 0xf2, 0x4a, 0x2d, 0x96, 0x8c, 0xe2, 0xc1,
+	0xd7, 0xb2, 0x74, 0x2b, 0x71, 0xb0, 0x57, 0x94, 0xa3, 0xe8, 0x53, 0xd8, 0xba, 0x42, 0x74, 0x6a,
+	0xca, 0xbe, 0x28, 0x10, 0x2b, 0x55, 0x7f, 0x3f, 0xcf, 0x4a, 0x1f, 0xce, 0xb3, 0xd2, 0x5f, 0xe7,
+	0x59, 0xe9, 0xa7, 0x8b, 0x6c, 0xe4, 0xc3, 0x45, 0x36, 0xf2, 0xc7, 0x45, 0x36, 0xf2, 0xf6, 0xa9,
+	0x65, 0xf3, 0xfe, 0xe8, 0x28, 0xdf, 0xf3, 0x9c, 0x82, 0x78, 0x61, 0xbb, 0x2e, 0xe5, 0x63, 0x8f,
+	0x1d, 0x4f, 0xad, 0x01, 0x35, 0x2d, 0xca, 0x0a, 0x27, 0xe2, 0xef, 0xca, 0xd1, 0x92, 0xf8, 0x5a,
+	0xed, 0xff, 0x33, 0x00, 0x27, 0x98, 0x94, 0x24, 0xc3, 0x08, 0x00, 0x00,
 }
 
 func (m *ContentHash) Marshal() (dAtA []byte, err error) {
@@ -865,6 +1107,150 @@ func (m *SignerEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChunkedUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkedUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkedUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != nil {
+		{
+			size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ReceivedBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReceivedBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Received != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Received))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Total != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeletionAuthority) > 0 {
+		i -= len(m.DeletionAuthority)
+		copy(dAtA[i:], m.DeletionAuthority)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DeletionAuthority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxDataSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxDataSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DigestGasCosts) > 0 {
+		for iNdEx := len(m.DigestGasCosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DigestGasCosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.StorageGasPerByte != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StorageGasPerByte))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DigestGasCost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DigestGasCost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DigestGasCost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasPerByte != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasPerByte))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DigestAlgorithm != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DigestAlgorithm))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -994,6 +1380,72 @@ func (m *SignerEntry) Size() (n int) {
 	return n
 }
 
+func (m *ChunkedUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovTypes(uint64(m.Total))
+	}
+	if m.Received != 0 {
+		n += 1 + sovTypes(uint64(m.Received))
+	}
+	if m.ReceivedBytes != 0 {
+		n += 1 + sovTypes(uint64(m.ReceivedBytes))
+	}
+	if m.ExpiresAt != nil {
+		l = m.ExpiresAt.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageGasPerByte != 0 {
+		n += 1 + sovTypes(uint64(m.StorageGasPerByte))
+	}
+	if len(m.DigestGasCosts) > 0 {
+		for _, e := range m.DigestGasCosts {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxDataSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxDataSize))
+	}
+	l = len(m.DeletionAuthority)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DigestGasCost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DigestAlgorithm != 0 {
+		n += 1 + sovTypes(uint64(m.DigestAlgorithm))
+	}
+	if m.GasPerByte != 0 {
+		n += 1 + sovTypes(uint64(m.GasPerByte))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1599,6 +2051,432 @@ func (m *SignerEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChunkedUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkedUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkedUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			m.Received = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Received |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedBytes", wireType)
+			}
+			m.ReceivedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = &types.Timestamp{}
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageGasPerByte", wireType)
+			}
+			m.StorageGasPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageGasPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DigestGasCosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DigestGasCosts = append(m.DigestGasCosts, &DigestGasCost{})
+			if err := m.DigestGasCosts[len(m.DigestGasCosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataSize", wireType)
+			}
+			m.MaxDataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletionAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DigestGasCost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DigestGasCost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DigestGasCost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DigestAlgorithm", wireType)
+			}
+			m.DigestAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DigestAlgorithm |= DigestAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerByte", wireType)
+			}
+			m.GasPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
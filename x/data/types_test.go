@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
 )
 
 func TestContentHash_Validate(t *testing.T) {
@@ -317,3 +318,32 @@ func TestMediaType_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestDigestAlgorithm_Digest(t *testing.T) {
+	// ensure every supported digest algorithm produces a digest of the expected length
+	for x, nBits := range DigestalgorithmLength {
+		digest, err := x.Digest([]byte("abc"))
+		require.NoError(t, err)
+		require.Len(t, digest, nBits/8)
+		require.NoError(t, x.Validate(digest))
+	}
+
+	_, err := DigestAlgorithm_DIGEST_ALGORITHM_UNSPECIFIED.Digest([]byte("abc"))
+	require.Error(t, err)
+}
+
+func TestContentHash_Raw_Verify(t *testing.T) {
+	content := []byte("some content")
+	hash := sha3.Sum256(content)
+	chr := ContentHash_Raw{
+		Hash:            hash[:],
+		DigestAlgorithm: DigestAlgorithm_DIGEST_ALGORITHM_SHA3_256,
+		MediaType:       MediaType_MEDIA_TYPE_UNSPECIFIED,
+	}
+
+	require.NoError(t, chr.Verify(content))
+	require.ErrorIs(t, chr.Verify([]byte("tampered content")), ErrHashVerificationFailed)
+
+	chr.DigestAlgorithm = DigestAlgorithm_DIGEST_ALGORITHM_UNSPECIFIED
+	require.Error(t, chr.Verify(content))
+}
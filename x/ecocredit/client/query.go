@@ -23,10 +23,16 @@ func QueryCmd(name string) *cobra.Command {
 		QueryClassesCmd(),
 		QueryClassInfoCmd(),
 		QueryBatchesCmd(),
+		QueryBatchesByDateRangeCmd(),
 		QueryBatchInfoCmd(),
 		QueryBalanceCmd(),
 		QuerySupplyCmd(),
+		QueryRetiredSupplyCmd(),
+		QueryClassRetirementByAccountCmd(),
+		QueryCancellationsCmd(),
+		QueryCancellationsByReasonCmd(),
 		QueryCreditTypesCmd(),
+		QueryCreditTypeSeqCmd(),
 	)
 	return cmd
 }
@@ -107,6 +113,54 @@ func QueryBatchesCmd() *cobra.Command {
 	return qflags(cmd)
 }
 
+func QueryBatchesByDateRangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batches-by-date-range [class_id]",
+		Short: "List all credit batches in the given class overlapping a date range with pagination flags",
+		Long: `List all credit batches in the given class whose start and end dates overlap
+the given date range. Both bounds of the range are inclusive and optional.
+
+Flags:
+  start-date: the beginning of the date range, formatted as YYYY-MM-DD
+  end-date:   the end of the date range, formatted as YYYY-MM-DD`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &ecocredit.QueryBatchesByDateRangeRequest{ClassId: args[0]}
+			if startDateStr, _ := cmd.Flags().GetString(FlagStartDate); startDateStr != "" {
+				startDate, err := ParseDate("start_date", startDateStr)
+				if err != nil {
+					return err
+				}
+				req.StartDate = &startDate
+			}
+			if endDateStr, _ := cmd.Flags().GetString(FlagEndDate); endDateStr != "" {
+				endDate, err := ParseDate("end_date", endDateStr)
+				if err != nil {
+					return err
+				}
+				req.EndDate = &endDate
+			}
+
+			req.Pagination, err = client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.BatchesByDateRange(cmd.Context(), req)
+			return print(ctx, res, err)
+		},
+	}
+	cmd.Flags().String(FlagStartDate, "", "The beginning of the date range (YYYY-MM-DD)")
+	cmd.Flags().String(FlagEndDate, "", "The end of the date range (YYYY-MM-DD)")
+	flags.AddPaginationFlagsToCmd(cmd, "batches-by-date-range")
+	return qflags(cmd)
+}
+
 func QueryBatchInfoCmd() *cobra.Command {
 	return qflags(&cobra.Command{
 		Use:   "batch-info [batch_denom]",
@@ -165,6 +219,99 @@ func QuerySupplyCmd() *cobra.Command {
 	})
 }
 
+func QueryRetiredSupplyCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:   "retired-supply [class_id]",
+		Short: "Retrieve the retired supply of the credit class",
+		Long:  "Retrieve the retired supply summed across all credit batches of the credit class",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := c.RetiredSupply(cmd.Context(), &ecocredit.QueryRetiredSupplyRequest{
+				ClassId: args[0],
+			})
+			return print(ctx, res, err)
+		},
+	})
+}
+
+func QueryClassRetirementByAccountCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:   "class-retirement [class_id] [account]",
+		Short: "Retrieve the retired balance of an account in the credit class",
+		Long:  "Retrieve the retired balance of an account summed across all credit batches of the credit class",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := c.ClassRetirementByAccount(cmd.Context(), &ecocredit.QueryClassRetirementByAccountRequest{
+				ClassId: args[0], Account: args[1],
+			})
+			return print(ctx, res, err)
+		},
+	})
+}
+
+func QueryCancellationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancellations [batch_denom]",
+		Short: "List the cancellations of credits of the credit batch with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.Cancellations(cmd.Context(), &ecocredit.QueryCancellationsRequest{
+				BatchDenom: args[0],
+				Pagination: pagination,
+			})
+			return print(ctx, res, err)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "cancellations")
+	return qflags(cmd)
+}
+
+func QueryCancellationsByReasonCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancellations-by-reason [class_id] [reason_category]",
+		Short: "List the cancellations of credits of the credit class with the reason category with pagination flags",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.CancellationsByReason(cmd.Context(), &ecocredit.QueryCancellationsByReasonRequest{
+				ClassId:        args[0],
+				ReasonCategory: args[1],
+				Pagination:     pagination,
+			})
+			return print(ctx, res, err)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "cancellations-by-reason")
+	return qflags(cmd)
+}
+
 func QueryCreditTypesCmd() *cobra.Command {
 	return qflags(&cobra.Command{
 		Use:   "types",
@@ -181,3 +328,25 @@ func QueryCreditTypesCmd() *cobra.Command {
 		},
 	})
 }
+
+func QueryCreditTypeSeqCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:   "credit-type-seq [abbreviation]",
+		Short: "Retrieve the sequence number of the credit classes of a credit type",
+		Long: `Retrieve the sequence number of the credit classes of a credit type, which
+is the number of credit classes created with it. The next credit class of the
+credit type gets the sequence number plus one, e.g. the next class ID is C03 if
+the sequence number of the credit type with abbreviation C is 2.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := c.CreditTypeSeq(cmd.Context(), &ecocredit.QueryCreditTypeSeqRequest{
+				Abbreviation: args[0],
+			})
+			return print(ctx, res, err)
+		},
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/regen-network/regen-ledger/types/testutil/cli"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/client"
@@ -104,7 +105,7 @@ func (s *IntegrationTestSuite) TestQueryClassInfo() {
 			name:           "invalid credit class",
 			args:           []string{"abcde"},
 			expectErr:      true,
-			expectedErrMsg: "not found: invalid request",
+			expectedErrMsg: "class ID didn't match the format",
 		},
 		{
 			name:      "valid credit class",
@@ -117,6 +118,8 @@ func (s *IntegrationTestSuite) TestQueryClassInfo() {
 				Metadata:   s.classInfo.Metadata,
 				CreditType: s.classInfo.CreditType,
 				NumBatches: 4,
+				// 4 batches of 100.000001 credits
+				IssuedSupply: "400.000004",
 			},
 		},
 	}
@@ -433,6 +436,178 @@ func (s *IntegrationTestSuite) TestQuerySupply() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestQueryRetiredSupply() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	clientCtx.OutputFormat = "JSON"
+
+	// create a batch in a class without batches, issuing retired credits
+	msgCreateBatch := ecocredit.MsgCreateBatch{
+		ClassId: "C03",
+		Issuance: []*ecocredit.MsgCreateBatch_BatchIssuance{
+			{
+				Recipient:          val.Address.String(),
+				TradableAmount:     "10",
+				RetiredAmount:      "1.5",
+				RetirementLocation: "AB",
+			},
+		},
+		StartDate:       s.batchInfo.StartDate,
+		EndDate:         s.batchInfo.EndDate,
+		ProjectLocation: "GB",
+	}
+	out, err := cli.ExecTestCLICmd(clientCtx, client.TxCreateBatchCmd(),
+		append(
+			[]string{
+				s.writeMsgCreateBatchJSON(&msgCreateBatch),
+				makeFlagFrom(val.Address.String()),
+			},
+			s.commonTxFlags()...,
+		),
+	)
+	s.Require().NoError(err, out.String())
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	// retire some of the tradable credits
+	out, err = cli.ExecTestCLICmd(clientCtx, client.TxRetireCmd(),
+		append(
+			[]string{
+				"[{batch_denom: \"C03-20210101-20210201-001\", amount: \"2\"}]",
+				"AB-CD 12345",
+				makeFlagFrom(val.Address.String()),
+			},
+			s.commonTxFlags()...,
+		),
+	)
+	s.Require().NoError(err, out.String())
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	testCases := []struct {
+		name                  string
+		args                  []string
+		expectErr             bool
+		expectedErrMsg        string
+		expectedRetiredSupply string
+	}{
+		{
+			name:           "missing args",
+			args:           []string{},
+			expectErr:      true,
+			expectedErrMsg: "Error: accepts 1 arg(s), received 0",
+		},
+		{
+			name:           "too many args",
+			args:           []string{"abcde", "abcde"},
+			expectErr:      true,
+			expectedErrMsg: "Error: accepts 1 arg(s), received 2",
+		},
+		{
+			name:           "invalid class id",
+			args:           []string{"abcde"},
+			expectErr:      true,
+			expectedErrMsg: "class ID didn't match the format",
+		},
+		{
+			name:                  "existing class no batches",
+			args:                  []string{"C04"},
+			expectErr:             false,
+			expectedRetiredSupply: "0",
+		},
+		{
+			name:                  "retired on issuance and by retirement",
+			args:                  []string{"C03"},
+			expectErr:             false,
+			expectedRetiredSupply: "3.5",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := client.QueryRetiredSupplyCmd()
+			out, err := cli.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(out.String(), tc.expectedErrMsg)
+			} else {
+				s.Require().NoError(err, out.String())
+
+				var res ecocredit.QueryRetiredSupplyResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+				s.Require().Equal(tc.expectedRetiredSupply, res.RetiredSupply)
+			}
+		})
+	}
+
+	s.Run("human readable output", func() {
+		clientCtx := val.ClientCtx
+		clientCtx.OutputFormat = "text"
+		out, err := cli.ExecTestCLICmd(clientCtx, client.QueryRetiredSupplyCmd(), []string{"C03"})
+		s.Require().NoError(err, out.String())
+		s.Require().Contains(out.String(), "retired_supply: \"3.5\"")
+	})
+}
+
+func (s *IntegrationTestSuite) TestQueryClassRetirementByAccount() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	clientCtx.OutputFormat = "JSON"
+
+	addr := sdk.AccAddress("no retirements")
+
+	testCases := []struct {
+		name            string
+		args            []string
+		expectErr       bool
+		expectedErrMsg  string
+		expectedRetired string
+	}{
+		{
+			name:           "missing args",
+			args:           []string{"abcde"},
+			expectErr:      true,
+			expectedErrMsg: "Error: accepts 2 arg(s), received 1",
+		},
+		{
+			name:           "invalid class id",
+			args:           []string{"abcde", addr.String()},
+			expectErr:      true,
+			expectedErrMsg: "class ID didn't match the format",
+		},
+		{
+			name:           "invalid account",
+			args:           []string{s.classInfo.ClassId, "abcde"},
+			expectErr:      true,
+			expectedErrMsg: "decoding bech32 failed",
+		},
+		{
+			name:            "account without retired credits",
+			args:            []string{s.classInfo.ClassId, addr.String()},
+			expectErr:       false,
+			expectedRetired: "0",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := client.QueryClassRetirementByAccountCmd()
+			out, err := cli.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(out.String(), tc.expectedErrMsg)
+			} else {
+				s.Require().NoError(err, out.String())
+
+				var res ecocredit.QueryClassRetirementByAccountResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+				s.Require().Equal(tc.expectedRetired, res.RetiredAmount)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestQueryCreditTypes() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
@@ -470,3 +645,58 @@ func (s *IntegrationTestSuite) TestQueryCreditTypes() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestQueryCreditTypeSeq() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	clientCtx.OutputFormat = "JSON"
+	testCases := []struct {
+		name           string
+		args           []string
+		expectErr      bool
+		expectedErrMsg string
+		expectedSeq    uint64
+	}{
+		{
+			name:           "missing abbreviation",
+			args:           []string{},
+			expectErr:      true,
+			expectedErrMsg: "Error: accepts 1 arg(s), received 0",
+		},
+		{
+			name:           "empty abbreviation",
+			args:           []string{""},
+			expectErr:      true,
+			expectedErrMsg: "credit type abbreviation should not be empty",
+		},
+		{
+			name:        "credit type without classes",
+			args:        []string{"BIO"},
+			expectErr:   false,
+			expectedSeq: 0,
+		},
+		{
+			name:        "credit type of the created classes",
+			args:        []string{s.classInfo.CreditType.Abbreviation},
+			expectErr:   false,
+			expectedSeq: 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := client.QueryCreditTypeSeqCmd()
+			out, err := cli.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(out.String(), tc.expectedErrMsg)
+			} else {
+				s.Require().NoError(err, out.String())
+
+				var res ecocredit.QueryCreditTypeSeqResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+				s.Require().Equal(tc.expectedSeq, res.SeqNumber)
+			}
+		})
+	}
+}
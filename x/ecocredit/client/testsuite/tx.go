@@ -345,6 +345,41 @@ func (s *IntegrationTestSuite) TestTxCreateClass() {
 				Metadata: []byte{0x1},
 			},
 		},
+		{
+			name: "invalid max supply",
+			args: append(
+				[]string{
+					val0.Address.String(),
+					validCreditType,
+					validMetadata,
+					makeFlagFrom(val0.Address.String()),
+					fmt.Sprintf("--%s=%s", client.FlagMaxSupply, "-10"),
+				},
+				s.commonTxFlags()...,
+			),
+			expectErr:      true,
+			expectedErrMsg: "invalid max supply",
+		},
+		{
+			name: "with max supply",
+			args: append(
+				[]string{
+					val0.Address.String(),
+					validCreditType,
+					validMetadata,
+					makeFlagFrom(val0.Address.String()),
+					fmt.Sprintf("--%s=%s", client.FlagMaxSupply, "1000"),
+				},
+				s.commonTxFlags()...,
+			),
+			expectErr: false,
+			expectedClassInfo: &ecocredit.ClassInfo{
+				Admin:     val0.Address.String(),
+				Issuers:   []string{val0.Address.String()},
+				Metadata:  []byte{0x1},
+				MaxSupply: "1000",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -387,6 +422,7 @@ func (s *IntegrationTestSuite) TestTxCreateClass() {
 									s.Require().Equal(tc.expectedClassInfo.Admin, queryRes.Info.Admin)
 									s.Require().Equal(tc.expectedClassInfo.Issuers, queryRes.Info.Issuers)
 									s.Require().Equal(tc.expectedClassInfo.Metadata, queryRes.Info.Metadata)
+									s.Require().Equal(tc.expectedClassInfo.MaxSupply, queryRes.Info.MaxSupply)
 								}
 							}
 						}
@@ -1039,6 +1075,7 @@ func (s *IntegrationTestSuite) TestTxCancel() {
 	validCredits := fmt.Sprintf("5 %s", s.batchInfo.BatchDenom)
 	invalidBatchDenomCredits := "5 abcde"
 	invalidAmountCredits := fmt.Sprintf("abcde %s", s.batchInfo.BatchDenom)
+	validReason := "double counting: also issued on another registry"
 
 	testCases := []struct {
 		name           string
@@ -1050,13 +1087,14 @@ func (s *IntegrationTestSuite) TestTxCancel() {
 			name:           "missing args",
 			args:           []string{},
 			expectErr:      true,
-			expectedErrMsg: "Error: accepts 1 arg(s), received 0",
+			expectedErrMsg: "Error: accepts 2 arg(s), received 0",
 		},
 		{
 			name: "invalid batch denom",
 			args: append(
 				[]string{
 					invalidBatchDenomCredits,
+					validReason,
 					makeFlagFrom(val0.Address.String()),
 				},
 				s.commonTxFlags()...,
@@ -1069,6 +1107,7 @@ func (s *IntegrationTestSuite) TestTxCancel() {
 			args: append(
 				[]string{
 					invalidAmountCredits,
+					validReason,
 					makeFlagFrom(val0.Address.String()),
 				},
 				s.commonTxFlags()...,
@@ -1081,17 +1120,32 @@ func (s *IntegrationTestSuite) TestTxCancel() {
 			args: append(
 				[]string{
 					validCredits,
+					validReason,
 				},
 				s.commonTxFlags()...,
 			),
 			expectErr:      true,
 			expectedErrMsg: "required flag(s) \"from\" not set",
 		},
+		{
+			name: "empty reason",
+			args: append(
+				[]string{
+					validCredits,
+					"",
+					makeFlagFrom(val0.Address.String()),
+				},
+				s.commonTxFlags()...,
+			),
+			expectErr:      true,
+			expectedErrMsg: "reason should not be empty",
+		},
 		{
 			name: "valid credits",
 			args: append(
 				[]string{
 					validCredits,
+					validReason,
 					makeFlagFrom(val0.Address.String()),
 				},
 				s.commonTxFlags()...,
@@ -1103,6 +1157,7 @@ func (s *IntegrationTestSuite) TestTxCancel() {
 			args: append(
 				[]string{
 					validCredits,
+					validReason,
 					makeFlagFrom(val0.Address.String()),
 					fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeLegacyAminoJSON),
 				},
@@ -1133,6 +1188,20 @@ func (s *IntegrationTestSuite) TestTxCancel() {
 				var res sdk.TxResponse
 				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
 				s.Require().Equal(uint32(0), res.Code)
+
+				// the cancellation is queryable by its batch denom
+				queryCmd := client.QueryCancellationsCmd()
+				queryArgs := []string{s.batchInfo.BatchDenom, flagOutputJSON}
+				queryOut, err := cli.ExecTestCLICmd(clientCtx, queryCmd, queryArgs)
+				s.Require().NoError(err, queryOut.String())
+				var queryRes ecocredit.QueryCancellationsResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(queryOut.Bytes(), &queryRes))
+				s.Require().NotEmpty(queryRes.Cancellations)
+				cancellation := queryRes.Cancellations[len(queryRes.Cancellations)-1]
+				s.Require().Equal(val0.Address.String(), cancellation.Holder)
+				s.Require().Equal("5", cancellation.Amount)
+				s.Require().Equal(validReason, cancellation.Reason)
+				s.Require().Equal("double counting", cancellation.ReasonCategory)
 			}
 		})
 	}
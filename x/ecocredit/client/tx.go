@@ -35,6 +35,8 @@ func TxCmd(name string) *cobra.Command {
 		TxSendCmd(),
 		TxRetireCmd(),
 		TxCancelCmd(),
+		TxAddClassCreatorCmd(),
+		TxRemoveClassCreatorCmd(),
 	)
 	return cmd
 }
@@ -46,7 +48,7 @@ func txflags(cmd *cobra.Command) *cobra.Command {
 }
 
 func TxCreateClassCmd() *cobra.Command {
-	return txflags(&cobra.Command{
+	cmd := txflags(&cobra.Command{
 		Use:   "create-class [issuer[,issuer]*] [credit type name] [metadata]",
 		Short: "Creates a new credit class with transaction author (--from) as admin",
 		Long: fmt.Sprintf(
@@ -62,7 +64,10 @@ by the %s parameter, so should make sure they have enough funds to cover that.
 Parameters:
   issuer:    	       comma separated (no spaces) list of issuer account addresses. Example: "addr1,addr2"
   credit type name:    the name of the credit class type (e.g. carbon, biodiversity, etc)
-  metadata:  	       base64 encoded metadata - arbitrary data attached to the credit class info`,
+  metadata:  	       base64 encoded metadata - arbitrary data attached to the credit class info
+
+Flags:
+  max-supply:          optional maximum amount of credits, tradable and retired, that can be issued in the class`,
 			ecocredit.KeyAllowedClassCreators,
 			ecocredit.KeyCreditClassFee,
 		),
@@ -97,15 +102,23 @@ Parameters:
 				return sdkerrors.ErrInvalidRequest.Wrap("metadata is malformed, proper base64 string is required")
 			}
 
+			maxSupply, err := cmd.Flags().GetString(FlagMaxSupply)
+			if err != nil {
+				return err
+			}
+
 			msg := ecocredit.MsgCreateClass{
 				Admin:          admin.String(),
 				Issuers:        issuers,
 				Metadata:       b,
 				CreditTypeName: creditTypeName,
+				MaxSupply:      maxSupply,
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	})
+	cmd.Flags().String(FlagMaxSupply, "", "The maximum amount of credits that can be issued in the credit class (unlimited if empty)")
+	return cmd
 }
 
 const (
@@ -115,6 +128,7 @@ const (
 	FlagEndDate         string = "end-date"
 	FlagProjectLocation string = "project-location"
 	FlagMetadata        string = "metadata"
+	FlagMaxSupply       string = "max-supply"
 )
 
 func TxGenBatchJSONCmd() *cobra.Command {
@@ -279,7 +293,9 @@ Parameters:
   recipient: recipient address
   credits:   YAML encoded credit list. Note: numerical values must be written in strings.
              eg: '[{batch_denom: "100/2", tradable_amount: "5", retired_amount: "0", retirement_location: "YY-ZZ 12345"}]'
-             Note: "retirement_location" is only required when "retired_amount" is positive.`,
+             Note: "retirement_location" is only required when "retired_amount" is positive.
+             Note: if "tradable_amount" is empty, the whole tradable balance of the batch is sent,
+             "retired_amount" of which is retired on receipt.`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var credits = []*ecocredit.MsgSend_SendCredits{}
@@ -336,14 +352,19 @@ Parameters:
 
 func TxCancelCmd() *cobra.Command {
 	return txflags(&cobra.Command{
-		Use:   "cancel [credits]",
+		Use:   "cancel [credits] [reason]",
 		Short: "Cancels a specified amount of credits from the account of the transaction author (--from)",
-		Long: `Cancels a specified amount of credits from the account of the transaction author (--from)
+		Long: fmt.Sprintf(`Cancels a specified amount of credits from the account of the transaction author (--from)
 
 Parameters:
   credits:  comma-separated list of credits in the form [<amount> <batch-denom>]
-            eg: '10 C01-20200101-20210101-001, 0.1 C01-20200101-20210101-001'`,
-		Args: cobra.ExactArgs(1),
+            eg: '10 C01-20200101-20210101-001, 0.1 C01-20200101-20210101-001'
+  reason:   reason for cancelling the credits, up to %d bytes. The text before
+            its first colon is the reason category used to index cancellations
+            eg: 'double counting: also issued on another registry'`,
+			ecocredit.MaxCancelReasonLength,
+		),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			credits, err := parseCancelCreditsList(args[0])
 			if err != nil {
@@ -356,6 +377,63 @@ Parameters:
 			msg := ecocredit.MsgCancel{
 				Holder:  clientCtx.GetFromAddress().String(),
 				Credits: credits,
+				Reason:  args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	})
+}
+
+func TxAddClassCreatorCmd() *cobra.Command {
+	return txflags(&cobra.Command{
+		Use:   "add-class-creator [creator]",
+		Short: "Adds an account to the credit class creator allowlist",
+		Long: fmt.Sprintf(`Adds an account to the credit class creator allowlist.
+
+The transaction author (--from) must be the allowlist authority defined by the
+%s parameter. The allowlist can't be managed while the parameter is not set.
+
+Parameters:
+  creator:  account address to add to the allowlist`,
+			ecocredit.KeyAllowlistAuthority,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := ecocredit.MsgAddClassCreator{
+				Authority: clientCtx.GetFromAddress().String(),
+				Creator:   args[0],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	})
+}
+
+func TxRemoveClassCreatorCmd() *cobra.Command {
+	return txflags(&cobra.Command{
+		Use:   "remove-class-creator [creator]",
+		Short: "Removes an account from the credit class creator allowlist",
+		Long: fmt.Sprintf(`Removes an account from the credit class creator allowlist.
+
+The transaction author (--from) must be the allowlist authority defined by the
+%s parameter. The allowlist can't be managed while the parameter is not set.
+
+Parameters:
+  creator:  account address to remove from the allowlist`,
+			ecocredit.KeyAllowlistAuthority,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := ecocredit.MsgRemoveClassCreator{
+				Authority: clientCtx.GetFromAddress().String(),
+				Creator:   args[0],
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
@@ -51,10 +51,13 @@ type credits struct {
 	amount     string
 }
 
-var (
-	reCreditAmt = `[[:digit:]]+(?:\.[[:digit:]]+)?|\.[[:digit:]]+`
-	reCredits   = regexp.MustCompile(fmt.Sprintf(`^(%s) (%s)$`, reCreditAmt, ecocredit.ReBatchDenom))
-)
+var reCreditAmt = `[[:digit:]]+(?:\.[[:digit:]]+)?|\.[[:digit:]]+`
+
+// reCredits matches credits using the batch denom format configured with
+// ecocredit.SetDenomFormat, so it's built on use rather than on package init.
+func reCredits() *regexp.Regexp {
+	return regexp.MustCompile(fmt.Sprintf(`^(%s) (%s)$`, reCreditAmt, ecocredit.GetDenomFormat().Pattern()))
+}
 
 func parseCancelCreditsList(creditsListStr string) ([]*ecocredit.MsgCancel_CancelCredits, error) {
 	creditsList, err := parseCreditsList(creditsListStr)
@@ -96,7 +99,7 @@ func parseCreditsList(creditsListStr string) ([]credits, error) {
 func parseCredits(creditsStr string) (credits, error) {
 	creditsStr = strings.TrimSpace(creditsStr)
 
-	matches := reCredits.FindStringSubmatch(creditsStr)
+	matches := reCredits().FindStringSubmatch(creditsStr)
 	if matches == nil {
 		return credits{}, ecocredit.ErrParseFailure.Wrapf("invalid credit expression: %s", creditsStr)
 	}
@@ -16,6 +16,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSend{}, "regen-ledger/MsgSend", nil)
 	cdc.RegisterConcrete(&MsgRetire{}, "regen-ledger/MsgRetire", nil)
 	cdc.RegisterConcrete(&MsgCancel{}, "regen-ledger/MsgCancel", nil)
+	cdc.RegisterConcrete(&MsgAddClassCreator{}, "regen-ledger/MsgAddClassCreator", nil)
+	cdc.RegisterConcrete(&MsgRemoveClassCreator{}, "regen-ledger/MsgRemoveClassCreator", nil)
 }
 
 func RegisterTypes(registry codectypes.InterfaceRegistry) {
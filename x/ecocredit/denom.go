@@ -3,6 +3,8 @@ package ecocredit
 import (
 	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -12,7 +14,19 @@ import (
 //
 // The initial version has format:
 // <credit type abbreviation><class seq no>
+// where:
+// - <credit type abbreviation> is 1-3 uppercase letters
+// - <class seq no> is the sequence number of the class within its credit
+//   type, padded to at least two digits
+//
+// e.g. C01
+//
+// An error is returned if the credit type abbreviation is invalid, so that
+// the result is always accepted by ValidateClassID.
 func FormatClassID(creditType CreditType, classSeqNo uint64) (string, error) {
+	if err := validateCreditTypeAbbreviation(creditType.Abbreviation); err != nil {
+		return "", err
+	}
 	return fmt.Sprintf("%s%02d", creditType.Abbreviation, classSeqNo), nil
 }
 
@@ -31,30 +45,280 @@ func FormatClassID(creditType CreditType, classSeqNo uint64) (string, error) {
 //
 // e.g C01-20190101-20200101-001
 //
+// Chains can customize the format with SetDenomFormat.
+//
 // NB: This might differ from the actual denomination used.
 func FormatDenom(classId string, batchSeqNo uint64, startDate *time.Time, endDate *time.Time) (string, error) {
-	return fmt.Sprintf(
-		"%s-%s-%s-%03d",
+	return denomFormat.Format(classId, batchSeqNo, startDate, endDate)
+}
+
+// ParseDenom parses a batch denomination produced by FormatDenom back into
+// its parts.
+func ParseDenom(denom string) (DenomParts, error) {
+	return denomFormat.Parse(denom)
+}
+
+// BatchDenomPrefix returns the prefix of the denominations produced by
+// FormatDenom for all batches of the given credit class.
+func BatchDenomPrefix(classID string) string {
+	return denomFormat.BatchDenomPrefix(classID)
+}
+
+// DenomParts are the parts of a batch denomination, see FormatDenom.
+type DenomParts struct {
+	ClassID    string
+	BatchSeqNo uint64
+	StartDate  time.Time
+	EndDate    time.Time
+}
+
+// The placeholders of a DenomFormat template.
+const (
+	DenomClassID    = "{class_id}"
+	DenomCreditType = "{credit_type}"
+	DenomStartDate  = "{start_date}"
+	DenomEndDate    = "{end_date}"
+	DenomBatchSeqNo = "{batch_seq_no}"
+)
+
+// DefaultDenomTemplate is the template of the initial batch denomination
+// format described in FormatDenom.
+const DefaultDenomTemplate = DenomClassID + "-" + DenomStartDate + "-" + DenomEndDate + "-" + DenomBatchSeqNo
+
+const denomDateFormat = "20060102"
+
+var (
+	denomPlaceholders = map[string]struct {
+		pattern string
+		example string
+	}{
+		DenomClassID:    {ReClassID, "A00"},
+		DenomCreditType: {`[A-Z]{1,3}`, "A"},
+		DenomStartDate:  {`[0-9]{8}`, "00000000"},
+		DenomEndDate:    {`[0-9]{8}`, "00000000"},
+		DenomBatchSeqNo: {`[0-9]{3,}`, "000"},
+	}
+	reDenomTemplate  = regexp.MustCompile(`\{[a-z_]+\}`)
+	reDenomSeparator = regexp.MustCompile(`^[-_./:]*$`)
+
+	denomFormat = MustNewDenomFormat(DefaultDenomTemplate)
+)
+
+// DenomFormat formats and parses batch denominations according to a
+// template. The template consists of the DenomClassID, DenomStartDate,
+// DenomEndDate and DenomBatchSeqNo placeholders, each exactly once, and an
+// optional DenomCreditType placeholder. Placeholders must be separated by at
+// least one of the separator characters `-_./:`, which are the only
+// characters allowed outside of placeholders, so that any denomination can be
+// parsed unambiguously.
+//
+// DenomClassID must be the first placeholder of the template, so that the
+// batches of a class can be found by the BatchDenomPrefix of its ID.
+type DenomFormat struct {
+	template     string
+	pattern      string
+	capture      string
+	example      string
+	placeholders []string
+	re           *regexp.Regexp
+	// classIDPrefix and classIDSuffix are the separators around DenomClassID.
+	classIDPrefix string
+	classIDSuffix string
+}
+
+// NewDenomFormat creates a DenomFormat from the given template, returning an
+// error if the template is invalid.
+func NewDenomFormat(template string) (DenomFormat, error) {
+	f := DenomFormat{template: template}
+
+	counts := make(map[string]int)
+	locs := reDenomTemplate.FindAllStringIndex(template, -1)
+	pos := 0
+	for i, loc := range locs {
+		separator := template[pos:loc[0]]
+		if !reDenomSeparator.MatchString(separator) {
+			return DenomFormat{}, ErrParseFailure.Wrapf("invalid separator %q in denom template %s", separator, template)
+		}
+		if i > 0 && separator == "" {
+			return DenomFormat{}, ErrParseFailure.Wrapf("missing separator before %s in denom template %s", template[loc[0]:loc[1]], template)
+		}
+
+		placeholder := template[loc[0]:loc[1]]
+		p, ok := denomPlaceholders[placeholder]
+		if !ok {
+			return DenomFormat{}, ErrParseFailure.Wrapf("unknown placeholder %s in denom template %s", placeholder, template)
+		}
+		counts[placeholder]++
+		switch i {
+		case 0:
+			if placeholder != DenomClassID {
+				return DenomFormat{}, ErrParseFailure.Wrapf("denom template %s must start with %s", template, DenomClassID)
+			}
+			f.classIDPrefix = separator
+		case 1:
+			f.classIDSuffix = separator
+		}
+
+		f.pattern += regexp.QuoteMeta(separator) + p.pattern
+		f.capture += regexp.QuoteMeta(separator) + "(" + p.pattern + ")"
+		f.example += separator + p.example
+		f.placeholders = append(f.placeholders, placeholder)
+		pos = loc[1]
+	}
+
+	separator := template[pos:]
+	if !reDenomSeparator.MatchString(separator) {
+		return DenomFormat{}, ErrParseFailure.Wrapf("invalid separator %q in denom template %s", separator, template)
+	}
+	f.pattern += regexp.QuoteMeta(separator)
+	f.capture += regexp.QuoteMeta(separator)
+	f.example += separator
+
+	for _, placeholder := range []string{DenomClassID, DenomStartDate, DenomEndDate, DenomBatchSeqNo} {
+		if counts[placeholder] != 1 {
+			return DenomFormat{}, ErrParseFailure.Wrapf("denom template %s must contain %s exactly once", template, placeholder)
+		}
+	}
+	if counts[DenomCreditType] > 1 {
+		return DenomFormat{}, ErrParseFailure.Wrapf("denom template %s must contain %s at most once", template, DenomCreditType)
+	}
+
+	f.re = regexp.MustCompile(fmt.Sprintf(`^%s$`, f.capture))
+	return f, nil
+}
 
+// MustNewDenomFormat is like NewDenomFormat but panics if the template is
+// invalid.
+func MustNewDenomFormat(template string) DenomFormat {
+	f, err := NewDenomFormat(template)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// SetDenomFormat sets the format used by FormatDenom, ParseDenom,
+// BatchDenomPrefix and ValidateDenom. Like sdk.SetCoinDenomRegex, it is meant to be called once
+// on app initialization. Changing the format of a chain with existing
+// batches makes their denominations invalid.
+func SetDenomFormat(f DenomFormat) {
+	denomFormat = f
+}
+
+// GetDenomFormat returns the format used by FormatDenom, ParseDenom,
+// BatchDenomPrefix and ValidateDenom.
+func GetDenomFormat() DenomFormat {
+	return denomFormat
+}
+
+// Template returns the template the format was created from.
+func (f DenomFormat) Template() string {
+	return f.template
+}
+
+// Pattern returns a regular expression matching the denominations of the
+// format, without anchors or capture groups so that it can be embedded in
+// other expressions.
+func (f DenomFormat) Pattern() string {
+	return f.pattern
+}
+
+// Format returns the batch denomination for the given parts.
+func (f DenomFormat) Format(classId string, batchSeqNo uint64, startDate *time.Time, endDate *time.Time) (string, error) {
+	if err := ValidateClassID(classId); err != nil {
+		return "", err
+	}
+
+	values := map[string]string{
 		// Class ID string
-		classId,
+		DenomClassID: classId,
+
+		// Credit type abbreviation, which the class ID is prefixed with
+		DenomCreditType: creditTypeOfClassID(classId),
 
 		// Start Date as YYYYMMDD
-		startDate.Format("20060102"),
+		DenomStartDate: startDate.Format(denomDateFormat),
 
 		// End Date as YYYYMMDD
-		endDate.Format("20060102"),
+		DenomEndDate: endDate.Format(denomDateFormat),
 
 		// Batch sequence number padded to at least three digits
-		batchSeqNo,
-	), nil
+		DenomBatchSeqNo: fmt.Sprintf("%03d", batchSeqNo),
+	}
+
+	return reDenomTemplate.ReplaceAllStringFunc(f.template, func(placeholder string) string {
+		return values[placeholder]
+	}), nil
+}
+
+// Validate checks that a batch denomination matches the format.
+func (f DenomFormat) Validate(denom string) error {
+	if !f.re.MatchString(denom) {
+		return ErrParseFailure.Wrapf("denomination didn't match the format: expected %s, got %s", f.example, denom)
+	}
+	return nil
+}
+
+// Parse parses a batch denomination of the format into its parts.
+func (f DenomFormat) Parse(denom string) (DenomParts, error) {
+	if err := f.Validate(denom); err != nil {
+		return DenomParts{}, err
+	}
+	matches := f.re.FindStringSubmatch(denom)
+
+	var (
+		parts      DenomParts
+		creditType string
+		err        error
+	)
+	for i, placeholder := range f.placeholders {
+		value := matches[i+1]
+		switch placeholder {
+		case DenomClassID:
+			parts.ClassID = value
+		case DenomCreditType:
+			creditType = value
+		case DenomStartDate:
+			parts.StartDate, err = time.Parse(denomDateFormat, value)
+		case DenomEndDate:
+			parts.EndDate, err = time.Parse(denomDateFormat, value)
+		case DenomBatchSeqNo:
+			parts.BatchSeqNo, err = strconv.ParseUint(value, 10, 64)
+		}
+		if err != nil {
+			return DenomParts{}, ErrParseFailure.Wrapf("invalid %s in denomination %s: %s", placeholder, denom, err)
+		}
+	}
+
+	if creditType != "" && creditType != creditTypeOfClassID(parts.ClassID) {
+		return DenomParts{}, ErrParseFailure.Wrapf("credit type %s doesn't match class ID %s in denomination %s", creditType, parts.ClassID, denom)
+	}
+
+	return parts, nil
+}
+
+// BatchDenomPrefix returns the prefix of the denominations of all batches of
+// the given credit class in the format. It ends with the separator following
+// the class ID, so that the batches of a class whose ID is a prefix of another
+// class ID, like C10 and C100, are told apart.
+func (f DenomFormat) BatchDenomPrefix(classID string) string {
+	return f.classIDPrefix + classID + f.classIDSuffix
+}
+
+// creditTypeOfClassID returns the credit type abbreviation of a class ID, see
+// FormatClassID.
+func creditTypeOfClassID(classID string) string {
+	return strings.TrimRight(classID, "0123456789")
 }
 
 var (
-	ReClassID        = `[A-Z]{1,3}[0-9]{2,}`
-	reFullClassID    = regexp.MustCompile(fmt.Sprintf(`^%s$`, ReClassID))
-	ReBatchDenom     = fmt.Sprintf(`%s-[0-9]{8}-[0-9]{8}-[0-9]{3,}`, ReClassID)
-	reFullBatchDenom = regexp.MustCompile(fmt.Sprintf(`^%s$`, ReBatchDenom))
+	// ReClassID matches exactly the class IDs produced by FormatClassID, so
+	// sequence numbers are only zero padded to two digits.
+	ReClassID     = `[A-Z]{1,3}(?:[0-9]{2}|[1-9][0-9]{2,})`
+	reFullClassID = regexp.MustCompile(fmt.Sprintf(`^%s$`, ReClassID))
+	// ReBatchDenom matches batch denominations of the default format, use
+	// GetDenomFormat().Pattern() for the configured format.
+	ReBatchDenom = fmt.Sprintf(`%s-[0-9]{8}-[0-9]{8}-[0-9]{3,}`, ReClassID)
 )
 
 // Validate a class ID conforms to the format described in FormatClassID. The
@@ -70,9 +334,5 @@ func ValidateClassID(classId string) error {
 // Validate a batch denomination conforms to the format described in
 // FormatDenom. The return is nil if the denom is valid.
 func ValidateDenom(denom string) error {
-	matches := reFullBatchDenom.FindStringSubmatch(denom)
-	if matches == nil {
-		return ErrParseFailure.Wrapf("denomination didn't match the format: expected A00-00000000-00000000-000, got %s", denom)
-	}
-	return nil
+	return denomFormat.Validate(denom)
 }
@@ -1,6 +1,8 @@
 package ecocredit
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,6 +17,105 @@ func TestDenom(t *testing.T) {
 	t.Run("TestInvalidBatchDenomsError", rapid.MakeCheck(testInvalidBatchDenomsError))
 }
 
+func TestDenomFormat(t *testing.T) {
+	templates := []string{
+		DefaultDenomTemplate,
+		"{class_id}-{credit_type}/{start_date}.{end_date}.{batch_seq_no}",
+		"{class_id}-{batch_seq_no}_{end_date}_{start_date}",
+		"{class_id}.{start_date}.{end_date}.{batch_seq_no}",
+		"/{class_id}:{credit_type}/{start_date}/{end_date}/{batch_seq_no}",
+	}
+	for _, template := range templates {
+		t.Run(template, func(t *testing.T) {
+			f, err := NewDenomFormat(template)
+			require.NoError(t, err)
+
+			defer SetDenomFormat(GetDenomFormat())
+			SetDenomFormat(f)
+
+			rapid.Check(t, testParseFormatDenom)
+		})
+	}
+}
+
+// Property: ParseDenom(FormatDenom(a, b, c, d)) == {a, b, c, d}
+func testParseFormatDenom(t *rapid.T) {
+	creditType := genCreditType.Draw(t, "creditType").(*CreditType)
+	classSeqNo := rapid.Uint64().Draw(t, "classSeqNo").(uint64)
+	batchSeqNo := rapid.Uint64().Draw(t, "batchSeqNo").(uint64)
+	startDate := genTime.Draw(t, "startDate").(*time.Time)
+	endDate := genTime.Draw(t, "endDate").(*time.Time)
+
+	classId, err := FormatClassID(*creditType, classSeqNo)
+	require.NoError(t, err)
+
+	denom, err := FormatDenom(classId, batchSeqNo, startDate, endDate)
+	require.NoError(t, err)
+	t.Log(denom)
+
+	parts, err := ParseDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, classId, parts.ClassID)
+	require.Equal(t, batchSeqNo, parts.BatchSeqNo)
+	require.Equal(t, startDate.Format(denomDateFormat), parts.StartDate.Format(denomDateFormat))
+	require.Equal(t, endDate.Format(denomDateFormat), parts.EndDate.Format(denomDateFormat))
+	require.Regexp(t, "^"+GetDenomFormat().Pattern()+"$", denom)
+	require.True(t, strings.HasPrefix(denom, BatchDenomPrefix(classId)))
+}
+
+func TestBatchDenomPrefix(t *testing.T) {
+	require.Equal(t, "C10-", BatchDenomPrefix("C10"))
+
+	f := MustNewDenomFormat("/{class_id}:{credit_type}/{start_date}/{end_date}/{batch_seq_no}")
+	require.Equal(t, "/C10:", f.BatchDenomPrefix("C10"))
+
+	start, end := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	denom, err := f.Format("C100", 1, &start, &end)
+	require.NoError(t, err)
+	require.False(t, strings.HasPrefix(denom, f.BatchDenomPrefix("C10")))
+}
+
+func TestParseDenomDefaultFormat(t *testing.T) {
+	parts, err := ParseDenom("C01-20190101-20200101-001")
+	require.NoError(t, err)
+	require.Equal(t, DenomParts{
+		ClassID:    "C01",
+		BatchSeqNo: 1,
+		StartDate:  time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, parts)
+
+	require.Equal(t, ReBatchDenom, GetDenomFormat().Pattern())
+
+	_, err = ParseDenom("C01-20191301-20200101-001")
+	require.Error(t, err)
+
+	f := MustNewDenomFormat("{class_id}-{credit_type}/{start_date}.{end_date}.{batch_seq_no}")
+	_, err = f.Parse("C01-A/20190101.20200101.001")
+	require.Error(t, err)
+	_, err = f.Parse("C01-C/20190101.20200101.001")
+	require.NoError(t, err)
+}
+
+func TestNewDenomFormatInvalid(t *testing.T) {
+	for name, template := range map[string]string{
+		"empty":                 "",
+		"missing class id":      "{start_date}-{end_date}-{batch_seq_no}",
+		"duplicate class id":    "{class_id}-{class_id}-{start_date}-{end_date}-{batch_seq_no}",
+		"duplicate credit type": "{credit_type}-{credit_type}-{class_id}-{start_date}-{end_date}-{batch_seq_no}",
+		"unknown placeholder":   "{class_id}-{start_date}-{end_date}-{batch_seq_no}-{project}",
+		"missing separator":     "{class_id}{start_date}-{end_date}-{batch_seq_no}",
+		"invalid separator":     "{class_id} {start_date}-{end_date}-{batch_seq_no}",
+		"invalid literal":       "batch-{class_id}-{start_date}-{end_date}-{batch_seq_no}",
+		"class id not first":    "{credit_type}/{class_id}-{start_date}.{end_date}.{batch_seq_no}",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewDenomFormat(template)
+			require.Error(t, err)
+		})
+	}
+}
+
 // Property: ValidateClassID(FormatClassID(a)) == nil
 func testValidateFormatClassID(t *rapid.T) {
 	creditType := genCreditType.Draw(t, "creditType").(*CreditType)
@@ -27,6 +128,41 @@ func testValidateFormatClassID(t *rapid.T) {
 	require.NoError(t, err)
 }
 
+func TestFormatClassID(t *testing.T) {
+	specs := []struct {
+		abbr   string
+		seqNo  uint64
+		exp    string
+		expErr bool
+	}{
+		{"C", 1, "C01", false},
+		{"BIO", 9, "BIO09", false},
+		{"C", 10, "C10", false},
+		{"C", 123, "C123", false},
+		{"C", 0, "C00", false},
+		{"", 1, "", true},
+		{"c", 1, "", true},
+		{"CARB", 1, "", true},
+		{"C1", 1, "", true},
+	}
+	for _, spec := range specs {
+		t.Run(fmt.Sprintf("%s/%d", spec.abbr, spec.seqNo), func(t *testing.T) {
+			classID, err := FormatClassID(CreditType{Abbreviation: spec.abbr}, spec.seqNo)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.exp, classID)
+			require.NoError(t, ValidateClassID(classID))
+		})
+	}
+
+	for _, classID := range []string{"C001", "C012", "C1", "C", "01", "c01", "CARB01", "C01-"} {
+		require.Error(t, ValidateClassID(classID), classID)
+	}
+}
+
 func testInvalidClassIDsError(t *rapid.T) {
 	classID := genInvalidClassID.Draw(t, "classID").(string)
 	require.Error(t, ValidateClassID(classID))
@@ -78,6 +214,9 @@ var genInvalidClassID = rapid.OneOf(
 	rapid.StringMatching(`[a-zA-Z]*`),
 	rapid.StringMatching(`[0-9]*`),
 	rapid.StringMatching(`[A-Z]{4,}[0-9]*`),
+	rapid.StringMatching(`[A-Z]{1,3}[0-9]?`),
+	// zero padding beyond two digits is never produced by FormatClassID
+	rapid.StringMatching(`[A-Z]{1,3}0[0-9]{2,}`),
 )
 
 // genInvalidBatchDenom generates strings that don't conform to the BatchDenom
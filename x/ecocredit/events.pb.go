@@ -28,6 +28,12 @@ type EventCreateClass struct {
 	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
 	// admin is the admin of the credit class.
 	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// issuers are the account addresses of the approved issuers of the credit
+	// class.
+	Issuers []string `protobuf:"bytes,3,rep,name=issuers,proto3" json:"issuers,omitempty"`
+	// credit_type is the name of the credit type of the credit class (e.g.
+	// "carbon", "biodiversity").
+	CreditType string `protobuf:"bytes,4,opt,name=credit_type,json=creditType,proto3" json:"credit_type,omitempty"`
 }
 
 func (m *EventCreateClass) Reset()         { *m = EventCreateClass{} }
@@ -77,6 +83,20 @@ func (m *EventCreateClass) GetAdmin() string {
 	return ""
 }
 
+func (m *EventCreateClass) GetIssuers() []string {
+	if m != nil {
+		return m.Issuers
+	}
+	return nil
+}
+
+func (m *EventCreateClass) GetCreditType() string {
+	if m != nil {
+		return m.CreditType
+	}
+	return ""
+}
+
 // EventCreateBatch is an event emitted when a credit batch is created.
 type EventCreateBatch struct {
 	// class_id is the unique ID of credit class.
@@ -349,6 +369,8 @@ type EventCancel struct {
 	BatchDenom string `protobuf:"bytes,2,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
 	// amount is the decimal number of credits that have been cancelled.
 	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// reason is the reason given for cancelling the credits.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *EventCancel) Reset()         { *m = EventCancel{} }
@@ -405,12 +427,115 @@ func (m *EventCancel) GetAmount() string {
 	return ""
 }
 
+func (m *EventCancel) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventAddClassCreator is an event emitted when an address is added to the
+// class creator allowlist.
+type EventAddClassCreator struct {
+	// creator is the account address added to the allowlist.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *EventAddClassCreator) Reset()         { *m = EventAddClassCreator{} }
+func (m *EventAddClassCreator) String() string { return proto.CompactTextString(m) }
+func (*EventAddClassCreator) ProtoMessage()    {}
+func (*EventAddClassCreator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b6a013b00aef3af, []int{5}
+}
+func (m *EventAddClassCreator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAddClassCreator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAddClassCreator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAddClassCreator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAddClassCreator.Merge(m, src)
+}
+func (m *EventAddClassCreator) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAddClassCreator) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAddClassCreator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAddClassCreator proto.InternalMessageInfo
+
+func (m *EventAddClassCreator) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// EventRemoveClassCreator is an event emitted when an address is removed from
+// the class creator allowlist.
+type EventRemoveClassCreator struct {
+	// creator is the account address removed from the allowlist.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *EventRemoveClassCreator) Reset()         { *m = EventRemoveClassCreator{} }
+func (m *EventRemoveClassCreator) String() string { return proto.CompactTextString(m) }
+func (*EventRemoveClassCreator) ProtoMessage()    {}
+func (*EventRemoveClassCreator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b6a013b00aef3af, []int{6}
+}
+func (m *EventRemoveClassCreator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRemoveClassCreator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRemoveClassCreator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRemoveClassCreator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRemoveClassCreator.Merge(m, src)
+}
+func (m *EventRemoveClassCreator) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRemoveClassCreator) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRemoveClassCreator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRemoveClassCreator proto.InternalMessageInfo
+
+func (m *EventRemoveClassCreator) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateClass)(nil), "regen.ecocredit.v1alpha1.EventCreateClass")
 	proto.RegisterType((*EventCreateBatch)(nil), "regen.ecocredit.v1alpha1.EventCreateBatch")
 	proto.RegisterType((*EventReceive)(nil), "regen.ecocredit.v1alpha1.EventReceive")
 	proto.RegisterType((*EventRetire)(nil), "regen.ecocredit.v1alpha1.EventRetire")
 	proto.RegisterType((*EventCancel)(nil), "regen.ecocredit.v1alpha1.EventCancel")
+	proto.RegisterType((*EventAddClassCreator)(nil), "regen.ecocredit.v1alpha1.EventAddClassCreator")
+	proto.RegisterType((*EventRemoveClassCreator)(nil), "regen.ecocredit.v1alpha1.EventRemoveClassCreator")
 }
 
 func init() {
@@ -418,34 +543,38 @@ func init() {
 }
 
 var fileDescriptor_5b6a013b00aef3af = []byte{
-	// 420 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x8a, 0x13, 0x41,
-	0x10, 0xcd, 0x18, 0x37, 0xd9, 0x54, 0x16, 0x5c, 0x1a, 0x91, 0x56, 0x74, 0xd4, 0x01, 0x41, 0x0f,
-	0x66, 0x08, 0x7e, 0x81, 0x9b, 0xf5, 0x20, 0x7a, 0xda, 0xa3, 0x97, 0xa1, 0xd3, 0x5d, 0x24, 0xad,
-	0x93, 0xee, 0xa1, 0xa7, 0x12, 0x3d, 0xe8, 0x3f, 0xf8, 0x59, 0x1e, 0xf7, 0xe8, 0x51, 0x92, 0xbb,
-	0xdf, 0x20, 0x53, 0xd3, 0x99, 0xb8, 0x22, 0x22, 0x7b, 0xab, 0xf7, 0xea, 0x51, 0xaf, 0x5e, 0xd1,
-	0x0d, 0x4f, 0x02, 0x2e, 0xd0, 0xe5, 0xa8, 0xbd, 0x0e, 0x68, 0x2c, 0xe5, 0x9b, 0xa9, 0x2a, 0xab,
-	0xa5, 0x9a, 0xe6, 0xb8, 0x41, 0x47, 0xf5, 0xa4, 0x0a, 0x9e, 0xbc, 0x90, 0x2c, 0x9b, 0x74, 0xb2,
-	0xc9, 0x5e, 0x96, 0xcd, 0xe0, 0xf4, 0x55, 0xa3, 0x9c, 0x05, 0x54, 0x84, 0xb3, 0x52, 0xd5, 0xb5,
-	0xb8, 0x0b, 0xc7, 0xba, 0x29, 0x0a, 0x6b, 0x64, 0xf2, 0x28, 0x79, 0x3a, 0xba, 0x18, 0x32, 0x7e,
-	0x6d, 0xc4, 0x6d, 0x38, 0x52, 0x66, 0x65, 0x9d, 0xbc, 0xc1, 0x7c, 0x0b, 0xb2, 0x9f, 0xc9, 0x95,
-	0x29, 0x67, 0x8a, 0xf4, 0xf2, 0x5f, 0x53, 0x1e, 0xc2, 0x78, 0xde, 0x68, 0x0a, 0x83, 0xce, 0xaf,
-	0xe2, 0x2c, 0x60, 0xea, 0xbc, 0x61, 0xc4, 0x1d, 0x18, 0xd8, 0xba, 0x5e, 0x63, 0x90, 0x7d, 0xee,
-	0x45, 0x24, 0x1e, 0xc3, 0x09, 0x79, 0x52, 0x65, 0xa1, 0x56, 0x7e, 0xed, 0x48, 0xde, 0xe4, 0xee,
-	0x98, 0xb9, 0x97, 0x4c, 0x89, 0x07, 0x00, 0x35, 0xa9, 0x40, 0x85, 0x51, 0x84, 0xf2, 0x88, 0x05,
-	0x23, 0x66, 0xce, 0x15, 0x61, 0xb3, 0x15, 0x3a, 0xd3, 0x36, 0x07, 0xed, 0x56, 0xe8, 0x0c, 0xb7,
-	0x9e, 0xc1, 0x69, 0x15, 0xfc, 0x7b, 0xd4, 0x54, 0x94, 0x5e, 0x2b, 0xb2, 0xde, 0xc9, 0x21, 0x4b,
-	0x6e, 0x45, 0xfe, 0x6d, 0xa4, 0xb3, 0x2f, 0x70, 0xc2, 0x79, 0x2f, 0x50, 0xa3, 0xdd, 0x60, 0xb3,
-	0x6f, 0x8d, 0xce, 0x60, 0x88, 0x49, 0x23, 0x12, 0xf7, 0x61, 0x14, 0x50, 0xdb, 0xca, 0xa2, 0xa3,
-	0x18, 0xf3, 0x40, 0xfc, 0x79, 0x86, 0xfe, 0xdf, 0xce, 0x70, 0x25, 0x68, 0x44, 0xd9, 0x67, 0x18,
-	0x47, 0x7b, 0xb2, 0x01, 0x85, 0x84, 0x61, 0xe0, 0x6a, 0x6f, 0xbf, 0x87, 0xff, 0x75, 0xe8, 0xe8,
-	0xd0, 0xff, 0xdd, 0x41, 0xdc, 0x83, 0xe3, 0xee, 0x06, 0xad, 0x77, 0x87, 0x33, 0x13, 0xdd, 0x67,
-	0xca, 0x69, 0x2c, 0x9b, 0x8c, 0x9a, 0xab, 0xb2, 0xf3, 0x3f, 0x10, 0xd7, 0xde, 0xe0, 0xec, 0xcd,
-	0xb7, 0x6d, 0x9a, 0x5c, 0x6e, 0xd3, 0xe4, 0xc7, 0x36, 0x4d, 0xbe, 0xee, 0xd2, 0xde, 0xe5, 0x2e,
-	0xed, 0x7d, 0xdf, 0xa5, 0xbd, 0x77, 0xd3, 0x85, 0xa5, 0xe5, 0x7a, 0x3e, 0xd1, 0x7e, 0x95, 0xf3,
-	0xbb, 0x7e, 0xee, 0x90, 0x3e, 0xfa, 0xf0, 0x21, 0xa2, 0x12, 0xcd, 0x02, 0x43, 0xfe, 0xe9, 0xf0,
-	0x2b, 0xe6, 0x03, 0xfe, 0x06, 0x2f, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x37, 0xcd, 0x9c, 0x66,
-	0x2f, 0x03, 0x00, 0x00,
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x14, 0x8c, 0x09, 0x4d, 0x9a, 0x97, 0x4a, 0x54, 0xab, 0xaa, 0x18, 0x04, 0x6e, 0xb1, 0x84, 0x04,
+	0x07, 0x62, 0xa2, 0x7e, 0x41, 0x9b, 0x72, 0x40, 0x70, 0xaa, 0x38, 0x71, 0xb1, 0x36, 0xbb, 0x4f,
+	0xc9, 0x82, 0xbd, 0x6b, 0xad, 0x37, 0x81, 0x4a, 0xc0, 0x37, 0xf0, 0x59, 0x1c, 0x7b, 0xe4, 0x88,
+	0x92, 0x3b, 0xdf, 0x80, 0xf6, 0x79, 0xe3, 0x50, 0x84, 0x50, 0xc5, 0xed, 0xcd, 0xec, 0x58, 0x33,
+	0x6f, 0xbc, 0x0b, 0x8f, 0x2d, 0xce, 0x50, 0x67, 0x28, 0x8c, 0xb0, 0x28, 0x95, 0xcb, 0x96, 0x63,
+	0x5e, 0x54, 0x73, 0x3e, 0xce, 0x70, 0x89, 0xda, 0xd5, 0xa3, 0xca, 0x1a, 0x67, 0x58, 0x4c, 0xb2,
+	0x51, 0x2b, 0x1b, 0x6d, 0x64, 0xe9, 0x17, 0xd8, 0x7f, 0xe1, 0x95, 0x13, 0x8b, 0xdc, 0xe1, 0xa4,
+	0xe0, 0x75, 0xcd, 0xee, 0xc1, 0xae, 0xf0, 0x43, 0xae, 0x64, 0x1c, 0x1d, 0x47, 0x4f, 0x06, 0x17,
+	0x7d, 0xc2, 0x2f, 0x25, 0x3b, 0x80, 0x1d, 0x2e, 0x4b, 0xa5, 0xe3, 0x5b, 0xc4, 0x37, 0x80, 0xc5,
+	0xd0, 0x57, 0x75, 0xbd, 0x40, 0x5b, 0xc7, 0xdd, 0xe3, 0xae, 0xd7, 0x07, 0xc8, 0x8e, 0x60, 0xd8,
+	0x38, 0xe6, 0xee, 0xb2, 0xc2, 0xf8, 0x36, 0x7d, 0x05, 0x0d, 0xf5, 0xe6, 0xb2, 0xc2, 0xf4, 0x67,
+	0x74, 0x2d, 0xc0, 0x19, 0x77, 0x62, 0xfe, 0xaf, 0x00, 0x47, 0x30, 0x9c, 0x7a, 0x4d, 0x2e, 0x51,
+	0x9b, 0x32, 0xc4, 0x00, 0xa2, 0xce, 0x3d, 0xc3, 0x0e, 0xa1, 0xd7, 0x98, 0xc7, 0x5d, 0x3a, 0x0b,
+	0x88, 0x3d, 0x82, 0x3d, 0x67, 0x1c, 0x2f, 0x72, 0x5e, 0x9a, 0x85, 0x76, 0x21, 0xca, 0x90, 0xb8,
+	0x53, 0xa2, 0xd8, 0x43, 0x80, 0xda, 0x71, 0xeb, 0x72, 0xc9, 0x1d, 0xc6, 0x3b, 0x24, 0x18, 0x10,
+	0x73, 0xce, 0x1d, 0xfa, 0x54, 0xa8, 0x65, 0x73, 0xd8, 0x6b, 0x52, 0xa1, 0x96, 0x74, 0xf4, 0x14,
+	0xf6, 0x2b, 0x6b, 0xde, 0xa1, 0x70, 0x79, 0x61, 0x04, 0x77, 0xca, 0xe8, 0xb8, 0x4f, 0x92, 0x3b,
+	0x81, 0x7f, 0x1d, 0xe8, 0xf4, 0x33, 0xec, 0xd1, 0xbe, 0x17, 0x28, 0x50, 0x2d, 0xd1, 0xe7, 0xad,
+	0x51, 0x4b, 0xb4, 0x61, 0xd3, 0x80, 0xd8, 0x03, 0x18, 0x58, 0x14, 0xaa, 0x52, 0xa8, 0x5d, 0x58,
+	0x73, 0x4b, 0xfc, 0x59, 0x43, 0xf7, 0x6f, 0x35, 0x5c, 0x5b, 0x34, 0xa0, 0xf4, 0x13, 0x0c, 0x83,
+	0xbd, 0x53, 0x16, 0xfd, 0x9f, 0xb3, 0x34, 0x6d, 0xec, 0x37, 0xf0, 0x46, 0x45, 0x07, 0x87, 0xee,
+	0xef, 0x0e, 0xec, 0x3e, 0xec, 0xb6, 0x1d, 0x34, 0xde, 0x2d, 0x6e, 0xdd, 0x27, 0x5c, 0x0b, 0x2c,
+	0xfc, 0x8e, 0x82, 0xa6, 0xa2, 0xf5, 0xdf, 0x12, 0xff, 0x9f, 0xe0, 0x10, 0x7a, 0x16, 0x79, 0xdd,
+	0xfa, 0x07, 0x94, 0x3e, 0x87, 0x03, 0x72, 0x3f, 0x95, 0x92, 0x2e, 0x3a, 0x5d, 0x39, 0x63, 0x7d,
+	0x09, 0xa2, 0x19, 0xdb, 0xdb, 0xd6, 0xc0, 0xf4, 0x04, 0xee, 0x86, 0xb6, 0x4a, 0xb3, 0xc4, 0x9b,
+	0x7d, 0x74, 0xf6, 0xea, 0xdb, 0x2a, 0x89, 0xae, 0x56, 0x49, 0xf4, 0x63, 0x95, 0x44, 0x5f, 0xd7,
+	0x49, 0xe7, 0x6a, 0x9d, 0x74, 0xbe, 0xaf, 0x93, 0xce, 0xdb, 0xf1, 0x4c, 0xb9, 0xf9, 0x62, 0x3a,
+	0x12, 0xa6, 0xcc, 0xe8, 0x45, 0x3e, 0xd3, 0xe8, 0x3e, 0x18, 0xfb, 0x3e, 0xa0, 0x02, 0xe5, 0x0c,
+	0x6d, 0xf6, 0x71, 0xfb, 0x9e, 0xa7, 0x3d, 0x7a, 0xc0, 0x27, 0xbf, 0x06, 0x00, 0x69, 0x6d, 0x0c,
+	0x37, 0xe9, 0x03, 0x00, 0x00,
 }
 
 func (m *EventCreateClass) Marshal() (dAtA []byte, err error) {
@@ -468,6 +597,22 @@ func (m *EventCreateClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CreditType) > 0 {
+		i -= len(m.CreditType)
+		copy(dAtA[i:], m.CreditType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CreditType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Issuers) > 0 {
+		for iNdEx := len(m.Issuers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Issuers[iNdEx])
+			copy(dAtA[i:], m.Issuers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Issuers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -679,6 +824,13 @@ func (m *EventCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Amount) > 0 {
 		i -= len(m.Amount)
 		copy(dAtA[i:], m.Amount)
@@ -703,6 +855,66 @@ func (m *EventCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventAddClassCreator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAddClassCreator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAddClassCreator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRemoveClassCreator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRemoveClassCreator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRemoveClassCreator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -728,6 +940,16 @@ func (m *EventCreateClass) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if len(m.Issuers) > 0 {
+		for _, s := range m.Issuers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.CreditType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -836,6 +1058,36 @@ func (m *EventCancel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAddClassCreator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRemoveClassCreator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -938,6 +1190,70 @@ func (m *EventCreateClass) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuers = append(m.Issuers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1726,6 +2042,208 @@ func (m *EventCancel) Unmarshal(dAtA []byte) error {
 			}
 			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAddClassCreator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAddClassCreator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAddClassCreator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRemoveClassCreator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRemoveClassCreator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRemoveClassCreator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -6,27 +6,48 @@ import (
 )
 
 // Validate performs basic validation for each credit-batch,
-// it returns an error if credit-batch tradable or retired supply
-// does not match the sum of all tradable or retired balances
+// it returns an error if credit classes or credit batches are duplicated,
+// if credit batches, supplies or balances reference unknown credit classes
+// or credit batches, if amounts exceed the credit type precision or if
+// credit-batch tradable or retired supply does not match the sum of all
+// tradable or retired balances
 func (s *GenesisState) Validate() error {
+	classes := make(map[string]*ClassInfo)
 	decimalPlaces := make(map[string]uint32)
 	calSupplies := make(map[string]math.Dec)
 	supplies := make(map[string]math.Dec)
 
+	for _, class := range s.ClassInfo {
+		if _, exists := classes[class.ClassId]; exists {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate credit class id: %s", class.ClassId)
+		}
+		classes[class.ClassId] = class
+
+		if err := validateClassSupply(class); err != nil {
+			return err
+		}
+	}
+
 	for _, batch := range s.BatchInfo {
 		if _, exists := decimalPlaces[batch.BatchDenom]; exists {
-			continue
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate credit batch denom: %s", batch.BatchDenom)
 		}
 
-		for _, class := range s.ClassInfo {
-			if batch.ClassId == class.ClassId {
-				decimalPlaces[batch.BatchDenom] = class.CreditType.GetPrecision()
-				break
-			}
+		class, ok := classes[batch.ClassId]
+		if !ok {
+			return sdkerrors.ErrNotFound.Wrapf("credit class %s is not found for %s credit batch", batch.ClassId, batch.BatchDenom)
 		}
+		decimalPlaces[batch.BatchDenom] = class.CreditType.GetPrecision()
 	}
 
 	for _, s := range s.Supplies {
+		if _, ok := decimalPlaces[s.BatchDenom]; !ok {
+			return sdkerrors.ErrNotFound.Wrapf("credit batch is not found for %s supply", s.BatchDenom)
+		}
+		if _, exists := supplies[s.BatchDenom]; exists {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate supply for %s credit batch", s.BatchDenom)
+		}
+
 		tSupply := math.NewDecFromInt64(0)
 		rSupply := math.NewDecFromInt64(0)
 		var err error
@@ -70,6 +91,76 @@ func (s *GenesisState) Validate() error {
 		return err
 	}
 
+	if err := validateAllowedClassCreators(s.ClassCreators); err != nil {
+		return err
+	}
+
+	if err := validateCancellations(s.BatchInfo, s.Cancellations, s.CancellationSeq); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateCancellations checks that cancellation IDs are unique and within the
+// cancellation sequence, and that cancellations reference known credit batches
+// of their credit class, with a valid reason and its reason category.
+func validateCancellations(batches []*BatchInfo, cancellations []*Cancellation, seq uint64) error {
+	batchClasses := make(map[string]string, len(batches))
+	for _, batch := range batches {
+		batchClasses[batch.BatchDenom] = batch.ClassId
+	}
+
+	ids := make(map[uint64]bool, len(cancellations))
+	for _, c := range cancellations {
+		if c.Id == 0 || c.Id > seq {
+			return sdkerrors.ErrInvalidRequest.Wrapf("cancellation id %d is out of the cancellation sequence %d", c.Id, seq)
+		}
+		if ids[c.Id] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate cancellation id: %d", c.Id)
+		}
+		ids[c.Id] = true
+
+		classID, ok := batchClasses[c.BatchDenom]
+		if !ok {
+			return sdkerrors.ErrNotFound.Wrapf("credit batch is not found for %d cancellation", c.Id)
+		}
+		if classID != c.ClassId {
+			return sdkerrors.ErrInvalidRequest.Wrapf("credit class %s of %d cancellation does not match credit batch %s", c.ClassId, c.Id, c.BatchDenom)
+		}
+
+		if err := ValidateCancelReason(c.Reason); err != nil {
+			return sdkerrors.Wrapf(err, "reason of %d cancellation", c.Id)
+		}
+		if c.ReasonCategory != CancelReasonCategory(c.Reason) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("reason category of %d cancellation does not match its reason", c.Id)
+		}
+	}
+	return nil
+}
+
+// validateClassSupply checks that the issued supply of a credit class doesn't
+// exceed its max supply, if any
+func validateClassSupply(class *ClassInfo) error {
+	issuedSupply := math.NewDecFromInt64(0)
+	if class.IssuedSupply != "" {
+		var err error
+		issuedSupply, err = math.NewNonNegativeDecFromString(class.IssuedSupply)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "issued supply of %s credit class", class.ClassId)
+		}
+	}
+
+	if class.MaxSupply == "" {
+		return nil
+	}
+	maxSupply, err := math.NewPositiveDecFromString(class.MaxSupply)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "max supply of %s credit class", class.ClassId)
+	}
+	if issuedSupply.Cmp(maxSupply) > 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("issued supply %v exceeds max supply %v for %s credit class", issuedSupply, maxSupply, class.ClassId)
+	}
 	return nil
 }
 
@@ -82,6 +173,10 @@ func validateClassInfoTypes(creditTypes []*CreditType, classInfos []*ClassInfo)
 	}
 
 	for _, cInfo := range classInfos {
+		if cInfo.CreditType == nil {
+			return sdkerrors.ErrNotFound.Wrapf("credit type is missing for %s credit class", cInfo.ClassId)
+		}
+
 		// fetch param via abbreviation
 		cType, ok := typeMap[cInfo.CreditType.Abbreviation]
 
@@ -113,6 +208,10 @@ func validateSupply(calSupply, supply map[string]math.Dec) error {
 
 func calculateSupply(decimalPlaces map[string]uint32, balances []*Balance, calSupply map[string]math.Dec) error {
 	for _, b := range balances {
+		if _, ok := decimalPlaces[b.BatchDenom]; !ok {
+			return sdkerrors.ErrNotFound.Wrapf("credit batch is not found for %s balance of %s", b.BatchDenom, b.Address)
+		}
+
 		tBalance := math.NewDecFromInt64(0)
 		rBalance := math.NewDecFromInt64(0)
 		var err error
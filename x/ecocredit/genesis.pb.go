@@ -38,6 +38,14 @@ type GenesisState struct {
 	Balances []*Balance `protobuf:"bytes,5,rep,name=balances,proto3" json:"balances,omitempty"`
 	// supplies is the list of credit batch tradable/retired supply.
 	Supplies []*Supply `protobuf:"bytes,6,rep,name=supplies,proto3" json:"supplies,omitempty"`
+	// class_creators is the state-based allowlist of accounts permitted to
+	// create credit classes.
+	ClassCreators []string `protobuf:"bytes,7,rep,name=class_creators,json=classCreators,proto3" json:"class_creators,omitempty"`
+	// cancellations is the list of credit cancellations.
+	Cancellations []*Cancellation `protobuf:"bytes,8,rep,name=cancellations,proto3" json:"cancellations,omitempty"`
+	// cancellation_seq is the cancellation table orm.Sequence,
+	// it is used to get the next cancellation ID.
+	CancellationSeq uint64 `protobuf:"varint,9,opt,name=cancellation_seq,json=cancellationSeq,proto3" json:"cancellation_seq,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -115,6 +123,27 @@ func (m *GenesisState) GetSupplies() []*Supply {
 	return nil
 }
 
+func (m *GenesisState) GetClassCreators() []string {
+	if m != nil {
+		return m.ClassCreators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCancellations() []*Cancellation {
+	if m != nil {
+		return m.Cancellations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCancellationSeq() uint64 {
+	if m != nil {
+		return m.CancellationSeq
+	}
+	return 0
+}
+
 // Balance represents tradable or retired units of a credit batch with an
 // account address, batch_denom, and balance.
 type Balance struct {
@@ -264,36 +293,40 @@ func init() {
 }
 
 var fileDescriptor_2f9cb84fe1853321 = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0x26, 0xa4, 0xcd, 0x04, 0x5a, 0xb4, 0xe2, 0x60, 0xf5, 0xe0, 0x86, 0xf0, 0x27,
-	0xe5, 0x80, 0xad, 0x94, 0x2b, 0x70, 0x30, 0x20, 0x84, 0xb8, 0x20, 0x87, 0x13, 0x97, 0x68, 0x6d,
-	0x4f, 0x1d, 0x0b, 0xc7, 0xeb, 0xee, 0x6e, 0x00, 0xbf, 0x05, 0x47, 0xc4, 0x13, 0xf5, 0xd8, 0x23,
-	0x27, 0x84, 0x92, 0x17, 0x41, 0xde, 0x3f, 0xae, 0x04, 0x4a, 0x7a, 0x9b, 0x99, 0xfc, 0xe6, 0xfb,
-	0xbe, 0x8c, 0xb5, 0xf0, 0x98, 0x63, 0x86, 0x65, 0x80, 0x09, 0x4b, 0x38, 0xa6, 0xb9, 0x0c, 0xbe,
-	0x4c, 0x69, 0x51, 0x2d, 0xe8, 0x34, 0xc8, 0xb0, 0x44, 0x91, 0x0b, 0xbf, 0xe2, 0x4c, 0x32, 0xe2,
-	0x2a, 0xce, 0x6f, 0x39, 0xdf, 0x72, 0xc7, 0x0f, 0xb7, 0x2a, 0xc8, 0xba, 0x42, 0xb3, 0x7f, 0x7c,
-	0x2f, 0x63, 0x19, 0x53, 0x65, 0xd0, 0x54, 0x7a, 0x3a, 0xfe, 0xd9, 0x85, 0xdb, 0x6f, 0xb5, 0xcf,
-	0x4c, 0x52, 0x89, 0xe4, 0x25, 0xf4, 0x2b, 0xca, 0xe9, 0x52, 0xb8, 0xce, 0xc8, 0x39, 0x1d, 0x9e,
-	0x8d, 0xfc, 0x6d, 0xbe, 0xfe, 0x07, 0xc5, 0x85, 0xbd, 0xcb, 0xdf, 0x27, 0x9d, 0xc8, 0x6c, 0x91,
-	0x10, 0x20, 0x29, 0xa8, 0x10, 0xf3, 0xbc, 0x3c, 0x67, 0xee, 0xde, 0xa8, 0x7b, 0x3a, 0x3c, 0x7b,
-	0xb0, 0x5d, 0xe3, 0x55, 0xc3, 0xbe, 0x2b, 0xcf, 0x59, 0x34, 0x48, 0x6c, 0xd9, 0x68, 0xc4, 0x54,
-	0x26, 0x0b, 0xad, 0xd1, 0xbd, 0x49, 0x23, 0x6c, 0x58, 0xad, 0x11, 0xdb, 0x92, 0xbc, 0x81, 0x81,
-	0xc0, 0x8b, 0x15, 0x96, 0x09, 0x0a, 0xb7, 0xa7, 0x24, 0x26, 0x3b, 0x62, 0xa8, 0xfe, 0x63, 0x5d,
-	0xe1, 0x0c, 0x2f, 0xa2, 0xeb, 0x4d, 0xf2, 0x02, 0x0e, 0x62, 0x5a, 0x50, 0xa5, 0x72, 0x4b, 0xa9,
-	0xdc, 0xdf, 0x15, 0x44, 0x91, 0x51, 0xbb, 0x42, 0x9e, 0xc3, 0x81, 0x58, 0x55, 0x55, 0x91, 0xa3,
-	0x70, 0xfb, 0x6a, 0x7d, 0xc7, 0x3d, 0x67, 0x0d, 0x59, 0x47, 0xed, 0xc6, 0xf8, 0x87, 0x03, 0xfb,
-	0x46, 0x93, 0xb8, 0xb0, 0x4f, 0xd3, 0x94, 0xa3, 0xd0, 0x1f, 0x66, 0x10, 0xd9, 0x96, 0x9c, 0xc0,
-	0x50, 0x5f, 0x2b, 0xc5, 0x92, 0x2d, 0xdd, 0x3d, 0xf5, 0xab, 0x3e, 0xe0, 0xeb, 0x66, 0x42, 0x9e,
-	0xc0, 0x5d, 0xc9, 0x69, 0x4a, 0xe3, 0x02, 0xe7, 0x26, 0x99, 0xdb, 0x55, 0xd4, 0x91, 0x9d, 0x5b,
-	0x97, 0x09, 0x1c, 0x71, 0x94, 0x39, 0xc7, 0xb4, 0x25, 0x7b, 0x8a, 0x3c, 0x34, 0x63, 0x03, 0x8e,
-	0x6b, 0xe8, 0xeb, 0xb8, 0xff, 0xda, 0x3b, 0xff, 0xd9, 0x4f, 0xa0, 0xb5, 0x99, 0xab, 0xbf, 0x56,
-	0x9b, 0x8c, 0x87, 0x76, 0x6c, 0x94, 0x1e, 0x81, 0x75, 0xb1, 0x9c, 0x4e, 0x79, 0xc7, 0x4c, 0x35,
-	0x16, 0xbe, 0xbf, 0x5c, 0x7b, 0xce, 0xd5, 0xda, 0x73, 0xfe, 0xac, 0x3d, 0xe7, 0xfb, 0xc6, 0xeb,
-	0x5c, 0x6d, 0xbc, 0xce, 0xaf, 0x8d, 0xd7, 0xf9, 0x34, 0xcd, 0x72, 0xb9, 0x58, 0xc5, 0x7e, 0xc2,
-	0x96, 0x81, 0xba, 0xf2, 0xd3, 0x12, 0xe5, 0x57, 0xc6, 0x3f, 0x9b, 0xae, 0xc0, 0x34, 0x43, 0x1e,
-	0x7c, 0xbb, 0x7e, 0x2a, 0x71, 0x5f, 0x3d, 0x83, 0x67, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x1f,
-	0xa3, 0xe5, 0x0c, 0x86, 0x03, 0x00, 0x00,
+	// 516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x93, 0xd2, 0x30,
+	0x18, 0xc6, 0xe9, 0x82, 0xfc, 0x09, 0x02, 0x4e, 0xc6, 0x43, 0x66, 0x0f, 0xdd, 0x8a, 0xee, 0x82,
+	0x07, 0xdb, 0x61, 0xbd, 0xaa, 0x07, 0x56, 0xc7, 0x71, 0xf4, 0xe0, 0x14, 0x4f, 0x5e, 0x98, 0xb4,
+	0x7d, 0xb7, 0x74, 0x2c, 0x4d, 0x49, 0x82, 0xca, 0xb7, 0xf0, 0xe8, 0x67, 0xf1, 0x13, 0xec, 0x71,
+	0x8f, 0x9e, 0x1c, 0x07, 0xbe, 0x88, 0xd3, 0x24, 0x65, 0x51, 0x07, 0xf6, 0xf6, 0xe6, 0xe1, 0xf7,
+	0x3e, 0xcf, 0xdb, 0x37, 0x04, 0x9d, 0x71, 0x88, 0x21, 0xf3, 0x20, 0x64, 0x21, 0x87, 0x28, 0x91,
+	0xde, 0xe7, 0x11, 0x4d, 0xf3, 0x19, 0x1d, 0x79, 0x31, 0x64, 0x20, 0x12, 0xe1, 0xe6, 0x9c, 0x49,
+	0x86, 0x89, 0xe2, 0xdc, 0x2d, 0xe7, 0x96, 0xdc, 0xf1, 0xa3, 0xbd, 0x0e, 0x72, 0x95, 0x83, 0xe9,
+	0x3f, 0xbe, 0x1f, 0xb3, 0x98, 0xa9, 0xd2, 0x2b, 0x2a, 0xad, 0xf6, 0x7f, 0xd4, 0xd0, 0xdd, 0xd7,
+	0x3a, 0x67, 0x22, 0xa9, 0x04, 0xfc, 0x02, 0xd5, 0x73, 0xca, 0xe9, 0x5c, 0x10, 0xcb, 0xb1, 0x86,
+	0xed, 0x73, 0xc7, 0xdd, 0x97, 0xeb, 0xbe, 0x57, 0xdc, 0xb8, 0x76, 0xf5, 0xeb, 0xa4, 0xe2, 0x9b,
+	0x2e, 0x3c, 0x46, 0x28, 0x4c, 0xa9, 0x10, 0xd3, 0x24, 0xbb, 0x64, 0xe4, 0xc8, 0xa9, 0x0e, 0xdb,
+	0xe7, 0x0f, 0xf7, 0x7b, 0x5c, 0x14, 0xec, 0x9b, 0xec, 0x92, 0xf9, 0xad, 0xb0, 0x2c, 0x0b, 0x8f,
+	0x80, 0xca, 0x70, 0xa6, 0x3d, 0xaa, 0xb7, 0x79, 0x8c, 0x0b, 0x56, 0x7b, 0x04, 0x65, 0x89, 0x5f,
+	0xa1, 0x96, 0x80, 0xc5, 0x12, 0xb2, 0x10, 0x04, 0xa9, 0x29, 0x8b, 0xc1, 0x81, 0x31, 0xd4, 0xf9,
+	0xc3, 0x2a, 0x87, 0x09, 0x2c, 0xfc, 0x9b, 0x4e, 0xfc, 0x1c, 0x35, 0x03, 0x9a, 0x52, 0xe5, 0x72,
+	0x47, 0xb9, 0x3c, 0x38, 0x34, 0x88, 0x22, 0xfd, 0x6d, 0x0b, 0x7e, 0x86, 0x9a, 0x62, 0x99, 0xe7,
+	0x69, 0x02, 0x82, 0xd4, 0x9d, 0xea, 0xe1, 0x7d, 0x4e, 0x0a, 0x72, 0xe5, 0x6f, 0x3b, 0xf0, 0x29,
+	0xea, 0xea, 0x5d, 0x86, 0x1c, 0xa8, 0x64, 0x5c, 0x90, 0x86, 0x53, 0x1d, 0xb6, 0xfc, 0x8e, 0x52,
+	0x2f, 0x8c, 0x88, 0xdf, 0xa1, 0x4e, 0x58, 0xc4, 0xa5, 0x29, 0x95, 0x09, 0xcb, 0x04, 0x69, 0xaa,
+	0xa4, 0xb3, 0x03, 0x9f, 0xbb, 0x83, 0xfb, 0x7f, 0x37, 0xe3, 0xc7, 0xe8, 0xde, 0xae, 0x30, 0x15,
+	0xb0, 0x20, 0x2d, 0xc7, 0x1a, 0xd6, 0xfc, 0xde, 0xae, 0x3e, 0x81, 0x45, 0xff, 0xbb, 0x85, 0x1a,
+	0xe6, 0x9b, 0x31, 0x41, 0x0d, 0x1a, 0x45, 0x1c, 0x84, 0xfe, 0xe3, 0xb4, 0xfc, 0xf2, 0x88, 0x4f,
+	0x50, 0x5b, 0xdf, 0x66, 0x04, 0x19, 0x9b, 0x93, 0x23, 0xf5, 0xab, 0xbe, 0xe0, 0x97, 0x85, 0x52,
+	0x24, 0x4a, 0x4e, 0x23, 0x1a, 0xa4, 0x30, 0x35, 0x9b, 0x23, 0x55, 0x45, 0xf5, 0x4a, 0xbd, 0x4c,
+	0x19, 0xa0, 0x1e, 0x07, 0x99, 0x70, 0x88, 0xb6, 0x64, 0x4d, 0x91, 0x5d, 0x23, 0x1b, 0xb0, 0xbf,
+	0x42, 0x75, 0xbd, 0xce, 0x7f, 0xe3, 0xad, 0xff, 0xe2, 0x07, 0x68, 0x1b, 0x33, 0x55, 0xab, 0x5f,
+	0x99, 0x19, 0xbb, 0xa5, 0x6c, 0x9c, 0x4e, 0x51, 0x99, 0x52, 0x72, 0x7a, 0xca, 0x8e, 0x51, 0x35,
+	0x36, 0x7e, 0x7b, 0xb5, 0xb6, 0xad, 0xeb, 0xb5, 0x6d, 0xfd, 0x5e, 0xdb, 0xd6, 0xb7, 0x8d, 0x5d,
+	0xb9, 0xde, 0xd8, 0x95, 0x9f, 0x1b, 0xbb, 0xf2, 0x71, 0x14, 0x27, 0x72, 0xb6, 0x0c, 0xdc, 0x90,
+	0xcd, 0x3d, 0x75, 0x37, 0x4f, 0x32, 0x90, 0x5f, 0x18, 0xff, 0x64, 0x4e, 0x29, 0x44, 0x31, 0x70,
+	0xef, 0xeb, 0xcd, 0x53, 0x0e, 0xea, 0xea, 0x99, 0x3e, 0xfd, 0x33, 0x00, 0x26, 0xf8, 0x0d, 0x66,
+	0x26, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -316,6 +349,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CancellationSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CancellationSeq))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Cancellations) > 0 {
+		for iNdEx := len(m.Cancellations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cancellations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ClassCreators) > 0 {
+		for iNdEx := len(m.ClassCreators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClassCreators[iNdEx])
+			copy(dAtA[i:], m.ClassCreators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClassCreators[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Supplies) > 0 {
 		for iNdEx := len(m.Supplies) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -543,6 +604,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ClassCreators) > 0 {
+		for _, s := range m.ClassCreators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Cancellations) > 0 {
+		for _, e := range m.Cancellations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CancellationSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.CancellationSeq))
+	}
 	return n
 }
 
@@ -830,6 +906,91 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassCreators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassCreators = append(m.ClassCreators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancellations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cancellations = append(m.Cancellations, &Cancellation{})
+			if err := m.Cancellations[len(m.Cancellations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancellationSeq", wireType)
+			}
+			m.CancellationSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancellationSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
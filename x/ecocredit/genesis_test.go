@@ -54,6 +54,46 @@ func TestGenesisValidate(t *testing.T) {
 			false,
 			"",
 		},
+		{
+			"valid: issued supply at max supply",
+			func() *ecocredit.GenesisState {
+				genesisState := ecocredit.DefaultGenesisState()
+				genesisState.ClassInfo = []*ecocredit.ClassInfo{
+					{
+						ClassId:      "1",
+						Admin:        addr1.String(),
+						Issuers:      []string{addr1.String(), addr2.String()},
+						Metadata:     []byte("meta-data"),
+						CreditType:   genesisState.Params.CreditTypes[0],
+						MaxSupply:    "100",
+						IssuedSupply: "100",
+					},
+				}
+				return genesisState
+			},
+			false,
+			"",
+		},
+		{
+			"invalid: issued supply exceeds max supply",
+			func() *ecocredit.GenesisState {
+				genesisState := ecocredit.DefaultGenesisState()
+				genesisState.ClassInfo = []*ecocredit.ClassInfo{
+					{
+						ClassId:      "1",
+						Admin:        addr1.String(),
+						Issuers:      []string{addr1.String(), addr2.String()},
+						Metadata:     []byte("meta-data"),
+						CreditType:   genesisState.Params.CreditTypes[0],
+						MaxSupply:    "100",
+						IssuedSupply: "100.5",
+					},
+				}
+				return genesisState
+			},
+			true,
+			"issued supply 100.5 exceeds max supply 100 for 1 credit class: invalid request",
+		},
 		{
 			"invalid: credit type param",
 			func() *ecocredit.GenesisState {
@@ -148,7 +188,7 @@ func TestGenesisValidate(t *testing.T) {
 				return genesisState
 			},
 			true,
-			formatCreditTypeParamError(ecocredit.CreditType{"badbadnotgood", "C", "metric ton CO2 equivalent", 6}).Error(),
+			formatCreditTypeParamError(ecocredit.CreditType{"badbadnotgood", "C", "metric ton CO2 equivalent", 6, false}).Error(),
 		},
 		{
 			"invalid: type unit does not match param unit",
@@ -171,7 +211,7 @@ func TestGenesisValidate(t *testing.T) {
 				return genesisState
 			},
 			true,
-			formatCreditTypeParamError(ecocredit.CreditType{"carbon", "C", "inches", 6}).Error(),
+			formatCreditTypeParamError(ecocredit.CreditType{"carbon", "C", "inches", 6, false}).Error(),
 		},
 		{
 			"invalid: non-existent abbreviation",
@@ -388,6 +428,160 @@ func TestGenesisValidate(t *testing.T) {
 			false,
 			"",
 		},
+		{
+			"expect error: duplicate class id",
+			func() *ecocredit.GenesisState {
+				genesisState := genesisStateWithBatch()
+				genesisState.ClassInfo = append(genesisState.ClassInfo, genesisState.ClassInfo[0])
+				return genesisState
+			},
+			true,
+			"duplicate credit class id: 1: invalid request",
+		},
+		{
+			"expect error: duplicate batch denom",
+			func() *ecocredit.GenesisState {
+				genesisState := genesisStateWithBatch()
+				genesisState.BatchInfo = append(genesisState.BatchInfo, genesisState.BatchInfo[0])
+				return genesisState
+			},
+			true,
+			"duplicate credit batch denom: 1/2: invalid request",
+		},
+		{
+			"expect error: batch of unknown class",
+			func() *ecocredit.GenesisState {
+				genesisState := genesisStateWithBatch()
+				genesisState.BatchInfo[0].ClassId = "2"
+				return genesisState
+			},
+			true,
+			"credit class 2 is not found for 1/2 credit batch: not found",
+		},
+		{
+			"expect error: balance of unknown batch",
+			func() *ecocredit.GenesisState {
+				genesisState := genesisStateWithBatch()
+				genesisState.Balances = append(genesisState.Balances, &ecocredit.Balance{
+					Address:         addr1.String(),
+					BatchDenom:      "1/3",
+					TradableBalance: "1",
+				})
+				return genesisState
+			},
+			true,
+			fmt.Sprintf("credit batch is not found for 1/3 balance of %s: not found", addr1),
+		},
+		{
+			"expect error: supply of unknown batch",
+			func() *ecocredit.GenesisState {
+				genesisState := genesisStateWithBatch()
+				genesisState.Supplies = append(genesisState.Supplies, &ecocredit.Supply{
+					BatchDenom:     "1/3",
+					TradableSupply: "1",
+				})
+				return genesisState
+			},
+			true,
+			"credit batch is not found for 1/3 supply: not found",
+		},
+		{
+			"expect error: duplicate supply",
+			func() *ecocredit.GenesisState {
+				genesisState := genesisStateWithBatch()
+				genesisState.Supplies = append(genesisState.Supplies, genesisState.Supplies[0])
+				return genesisState
+			},
+			true,
+			"duplicate supply for 1/2 credit batch: invalid request",
+		},
+		{
+			"expect error: balance exceeds credit type precision",
+			func() *ecocredit.GenesisState {
+				genesisState := genesisStateWithBatch()
+				genesisState.Balances[0].TradableBalance = "100.1234567"
+				genesisState.Supplies[0].TradableSupply = "100.1234567"
+				return genesisState
+			},
+			true,
+			"100.1234567 exceeds maximum decimal places: 6",
+		},
+		{
+			"expect error: class without credit type",
+			func() *ecocredit.GenesisState {
+				genesisState := genesisStateWithBatch()
+				genesisState.ClassInfo[0].CreditType = nil
+				return genesisState
+			},
+			true,
+			"credit type is missing for 1 credit class: not found",
+		},
+		{
+			"valid test case, single batch",
+			func() *ecocredit.GenesisState {
+				return genesisStateWithBatch()
+			},
+			false,
+			"",
+		},
+		{
+			"valid: cancellation",
+			func() *ecocredit.GenesisState {
+				return genesisStateWithCancellation()
+			},
+			false,
+			"",
+		},
+		{
+			"expect error: cancellation id out of sequence",
+			func() *ecocredit.GenesisState {
+				genesisState := genesisStateWithCancellation()
+				genesisState.CancellationSeq = 0
+				return genesisState
+			},
+			true,
+			"cancellation id 1 is out of the cancellation sequence 0: invalid request",
+		},
+		{
+			"expect error: duplicate cancellation id",
+			func() *ecocredit.GenesisState {
+				genesisState := genesisStateWithCancellation()
+				genesisState.Cancellations = append(genesisState.Cancellations, genesisState.Cancellations[0])
+				return genesisState
+			},
+			true,
+			"duplicate cancellation id: 1: invalid request",
+		},
+		{
+			"expect error: cancellation of unknown batch",
+			func() *ecocredit.GenesisState {
+				genesisState := genesisStateWithCancellation()
+				genesisState.Cancellations[0].BatchDenom = "1/3"
+				return genesisState
+			},
+			true,
+			"credit batch is not found for 1 cancellation: not found",
+		},
+		{
+			"expect error: cancellation without reason",
+			func() *ecocredit.GenesisState {
+				genesisState := genesisStateWithCancellation()
+				genesisState.Cancellations[0].Reason = ""
+				return genesisState
+			},
+			true,
+			"reason of 1 cancellation: reason should not be empty: invalid request",
+		},
+		{
+			"expect error: cancellation reason category mismatch",
+			func() *ecocredit.GenesisState {
+				genesisState := genesisStateWithCancellation()
+				genesisState.Cancellations[0].ReasonCategory = "reversal"
+				return genesisState
+			},
+			true,
+			"reason category of 1 cancellation does not match its reason: invalid request",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -403,6 +597,62 @@ func TestGenesisValidate(t *testing.T) {
 	}
 }
 
+// genesisStateWithBatch returns a valid genesis state with a single credit class and batch.
+func genesisStateWithBatch() *ecocredit.GenesisState {
+	genesisState := ecocredit.DefaultGenesisState()
+	genesisState.ClassInfo = []*ecocredit.ClassInfo{
+		{
+			ClassId:    "1",
+			Admin:      addr1.String(),
+			Issuers:    []string{addr1.String()},
+			CreditType: genesisState.Params.CreditTypes[0],
+		},
+	}
+	genesisState.BatchInfo = []*ecocredit.BatchInfo{
+		{
+			ClassId:     "1",
+			BatchDenom:  "1/2",
+			Issuer:      addr1.String(),
+			TotalAmount: "200",
+		},
+	}
+	genesisState.Balances = []*ecocredit.Balance{
+		{
+			Address:         addr2.String(),
+			BatchDenom:      "1/2",
+			TradableBalance: "100",
+			RetiredBalance:  "100",
+		},
+	}
+	genesisState.Supplies = []*ecocredit.Supply{
+		{
+			BatchDenom:     "1/2",
+			TradableSupply: "100",
+			RetiredSupply:  "100",
+		},
+	}
+	return genesisState
+}
+
+// genesisStateWithCancellation returns a valid genesis state with a single
+// credit class and batch, and a cancellation of credits of the batch.
+func genesisStateWithCancellation() *ecocredit.GenesisState {
+	genesisState := genesisStateWithBatch()
+	genesisState.Cancellations = []*ecocredit.Cancellation{
+		{
+			Id:             1,
+			ClassId:        "1",
+			BatchDenom:     "1/2",
+			Holder:         addr2.String(),
+			Amount:         "10",
+			Reason:         "double counting: also issued on another registry",
+			ReasonCategory: "double counting",
+		},
+	}
+	genesisState.CancellationSeq = 1
+	return genesisState
+}
+
 var defaultCreditTypes = ecocredit.DefaultGenesisState().Params.CreditTypes
 
 func formatCreditTypeParamError(ct ecocredit.CreditType) error {
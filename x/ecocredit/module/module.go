@@ -25,6 +25,7 @@ import (
 type Module struct {
 	paramSpace paramtypes.Subspace
 	bankKeeper ecocredit.BankKeeper
+	keeper     *server.Keeper
 }
 
 func NewModule(paramSpace paramtypes.Subspace, bankKeeper ecocredit.BankKeeper) Module {
@@ -35,9 +36,16 @@ func NewModule(paramSpace paramtypes.Subspace, bankKeeper ecocredit.BankKeeper)
 	return Module{
 		paramSpace: paramSpace,
 		bankKeeper: bankKeeper,
+		keeper:     &server.Keeper{},
 	}
 }
 
+// Keeper returns the keeper other modules can use to access ecocredit state
+// transitions. It is set up once the module services are registered.
+func (a Module) Keeper() *server.Keeper {
+	return a.keeper
+}
+
 var _ module.AppModuleBasic = Module{}
 var _ servermodule.Module = Module{}
 var _ restmodule.Module = Module{}
@@ -52,7 +60,10 @@ func (a Module) RegisterInterfaces(registry types.InterfaceRegistry) {
 }
 
 func (a Module) RegisterServices(configurator servermodule.Configurator) {
-	server.RegisterServices(configurator, a.paramSpace, a.bankKeeper)
+	keeper := server.RegisterServices(configurator, a.paramSpace, a.bankKeeper)
+	if a.keeper != nil {
+		*a.keeper = keeper
+	}
 }
 
 //nolint:errcheck
@@ -82,7 +93,7 @@ func (a Module) GetTxCmd() *cobra.Command {
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (Module) ConsensusVersion() uint64 { return 1 }
+func (Module) ConsensusVersion() uint64 { return 2 }
 
 /**** DEPRECATED ****/
 func (a Module) RegisterRESTRoutes(sdkclient.Context, *mux.Router) {}
@@ -9,10 +9,10 @@ import (
 )
 
 var (
-	_, _, _, _, _ sdk.Msg = &MsgCreateClass{}, &MsgCreateBatch{}, &MsgSend{},
-		&MsgRetire{}, &MsgCancel{}
-	_, _, _, _, _ legacytx.LegacyMsg = &MsgCreateClass{}, &MsgCreateBatch{}, &MsgSend{},
-		&MsgRetire{}, &MsgCancel{}
+	_, _, _, _, _, _, _ sdk.Msg = &MsgCreateClass{}, &MsgCreateBatch{}, &MsgSend{},
+		&MsgRetire{}, &MsgCancel{}, &MsgAddClassCreator{}, &MsgRemoveClassCreator{}
+	_, _, _, _, _, _, _ legacytx.LegacyMsg = &MsgCreateClass{}, &MsgCreateBatch{}, &MsgSend{},
+		&MsgRetire{}, &MsgCancel{}, &MsgAddClassCreator{}, &MsgRemoveClassCreator{}
 )
 
 // Route Implements LegacyMsg.
@@ -39,10 +39,13 @@ func (m *MsgCreateClass) ValidateBasic() error {
 	if len(m.CreditTypeName) == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("credit class must have a credit type")
 	}
-	for _, issuer := range m.Issuers {
+	if err := ValidateAddresses(m.Issuers); err != nil {
+		return sdkerrors.Wrap(err, "issuers")
+	}
 
-		if _, err := sdk.AccAddressFromBech32(issuer); err != nil {
-			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	if m.MaxSupply != "" {
+		if _, err := math.NewPositiveDecFromString(m.MaxSupply); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid max supply: %s", err)
 		}
 	}
 
@@ -89,11 +92,15 @@ func (m *MsgCreateBatch) ValidateBasic() error {
 		return err
 	}
 
-	for _, iss := range m.Issuance {
+	recipients := make([]string, len(m.Issuance))
+	for i, iss := range m.Issuance {
+		recipients[i] = iss.Recipient
+	}
+	if err := ValidateAddresses(recipients); err != nil {
+		return sdkerrors.Wrap(err, "issuance recipients")
+	}
 
-		if _, err := sdk.AccAddressFromBech32(iss.Recipient); err != nil {
-			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
-		}
+	for _, iss := range m.Issuance {
 
 		if iss.TradableAmount != "" {
 			if _, err := math.NewNonNegativeDecFromString(iss.TradableAmount); err != nil {
@@ -108,6 +115,9 @@ func (m *MsgCreateBatch) ValidateBasic() error {
 			}
 
 			if !retiredAmount.IsZero() {
+				if iss.RetirementLocation == "" {
+					return sdkerrors.ErrInvalidRequest.Wrapf("retirement location is required for retired amount %s of recipient %s", iss.RetiredAmount, iss.Recipient)
+				}
 				if err = validateLocation(iss.RetirementLocation); err != nil {
 					return err
 				}
@@ -136,12 +146,8 @@ func (m MsgSend) GetSignBytes() []byte {
 
 func (m *MsgSend) ValidateBasic() error {
 
-	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
-		return sdkerrors.Wrap(err, "sender")
-	}
-
-	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
-		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	if err := ValidateAddresses([]string{m.Sender, m.Recipient}); err != nil {
+		return sdkerrors.Wrap(err, "sender and recipient")
 	}
 
 	if len(m.Credits) == 0 {
@@ -153,8 +159,11 @@ func (m *MsgSend) ValidateBasic() error {
 			return sdkerrors.ErrInvalidRequest.Wrap("batch denom should not be empty")
 		}
 
-		if _, err := math.NewNonNegativeDecFromString(credit.TradableAmount); err != nil {
-			return err
+		// an empty tradable amount sends the whole tradable balance
+		if credit.TradableAmount != "" {
+			if _, err := math.NewNonNegativeDecFromString(credit.TradableAmount); err != nil {
+				return err
+			}
 		}
 
 		retiredAmount, err := math.NewNonNegativeDecFromString(credit.RetiredAmount)
@@ -247,6 +256,10 @@ func (m *MsgCancel) ValidateBasic() error {
 			return err
 		}
 	}
+
+	if err := ValidateCancelReason(m.Reason); err != nil {
+		return sdkerrors.Wrap(err, "reason")
+	}
 	return nil
 }
 
@@ -254,3 +267,61 @@ func (m *MsgCancel) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Holder)
 	return []sdk.AccAddress{addr}
 }
+
+// Route Implements LegacyMsg.
+func (m MsgAddClassCreator) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements LegacyMsg.
+func (m MsgAddClassCreator) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements LegacyMsg.
+func (m MsgAddClassCreator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m *MsgAddClassCreator) ValidateBasic() error {
+
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "authority")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return sdkerrors.Wrap(err, "creator")
+	}
+
+	return nil
+}
+
+func (m *MsgAddClassCreator) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// Route Implements LegacyMsg.
+func (m MsgRemoveClassCreator) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements LegacyMsg.
+func (m MsgRemoveClassCreator) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements LegacyMsg.
+func (m MsgRemoveClassCreator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m *MsgRemoveClassCreator) ValidateBasic() error {
+
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "authority")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return sdkerrors.Wrap(err, "creator")
+	}
+
+	return nil
+}
+
+func (m *MsgRemoveClassCreator) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
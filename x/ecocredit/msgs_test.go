@@ -1,6 +1,7 @@
 package ecocredit
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -66,6 +67,33 @@ func TestMsgCreateClass(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"valid msg with max supply": {
+			src: MsgCreateClass{
+				Admin:          addr1.String(),
+				CreditTypeName: "carbon",
+				Issuers:        []string{addr1.String(), addr2.String()},
+				MaxSupply:      "1000.5",
+			},
+			expErr: false,
+		},
+		"invalid with zero max supply": {
+			src: MsgCreateClass{
+				Admin:          addr1.String(),
+				CreditTypeName: "carbon",
+				Issuers:        []string{addr1.String(), addr2.String()},
+				MaxSupply:      "0",
+			},
+			expErr: true,
+		},
+		"invalid with malformed max supply": {
+			src: MsgCreateClass{
+				Admin:          addr1.String(),
+				CreditTypeName: "carbon",
+				Issuers:        []string{addr1.String(), addr2.String()},
+				MaxSupply:      "abc",
+			},
+			expErr: true,
+		},
 	}
 
 	for msg, test := range tests {
@@ -197,7 +225,7 @@ func TestMsgCreateBatch(t *testing.T) {
 		"invalid msg with wrong Issuance.RetirementLocation": {
 			src: MsgCreateBatch{
 				Issuer:    addr1.String(),
-				ClassId:   "ID",
+				ClassId:   "C01",
 				StartDate: &startDate,
 				EndDate:   &endDate,
 				Issuance: []*MsgCreateBatch_BatchIssuance{
@@ -214,7 +242,7 @@ func TestMsgCreateBatch(t *testing.T) {
 		"invalid msg without Issuance.RetirementLocation": {
 			src: MsgCreateBatch{
 				Issuer:    addr1.String(),
-				ClassId:   "ID",
+				ClassId:   "C01",
 				StartDate: &startDate,
 				EndDate:   &endDate,
 				Issuance: []*MsgCreateBatch_BatchIssuance{
@@ -227,6 +255,45 @@ func TestMsgCreateBatch(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"invalid msg with a later Issuance without RetirementLocation": {
+			src: MsgCreateBatch{
+				Issuer:    addr1.String(),
+				ClassId:   "C01",
+				StartDate: &startDate,
+				EndDate:   &endDate,
+				Issuance: []*MsgCreateBatch_BatchIssuance{
+					{
+						Recipient:          addr2.String(),
+						RetiredAmount:      "50",
+						RetirementLocation: "ST-UVW XY Z12",
+					},
+					{
+						Recipient:      addr1.String(),
+						TradableAmount: "10",
+						RetiredAmount:  "0.1",
+					},
+				},
+				ProjectLocation: "AB-CDE FG1 345",
+			},
+			expErr: true,
+		},
+		"valid msg with zero Issuance.RetiredAmount without RetirementLocation": {
+			src: MsgCreateBatch{
+				Issuer:    addr1.String(),
+				ClassId:   "C01",
+				StartDate: &startDate,
+				EndDate:   &endDate,
+				Issuance: []*MsgCreateBatch_BatchIssuance{
+					{
+						Recipient:      addr2.String(),
+						TradableAmount: "10",
+						RetiredAmount:  "0.000",
+					},
+				},
+				ProjectLocation: "AB-CDE FG1 345",
+			},
+			expErr: false,
+		},
 		"invalid msg with wrong ProjectLocation": {
 			src: MsgCreateBatch{
 				Issuer:          addr1.String(),
@@ -430,7 +497,7 @@ func TestMsgSend(t *testing.T) {
 			},
 			expErr: true,
 		},
-		"invalid msg without Credits.TradableAmount set": {
+		"valid msg without Credits.TradableAmount set to send the whole balance": {
 			src: MsgSend{
 				Sender:    addr1.String(),
 				Recipient: addr2.String(),
@@ -442,7 +509,7 @@ func TestMsgSend(t *testing.T) {
 					},
 				},
 			},
-			expErr: true,
+			expErr: false,
 		},
 		"invalid msg without Credits.RetiredAmount set": {
 			src: MsgSend{
@@ -666,6 +733,7 @@ func TestMsgCancel(t *testing.T) {
 		"valid msg": {
 			src: MsgCancel{
 				Holder: addr1.String(),
+				Reason: "double counting",
 				Credits: []*MsgCancel_CancelCredits{
 					{
 						BatchDenom: "some_denom",
@@ -677,6 +745,7 @@ func TestMsgCancel(t *testing.T) {
 		},
 		"invalid msg without holder": {
 			src: MsgCancel{
+				Reason: "double counting",
 				Credits: []*MsgCancel_CancelCredits{
 					{
 						BatchDenom: "some_denom",
@@ -689,6 +758,7 @@ func TestMsgCancel(t *testing.T) {
 		"invalid msg with wrong holder address": {
 			src: MsgCancel{
 				Holder: "wrongHolder",
+				Reason: "double counting",
 				Credits: []*MsgCancel_CancelCredits{
 					{
 						BatchDenom: "some_denom",
@@ -701,12 +771,14 @@ func TestMsgCancel(t *testing.T) {
 		"invalid msg without credits": {
 			src: MsgCancel{
 				Holder: addr1.String(),
+				Reason: "double counting",
 			},
 			expErr: true,
 		},
 		"invalid msg without Credits.BatchDenom": {
 			src: MsgCancel{
 				Holder: addr1.String(),
+				Reason: "double counting",
 				Credits: []*MsgCancel_CancelCredits{
 					{
 						Amount: "10",
@@ -718,6 +790,7 @@ func TestMsgCancel(t *testing.T) {
 		"invalid msg without Credits.Amount": {
 			src: MsgCancel{
 				Holder: addr1.String(),
+				Reason: "double counting",
 				Credits: []*MsgCancel_CancelCredits{
 					{
 						BatchDenom: "some_denom",
@@ -729,6 +802,7 @@ func TestMsgCancel(t *testing.T) {
 		"invalid msg with wrong Credits.Amount": {
 			src: MsgCancel{
 				Holder: addr1.String(),
+				Reason: "double counting",
 				Credits: []*MsgCancel_CancelCredits{
 					{
 						BatchDenom: "some_denom",
@@ -738,6 +812,154 @@ func TestMsgCancel(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"invalid msg without reason": {
+			src: MsgCancel{
+				Holder: addr1.String(),
+				Credits: []*MsgCancel_CancelCredits{
+					{
+						BatchDenom: "some_denom",
+						Amount:     "10",
+					},
+				},
+			},
+			expErr: true,
+		},
+		"invalid msg with empty reason category": {
+			src: MsgCancel{
+				Holder: addr1.String(),
+				Reason: " : also issued on another registry",
+				Credits: []*MsgCancel_CancelCredits{
+					{
+						BatchDenom: "some_denom",
+						Amount:     "10",
+					},
+				},
+			},
+			expErr: true,
+		},
+		"invalid msg with too long reason": {
+			src: MsgCancel{
+				Holder: addr1.String(),
+				Reason: strings.Repeat("x", MaxCancelReasonLength+1),
+				Credits: []*MsgCancel_CancelCredits{
+					{
+						BatchDenom: "some_denom",
+						Amount:     "10",
+					},
+				},
+			},
+			expErr: true,
+		},
+	}
+
+	for msg, test := range tests {
+		t.Run(msg, func(t *testing.T) {
+			err := test.src.ValidateBasic()
+			if test.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgAddClassCreator(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	tests := map[string]struct {
+		src    MsgAddClassCreator
+		expErr bool
+	}{
+		"valid msg": {
+			src: MsgAddClassCreator{
+				Authority: addr1.String(),
+				Creator:   addr2.String(),
+			},
+			expErr: false,
+		},
+		"invalid msg without authority": {
+			src: MsgAddClassCreator{
+				Creator: addr2.String(),
+			},
+			expErr: true,
+		},
+		"invalid msg with wrong authority address": {
+			src: MsgAddClassCreator{
+				Authority: "wrongAuthority",
+				Creator:   addr2.String(),
+			},
+			expErr: true,
+		},
+		"invalid msg without creator": {
+			src: MsgAddClassCreator{
+				Authority: addr1.String(),
+			},
+			expErr: true,
+		},
+		"invalid msg with wrong creator address": {
+			src: MsgAddClassCreator{
+				Authority: addr1.String(),
+				Creator:   "wrongCreator",
+			},
+			expErr: true,
+		},
+	}
+
+	for msg, test := range tests {
+		t.Run(msg, func(t *testing.T) {
+			err := test.src.ValidateBasic()
+			if test.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRemoveClassCreator(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	tests := map[string]struct {
+		src    MsgRemoveClassCreator
+		expErr bool
+	}{
+		"valid msg": {
+			src: MsgRemoveClassCreator{
+				Authority: addr1.String(),
+				Creator:   addr2.String(),
+			},
+			expErr: false,
+		},
+		"invalid msg without authority": {
+			src: MsgRemoveClassCreator{
+				Creator: addr2.String(),
+			},
+			expErr: true,
+		},
+		"invalid msg with wrong authority address": {
+			src: MsgRemoveClassCreator{
+				Authority: "wrongAuthority",
+				Creator:   addr2.String(),
+			},
+			expErr: true,
+		},
+		"invalid msg without creator": {
+			src: MsgRemoveClassCreator{
+				Authority: addr1.String(),
+			},
+			expErr: true,
+		},
+		"invalid msg with wrong creator address": {
+			src: MsgRemoveClassCreator{
+				Authority: addr1.String(),
+				Creator:   "wrongCreator",
+			},
+			expErr: true,
+		},
 	}
 
 	for msg, test := range tests {
@@ -15,6 +15,9 @@ var (
 	KeyAllowedClassCreators     = []byte("AllowedClassCreators")
 	KeyAllowlistEnabled         = []byte("AllowlistEnabled")
 	KeyCreditTypes              = []byte("CreditTypes")
+	KeyAllowlistAuthority       = []byte("AllowlistAuthority")
+	KeyMaxClassMetadataLength   = []byte("MaxClassMetadataLength")
+	KeyMaxBatchMetadataLength   = []byte("MaxBatchMetadataLength")
 )
 
 // TODO: remove after we open governance changes for precision
@@ -22,6 +25,10 @@ const (
 	PRECISION uint32 = 6
 )
 
+// DefaultMaxMetadataLength is the default maximum length in bytes of credit
+// class and credit batch metadata.
+const DefaultMaxMetadataLength uint64 = 256
+
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
@@ -33,6 +40,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyAllowedClassCreators, &p.AllowedClassCreators, validateAllowedClassCreators),
 		paramtypes.NewParamSetPair(KeyAllowlistEnabled, &p.AllowlistEnabled, validateAllowlistEnabled),
 		paramtypes.NewParamSetPair(KeyCreditTypes, &p.CreditTypes, validateCreditTypes),
+		paramtypes.NewParamSetPair(KeyAllowlistAuthority, &p.AllowlistAuthority, validateAllowlistAuthority),
+		paramtypes.NewParamSetPair(KeyMaxClassMetadataLength, &p.MaxClassMetadataLength, validateMaxMetadataLength),
+		paramtypes.NewParamSetPair(KeyMaxBatchMetadataLength, &p.MaxBatchMetadataLength, validateMaxMetadataLength),
 	}
 }
 
@@ -54,6 +64,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateAllowlistAuthority(p.AllowlistAuthority); err != nil {
+		return err
+	}
+
+	if err := validateMaxMetadataLength(p.MaxClassMetadataLength); err != nil {
+		return err
+	}
+
+	if err := validateMaxMetadataLength(p.MaxBatchMetadataLength); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -93,6 +115,38 @@ func validateAllowlistEnabled(i interface{}) error {
 	return nil
 }
 
+func validateAllowlistAuthority(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("invalid parameter type: %T", i)
+	}
+
+	// an empty authority disables managing the state-based allowlist
+	if v == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid allowlist authority address: %s", err.Error())
+	}
+
+	return nil
+}
+
+func validateMaxMetadataLength(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("invalid parameter type: %T", i)
+	}
+
+	// a zero length would reject any metadata rather than leave it unlimited
+	if v == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("max metadata length must be positive")
+	}
+
+	return nil
+}
+
 func validateCreditTypes(i interface{}) error {
 	creditTypes, ok := i.([]*CreditType)
 	if !ok {
@@ -101,19 +155,23 @@ func validateCreditTypes(i interface{}) error {
 
 	// ensure no duplicate credit types or abbreviations and that all
 	// precisions conform to hardcoded PRECISION above
-	seenTypes := make(map[string]bool)
+	seenTypes := make(map[string]string)
 	seenAbbrs := make(map[string]bool)
 	for _, creditType := range creditTypes {
-		// Validate name
+		// Validate name, checking for collisions between normalized names
+		// first so that e.g. "carbon" and "Car bon" are reported as such
 		T := NormalizeCreditTypeName(creditType.Name)
-		if T != creditType.Name {
-			return sdkerrors.ErrInvalidRequest.Wrapf("credit type name should be normalized: got %s, should be %s", creditType.Name, T)
-		}
-		if creditType.Name == "" {
+		if T == "" {
 			return sdkerrors.ErrInvalidRequest.Wrap("empty credit type name")
 		}
-		if seenTypes[T] {
-			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate credit type name in request: %s", T)
+		if seen, ok := seenTypes[T]; ok {
+			if seen == creditType.Name {
+				return sdkerrors.ErrInvalidRequest.Wrapf("duplicate credit type name in request: %s", T)
+			}
+			return sdkerrors.ErrInvalidRequest.Wrapf("credit type names %q and %q collide after normalization to %s", seen, creditType.Name, T)
+		}
+		if T != creditType.Name {
+			return sdkerrors.ErrInvalidRequest.Wrapf("credit type name should be normalized: got %s, should be %s", creditType.Name, T)
 		}
 
 		// Validate abbreviation
@@ -138,7 +196,7 @@ func validateCreditTypes(i interface{}) error {
 		}
 
 		// Mark type and abbr as seen
-		seenTypes[T] = true
+		seenTypes[T] = creditType.Name
 		seenAbbrs[abbr] = true
 	}
 
@@ -166,17 +224,21 @@ func NewParams(creditClassFee sdk.Coins, allowlist []string, allowlistEnabled bo
 }
 
 func DefaultParams() Params {
-	return NewParams(
+	p := NewParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultCreditClassFeeTokens)),
 		[]string{},
 		false,
 		[]*CreditType{
 			{
-				Name:         "carbon",
-				Abbreviation: "C",
-				Unit:         "metric ton CO2 equivalent",
-				Precision:    PRECISION,
+				Name:            "carbon",
+				Abbreviation:    "C",
+				Unit:            "metric ton CO2 equivalent",
+				Precision:       PRECISION,
+				AllowFractional: true,
 			},
 		},
 	)
+	p.MaxClassMetadataLength = DefaultMaxMetadataLength
+	p.MaxBatchMetadataLength = DefaultMaxMetadataLength
+	return p
 }
@@ -15,12 +15,15 @@ func TestDefaultParams(t *testing.T) {
 		AllowlistEnabled:     false,
 		CreditTypes: []*CreditType{
 			{
-				Name:         "carbon",
-				Abbreviation: "C",
-				Unit:         "metric ton CO2 equivalent",
-				Precision:    PRECISION,
+				Name:            "carbon",
+				Abbreviation:    "C",
+				Unit:            "metric ton CO2 equivalent",
+				Precision:       PRECISION,
+				AllowFractional: true,
 			},
 		},
+		MaxClassMetadataLength: DefaultMaxMetadataLength,
+		MaxBatchMetadataLength: DefaultMaxMetadataLength,
 	}
 	df := DefaultParams()
 
@@ -187,6 +190,22 @@ func Test_validateCreditTypes(t *testing.T) {
 			args:    []*CreditType{{Name: "", Abbreviation: "C", Unit: "ton", Precision: 6}},
 			wantErr: true,
 		},
+		{
+			name: "cant have names that differ only in case",
+			args: []*CreditType{
+				{Name: "carbon", Abbreviation: "C", Unit: "ton", Precision: 6},
+				{Name: "Carbon", Abbreviation: "CAR", Unit: "ton", Precision: 6},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cant have names that differ only in whitespace",
+			args: []*CreditType{
+				{Name: "carbon", Abbreviation: "C", Unit: "ton", Precision: 6},
+				{Name: "car bon", Abbreviation: "CAR", Unit: "ton", Precision: 6},
+			},
+			wantErr: true,
+		},
 		{
 			name: "cant have duplicate abbreviations",
 			args: []*CreditType{
@@ -229,3 +248,72 @@ func Test_validateCreditTypes(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateAllowlistAuthority(t *testing.T) {
+	addr := sdk.MustBech32ifyAddressBytes(sdk.Bech32MainPrefix, []byte("testaddr"))
+
+	tests := []struct {
+		name    string
+		args    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "valid authority",
+			args:    addr,
+			wantErr: false,
+		},
+		{
+			name:    "empty authority defaults to gov",
+			args:    "",
+			wantErr: false,
+		},
+		{
+			name:    "invalid authority",
+			args:    "bogus",
+			wantErr: true,
+		},
+		{
+			name:    "wrong type",
+			args:    []string{addr},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateAllowlistAuthority(tt.args); (err != nil) != tt.wantErr {
+				t.Errorf("validateAllowlistAuthority() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateMaxMetadataLength(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "valid length",
+			args:    uint64(256),
+			wantErr: false,
+		},
+		{
+			name:    "zero length",
+			args:    uint64(0),
+			wantErr: true,
+		},
+		{
+			name:    "invalid type",
+			args:    256,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateMaxMetadataLength(tt.args); (err != nil) != tt.wantErr {
+				t.Errorf("validateMaxMetadataLength() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
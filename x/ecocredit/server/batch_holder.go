@@ -0,0 +1,52 @@
+package server
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+// IterateBatchHolders calls cb with the tradable and retired balances of
+// every holder of the given batch-denom until cb returns true.
+func (s serverImpl) IterateBatchHolders(ctx sdk.Context, batchDenom string, cb func(addr sdk.AccAddress, tradable, retired math.Dec) bool) error {
+	return iterateBatchHolders(ctx.KVStore(s.storeKey), batchDenomT(batchDenom), cb)
+}
+
+// updateBatchHolder keeps the batch holder index in sync with the tradable or
+// retired balance stored at key. An account is a holder of a batch as long as
+// it has a tradable or retired balance of it.
+func updateBatchHolder(store sdk.KVStore, balanceKey []byte) {
+	addr, denom := ParseBalanceKey(balanceKey)
+	holderKey := BatchHolderKey(denom, addr)
+	if store.Has(TradableBalanceKey(addr, denom)) || store.Has(RetiredBalanceKey(addr, denom)) {
+		store.Set(holderKey, []byte{})
+	} else {
+		store.Delete(holderKey)
+	}
+}
+
+// iterateBatchHolders calls cb with the tradable and retired balances of every
+// holder of the given batch-denom until cb returns true.
+func iterateBatchHolders(store sdk.KVStore, batchDenom batchDenomT, cb func(addr sdk.AccAddress, tradable, retired math.Dec) bool) error {
+	iter := sdk.KVStorePrefixIterator(store, BatchHoldersPrefix(batchDenom))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, addr := ParseBatchHolderKey(iter.Key())
+
+		tradable, err := getDecimal(store, TradableBalanceKey(addr, batchDenom))
+		if err != nil {
+			return err
+		}
+
+		retired, err := getDecimal(store, RetiredBalanceKey(addr, batchDenom))
+		if err != nil {
+			return err
+		}
+
+		if cb(addr, tradable, retired) {
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+func TestIterateBatchHolders(t *testing.T) {
+	ctx, storeKey := setupStore(t)
+	store := ctx.KVStore(storeKey)
+	s := serverImpl{storeKey: storeKey}
+
+	acc1 := sdk.AccAddress([]byte("account1"))
+	acc2 := sdk.AccAddress([]byte("account2"))
+	acc3 := sdk.AccAddress([]byte("account3"))
+	denom := batchDenomT("C01-20200101-20210101-001")
+	otherDenom := batchDenomT("C01-20200101-20210101-002")
+
+	dec := func(s string) math.Dec {
+		d, err := math.NewDecFromString(s)
+		require.NoError(t, err)
+		return d
+	}
+
+	setDecimal(store, TradableBalanceKey(acc1, denom), dec("10"))
+	setDecimal(store, TradableBalanceKey(acc2, denom), dec("1.5"))
+	setDecimal(store, RetiredBalanceKey(acc2, denom), dec("2"))
+	setDecimal(store, RetiredBalanceKey(acc3, denom), dec("3"))
+	setDecimal(store, TradableBalanceKey(acc3, otherDenom), dec("4"))
+
+	type holder struct {
+		addr              sdk.AccAddress
+		tradable, retired string
+	}
+	collect := func(denom batchDenomT, max int) []holder {
+		var holders []holder
+		err := s.IterateBatchHolders(ctx, string(denom), func(addr sdk.AccAddress, tradable, retired math.Dec) bool {
+			holders = append(holders, holder{addr, tradable.String(), retired.String()})
+			return len(holders) == max
+		})
+		require.NoError(t, err)
+		return holders
+	}
+
+	// every holder of the denom is visited
+	require.Equal(t, []holder{
+		{acc1, "10", "0"},
+		{acc2, "1.5", "2"},
+		{acc3, "0", "3"},
+	}, collect(denom, -1))
+	require.Equal(t, []holder{{acc3, "4", "0"}}, collect(otherDenom, -1))
+
+	// the iteration stops when the callback returns true
+	require.Equal(t, []holder{{acc1, "10", "0"}}, collect(denom, 1))
+
+	// an account stays a holder as long as it has a tradable or retired balance
+	err := transferCredits(store,
+		[]creditDelta{{key: TradableBalanceKey(acc2, denom), amount: dec("1.5")}},
+		[]creditDelta{{key: TradableBalanceKey(acc1, denom), amount: dec("1.5")}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []holder{
+		{acc1, "11.5", "0"},
+		{acc2, "0", "2"},
+		{acc3, "0", "3"},
+	}, collect(denom, -1))
+
+	err = transferCredits(store,
+		[]creditDelta{{key: TradableBalanceKey(acc1, denom), amount: dec("11.5")}},
+		[]creditDelta{{key: TradableBalanceKey(acc3, denom), amount: dec("11.5")}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []holder{
+		{acc2, "0", "2"},
+		{acc3, "11.5", "3"},
+	}, collect(denom, -1))
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// getAllowlistAuthority returns the address allowed to manage the class
+// creator allowlist. Managing the allowlist is disabled while the
+// AllowlistAuthority param is not set, as the governance module account
+// can't sign messages itself.
+func (s serverImpl) getAllowlistAuthority(ctx sdk.Context) (sdk.AccAddress, error) {
+	var params ecocredit.Params
+	s.paramSpace.GetParamSet(ctx, &params)
+	if params.AllowlistAuthority == "" {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("the class creator allowlist can't be managed as no allowlist authority is set")
+	}
+
+	return sdk.AccAddressFromBech32(params.AllowlistAuthority)
+}
+
+func isClassCreator(store sdk.KVStore, addr sdk.AccAddress) bool {
+	return store.Has(ClassCreatorKey(addr))
+}
+
+func setClassCreator(store sdk.KVStore, addr sdk.AccAddress) {
+	store.Set(ClassCreatorKey(addr), []byte{0})
+}
+
+func deleteClassCreator(store sdk.KVStore, addr sdk.AccAddress) {
+	store.Delete(ClassCreatorKey(addr))
+}
+
+func iterateClassCreators(store sdk.KVStore, cb func(addr sdk.AccAddress) bool) {
+	iter := sdk.KVStorePrefixIterator(store, []byte{ClassCreatorPrefix})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(ParseClassCreatorKey(iter.Key())) {
+			break
+		}
+	}
+}
@@ -8,17 +8,18 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 )
 
-func (s serverImpl) getCreditType(ctx sdk.Context, creditTypeName string) (ecocredit.CreditType, error) {
-	creditTypes := s.getAllCreditTypes(ctx)
-	creditTypeName = ecocredit.NormalizeCreditTypeName(creditTypeName)
-	for _, creditType := range creditTypes {
-		// credit type name's stored via params have enforcement on normalization, so we can be sure they will already
-		// be normalized here.
-		if creditType.Name == creditTypeName {
+// getCreditType returns the credit type matching name in the params, ignoring
+// case and whitespace differences.
+func (s serverImpl) getCreditType(ctx sdk.Context, name string) (ecocredit.CreditType, error) {
+	name = ecocredit.NormalizeCreditTypeName(name)
+	for _, creditType := range s.getAllCreditTypes(ctx) {
+		// credit type names stored via params have enforcement on
+		// normalization, so we can be sure they will already be normalized here.
+		if creditType.Name == name {
 			return *creditType, nil
 		}
 	}
-	return ecocredit.CreditType{}, sdkerrors.ErrInvalidType.Wrapf("%s is not a valid credit type", creditTypeName)
+	return ecocredit.CreditType{}, sdkerrors.ErrInvalidType.Wrapf("%s is not a valid credit type", name)
 }
 
 func (s serverImpl) getAllCreditTypes(ctx sdk.Context) []*ecocredit.CreditType {
@@ -27,6 +28,26 @@ func (s serverImpl) getAllCreditTypes(ctx sdk.Context) []*ecocredit.CreditType {
 	return params.CreditTypes
 }
 
+// getCreditTypeSeq returns the sequence number of the credit classes of the
+// credit type with the given abbreviation, which is the number of classes
+// created with it, or 0 if there is no CreditTypeSeq for it yet.
+func (s serverImpl) getCreditTypeSeq(ctx sdk.Context, abbreviation string) (uint64, error) {
+	if abbreviation == "" {
+		return 0, sdkerrors.ErrInvalidRequest.Wrap("credit type abbreviation should not be empty")
+	}
+
+	var creditTypeSeq ecocredit.CreditTypeSeq
+	err := s.creditTypeSeqTable.GetOne(ctx, orm.RowID(abbreviation), &creditTypeSeq)
+	switch {
+	case err == nil:
+		return creditTypeSeq.SeqNumber, nil
+	case orm.ErrNotFound.Is(err):
+		return 0, nil
+	default:
+		return 0, err
+	}
+}
+
 // getCreditTypeSeqNextVal looks up the CreditTypeSeq for the given CreditType,
 // returns its next value, and persists that value in the store. If there is no
 // CreditTypeSeq for the given CreditType, then a new CreditTypeSeq is created
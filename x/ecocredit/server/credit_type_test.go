@@ -0,0 +1,87 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+func TestGetCreditType(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tkey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(tkey, sdk.StoreTypeTransient, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	paramSpace := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), paramsKey, tkey, ecocredit.ModuleName).
+		WithKeyTable(ecocredit.ParamKeyTable())
+	s := serverImpl{paramSpace: paramSpace}
+
+	params := ecocredit.DefaultParams()
+	params.CreditTypes = append(params.CreditTypes, &ecocredit.CreditType{
+		Name:         "biodiversity",
+		Abbreviation: "BIO",
+		Unit:         "hectare",
+		Precision:    ecocredit.PRECISION,
+	})
+	paramSpace.SetParamSet(ctx, &params)
+
+	tests := []struct {
+		name    string
+		args    string
+		expAbbr string
+		wantErr bool
+	}{
+		{
+			name:    "exact name",
+			args:    "carbon",
+			expAbbr: "C",
+		},
+		{
+			name:    "different case",
+			args:    "BioDiversity",
+			expAbbr: "BIO",
+		},
+		{
+			name:    "surrounding and inner whitespace",
+			args:    " car\tbon ",
+			expAbbr: "C",
+		},
+		{
+			name:    "unknown name",
+			args:    "water",
+			wantErr: true,
+		},
+		{
+			name:    "empty name",
+			args:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creditType, err := s.getCreditType(ctx, tt.args)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expAbbr, creditType.Abbreviation)
+		})
+	}
+}
@@ -13,6 +13,8 @@ type batchDenomT string
 // - 0x1 <denom_Bytes>: TradableSupply
 // - 0x2 <accAddrLen (1 Byte)><accAddr_Bytes><denom_Bytes>: RetiredBalance
 // - 0x3 <denom_Bytes>: RetiredSupply
+// - 0x7 <accAddrLen (1 Byte)><accAddr_Bytes>: ClassCreator
+// - 0x8 <denomLen (1 Byte)><denom_Bytes><accAddrLen (1 Byte)><accAddr_Bytes>: BatchHolder
 
 // TradableBalanceKey creates the index key for recipient address and batch-denom
 func TradableBalanceKey(acc sdk.AccAddress, denom batchDenomT) []byte {
@@ -51,3 +53,35 @@ func RetiredSupplyKey(batchDenom batchDenomT) []byte {
 	key := []byte{RetiredSupplyPrefix}
 	return append(key, batchDenom...)
 }
+
+// ClassCreatorKey creates the allowlist key for a credit class creator address
+func ClassCreatorKey(acc sdk.AccAddress) []byte {
+	key := []byte{ClassCreatorPrefix}
+	return append(key, address.MustLengthPrefix(acc)...)
+}
+
+// ParseClassCreatorKey parses the creator address from a class creator key
+func ParseClassCreatorKey(key []byte) sdk.AccAddress {
+	addrLen := key[1]
+	return sdk.AccAddress(key[2 : 2+addrLen])
+}
+
+// BatchHolderKey creates the index key for batch-denom and holder address
+func BatchHolderKey(batchDenom batchDenomT, acc sdk.AccAddress) []byte {
+	key := BatchHoldersPrefix(batchDenom)
+	return append(key, address.MustLengthPrefix(acc)...)
+}
+
+// BatchHoldersPrefix creates the prefix of all batch holder keys for a given batch-denom
+func BatchHoldersPrefix(batchDenom batchDenomT) []byte {
+	key := []byte{BatchHolderPrefix}
+	return append(key, address.MustLengthPrefix([]byte(batchDenom))...)
+}
+
+// ParseBatchHolderKey parses the batch-denom and holder address from a batch holder key
+func ParseBatchHolderKey(key []byte) (batchDenomT, sdk.AccAddress) {
+	denomLen := key[1]
+	denom := batchDenomT(key[2 : 2+denomLen])
+	addrLen := key[2+denomLen]
+	return denom, sdk.AccAddress(key[3+denomLen : 3+denomLen+addrLen])
+}
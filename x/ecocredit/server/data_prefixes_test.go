@@ -1,6 +1,7 @@
 package server
 
 import (
+	"bytes"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -37,4 +38,10 @@ func TestKeys(t *testing.T) {
 	require.Equal(t, a, addr)
 	require.Equal(t, d, batchDenom)
 
+	// batch-holder-key
+	key = BatchHolderKey(batchDenom, addr)
+	d, a = ParseBatchHolderKey(key)
+	require.Equal(t, a, addr)
+	require.Equal(t, d, batchDenom)
+	require.True(t, bytes.HasPrefix(key, BatchHoldersPrefix(batchDenom)))
 }
@@ -21,6 +21,10 @@ func (s serverImpl) InitGenesis(ctx types.Context, cdc codec.Codec, data json.Ra
 	var genesisState ecocredit.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 
+	if err := genesisState.Validate(); err != nil {
+		return nil, err
+	}
+
 	s.paramSpace.SetParamSet(ctx.Context, &genesisState.Params)
 
 	if err := s.creditTypeSeqTable.Import(ctx, genesisState.Sequences, 0); err != nil {
@@ -44,6 +48,18 @@ func (s serverImpl) InitGenesis(ctx types.Context, cdc codec.Codec, data json.Ra
 		return nil, err
 	}
 
+	for _, creator := range genesisState.ClassCreators {
+		addr, err := sdk.AccAddressFromBech32(creator)
+		if err != nil {
+			return nil, err
+		}
+		setClassCreator(store, addr)
+	}
+
+	if err := s.cancellationTable.Import(ctx, genesisState.Cancellations, genesisState.CancellationSeq); err != nil {
+		return nil, errors.Wrap(err, "cancellations")
+	}
+
 	return []abci.ValidatorUpdate{}, nil
 }
 
@@ -215,13 +231,28 @@ func (s serverImpl) ExportGenesis(ctx types.Context, cdc codec.Codec) (json.RawM
 		index++
 	}
 
+	var classCreators []string
+	iterateClassCreators(store, func(addr sdk.AccAddress) bool {
+		classCreators = append(classCreators, addr.String())
+		return false
+	})
+
+	var cancellations []*ecocredit.Cancellation
+	cancellationSeq, err := s.cancellationTable.Export(ctx, &cancellations)
+	if err != nil {
+		return nil, errors.Wrap(err, "cancellations")
+	}
+
 	gs := &ecocredit.GenesisState{
-		Params:    params,
-		ClassInfo: classInfo,
-		BatchInfo: batchInfo,
-		Sequences: sequences,
-		Balances:  balances,
-		Supplies:  supplies,
+		Params:          params,
+		ClassInfo:       classInfo,
+		BatchInfo:       batchInfo,
+		Sequences:       sequences,
+		Balances:        balances,
+		Supplies:        supplies,
+		ClassCreators:   classCreators,
+		Cancellations:   cancellations,
+		CancellationSeq: cancellationSeq,
 	}
 
 	return cdc.MustMarshalJSON(gs), nil
@@ -33,6 +33,10 @@ func setDecimal(store sdk.KVStore, key []byte, value math.Dec) {
 		// use floating notation here always for canonical representation
 		store.Set(key, []byte(value.String()))
 	}
+
+	if key[0] == TradableBalancePrefix || key[0] == RetiredBalancePrefix {
+		updateBatchHolder(store, key)
+	}
 }
 
 func addAndSetDecimal(store sdk.KVStore, key []byte, x math.Dec) error {
@@ -65,6 +69,61 @@ func subAndSetDecimal(store sdk.KVStore, key []byte, x math.Dec) error {
 	return nil
 }
 
+// creditDelta is an amount of credits added to or subtracted from the decimal
+// stored at key, which is either a balance or a supply.
+type creditDelta struct {
+	key    []byte
+	amount math.Dec
+}
+
+// transferCredits subtracts each of the debits and adds each of the credits.
+// It asserts that the debits and credits sum to the same amount before
+// changing any state so that a transfer never creates or destroys credits.
+func transferCredits(store sdk.KVStore, debits, credits []creditDelta) error {
+	debited, err := sumCreditDeltas(debits)
+	if err != nil {
+		return err
+	}
+
+	credited, err := sumCreditDeltas(credits)
+	if err != nil {
+		return err
+	}
+
+	if !debited.IsEqual(credited) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("unbalanced credit transfer: debited %s, credited %s", debited, credited)
+	}
+
+	for _, debit := range debits {
+		err = subAndSetDecimal(store, debit.key, debit.amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, credit := range credits {
+		err = addAndSetDecimal(store, credit.key, credit.amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func sumCreditDeltas(deltas []creditDelta) (math.Dec, error) {
+	sum := math.NewDecFromInt64(0)
+	for _, delta := range deltas {
+		var err error
+		sum, err = math.SafeAddBalance(sum, delta.amount)
+		if err != nil {
+			return math.Dec{}, err
+		}
+	}
+
+	return sum, nil
+}
+
 func iterateSupplies(store sdk.KVStore, storeKey byte, cb func(denom, supply string) (bool, error)) error {
 	iter := sdk.KVStorePrefixIterator(store, []byte{storeKey})
 	defer iter.Close()
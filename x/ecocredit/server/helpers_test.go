@@ -0,0 +1,79 @@
+package server
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+func TestTransferCredits(t *testing.T) {
+	ctx, storeKey := setupStore(t)
+	store := ctx.KVStore(storeKey)
+
+	acc1 := sdk.AccAddress([]byte("account1"))
+	acc2 := sdk.AccAddress([]byte("account2"))
+	denom := batchDenomT("C01-20200101-20210101-001")
+
+	dec := func(s string) math.Dec {
+		d, err := math.NewDecFromString(s)
+		require.NoError(t, err)
+		return d
+	}
+	requireBalance := func(key []byte, expected string) {
+		balance, err := getDecimal(store, key)
+		require.NoError(t, err)
+		require.True(t, balance.IsEqual(dec(expected)), "expected %s, got %s", expected, balance)
+	}
+
+	setDecimal(store, TradableBalanceKey(acc1, denom), dec("10.5"))
+
+	// a balanced transfer is applied
+	err := transferCredits(store,
+		[]creditDelta{{key: TradableBalanceKey(acc1, denom), amount: dec("3.25")}},
+		[]creditDelta{
+			{key: TradableBalanceKey(acc2, denom), amount: dec("2")},
+			{key: RetiredBalanceKey(acc2, denom), amount: dec("1.25")},
+		},
+	)
+	require.NoError(t, err)
+	requireBalance(TradableBalanceKey(acc1, denom), "7.25")
+	requireBalance(TradableBalanceKey(acc2, denom), "2")
+	requireBalance(RetiredBalanceKey(acc2, denom), "1.25")
+
+	// an unbalanced transfer which would create credits is rejected without changing any balance
+	err = transferCredits(store,
+		[]creditDelta{{key: TradableBalanceKey(acc1, denom), amount: dec("1")}},
+		[]creditDelta{{key: TradableBalanceKey(acc2, denom), amount: dec("1.000001")}},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unbalanced credit transfer")
+	requireBalance(TradableBalanceKey(acc1, denom), "7.25")
+	requireBalance(TradableBalanceKey(acc2, denom), "2")
+
+	// an unbalanced transfer which would destroy credits is rejected
+	err = transferCredits(store,
+		[]creditDelta{{key: TradableBalanceKey(acc1, denom), amount: dec("1")}},
+		nil,
+	)
+	require.Error(t, err)
+	requireBalance(TradableBalanceKey(acc1, denom), "7.25")
+
+	// negative deltas are rejected
+	err = transferCredits(store,
+		[]creditDelta{{key: TradableBalanceKey(acc1, denom), amount: dec("-1")}},
+		[]creditDelta{{key: TradableBalanceKey(acc2, denom), amount: dec("-1")}},
+	)
+	require.Error(t, err)
+	requireBalance(TradableBalanceKey(acc1, denom), "7.25")
+	requireBalance(TradableBalanceKey(acc2, denom), "2")
+
+	// debiting more than the balance fails
+	err = transferCredits(store,
+		[]creditDelta{{key: TradableBalanceKey(acc1, denom), amount: dec("8")}},
+		[]creditDelta{{key: TradableBalanceKey(acc2, denom), amount: dec("8")}},
+	)
+	require.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/regen-network/regen-ledger/orm"
 	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 )
@@ -13,6 +14,7 @@ import (
 func (s serverImpl) RegisterInvariants(ir sdk.InvariantRegistry) {
 	ir.RegisterRoute(ecocredit.ModuleName, "tradable-supply", s.tradableSupplyInvariant())
 	ir.RegisterRoute(ecocredit.ModuleName, "retired-supply", s.retiredSupplyInvariant())
+	ir.RegisterRoute(ecocredit.ModuleName, "batch-supply", s.batchSupplyInvariant())
 }
 
 func (s serverImpl) tradableSupplyInvariant() sdk.Invariant {
@@ -127,3 +129,68 @@ func retiredSupplyInvariant(store types.KVStore) (string, bool) {
 
 	return msg, broken
 }
+
+func (s serverImpl) batchSupplyInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		store := ctx.KVStore(s.storeKey)
+		return batchSupplyInvariant(ctx, store, s.batchInfoTable)
+	}
+}
+
+// batchSupplyInvariant checks that the tradable and retired supplies of each credit batch sum up to its
+// total amount, which excludes cancelled credits. Together with the tradable and retired supply invariants,
+// this ensures that the balances of a batch add up to its active credits.
+func batchSupplyInvariant(ctx sdk.Context, store types.KVStore, batchInfoTable orm.PrimaryKeyTable) (string, bool) {
+	var (
+		msg    string
+		broken bool
+	)
+	it, err := batchInfoTable.PrefixScan(ctx, nil, nil)
+	if err != nil {
+		return fmt.Sprintf("error querying credit batches %v", err), true
+	}
+	defer it.Close()
+
+	var batchInfo ecocredit.BatchInfo
+	for {
+		_, err := it.LoadNext(&batchInfo)
+		if orm.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return msg + fmt.Sprintf("error while loading credit batch %v", err), true
+		}
+
+		denom := batchDenomT(batchInfo.BatchDenom)
+		totalAmount, err := math.NewNonNegativeDecFromString(batchInfo.TotalAmount)
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("error while parsing total amount for denom: %s", denom)
+			continue
+		}
+		tradableSupply, err := getDecimal(store, TradableSupplyKey(denom))
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("error while parsing tradable supply for denom: %s", denom)
+			continue
+		}
+		retiredSupply, err := getDecimal(store, RetiredSupplyKey(denom))
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("error while parsing retired supply for denom: %s", denom)
+			continue
+		}
+		supply, err := math.SafeAddBalance(tradableSupply, retiredSupply)
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("error adding credit batch supply %v", err)
+			continue
+		}
+		if supply.Cmp(totalAmount) != 0 {
+			broken = true
+			msg += fmt.Sprintf("supply is incorrect for %s credit batch, expected total amount %v, got %v", denom, totalAmount, supply)
+		}
+	}
+
+	return msg, broken
+}
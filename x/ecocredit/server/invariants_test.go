@@ -3,9 +3,11 @@ package server
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/stretchr/testify/require"
@@ -333,6 +335,129 @@ func TestRetiredSupplyInvariants(t *testing.T) {
 	}
 }
 
+func TestBatchSupplyInvariant(t *testing.T) {
+	acc1 := sdk.AccAddress([]byte("account1"))
+	acc2 := sdk.AccAddress([]byte("account2"))
+
+	testCases := []struct {
+		msg       string
+		batches   []*ecocredit.BatchInfo
+		supply    []*ecocredit.Supply
+		expBroken bool
+	}{
+		{
+			"valid test case",
+			[]*ecocredit.BatchInfo{
+				{ClassId: "1", BatchDenom: "1/2", TotalAmount: "520", AmountCancelled: "30"},
+				{ClassId: "1", BatchDenom: "1/3", TotalAmount: "10.5", AmountCancelled: "0"},
+			},
+			[]*ecocredit.Supply{
+				{BatchDenom: "1/2", TradableSupply: "310", RetiredSupply: "210"},
+				{BatchDenom: "1/3", TradableSupply: "10.5", RetiredSupply: "0"},
+			},
+			false,
+		},
+		{
+			"valid test case fully retired batch",
+			[]*ecocredit.BatchInfo{
+				{ClassId: "1", BatchDenom: "1/2", TotalAmount: "210", AmountCancelled: "0"},
+			},
+			[]*ecocredit.Supply{
+				{BatchDenom: "1/2", TradableSupply: "0", RetiredSupply: "210"},
+			},
+			false,
+		},
+		{
+			"fail with error supply exceeds total amount",
+			[]*ecocredit.BatchInfo{
+				{ClassId: "1", BatchDenom: "1/2", TotalAmount: "520", AmountCancelled: "30"},
+			},
+			[]*ecocredit.Supply{
+				{BatchDenom: "1/2", TradableSupply: "340", RetiredSupply: "210"},
+			},
+			true,
+		},
+		{
+			"fail with error supply not found",
+			[]*ecocredit.BatchInfo{
+				{ClassId: "1", BatchDenom: "1/2", TotalAmount: "520", AmountCancelled: "0"},
+				{ClassId: "1", BatchDenom: "1/3", TotalAmount: "10", AmountCancelled: "0"},
+			},
+			[]*ecocredit.Supply{
+				{BatchDenom: "1/2", TradableSupply: "310", RetiredSupply: "210"},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		ctx, storeKey := setupStore(t)
+		store := ctx.KVStore(storeKey)
+		s := setupInvariantServer(storeKey)
+		t.Run(tc.msg, func(t *testing.T) {
+			for _, b := range tc.batches {
+				require.NoError(t, s.batchInfoTable.Create(ctx, b))
+			}
+			initSupply(t, store, tc.supply)
+
+			msg, broken := batchSupplyInvariant(ctx, store, s.batchInfoTable)
+			if tc.expBroken {
+				require.True(t, broken, msg)
+				require.Contains(t, msg, "1/")
+			} else {
+				require.False(t, broken, msg)
+			}
+		})
+	}
+
+	t.Run("corrupted balance", func(t *testing.T) {
+		ctx, storeKey := setupStore(t)
+		store := ctx.KVStore(storeKey)
+		s := setupInvariantServer(storeKey)
+		require.NoError(t, s.batchInfoTable.Create(ctx, &ecocredit.BatchInfo{ClassId: "1", BatchDenom: "1/2", TotalAmount: "520", AmountCancelled: "30"}))
+		initBalances(t, store, []*ecocredit.Balance{
+			{Address: acc1.String(), BatchDenom: "1/2", TradableBalance: "100"},
+			{Address: acc2.String(), BatchDenom: "1/2", TradableBalance: "210", RetiredBalance: "210"},
+		})
+		initSupply(t, store, []*ecocredit.Supply{{BatchDenom: "1/2", TradableSupply: "310", RetiredSupply: "210"}})
+		invariants := func() (string, bool) {
+			var (
+				msg    string
+				broken bool
+			)
+			for _, invariant := range []func() (string, bool){
+				func() (string, bool) { return tradableSupplyInvariant(store) },
+				func() (string, bool) { return retiredSupplyInvariant(store) },
+				func() (string, bool) { return batchSupplyInvariant(ctx, store, s.batchInfoTable) },
+			} {
+				m, b := invariant()
+				msg += m
+				broken = broken || b
+			}
+			return msg, broken
+		}
+		msg, broken := invariants()
+		require.False(t, broken, msg)
+
+		// minting credits out of thin air breaks the invariants,
+		// even when the tradable supply is updated accordingly
+		setDecimal(store, TradableBalanceKey(acc1, "1/2"), math.NewDecFromInt64(150))
+		msg, broken = invariants()
+		require.True(t, broken, msg)
+		setDecimal(store, TradableSupplyKey("1/2"), math.NewDecFromInt64(360))
+		msg, broken = batchSupplyInvariant(ctx, store, s.batchInfoTable)
+		require.True(t, broken, msg)
+		require.Contains(t, msg, "1/2")
+	})
+}
+
+func setupInvariantServer(storeKey sdk.StoreKey) serverImpl {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	ecocredit.RegisterTypes(interfaceRegistry)
+	return newServer(storeKey, paramtypes.Subspace{}, nil, codec.NewProtoCodec(interfaceRegistry))
+}
+
 func initBalances(t *testing.T, store sdk.KVStore, balances []*ecocredit.Balance) {
 	for _, b := range balances {
 		denomT := batchDenomT(b.BatchDenom)
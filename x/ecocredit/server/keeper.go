@@ -0,0 +1,53 @@
+package server
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Keeper gives other modules of the app direct access to ecocredit state
+// transitions, without going through the Msg service and its signer checks.
+// It is only returned by RegisterServices, so that it can be handed over to
+// trusted modules when wiring the app, but can't be used by arbitrary callers.
+type Keeper struct {
+	s *serverImpl
+}
+
+// SendCredits moves credits from one account to another on behalf of the
+// sender, retiring the given retired amounts. The credits are validated and
+// the bookkeeping and events are the same as for Msg/Send. No state is changed
+// if an error is returned.
+func (k Keeper) SendCredits(ctx sdk.Context, from, to sdk.AccAddress, credits []*ecocredit.MsgSend_SendCredits) error {
+	if k.s == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("ecocredit keeper is not initialized")
+	}
+
+	// run the same stateless checks as for Msg/Send, which the keeper bypasses
+	msg := ecocredit.MsgSend{Sender: from.String(), Recipient: to.String(), Credits: credits}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.s.sendCredits(types.Context{Context: cacheCtx}, from, to, credits); err != nil {
+		return err
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
+
+// GetCreditTypeSeq returns the sequence number of the credit classes of the
+// credit type with the given abbreviation, which is the number of classes
+// created with it. The next class of the credit type gets the ID
+// ecocredit.FormatClassID(creditType, seq+1).
+func (k Keeper) GetCreditTypeSeq(ctx sdk.Context, abbreviation string) (uint64, error) {
+	if k.s == nil {
+		return 0, sdkerrors.ErrInvalidRequest.Wrap("ecocredit keeper is not initialized")
+	}
+	return k.s.getCreditTypeSeq(ctx, abbreviation)
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// migrateV1ToV2 migrates the ecocredit state from consensus version 1 to 2.
+func (s serverImpl) migrateV1ToV2(ctx types.Context) error {
+	s.migrateParams(ctx.Context)
+	migrateBatchHolders(ctx.KVStore(s.storeKey))
+	return nil
+}
+
+// migrateParams sets the params added in version 2, which have no value in the
+// param store yet, so that loading the params doesn't panic, and explicitly
+// allows fractional retirement for the existing credit types.
+func (s serverImpl) migrateParams(ctx sdk.Context) {
+	// managing the allowlist wasn't possible in version 1, so no authority is set
+	s.setParamIfMissing(ctx, ecocredit.KeyAllowlistAuthority, "")
+	s.setParamIfMissing(ctx, ecocredit.KeyMaxClassMetadataLength, ecocredit.DefaultMaxMetadataLength)
+	s.setParamIfMissing(ctx, ecocredit.KeyMaxBatchMetadataLength, ecocredit.DefaultMaxMetadataLength)
+
+	// credit types of version 1 decode with AllowFractional unset, but fractional
+	// amounts of all credit types could be retired in version 1
+	var creditTypes []*ecocredit.CreditType
+	s.paramSpace.GetIfExists(ctx, ecocredit.KeyCreditTypes, &creditTypes)
+	for _, creditType := range creditTypes {
+		creditType.AllowFractional = true
+	}
+	s.paramSpace.Set(ctx, ecocredit.KeyCreditTypes, creditTypes)
+}
+
+func (s serverImpl) setParamIfMissing(ctx sdk.Context, key []byte, value interface{}) {
+	if !s.paramSpace.Has(ctx, key) {
+		s.paramSpace.Set(ctx, key, value)
+	}
+}
+
+// migrateBatchHolders adds the batch holder index entries of all tradable and
+// retired balances, which were stored before the index was added.
+func migrateBatchHolders(store sdk.KVStore) {
+	var holderKeys [][]byte
+	for _, prefix := range []byte{TradableBalancePrefix, RetiredBalancePrefix} {
+		iter := sdk.KVStorePrefixIterator(store, []byte{prefix})
+		for ; iter.Valid(); iter.Next() {
+			addr, denom := ParseBalanceKey(iter.Key())
+			holderKeys = append(holderKeys, BatchHolderKey(denom, addr))
+		}
+		iter.Close()
+	}
+
+	for _, key := range holderKeys {
+		store.Set(key, []byte{})
+	}
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+func TestMigrateParams(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tkey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(tkey, sdk.StoreTypeTransient, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	paramSpace := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), paramsKey, tkey, ecocredit.ModuleName).
+		WithKeyTable(ecocredit.ParamKeyTable())
+	s := serverImpl{paramSpace: paramSpace}
+
+	// params of version 1
+	defaults := ecocredit.DefaultParams()
+	paramSpace.Set(ctx, ecocredit.KeyCreditClassFee, defaults.CreditClassFee)
+	paramSpace.Set(ctx, ecocredit.KeyAllowedClassCreators, defaults.AllowedClassCreators)
+	paramSpace.Set(ctx, ecocredit.KeyAllowlistEnabled, true)
+	paramSpace.Set(ctx, ecocredit.KeyCreditTypes, []*ecocredit.CreditType{
+		{Name: "carbon", Abbreviation: "C", Unit: "metric ton CO2 equivalent", Precision: ecocredit.PRECISION},
+		{Name: "biodiversity", Abbreviation: "BIO", Unit: "acres", Precision: ecocredit.PRECISION},
+	})
+
+	s.migrateParams(ctx)
+
+	var params ecocredit.Params
+	paramSpace.GetParamSet(ctx, &params)
+	require.NoError(t, params.Validate())
+	require.Len(t, params.CreditTypes, 2)
+	for _, creditType := range params.CreditTypes {
+		require.True(t, creditType.AllowFractional, creditType.Name)
+	}
+
+	var authority string
+	paramSpace.Get(ctx, ecocredit.KeyAllowlistAuthority, &authority)
+	require.Equal(t, "", authority)
+	var maxClassMetadataLength, maxBatchMetadataLength uint64
+	paramSpace.Get(ctx, ecocredit.KeyMaxClassMetadataLength, &maxClassMetadataLength)
+	require.Equal(t, ecocredit.DefaultMaxMetadataLength, maxClassMetadataLength)
+	paramSpace.Get(ctx, ecocredit.KeyMaxBatchMetadataLength, &maxBatchMetadataLength)
+	require.Equal(t, ecocredit.DefaultMaxMetadataLength, maxBatchMetadataLength)
+
+	// values that are already set are kept
+	authority = sdk.AccAddress("authority").String()
+	paramSpace.Set(ctx, ecocredit.KeyAllowlistAuthority, authority)
+	s.migrateParams(ctx)
+	var migrated string
+	paramSpace.Get(ctx, ecocredit.KeyAllowlistAuthority, &migrated)
+	require.Equal(t, authority, migrated)
+	var enabled bool
+	paramSpace.Get(ctx, ecocredit.KeyAllowlistEnabled, &enabled)
+	require.True(t, enabled)
+}
+
+func TestMigrateBatchHolders(t *testing.T) {
+	ctx, storeKey := setupStore(t)
+	store := ctx.KVStore(storeKey)
+	s := serverImpl{storeKey: storeKey}
+
+	acc1 := sdk.AccAddress([]byte("account1"))
+	acc2 := sdk.AccAddress([]byte("account2"))
+	denom := batchDenomT("C01-20200101-20210101-001")
+	otherDenom := batchDenomT("C01-20200101-20210101-002")
+
+	// balances stored before the batch holder index was added
+	store.Set(TradableBalanceKey(acc1, denom), []byte("10"))
+	store.Set(TradableBalanceKey(acc2, denom), []byte("1.5"))
+	store.Set(RetiredBalanceKey(acc2, denom), []byte("2"))
+	store.Set(RetiredBalanceKey(acc1, otherDenom), []byte("3"))
+
+	migrateBatchHolders(store)
+
+	holders := func(denom batchDenomT) map[string]string {
+		res := make(map[string]string)
+		err := s.IterateBatchHolders(ctx, string(denom), func(addr sdk.AccAddress, tradable, retired math.Dec) bool {
+			res[addr.String()] = tradable.String() + "/" + retired.String()
+			return false
+		})
+		require.NoError(t, err)
+		return res
+	}
+	require.Equal(t, map[string]string{acc1.String(): "10/0", acc2.String(): "1.5/2"}, holders(denom))
+	require.Equal(t, map[string]string{acc1.String(): "0/3"}, holders(otherDenom))
+
+	// re-running the migration doesn't change anything
+	migrateBatchHolders(store)
+	require.Equal(t, map[string]string{acc1.String(): "0/3"}, holders(otherDenom))
+}
@@ -29,10 +29,14 @@ func (s serverImpl) CreateClass(goCtx context.Context, req *ecocredit.MsgCreateC
 
 	var params ecocredit.Params
 	s.paramSpace.GetParamSet(ctx.Context, &params)
-	if params.AllowlistEnabled && !s.isCreatorAllowListed(params.AllowedClassCreators, adminAddress) {
+	if params.AllowlistEnabled && !s.isCreatorAllowListed(ctx, params.AllowedClassCreators, adminAddress) {
 		return nil, fmt.Errorf("%s is not allowed to create credit classes", adminAddress.String())
 	}
 
+	if err := assertMetadataLength(req.Metadata, params.MaxClassMetadataLength, "credit class"); err != nil {
+		return nil, err
+	}
+
 	err = s.chargeCreditClassFee(ctx.Context, adminAddress)
 	if err != nil {
 		return nil, err
@@ -43,6 +47,12 @@ func (s serverImpl) CreateClass(goCtx context.Context, req *ecocredit.MsgCreateC
 		return nil, err
 	}
 
+	if req.MaxSupply != "" {
+		if _, err := math.NewPositiveFixedDecFromString(req.MaxSupply, creditType.Precision); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("max supply: %s", err)
+		}
+	}
+
 	classSeqNo, err := s.getCreditTypeSeqNextVal(ctx.Context, creditType)
 	if err != nil {
 		return nil, err
@@ -59,14 +69,17 @@ func (s serverImpl) CreateClass(goCtx context.Context, req *ecocredit.MsgCreateC
 		Issuers:    req.Issuers,
 		Metadata:   req.Metadata,
 		CreditType: &creditType,
+		MaxSupply:  req.MaxSupply,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	err = ctx.EventManager().EmitTypedEvent(&ecocredit.EventCreateClass{
-		ClassId: classID,
-		Admin:   req.Admin,
+		ClassId:    classID,
+		Admin:      req.Admin,
+		Issuers:    req.Issuers,
+		CreditType: creditType.Name,
 	})
 	if err != nil {
 		return nil, err
@@ -80,7 +93,7 @@ func (s serverImpl) CreateClass(goCtx context.Context, req *ecocredit.MsgCreateC
 func (s serverImpl) CreateBatch(goCtx context.Context, req *ecocredit.MsgCreateBatch) (*ecocredit.MsgCreateBatchResponse, error) {
 	ctx := types.UnwrapSDKContext(goCtx)
 	classID := req.ClassId
-	classInfo, err := s.getClassInfo(ctx, classID)
+	classInfo, err := s.GetClassInfo(ctx.Context, classID)
 	if err != nil {
 		return nil, err
 	}
@@ -89,6 +102,12 @@ func (s serverImpl) CreateBatch(goCtx context.Context, req *ecocredit.MsgCreateB
 		return nil, err
 	}
 
+	var params ecocredit.Params
+	s.paramSpace.GetParamSet(ctx.Context, &params)
+	if err = assertMetadataLength(req.Metadata, params.MaxBatchMetadataLength, "credit batch"); err != nil {
+		return nil, err
+	}
+
 	maxDecimalPlaces := classInfo.CreditType.Precision
 	batchSeqNo, err := s.nextBatchInClass(ctx, classInfo)
 	if err != nil {
@@ -190,6 +209,10 @@ func (s serverImpl) CreateBatch(goCtx context.Context, req *ecocredit.MsgCreateB
 	}
 	totalSupplyStr := totalSupply.String()
 
+	if err = s.addClassIssuedSupply(ctx, classInfo, totalSupply); err != nil {
+		return nil, err
+	}
+
 	amountCancelledStr := math.NewDecFromInt64(0).String()
 
 	err = s.batchInfoTable.Create(ctx, &ecocredit.BatchInfo{
@@ -227,90 +250,126 @@ func (s serverImpl) CreateBatch(goCtx context.Context, req *ecocredit.MsgCreateB
 // Send also retires credits if the amount to retire is specified in the request.
 func (s serverImpl) Send(goCtx context.Context, req *ecocredit.MsgSend) (*ecocredit.MsgSendResponse, error) {
 	ctx := types.UnwrapSDKContext(goCtx)
-	store := ctx.KVStore(s.storeKey)
-	sender := req.Sender
-	recipient := req.Recipient
 
-	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	senderAddr, err := sdk.AccAddressFromBech32(req.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	recipientAddr, err := sdk.AccAddressFromBech32(req.Recipient)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, credit := range req.Credits {
+	if err = s.sendCredits(ctx, senderAddr, recipientAddr, req.Credits); err != nil {
+		return nil, err
+	}
+
+	return &ecocredit.MsgSendResponse{}, nil
+}
+
+// sendCredits moves credits from the tradable balance of the sender to the
+// tradable and retired balances of the recipient, updating the retired supply
+// and emitting the receive and retire events.
+func (s serverImpl) sendCredits(ctx types.Context, senderAddr, recipientAddr sdk.AccAddress, credits []*ecocredit.MsgSend_SendCredits) error {
+	store := ctx.KVStore(s.storeKey)
+
+	for _, credit := range credits {
 		denom := batchDenomT(credit.BatchDenom)
 		if !s.batchInfoTable.Has(ctx, orm.RowID(denom)) {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not a valid credit batch denom", denom)
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s is not a valid credit batch denom", denom)
 		}
 
-		maxDecimalPlaces, err := s.getBatchPrecision(ctx, denom)
+		creditType, err := s.getBatchCreditType(ctx, denom)
 		if err != nil {
-			return nil, err
-		}
-
-		tradable, err := math.NewNonNegativeFixedDecFromString(credit.TradableAmount, maxDecimalPlaces)
-		if err != nil {
-			return nil, err
+			return err
 		}
+		maxDecimalPlaces := creditType.Precision
 
 		retired, err := math.NewNonNegativeFixedDecFromString(credit.RetiredAmount, maxDecimalPlaces)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		sum, err := tradable.Add(retired)
-		if err != nil {
-			return nil, err
+		if err = assertRetirable(creditType, retired); err != nil {
+			return err
 		}
 
-		// subtract balance
-		err = subAndSetDecimal(store, TradableBalanceKey(senderAddr, denom), sum)
+		var tradable math.Dec
+		if credit.TradableAmount == "" {
+			// send the whole tradable balance of the sender, which is read
+			// from the same store as it is debited from below, so that it
+			// includes the effects of any previous send
+			tradable, err = sendAllTradableAmount(store, senderAddr, denom, retired)
+		} else {
+			tradable, err = math.NewNonNegativeFixedDecFromString(credit.TradableAmount, maxDecimalPlaces)
+		}
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		// Add tradable balance
-		err = addAndSetDecimal(store, TradableBalanceKey(recipientAddr, denom), tradable)
+		sum, err := tradable.Add(retired)
 		if err != nil {
-			return nil, err
+			return err
+		}
+
+		// move the credits from the tradable balance of the sender to the
+		// tradable and retired balances of the recipient
+		err = transferCredits(store,
+			[]creditDelta{{key: TradableBalanceKey(senderAddr, denom), amount: sum}},
+			[]creditDelta{
+				{key: TradableBalanceKey(recipientAddr, denom), amount: tradable},
+				{key: RetiredBalanceKey(recipientAddr, denom), amount: retired},
+			},
+		)
+		if err != nil {
+			return err
 		}
 
 		if !retired.IsZero() {
-			// subtract retired from tradable supply
-			err = subAndSetDecimal(store, TradableSupplyKey(denom), retired)
-			if err != nil {
-				return nil, err
-			}
-
-			// Add retired balance
-			err = retire(ctx, store, recipientAddr, denom, retired, credit.RetirementLocation)
+			err = retireSupply(store, denom, retired)
 			if err != nil {
-				return nil, err
+				return err
 			}
 
-			// Add retired supply
-			err = addAndSetDecimal(store, RetiredSupplyKey(denom), retired)
+			err = emitRetireEvent(ctx, recipientAddr, denom, retired, credit.RetirementLocation)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		err = ctx.EventManager().EmitTypedEvent(&ecocredit.EventReceive{
-			Sender:     sender,
-			Recipient:  recipient,
+			Sender:     senderAddr.String(),
+			Recipient:  recipientAddr.String(),
 			BatchDenom: string(denom),
 			Amount:     sum.String(),
 		})
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	return &ecocredit.MsgSendResponse{}, nil
+	return nil
+}
+
+// sendAllTradableAmount returns the amount of credits to send as tradable in
+// order to send the whole tradable balance of the sender, retired of which
+// are retired on receipt.
+func sendAllTradableAmount(store sdk.KVStore, senderAddr sdk.AccAddress, denom batchDenomT, retired math.Dec) (math.Dec, error) {
+	balance, err := getDecimal(store, TradableBalanceKey(senderAddr, denom))
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	if balance.IsZero() {
+		return math.Dec{}, sdkerrors.ErrInsufficientFunds.Wrapf("no tradable credits of %s to send", denom)
+	}
+
+	tradable, err := math.SafeSubBalance(balance, retired)
+	if err != nil {
+		return math.Dec{}, sdkerrors.Wrapf(err, "retiring %s out of a tradable balance of %s", retired, balance)
+	}
+	return tradable, nil
 }
 
 // Retire credits to the specified location.
@@ -329,29 +388,35 @@ func (s serverImpl) Retire(goCtx context.Context, req *ecocredit.MsgRetire) (*ec
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not a valid credit batch denom", denom)
 		}
 
-		maxDecimalPlaces, err := s.getBatchPrecision(ctx, denom)
+		creditType, err := s.getBatchCreditType(ctx, denom)
 		if err != nil {
 			return nil, err
 		}
 
-		toRetire, err := math.NewPositiveFixedDecFromString(credit.Amount, maxDecimalPlaces)
+		toRetire, err := math.NewPositiveFixedDecFromString(credit.Amount, creditType.Precision)
 		if err != nil {
 			return nil, err
 		}
 
-		err = subtractTradableBalanceAndSupply(store, holderAddr, denom, toRetire)
+		if err = assertRetirable(creditType, toRetire); err != nil {
+			return nil, err
+		}
+
+		// move the credits from the tradable balance to the retired balance of the holder
+		err = transferCredits(store,
+			[]creditDelta{{key: TradableBalanceKey(holderAddr, denom), amount: toRetire}},
+			[]creditDelta{{key: RetiredBalanceKey(holderAddr, denom), amount: toRetire}},
+		)
 		if err != nil {
 			return nil, err
 		}
 
-		//  Add retired balance
-		err = retire(ctx, store, holderAddr, denom, toRetire, req.Location)
+		err = retireSupply(store, denom, toRetire)
 		if err != nil {
 			return nil, err
 		}
 
-		//  Add retired supply
-		err = addAndSetDecimal(store, RetiredSupplyKey(denom), toRetire)
+		err = emitRetireEvent(ctx, holderAddr, denom, toRetire, req.Location)
 		if err != nil {
 			return nil, err
 		}
@@ -386,7 +451,7 @@ func (s serverImpl) Cancel(goCtx context.Context, req *ecocredit.MsgCancel) (*ec
 			return nil, err
 		}
 
-		classInfo, err := s.getClassInfo(ctx, batchInfo.ClassId)
+		classInfo, err := s.GetClassInfo(ctx.Context, batchInfo.ClassId)
 		if err != nil {
 			return nil, err
 		}
@@ -433,11 +498,27 @@ func (s serverImpl) Cancel(goCtx context.Context, req *ecocredit.MsgCancel) (*ec
 			return nil, err
 		}
 
+		// Record the cancellation so that it can be queried by batch
+		// and by reason
+		_, err = s.cancellationTable.Create(ctx, &ecocredit.Cancellation{
+			Id:             s.cancellationTable.Sequence().PeekNextVal(ctx),
+			ClassId:        batchInfo.ClassId,
+			BatchDenom:     string(denom),
+			Holder:         req.Holder,
+			Amount:         toCancel.String(),
+			Reason:         req.Reason,
+			ReasonCategory: ecocredit.CancelReasonCategory(req.Reason),
+		})
+		if err != nil {
+			return nil, err
+		}
+
 		// Emit the cancellation event
 		err = ctx.EventManager().EmitTypedEvent(&ecocredit.EventCancel{
 			Canceller:  req.Holder,
 			BatchDenom: string(denom),
 			Amount:     toCancel.String(),
+			Reason:     req.Reason,
 		})
 		if err != nil {
 			return nil, err
@@ -463,12 +544,56 @@ func (s serverImpl) nextBatchInClass(ctx types.Context, classInfo *ecocredit.Cla
 	return nextVal, nil
 }
 
+// addClassIssuedSupply adds newly issued credits to the cumulative issued
+// supply of the credit class, failing if it would exceed the class max supply
+func (s serverImpl) addClassIssuedSupply(ctx types.Context, classInfo *ecocredit.ClassInfo, amount math.Dec) error {
+	issuedSupply := math.NewDecFromInt64(0)
+	if classInfo.IssuedSupply != "" {
+		var err error
+		issuedSupply, err = math.NewNonNegativeDecFromString(classInfo.IssuedSupply)
+		if err != nil {
+			return err
+		}
+	}
+
+	issuedSupply, err := issuedSupply.Add(amount)
+	if err != nil {
+		return err
+	}
+
+	if classInfo.MaxSupply != "" {
+		maxSupply, err := math.NewPositiveDecFromString(classInfo.MaxSupply)
+		if err != nil {
+			return err
+		}
+		if issuedSupply.Cmp(maxSupply) > 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("credit class %s issued supply would be %s, exceeding its max supply %s",
+				classInfo.ClassId, issuedSupply, maxSupply)
+		}
+	}
+
+	classInfo.IssuedSupply = issuedSupply.String()
+	return s.classInfoTable.Update(ctx, classInfo)
+}
+
 func retire(ctx types.Context, store sdk.KVStore, recipient sdk.AccAddress, batchDenom batchDenomT, retired math.Dec, location string) error {
 	err := addAndSetDecimal(store, RetiredBalanceKey(recipient, batchDenom), retired)
 	if err != nil {
 		return err
 	}
 
+	return emitRetireEvent(ctx, recipient, batchDenom, retired, location)
+}
+
+// moves `amount` from the tradable supply to the retired supply
+func retireSupply(store sdk.KVStore, batchDenom batchDenomT, amount math.Dec) error {
+	return transferCredits(store,
+		[]creditDelta{{key: TradableSupplyKey(batchDenom), amount: amount}},
+		[]creditDelta{{key: RetiredSupplyKey(batchDenom), amount: amount}},
+	)
+}
+
+func emitRetireEvent(ctx types.Context, recipient sdk.AccAddress, batchDenom batchDenomT, retired math.Dec, location string) error {
 	return ctx.EventManager().EmitTypedEvent(&ecocredit.EventRetire{
 		Retirer:    recipient.String(),
 		BatchDenom: string(batchDenom),
@@ -494,29 +619,122 @@ func subtractTradableBalanceAndSupply(store sdk.KVStore, holder sdk.AccAddress,
 	return nil
 }
 
-// gets the precision of the credit type associated with the batch
-func (s serverImpl) getBatchPrecision(ctx types.Context, denom batchDenomT) (uint32, error) {
+// gets the credit type associated with the batch
+func (s serverImpl) getBatchCreditType(ctx types.Context, denom batchDenomT) (*ecocredit.CreditType, error) {
 	var batchInfo ecocredit.BatchInfo
 	err := s.batchInfoTable.GetOne(ctx, orm.RowID(denom), &batchInfo)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	classInfo, err := s.getClassInfo(ctx, batchInfo.ClassId)
+	classInfo, err := s.GetClassInfo(ctx.Context, batchInfo.ClassId)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	return classInfo.CreditType.Precision, nil
+	return classInfo.CreditType, nil
+}
+
+// assertRetirable checks that amount can be retired under the fractional
+// retirement policy of the credit type
+func assertRetirable(creditType *ecocredit.CreditType, amount math.Dec) error {
+	if !creditType.AllowFractional && !amount.IsInteger() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s credits can only be retired in whole amounts, got %s", creditType.Name, amount)
+	}
+	return nil
+}
+
+// assertMetadataLength returns an error if metadata is longer than maxLength
+// bytes. Limits are only enforced on new messages so genesis state with longer
+// metadata can still be imported.
+func assertMetadataLength(metadata []byte, maxLength uint64, kind string) error {
+	if uint64(len(metadata)) > maxLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s metadata length %d exceeds maximum of %d bytes", kind, len(metadata), maxLength)
+	}
+	return nil
 }
 
-// Checks if the given address is in the allowlist of credit class designers
-func (s serverImpl) isCreatorAllowListed(allowlist []string, designer sdk.Address) bool {
+// Checks if the given address is in the allowlist of credit class designers,
+// either in the AllowedClassCreators param or in the state-based allowlist
+func (s serverImpl) isCreatorAllowListed(ctx types.Context, allowlist []string, designer sdk.AccAddress) bool {
 	for _, addr := range allowlist {
 		allowListedAddr, _ := sdk.AccAddressFromBech32(addr)
 		if designer.Equals(allowListedAddr) {
 			return true
 		}
 	}
-	return false
+	return isClassCreator(ctx.KVStore(s.storeKey), designer)
+}
+
+// AddClassCreator adds an address to the state-based allowlist of credit
+// class creators. Only the allowlist authority can add class creators.
+func (s serverImpl) AddClassCreator(goCtx context.Context, req *ecocredit.MsgAddClassCreator) (*ecocredit.MsgAddClassCreatorResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+
+	creator, err := s.assertAllowlistAuthority(ctx, req.Authority, req.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(s.storeKey)
+	if isClassCreator(store, creator) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is already a class creator", req.Creator)
+	}
+	setClassCreator(store, creator)
+
+	err = ctx.EventManager().EmitTypedEvent(&ecocredit.EventAddClassCreator{
+		Creator: req.Creator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ecocredit.MsgAddClassCreatorResponse{}, nil
+}
+
+// RemoveClassCreator removes an address from the state-based allowlist of
+// credit class creators. Only the allowlist authority can remove class
+// creators.
+func (s serverImpl) RemoveClassCreator(goCtx context.Context, req *ecocredit.MsgRemoveClassCreator) (*ecocredit.MsgRemoveClassCreatorResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+
+	creator, err := s.assertAllowlistAuthority(ctx, req.Authority, req.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(s.storeKey)
+	if !isClassCreator(store, creator) {
+		return nil, sdkerrors.ErrNotFound.Wrapf("%s is not a class creator", req.Creator)
+	}
+	deleteClassCreator(store, creator)
+
+	err = ctx.EventManager().EmitTypedEvent(&ecocredit.EventRemoveClassCreator{
+		Creator: req.Creator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ecocredit.MsgRemoveClassCreatorResponse{}, nil
+}
+
+// assertAllowlistAuthority checks that the signer is the allowlist authority
+// and returns the parsed creator address.
+func (s serverImpl) assertAllowlistAuthority(ctx types.Context, signer, creator string) (sdk.AccAddress, error) {
+	authority, err := s.getAllowlistAuthority(ctx.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	signerAddr, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authority.Equals(signerAddr) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not the allowlist authority", signer)
+	}
+
+	return sdk.AccAddressFromBech32(creator)
 }
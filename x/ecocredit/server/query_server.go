@@ -1,14 +1,19 @@
 package server
 
 import (
+	"bytes"
 	"context"
 
+	"github.com/gogo/protobuf/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/math"
 
 	"github.com/regen-network/regen-ledger/orm"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
@@ -20,7 +25,11 @@ func (s serverImpl) Classes(goCtx context.Context, request *ecocredit.QueryClass
 	}
 
 	ctx := types.UnwrapSDKContext(goCtx)
-	classesIter, err := s.classInfoTable.PrefixScan(ctx, nil, nil)
+	start, end, err := paginatedRange(nil, nil, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	classesIter, err := s.classInfoTable.PrefixScan(ctx, start, end)
 	if err != nil {
 		return nil, err
 	}
@@ -37,13 +46,29 @@ func (s serverImpl) Classes(goCtx context.Context, request *ecocredit.QueryClass
 	}, nil
 }
 
+// paginatedRange returns the [start, end) range of a table scan, resuming
+// from pageRequest.Key if provided. The key is the RowID returned as NextKey
+// by the previous page and must be within the original range.
+func paginatedRange(start, end []byte, pageRequest *query.PageRequest) ([]byte, []byte, error) {
+	if pageRequest == nil || len(pageRequest.Key) == 0 {
+		return start, end, nil
+	}
+
+	key := pageRequest.Key
+	if bytes.Compare(key, start) < 0 || (end != nil && bytes.Compare(key, end) >= 0) {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "pagination key is out of range")
+	}
+
+	return key, end, nil
+}
+
 func (s serverImpl) ClassInfo(goCtx context.Context, request *ecocredit.QueryClassInfoRequest) (*ecocredit.QueryClassInfoResponse, error) {
 	if request == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
 	}
 
 	ctx := types.UnwrapSDKContext(goCtx)
-	classInfo, err := s.getClassInfo(ctx, request.ClassId)
+	classInfo, err := s.GetClassInfo(ctx.Context, request.ClassId)
 	if err != nil {
 		return nil, err
 	}
@@ -51,10 +76,23 @@ func (s serverImpl) ClassInfo(goCtx context.Context, request *ecocredit.QueryCla
 	return &ecocredit.QueryClassInfoResponse{Info: classInfo}, nil
 }
 
-func (s serverImpl) getClassInfo(ctx types.Context, classID string) (*ecocredit.ClassInfo, error) {
+// GetClassInfo returns the credit class with the given ID. It returns an
+// ecocredit.ErrParseFailure error if the ID is malformed and an
+// orm.ErrNotFound error if there is no such class.
+func (s serverImpl) GetClassInfo(ctx sdk.Context, classID string) (*ecocredit.ClassInfo, error) {
+	if err := ecocredit.ValidateClassID(classID); err != nil {
+		return nil, err
+	}
+
 	var classInfo ecocredit.ClassInfo
 	err := s.classInfoTable.GetOne(ctx, orm.RowID(classID), &classInfo)
-	return &classInfo, err
+	if err != nil {
+		if orm.ErrNotFound.Is(err) {
+			return nil, sdkerrors.Wrapf(orm.ErrNotFound, "credit class %s", classID)
+		}
+		return nil, err
+	}
+	return &classInfo, nil
 }
 
 func (s serverImpl) Batches(goCtx context.Context, request *ecocredit.QueryBatchesRequest) (*ecocredit.QueryBatchesResponse, error) {
@@ -66,9 +104,13 @@ func (s serverImpl) Batches(goCtx context.Context, request *ecocredit.QueryBatch
 		return nil, err
 	}
 
-	// Only read IDs that have a prefix match with the ClassID
+	// Only read the batches whose denom has the prefix of the class
 	ctx := types.UnwrapSDKContext(goCtx)
-	start, end := orm.PrefixRange([]byte(request.ClassId))
+	start, end := orm.PrefixRange([]byte(ecocredit.BatchDenomPrefix(request.ClassId)))
+	start, end, err := paginatedRange(start, end, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
 	batchesIter, err := s.batchInfoTable.PrefixScan(ctx, start, end)
 	if err != nil {
 		return nil, err
@@ -86,6 +128,50 @@ func (s serverImpl) Batches(goCtx context.Context, request *ecocredit.QueryBatch
 	}, nil
 }
 
+// BatchesByDateRange queries for all batches in the given credit class whose
+// [StartDate, EndDate] period overlaps the inclusive requested date range.
+func (s serverImpl) BatchesByDateRange(goCtx context.Context, request *ecocredit.QueryBatchesByDateRangeRequest) (*ecocredit.QueryBatchesByDateRangeResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ecocredit.ValidateClassID(request.ClassId); err != nil {
+		return nil, err
+	}
+
+	if request.StartDate != nil && request.EndDate != nil && request.EndDate.Before(*request.StartDate) {
+		return nil, status.Errorf(codes.InvalidArgument, "end date must not be before start date")
+	}
+
+	// Only read the batches whose denom has the prefix of the class
+	ctx := types.UnwrapSDKContext(goCtx)
+	start, end := orm.PrefixRange([]byte(ecocredit.BatchDenomPrefix(request.ClassId)))
+	start, end, err := paginatedRange(start, end, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	batchesIter, err := s.batchInfoTable.PrefixScan(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip batches outside of the requested date range
+	filteredIter := orm.FilterIterator(batchesIter, func(_ orm.RowID, obj proto.Message) (bool, error) {
+		return obj.(*ecocredit.BatchInfo).OverlapsDateRange(request.StartDate, request.EndDate), nil
+	})
+
+	var batches []*ecocredit.BatchInfo
+	pageResp, err := orm.Paginate(filteredIter, request.Pagination, &batches)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ecocredit.QueryBatchesByDateRangeResponse{
+		Batches:    batches,
+		Pagination: pageResp,
+	}, nil
+}
+
 func (s serverImpl) BatchInfo(goCtx context.Context, request *ecocredit.QueryBatchInfoRequest) (*ecocredit.QueryBatchInfoResponse, error) {
 	if request == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
@@ -153,8 +239,174 @@ func (s serverImpl) Supply(goCtx context.Context, request *ecocredit.QuerySupply
 	}, nil
 }
 
+// RetiredSupply sums the retired supply of all credit batches in a class.
+func (s serverImpl) RetiredSupply(goCtx context.Context, request *ecocredit.QueryRetiredSupplyRequest) (*ecocredit.QueryRetiredSupplyResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ecocredit.ValidateClassID(request.ClassId); err != nil {
+		return nil, err
+	}
+
+	// Only read the batches whose denom has the prefix of the class
+	ctx := types.UnwrapSDKContext(goCtx)
+	start, end := orm.PrefixRange([]byte(ecocredit.BatchDenomPrefix(request.ClassId)))
+	batchesIter, err := s.batchInfoTable.PrefixScan(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer batchesIter.Close()
+
+	store := ctx.KVStore(s.storeKey)
+	total := math.NewDecFromInt64(0)
+	for {
+		var batchInfo ecocredit.BatchInfo
+		if _, err := batchesIter.LoadNext(&batchInfo); err != nil {
+			if orm.ErrIteratorDone.Is(err) {
+				break
+			}
+			return nil, err
+		}
+
+		retired, err := getDecimal(store, RetiredSupplyKey(batchDenomT(batchInfo.BatchDenom)))
+		if err != nil {
+			return nil, err
+		}
+
+		total, err = total.Add(retired)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ecocredit.QueryRetiredSupplyResponse{RetiredSupply: total.String()}, nil
+}
+
+// ClassRetirementByAccount sums the retired balance of an account across all
+// credit batches in a class.
+func (s serverImpl) ClassRetirementByAccount(goCtx context.Context, request *ecocredit.QueryClassRetirementByAccountRequest) (*ecocredit.QueryClassRetirementByAccountResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ecocredit.ValidateClassID(request.ClassId); err != nil {
+		return nil, err
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(request.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only read the retired balances of the account whose denom has the prefix of the class
+	ctx := types.UnwrapSDKContext(goCtx)
+	store := ctx.KVStore(s.storeKey)
+	retiredIter := sdk.KVStorePrefixIterator(store, RetiredBalanceKey(accAddr, batchDenomT(ecocredit.BatchDenomPrefix(request.ClassId))))
+	defer retiredIter.Close()
+
+	total := math.NewDecFromInt64(0)
+	for ; retiredIter.Valid(); retiredIter.Next() {
+		retired, err := math.NewDecFromString(string(retiredIter.Value()))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "can't unmarshal %s as decimal", retiredIter.Value())
+		}
+
+		total, err = total.Add(retired)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ecocredit.QueryClassRetirementByAccountResponse{RetiredAmount: total.String()}, nil
+}
+
+func (s serverImpl) Cancellations(goCtx context.Context, request *ecocredit.QueryCancellationsRequest) (*ecocredit.QueryCancellationsResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ecocredit.ValidateDenom(request.BatchDenom); err != nil {
+		return nil, err
+	}
+
+	ctx := types.UnwrapSDKContext(goCtx)
+	it, err := s.cancellationByBatchDenomIndex.GetPaginated(ctx, cancellationByBatchDenomKey(request.BatchDenom), request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var cancellations []*ecocredit.Cancellation
+	pageResp, err := orm.Paginate(it, request.Pagination, &cancellations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ecocredit.QueryCancellationsResponse{
+		Cancellations: cancellations,
+		Pagination:    pageResp,
+	}, nil
+}
+
+func (s serverImpl) CancellationsByReason(goCtx context.Context, request *ecocredit.QueryCancellationsByReasonRequest) (*ecocredit.QueryCancellationsByReasonResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ecocredit.ValidateClassID(request.ClassId); err != nil {
+		return nil, err
+	}
+
+	if request.ReasonCategory == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reason category should not be empty")
+	}
+
+	ctx := types.UnwrapSDKContext(goCtx)
+	it, err := s.cancellationByClassReasonIndex.GetPaginated(ctx, cancellationByClassReasonKey(request.ClassId, request.ReasonCategory), request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var cancellations []*ecocredit.Cancellation
+	pageResp, err := orm.Paginate(it, request.Pagination, &cancellations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ecocredit.QueryCancellationsByReasonResponse{
+		Cancellations: cancellations,
+		Pagination:    pageResp,
+	}, nil
+}
+
+// cancellationByBatchDenomKey builds the cancellationByBatchDenomIndex key of
+// the cancellations of the given credit batch. The denom is null terminated so
+// that a denom which is a prefix of another one doesn't match its cancellations.
+func cancellationByBatchDenomKey(batchDenom string) []byte {
+	return orm.BuildCompositeKey(batchDenom)
+}
+
+// cancellationByClassReasonKey builds the cancellationByClassReasonIndex key of
+// the cancellations of the given credit class with the given reason category.
+func cancellationByClassReasonKey(classID, reasonCategory string) []byte {
+	return orm.BuildCompositeKey(classID, reasonCategory)
+}
+
 func (s serverImpl) CreditTypes(goCtx context.Context, _ *ecocredit.QueryCreditTypesRequest) (*ecocredit.QueryCreditTypesResponse, error) {
 	ctx := types.UnwrapSDKContext(goCtx).Context
 	creditTypes := s.getAllCreditTypes(ctx)
 	return &ecocredit.QueryCreditTypesResponse{CreditTypes: creditTypes}, nil
 }
+
+func (s serverImpl) CreditTypeSeq(goCtx context.Context, request *ecocredit.QueryCreditTypeSeqRequest) (*ecocredit.QueryCreditTypeSeqResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := types.UnwrapSDKContext(goCtx).Context
+	seqNumber, err := s.getCreditTypeSeq(ctx, request.Abbreviation)
+	if err != nil {
+		return nil, err
+	}
+	return &ecocredit.QueryCreditTypeSeqResponse{SeqNumber: seqNumber}, nil
+}
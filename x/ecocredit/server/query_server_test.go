@@ -0,0 +1,41 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+func TestGetClassInfo(t *testing.T) {
+	ctx, storeKey := setupStore(t)
+	s := newServer(storeKey, paramtypes.Subspace{}, nil, codec.NewProtoCodec(types.NewInterfaceRegistry()))
+
+	admin := sdk.AccAddress([]byte("admin")).String()
+	issuer := sdk.AccAddress([]byte("issuer")).String()
+	classInfo := &ecocredit.ClassInfo{
+		ClassId:    "C01",
+		Admin:      admin,
+		Issuers:    []string{issuer},
+		Metadata:   []byte("metadata"),
+		CreditType: &ecocredit.CreditType{Name: "carbon", Abbreviation: "C", Unit: "ton", Precision: 6},
+	}
+	require.NoError(t, s.classInfoTable.Create(ctx, classInfo))
+
+	found, err := s.GetClassInfo(ctx, "C01")
+	require.NoError(t, err)
+	require.Equal(t, classInfo, found)
+
+	_, err = s.GetClassInfo(ctx, "C02")
+	require.ErrorIs(t, err, orm.ErrNotFound)
+	require.EqualError(t, err, "credit class C02: not found")
+
+	_, err = s.GetClassInfo(ctx, "abcde")
+	require.EqualError(t, err, "class ID didn't match the format: expected A00, got abcde: parse error")
+}
@@ -18,6 +18,14 @@ const (
 	CreditTypeSeqTablePrefix byte = 0x4
 	ClassInfoTablePrefix     byte = 0x5
 	BatchInfoTablePrefix     byte = 0x6
+	ClassCreatorPrefix       byte = 0x7
+	BatchHolderPrefix        byte = 0x8
+
+	// Cancellation Table
+	CancellationTablePrefix              byte = 0x9
+	CancellationTableSeqPrefix           byte = 0xA
+	CancellationByBatchDenomIndexPrefix  byte = 0xB
+	CancellationByClassReasonIndexPrefix byte = 0xC
 )
 
 type serverImpl struct {
@@ -31,6 +39,11 @@ type serverImpl struct {
 
 	classInfoTable orm.PrimaryKeyTable
 	batchInfoTable orm.PrimaryKeyTable
+
+	// Cancellation Table
+	cancellationTable              orm.AutoUInt64Table
+	cancellationByBatchDenomIndex  orm.Index
+	cancellationByClassReasonIndex orm.Index
 }
 
 func newServer(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, bankKeeper ecocredit.BankKeeper, cdc codec.Codec) serverImpl {
@@ -58,13 +71,39 @@ func newServer(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, bankKeeper
 	}
 	s.batchInfoTable = batchInfoTableBuilder.Build()
 
+	// Cancellation Table
+	cancellationTableBuilder, err := orm.NewAutoUInt64TableBuilder(CancellationTablePrefix, CancellationTableSeqPrefix, storeKey, &ecocredit.Cancellation{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	s.cancellationByBatchDenomIndex, err = orm.NewIndex(cancellationTableBuilder, CancellationByBatchDenomIndexPrefix, func(val interface{}) ([]orm.RowID, error) {
+		return []orm.RowID{cancellationByBatchDenomKey(val.(*ecocredit.Cancellation).BatchDenom)}, nil
+	})
+	if err != nil {
+		panic(err.Error())
+	}
+	s.cancellationByClassReasonIndex, err = orm.NewIndex(cancellationTableBuilder, CancellationByClassReasonIndexPrefix, func(val interface{}) ([]orm.RowID, error) {
+		c := val.(*ecocredit.Cancellation)
+		return []orm.RowID{cancellationByClassReasonKey(c.ClassId, c.ReasonCategory)}, nil
+	})
+	if err != nil {
+		panic(err.Error())
+	}
+	s.cancellationTable = cancellationTableBuilder.Build()
+
 	return s
 }
 
-func RegisterServices(configurator server.Configurator, paramSpace paramtypes.Subspace, bankKeeper ecocredit.BankKeeper) {
+// RegisterServices registers the ecocredit services and returns the Keeper
+// through which other modules can access ecocredit state transitions.
+func RegisterServices(configurator server.Configurator, paramSpace paramtypes.Subspace, bankKeeper ecocredit.BankKeeper) Keeper {
 	impl := newServer(configurator.ModuleKey(), paramSpace, bankKeeper, configurator.Marshaler())
 	ecocredit.RegisterMsgServer(configurator.MsgServer(), impl)
 	ecocredit.RegisterQueryServer(configurator.QueryServer(), impl)
 	configurator.RegisterGenesisHandlers(impl.InitGenesis, impl.ExportGenesis)
 	configurator.RegisterInvariantsHandler(impl.RegisterInvariants)
+	if err := configurator.RegisterMigrationHandler(1, impl.migrateV1ToV2); err != nil {
+		panic(err)
+	}
+	return Keeper{s: &impl}
 }
@@ -59,6 +59,6 @@ func TestServer(t *testing.T) {
 	ecocreditModule := ecocredit.NewModule(ecocreditSubspace, bankKeeper)
 	ff.SetModules([]module.Module{ecocreditModule})
 
-	s := testsuite.NewIntegrationTestSuite(ff, ecocreditSubspace, bankKeeper)
+	s := testsuite.NewIntegrationTestSuite(ff, ecocreditSubspace, bankKeeper, ecocreditModule.Keeper())
 	suite.Run(t, s)
 }
@@ -17,22 +17,28 @@ func (s *IntegrationTestSuite) TestInitExportGenesis() {
 	issuer2 := s.signers[3].String()
 	addr1 := s.signers[4].String()
 
-	// Set the param set to empty values to properly test init
-	var ecocreditParams ecocredit.Params
+	// Set the param set to empty values to properly test init, apart from
+	// the metadata lengths which must be positive
+	ecocreditParams := ecocredit.Params{MaxClassMetadataLength: 1, MaxBatchMetadataLength: 1}
 	s.paramSpace.SetParamSet(ctx.Context, &ecocreditParams)
 
+	creditType := ecocredit.DefaultParams().CreditTypes[0]
 	classInfo := []*ecocredit.ClassInfo{
 		{
-			ClassId:  "BIO01",
-			Admin:    admin1.String(),
-			Issuers:  []string{issuer1, issuer2},
-			Metadata: []byte("credit class metadata"),
+			ClassId:    "BIO01",
+			Admin:      admin1.String(),
+			Issuers:    []string{issuer1, issuer2},
+			Metadata:   []byte("credit class metadata"),
+			CreditType: creditType,
 		},
 		{
-			ClassId:  "BIO02",
-			Admin:    admin2,
-			Issuers:  []string{issuer2, addr1},
-			Metadata: []byte("credit class metadata"),
+			ClassId:    "BIO02",
+			Admin:      admin2,
+			Issuers:    []string{issuer2, addr1},
+			CreditType: creditType,
+			// metadata limits only apply to new messages, so oversized
+			// metadata must still be importable
+			Metadata: make([]byte, ecocredit.DefaultMaxMetadataLength+1),
 		},
 	}
 
@@ -76,13 +82,28 @@ func (s *IntegrationTestSuite) TestInitExportGenesis() {
 		},
 	}
 
+	cancellations := []*ecocredit.Cancellation{
+		{
+			Id:             100,
+			ClassId:        "BIO02",
+			BatchDenom:     "BIO02-00000000-00000000-001",
+			Holder:         addr1,
+			Amount:         "5",
+			Reason:         "reversal: forest fire",
+			ReasonCategory: "reversal",
+		},
+	}
+
 	genesisState := &ecocredit.GenesisState{
-		Params:    ecocredit.DefaultParams(),
-		Sequences: sequences,
-		ClassInfo: classInfo,
-		BatchInfo: batchInfo,
-		Balances:  balances,
-		Supplies:  supplies,
+		Params:          ecocredit.DefaultParams(),
+		Sequences:       sequences,
+		ClassInfo:       classInfo,
+		BatchInfo:       batchInfo,
+		Balances:        balances,
+		Supplies:        supplies,
+		ClassCreators:   []string{s.signers[6].String()},
+		Cancellations:   cancellations,
+		CancellationSeq: 100,
 	}
 	require.NoError(s.initGenesisState(ctx, genesisState))
 
@@ -132,6 +153,13 @@ func (s *IntegrationTestSuite) TestInitExportGenesis() {
 		require.Equal(rSupply.String(), supply.RetiredSupply)
 	}
 
+	cancellationsRes, err := s.queryClient.CancellationsByReason(ctx, &ecocredit.QueryCancellationsByReasonRequest{
+		ClassId:        "BIO02",
+		ReasonCategory: "reversal",
+	})
+	require.NoError(err)
+	require.Equal(cancellations, cancellationsRes.Cancellations)
+
 	exported := s.exportGenesisState(ctx)
 	require.Equal(genesisState.Sequences, exportedGenesisState.Sequences)
 	require.Equal(genesisState.Params, exported.Params)
@@ -139,6 +167,9 @@ func (s *IntegrationTestSuite) TestInitExportGenesis() {
 	require.Equal(genesisState.BatchInfo, exported.BatchInfo)
 	require.Equal(genesisState.Balances, exported.Balances)
 	require.Equal(genesisState.Supplies, exported.Supplies)
+	require.Equal(genesisState.ClassCreators, exported.ClassCreators)
+	require.Equal(genesisState.Cancellations, exported.Cancellations)
+	require.Equal(genesisState.CancellationSeq, exported.CancellationSeq)
 
 	// invalid supply
 	genesisState.Supplies = []*ecocredit.Supply{
@@ -148,7 +179,7 @@ func (s *IntegrationTestSuite) TestInitExportGenesis() {
 		},
 	}
 
-	err := s.initGenesisState(ctx, genesisState)
+	err = s.initGenesisState(ctx, genesisState)
 	require.Error(err)
 	require.Contains(err.Error(), "supply is incorrect for BIO01-00000000-00000000-001 credit batch")
 
@@ -2,7 +2,13 @@ package testsuite
 
 import (
 	"fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 )
 
@@ -63,14 +69,22 @@ func (s *IntegrationTestSuite) TestQueryClassInfo() {
 				ClassId: "",
 			},
 			true,
-			"not found",
+			"class ID didn't match the format",
 		},
 		{
-			"credit class not found",
+			"invalid class-ID",
 			&ecocredit.QueryClassInfoRequest{
 				ClassId: "123",
 			},
 			true,
+			"class ID didn't match the format",
+		},
+		{
+			"credit class not found",
+			&ecocredit.QueryClassInfoRequest{
+				ClassId: "C99",
+			},
+			true,
 			"not found",
 		},
 		{
@@ -140,6 +154,321 @@ func (s *IntegrationTestSuite) TestQueryBatches() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestQueryBatchesByDateRange() {
+	require := s.Require()
+
+	// use a cached context so the created class and batches don't leak into
+	// other tests
+	cacheCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: cacheCtx}
+	admin, issuer := s.signers[0], s.signers[1].String()
+
+	fee := sdk.NewCoins(sdk.NewCoin("stake", ecocredit.DefaultCreditClassFeeTokens))
+	require.NoError(s.bankKeeper.MintCoins(cacheCtx, minttypes.ModuleName, fee))
+	require.NoError(s.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, minttypes.ModuleName, admin, fee))
+	createClsRes, err := s.msgClient.CreateClass(ctx, &ecocredit.MsgCreateClass{
+		Admin:          admin.String(),
+		Issuers:        []string{issuer},
+		CreditTypeName: "carbon",
+	})
+	require.NoError(err)
+	classID := createClsRes.ClassId
+
+	date := func(month time.Month, day int) *time.Time {
+		t := time.Date(2021, month, day, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	// batches before, straddling the start of, inside, straddling the end of
+	// and after the 2021-03-01 to 2021-06-30 window
+	batchDates := [][2]*time.Time{
+		{date(1, 1), date(2, 28)},
+		{date(2, 1), date(3, 1)},
+		{date(4, 1), date(5, 1)},
+		{date(6, 30), date(8, 1)},
+		{date(7, 1), date(9, 1)},
+	}
+	denoms := make([]string, len(batchDates))
+	for i, dates := range batchDates {
+		res, err := s.msgClient.CreateBatch(ctx, &ecocredit.MsgCreateBatch{
+			Issuer:          issuer,
+			ClassId:         classID,
+			StartDate:       dates[0],
+			EndDate:         dates[1],
+			ProjectLocation: "AB",
+		})
+		require.NoError(err)
+		denoms[i] = res.BatchDenom
+	}
+
+	testCases := []struct {
+		name      string
+		request   *ecocredit.QueryBatchesByDateRangeRequest
+		expDenoms []string
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			name:      "nil request",
+			expectErr: true,
+			errMsg:    "empty request",
+		},
+		{
+			name:      "empty class id",
+			request:   &ecocredit.QueryBatchesByDateRangeRequest{},
+			expectErr: true,
+			errMsg:    "class ID didn't match the format",
+		},
+		{
+			name: "end date before start date",
+			request: &ecocredit.QueryBatchesByDateRangeRequest{
+				ClassId:   classID,
+				StartDate: date(6, 30),
+				EndDate:   date(3, 1),
+			},
+			expectErr: true,
+			errMsg:    "end date must not be before start date",
+		},
+		{
+			name: "inside, straddling and on the bounds of the window",
+			request: &ecocredit.QueryBatchesByDateRangeRequest{
+				ClassId:   classID,
+				StartDate: date(3, 1),
+				EndDate:   date(6, 30),
+			},
+			expDenoms: denoms[1:4],
+		},
+		{
+			name: "no lower bound",
+			request: &ecocredit.QueryBatchesByDateRangeRequest{
+				ClassId: classID,
+				EndDate: date(2, 15),
+			},
+			expDenoms: denoms[:2],
+		},
+		{
+			name: "no upper bound",
+			request: &ecocredit.QueryBatchesByDateRangeRequest{
+				ClassId:   classID,
+				StartDate: date(8, 15),
+			},
+			expDenoms: denoms[4:],
+		},
+		{
+			name: "no bounds",
+			request: &ecocredit.QueryBatchesByDateRangeRequest{
+				ClassId: classID,
+			},
+			expDenoms: denoms,
+		},
+		{
+			name: "paginated",
+			request: &ecocredit.QueryBatchesByDateRangeRequest{
+				ClassId:    classID,
+				StartDate:  date(3, 1),
+				EndDate:    date(6, 30),
+				Pagination: &query.PageRequest{Limit: 1},
+			},
+			expDenoms: denoms[1:2],
+		},
+		{
+			name: "no batches in window",
+			request: &ecocredit.QueryBatchesByDateRangeRequest{
+				ClassId:   classID,
+				StartDate: date(10, 1),
+				EndDate:   date(12, 31),
+			},
+			expDenoms: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			res, err := s.queryClient.BatchesByDateRange(ctx, tc.request)
+			if tc.expectErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.errMsg)
+				return
+			}
+			require.NoError(err)
+
+			resDenoms := make([]string, len(res.Batches))
+			for i, batch := range res.Batches {
+				resDenoms[i] = batch.BatchDenom
+			}
+			require.Equal(tc.expDenoms, resDenoms)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestQueryBatchesClassIDPrefix() {
+	require := s.Require()
+
+	cacheCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: cacheCtx}
+	admin, issuer := s.signers[0], s.signers[1].String()
+
+	// use a fresh credit type so that the class IDs are predictable
+	creditType := &ecocredit.CreditType{
+		Name:         "prefix",
+		Abbreviation: "P",
+		Unit:         "metric ton CO2 equivalent",
+		Precision:    6,
+	}
+	var creditTypes []*ecocredit.CreditType
+	s.paramSpace.Get(cacheCtx, ecocredit.KeyCreditTypes, &creditTypes)
+	s.paramSpace.Set(cacheCtx, ecocredit.KeyCreditTypes, append(creditTypes, creditType))
+
+	// create classes up to P100, so that P10 is a prefix of another class ID
+	const numClasses = 100
+	fee := sdk.NewCoins(sdk.NewCoin("stake", ecocredit.DefaultCreditClassFeeTokens.MulRaw(numClasses)))
+	require.NoError(s.bankKeeper.MintCoins(cacheCtx, minttypes.ModuleName, fee))
+	require.NoError(s.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, minttypes.ModuleName, admin, fee))
+	for i := 0; i < numClasses; i++ {
+		_, err := s.msgClient.CreateClass(ctx, &ecocredit.MsgCreateClass{
+			Admin:          admin.String(),
+			Issuers:        []string{issuer},
+			CreditTypeName: creditType.Name,
+		})
+		require.NoError(err)
+	}
+
+	start, end := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+	createBatch := func(classID string) string {
+		res, err := s.msgClient.CreateBatch(ctx, &ecocredit.MsgCreateBatch{
+			Issuer:          issuer,
+			ClassId:         classID,
+			StartDate:       &start,
+			EndDate:         &end,
+			ProjectLocation: "AB",
+		})
+		require.NoError(err)
+		return res.BatchDenom
+	}
+	batchDenoms := func(batches []*ecocredit.BatchInfo) []string {
+		denoms := make([]string, len(batches))
+		for i, batch := range batches {
+			denoms[i] = batch.BatchDenom
+		}
+		return denoms
+	}
+
+	shortDenom := createBatch("P10")
+	longDenom := createBatch("P100")
+
+	for classID, expDenom := range map[string]string{"P10": shortDenom, "P100": longDenom} {
+		batchesRes, err := s.queryClient.Batches(ctx, &ecocredit.QueryBatchesRequest{ClassId: classID})
+		require.NoError(err)
+		require.Equal([]string{expDenom}, batchDenoms(batchesRes.Batches), classID)
+
+		dateRangeRes, err := s.queryClient.BatchesByDateRange(ctx, &ecocredit.QueryBatchesByDateRangeRequest{
+			ClassId:   classID,
+			StartDate: &start,
+			EndDate:   &end,
+		})
+		require.NoError(err)
+		require.Equal([]string{expDenom}, batchDenoms(dateRangeRes.Batches), classID)
+	}
+}
+
+func (s *IntegrationTestSuite) TestQueryPagination() {
+	require := s.Require()
+
+	// use a cached context so the created classes and batches don't leak into
+	// other tests
+	cacheCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: cacheCtx}
+	admin, issuer := s.signers[0], s.signers[1].String()
+
+	numClasses := 5
+	fee := sdk.NewCoins(sdk.NewCoin("stake", ecocredit.DefaultCreditClassFeeTokens.MulRaw(int64(numClasses))))
+	require.NoError(s.bankKeeper.MintCoins(cacheCtx, minttypes.ModuleName, fee))
+	require.NoError(s.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, minttypes.ModuleName, admin, fee))
+
+	var classID string
+	for i := 0; i < numClasses; i++ {
+		res, err := s.msgClient.CreateClass(ctx, &ecocredit.MsgCreateClass{
+			Admin:          admin.String(),
+			Issuers:        []string{issuer},
+			CreditTypeName: "carbon",
+		})
+		require.NoError(err)
+		classID = res.ClassId
+	}
+
+	startDate, endDate := time.Now(), time.Now()
+	for i := 0; i < 5; i++ {
+		_, err := s.msgClient.CreateBatch(ctx, &ecocredit.MsgCreateBatch{
+			Issuer:          issuer,
+			ClassId:         classID,
+			StartDate:       &startDate,
+			EndDate:         &endDate,
+			ProjectLocation: "AB",
+		})
+		require.NoError(err)
+	}
+
+	classes := func(pageReq *query.PageRequest) ([]string, *query.PageResponse) {
+		res, err := s.queryClient.Classes(ctx, &ecocredit.QueryClassesRequest{Pagination: pageReq})
+		require.NoError(err)
+		ids := make([]string, len(res.Classes))
+		for i, class := range res.Classes {
+			ids[i] = class.ClassId
+		}
+		return ids, res.Pagination
+	}
+	batches := func(pageReq *query.PageRequest) ([]string, *query.PageResponse) {
+		res, err := s.queryClient.Batches(ctx, &ecocredit.QueryBatchesRequest{ClassId: classID, Pagination: pageReq})
+		require.NoError(err)
+		denoms := make([]string, len(res.Batches))
+		for i, batch := range res.Batches {
+			denoms[i] = batch.BatchDenom
+		}
+		return denoms, res.Pagination
+	}
+
+	for name, fetch := range map[string]func(*query.PageRequest) ([]string, *query.PageResponse){
+		"classes": classes,
+		"batches": batches,
+	} {
+		fetch := fetch
+		s.Run(name, func() {
+			// nil pagination returns the first default-limit page
+			all, pageRes := fetch(nil)
+			require.GreaterOrEqual(len(all), 5)
+			require.Nil(pageRes.NextKey)
+			require.Equal(uint64(len(all)), pageRes.Total)
+
+			// offset based page boundaries
+			page, pageRes := fetch(&query.PageRequest{Limit: 2, Offset: 2})
+			require.Equal(all[2:4], page)
+			require.NotNil(pageRes.NextKey)
+
+			// following next keys visits every item exactly once
+			var paged []string
+			var key []byte
+			for {
+				page, pageRes := fetch(&query.PageRequest{Limit: 2, Key: key})
+				require.LessOrEqual(len(page), 2)
+				paged = append(paged, page...)
+				if pageRes.NextKey == nil {
+					break
+				}
+				key = pageRes.NextKey
+			}
+			require.Equal(all, paged)
+		})
+	}
+
+	_, err := s.queryClient.Batches(ctx, &ecocredit.QueryBatchesRequest{
+		ClassId:    classID,
+		Pagination: &query.PageRequest{Key: []byte("A")},
+	})
+	require.Error(err)
+	require.Contains(err.Error(), "pagination key is out of range")
+}
+
 func (s *IntegrationTestSuite) TestQueryBatchInfo() {
 	require := s.Require()
 
@@ -257,6 +586,10 @@ func (s *IntegrationTestSuite) TestBalanceQuery() {
 func (s *IntegrationTestSuite) TestCreditTypeQuery() {
 	require := s.Require()
 
+	var params ecocredit.Params
+	s.paramSpace.GetParamSet(s.sdkCtx, &params)
+	require.NotEmpty(params.CreditTypes)
+
 	testCases := []struct {
 		name      string
 		request   *ecocredit.QueryCreditTypesRequest
@@ -279,13 +612,23 @@ func (s *IntegrationTestSuite) TestCreditTypeQuery() {
 
 	for _, tc := range testCases {
 		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
-			_, err := s.queryClient.CreditTypes(s.ctx, tc.request)
+			res, err := s.queryClient.CreditTypes(s.ctx, tc.request)
 			if tc.expectErr {
 				require.Error(err)
 				require.Contains(err.Error(), tc.errMsg)
 			} else {
 				require.NoError(err)
+				require.Equal(params.CreditTypes, res.CreditTypes)
 			}
 		})
 	}
+
+	s.Run("no credit types", func() {
+		s.paramSpace.Set(s.sdkCtx, ecocredit.KeyCreditTypes, []*ecocredit.CreditType{})
+		defer s.paramSpace.Set(s.sdkCtx, ecocredit.KeyCreditTypes, params.CreditTypes)
+
+		res, err := s.queryClient.CreditTypes(s.ctx, &ecocredit.QueryCreditTypesRequest{})
+		require.NoError(err)
+		require.Empty(res.CreditTypes)
+	})
 }
@@ -11,10 +11,13 @@ import (
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	params "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server"
 )
 
 type IntegrationTestSuite struct {
@@ -32,16 +35,18 @@ type IntegrationTestSuite struct {
 
 	paramSpace paramstypes.Subspace
 	bankKeeper bankkeeper.Keeper
+	keeper     *server.Keeper
 
 	genesisCtx types.Context
 	blockTime  time.Time
 }
 
-func NewIntegrationTestSuite(fixtureFactory testutil.FixtureFactory, paramSpace paramstypes.Subspace, bankKeeper bankkeeper.BaseKeeper) *IntegrationTestSuite {
+func NewIntegrationTestSuite(fixtureFactory testutil.FixtureFactory, paramSpace paramstypes.Subspace, bankKeeper bankkeeper.BaseKeeper, keeper *server.Keeper) *IntegrationTestSuite {
 	return &IntegrationTestSuite{
 		fixtureFactory: fixtureFactory,
 		paramSpace:     paramSpace,
 		bankKeeper:     bankKeeper,
+		keeper:         keeper,
 	}
 }
 
@@ -61,9 +66,17 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	ecocreditParams.CreditTypes = append(
 		ecocreditParams.CreditTypes,
 		&ecocredit.CreditType{
-			Name:         "biodiversity",
-			Abbreviation: "BIO",
-			Unit:         "hectare",
+			Name:            "biodiversity",
+			Abbreviation:    "BIO",
+			Unit:            "hectare",
+			Precision:       6,
+			AllowFractional: true,
+		},
+		// Add a credit type that can only be retired in whole amounts
+		&ecocredit.CreditType{
+			Name:         "wholecarbon",
+			Abbreviation: "WC",
+			Unit:         "metric ton CO2 equivalent",
 			Precision:    6,
 		},
 	)
@@ -245,6 +258,7 @@ func (s *IntegrationTestSuite) TestScenario() {
 		name               string
 		holder             string
 		toCancel           string
+		reason             string
 		expectErr          bool
 		expTradable        string
 		expTradableSupply  string
@@ -256,24 +270,28 @@ func (s *IntegrationTestSuite) TestScenario() {
 			name:      "can't cancel more credits than are tradable",
 			holder:    addr4,
 			toCancel:  "101",
+			reason:    "double counting",
 			expectErr: true,
 		},
 		{
 			name:      "can't cancel with a higher precision than the credit type",
 			holder:    addr4,
 			toCancel:  "0.1234567",
+			reason:    "double counting",
 			expectErr: true,
 		},
 		{
 			name:      "can't cancel no credits",
 			holder:    addr4,
 			toCancel:  "0",
+			reason:    "double counting",
 			expectErr: true,
 		},
 		{
 			name:               "can cancel a small amount of credits",
 			holder:             addr4,
 			toCancel:           "2.0002",
+			reason:             "double counting: also issued on another registry",
 			expectErr:          false,
 			expTradable:        "97.9998",
 			expTradableSupply:  "1115.7567",
@@ -285,6 +303,7 @@ func (s *IntegrationTestSuite) TestScenario() {
 			name:               "can cancel all remaining credits",
 			holder:             addr4,
 			toCancel:           "97.9998",
+			reason:             "double counting",
 			expectErr:          false,
 			expTradable:        "0",
 			expTradableSupply:  "1017.7569",
@@ -292,16 +311,24 @@ func (s *IntegrationTestSuite) TestScenario() {
 			expTotalAmount:     "11022.50189",
 			expAmountCancelled: "100.0000",
 		},
+		{
+			name:      "can't cancel without a reason",
+			holder:    addr4,
+			toCancel:  "1",
+			expectErr: true,
+		},
 		{
 			name:      "can't cancel anymore credits",
 			holder:    addr4,
 			toCancel:  "1",
+			reason:    "double counting",
 			expectErr: true,
 		},
 		{
 			name:               "can cancel from account with positive retired balance",
 			holder:             addr1,
 			toCancel:           "1",
+			reason:             "reversal: forest fire",
 			expectErr:          false,
 			expTradable:        "9.37",
 			expTradableSupply:  "1016.7569",
@@ -315,6 +342,7 @@ func (s *IntegrationTestSuite) TestScenario() {
 		s.Run(tc.name, func() {
 			_, err := s.msgClient.Cancel(s.ctx, &ecocredit.MsgCancel{
 				Holder: tc.holder,
+				Reason: tc.reason,
 				Credits: []*ecocredit.MsgCancel_CancelCredits{
 					{
 						BatchDenom: batchDenom,
@@ -355,6 +383,37 @@ func (s *IntegrationTestSuite) TestScenario() {
 		})
 	}
 
+	// the cancellations are queryable by batch denom and by reason
+	queryCancellationsRes, err := s.queryClient.Cancellations(s.ctx, &ecocredit.QueryCancellationsRequest{BatchDenom: batchDenom})
+	s.Require().NoError(err)
+	s.Require().Len(queryCancellationsRes.Cancellations, 3)
+	s.Require().Equal(&ecocredit.Cancellation{
+		Id:             1,
+		ClassId:        clsID,
+		BatchDenom:     batchDenom,
+		Holder:         addr4,
+		Amount:         "2.0002",
+		Reason:         "double counting: also issued on another registry",
+		ReasonCategory: "double counting",
+	}, queryCancellationsRes.Cancellations[0])
+	s.Require().Equal("97.9998", queryCancellationsRes.Cancellations[1].Amount)
+	s.Require().Equal(addr1, queryCancellationsRes.Cancellations[2].Holder)
+
+	queryCancellationsByReasonRes, err := s.queryClient.CancellationsByReason(s.ctx, &ecocredit.QueryCancellationsByReasonRequest{
+		ClassId:        clsID,
+		ReasonCategory: "double counting",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(queryCancellationsByReasonRes.Cancellations, 2)
+	s.Require().Equal(queryCancellationsRes.Cancellations[:2], queryCancellationsByReasonRes.Cancellations)
+
+	queryCancellationsByReasonRes, err = s.queryClient.CancellationsByReason(s.ctx, &ecocredit.QueryCancellationsByReasonRequest{
+		ClassId:        clsID,
+		ReasonCategory: "reversal",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(queryCancellationsRes.Cancellations[2:], queryCancellationsByReasonRes.Cancellations)
+
 	// retire credits
 	retireCases := []struct {
 		name               string
@@ -631,6 +690,172 @@ func (s *IntegrationTestSuite) TestScenario() {
 		})
 	}
 
+	/****   TEST METADATA LENGTH LIMITS   ****/
+	s.Require().NoError(s.fundAccount(admin, sdk.NewCoins(sdk.NewCoin("stake", ecocredit.DefaultCreditClassFeeTokens))))
+	maxLen := int(ecocredit.DefaultMaxMetadataLength)
+
+	metadataCases := []struct {
+		name     string
+		metadata []byte
+		wantErr  bool
+	}{
+		{
+			name:     "metadata at max length",
+			metadata: make([]byte, maxLen),
+			wantErr:  false,
+		},
+		{
+			name:     "metadata above max length",
+			metadata: make([]byte, maxLen+1),
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range metadataCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			// use a cached context so state changes don't leak into later tests
+			cacheCtx, _ := s.sdkCtx.CacheContext()
+			ctx := types.Context{Context: cacheCtx}
+
+			_, err := s.msgClient.CreateClass(ctx, &ecocredit.MsgCreateClass{
+				Admin:          admin.String(),
+				Issuers:        []string{issuer1},
+				CreditTypeName: "carbon",
+				Metadata:       tc.metadata,
+			})
+			if tc.wantErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), "credit class metadata length")
+			} else {
+				s.Require().NoError(err)
+			}
+
+			_, err = s.msgClient.CreateBatch(ctx, &ecocredit.MsgCreateBatch{
+				Issuer:          issuer1,
+				ClassId:         clsID,
+				StartDate:       &time1,
+				EndDate:         &time2,
+				ProjectLocation: "AB",
+				Metadata:        tc.metadata,
+			})
+			if tc.wantErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), "credit batch metadata length")
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	/****   TEST FRACTIONAL RETIREMENT POLICY   ****/
+	s.Require().NoError(s.fundAccount(admin, sdk.NewCoins(sdk.NewCoin("stake", ecocredit.DefaultCreditClassFeeTokens))))
+	createClsRes, err = s.msgClient.CreateClass(s.ctx, &ecocredit.MsgCreateClass{
+		Admin:          admin.String(),
+		Issuers:        []string{issuer1},
+		CreditTypeName: "wholecarbon",
+	})
+	s.Require().NoError(err)
+
+	fractionalDenoms := make(map[string]string)
+	for creditType, classID := range map[string]string{"carbon": clsID, "wholecarbon": createClsRes.ClassId} {
+		createBatchRes, err := s.msgClient.CreateBatch(s.ctx, &ecocredit.MsgCreateBatch{
+			Issuer:          issuer1,
+			ClassId:         classID,
+			StartDate:       &time1,
+			EndDate:         &time2,
+			ProjectLocation: "AB",
+			Issuance: []*ecocredit.MsgCreateBatch_BatchIssuance{
+				{
+					Recipient:      addr3,
+					TradableAmount: "100",
+				},
+			},
+		})
+		s.Require().NoError(err)
+		fractionalDenoms[creditType] = createBatchRes.BatchDenom
+	}
+
+	fractionalCases := []struct {
+		name       string
+		creditType string
+		retire     bool
+		amount     string
+		wantErr    bool
+	}{
+		{
+			name:       "retire fractional amount of fractional credit type",
+			creditType: "carbon",
+			retire:     true,
+			amount:     "1.5",
+		},
+		{
+			name:       "send-with-retire fractional amount of fractional credit type",
+			creditType: "carbon",
+			amount:     "1.5",
+		},
+		{
+			name:       "retire fractional amount of whole credit type",
+			creditType: "wholecarbon",
+			retire:     true,
+			amount:     "1.5",
+			wantErr:    true,
+		},
+		{
+			name:       "retire whole amount of whole credit type",
+			creditType: "wholecarbon",
+			retire:     true,
+			amount:     "2.000",
+		},
+		{
+			name:       "send-with-retire fractional amount of whole credit type",
+			creditType: "wholecarbon",
+			amount:     "0.5",
+			wantErr:    true,
+		},
+		{
+			name:       "send-with-retire whole amount of whole credit type",
+			creditType: "wholecarbon",
+			amount:     "2",
+		},
+	}
+
+	for _, tc := range fractionalCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			var err error
+			denom := fractionalDenoms[tc.creditType]
+			if tc.retire {
+				_, err = s.msgClient.Retire(s.ctx, &ecocredit.MsgRetire{
+					Holder: addr3,
+					Credits: []*ecocredit.MsgRetire_RetireCredits{
+						{BatchDenom: denom, Amount: tc.amount},
+					},
+					Location: "ST-UVW XY Z12",
+				})
+			} else {
+				_, err = s.msgClient.Send(s.ctx, &ecocredit.MsgSend{
+					Sender:    addr3,
+					Recipient: addr5,
+					Credits: []*ecocredit.MsgSend_SendCredits{
+						{
+							BatchDenom:         denom,
+							TradableAmount:     "0.5",
+							RetiredAmount:      tc.amount,
+							RetirementLocation: "ST-UVW XY Z12",
+						},
+					},
+				})
+			}
+			if tc.wantErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), "can only be retired in whole amounts")
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
 	/****   TEST ALLOWLIST CREDIT CREATORS   ****/
 	allowlistCases := []struct {
 		name             string
@@ -701,6 +926,97 @@ func (s *IntegrationTestSuite) TestScenario() {
 		})
 	}
 
+	/****   TEST STATE-BASED ALLOWLIST CREDIT CREATORS   ****/
+	authority := s.signers[7]
+	creator := s.signers[5]
+	s.paramSpace.Set(s.sdkCtx, ecocredit.KeyAllowedClassCreators, []string{})
+	s.paramSpace.Set(s.sdkCtx, ecocredit.KeyAllowlistEnabled, true)
+
+	// the allowlist can't be managed while no authority is set, which is the default
+	s.paramSpace.Set(s.sdkCtx, ecocredit.KeyAllowlistAuthority, ecocredit.DefaultParams().AllowlistAuthority)
+	_, err = s.msgClient.AddClassCreator(s.ctx, &ecocredit.MsgAddClassCreator{Authority: authority.String(), Creator: creator.String()})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "no allowlist authority is set")
+	_, err = s.msgClient.RemoveClassCreator(s.ctx, &ecocredit.MsgRemoveClassCreator{Authority: authority.String(), Creator: creator.String()})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "no allowlist authority is set")
+
+	s.paramSpace.Set(s.sdkCtx, ecocredit.KeyAllowlistAuthority, authority.String())
+	s.Require().NoError(s.fundAccount(creator, sdk.NewCoins(sdk.NewCoin("stake", ecocredit.DefaultCreditClassFeeTokens.MulRaw(2)))))
+
+	createClassMsg := &ecocredit.MsgCreateClass{
+		Admin:          creator.String(),
+		Issuers:        []string{issuer1, issuer2},
+		CreditTypeName: "carbon",
+	}
+
+	stateAllowlistCases := []struct {
+		name      string
+		msg       interface{}
+		expCreate bool
+		wantErr   bool
+	}{
+		{
+			name:    "add by non-authority fails",
+			msg:     &ecocredit.MsgAddClassCreator{Authority: creator.String(), Creator: creator.String()},
+			wantErr: true,
+		},
+		{
+			name:      "add by authority",
+			msg:       &ecocredit.MsgAddClassCreator{Authority: authority.String(), Creator: creator.String()},
+			expCreate: true,
+		},
+		{
+			name:      "add existing creator fails",
+			msg:       &ecocredit.MsgAddClassCreator{Authority: authority.String(), Creator: creator.String()},
+			expCreate: true,
+			wantErr:   true,
+		},
+		{
+			name:      "remove by non-authority fails",
+			msg:       &ecocredit.MsgRemoveClassCreator{Authority: creator.String(), Creator: creator.String()},
+			expCreate: true,
+			wantErr:   true,
+		},
+		{
+			name: "remove by authority",
+			msg:  &ecocredit.MsgRemoveClassCreator{Authority: authority.String(), Creator: creator.String()},
+		},
+		{
+			name:    "remove unknown creator fails",
+			msg:     &ecocredit.MsgRemoveClassCreator{Authority: authority.String(), Creator: creator.String()},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range stateAllowlistCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			var err error
+			switch msg := tc.msg.(type) {
+			case *ecocredit.MsgAddClassCreator:
+				_, err = s.msgClient.AddClassCreator(s.ctx, msg)
+			case *ecocredit.MsgRemoveClassCreator:
+				_, err = s.msgClient.RemoveClassCreator(s.ctx, msg)
+			}
+			if tc.wantErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+
+			// check whether the creator is allowed to create a class using a
+			// cached context so no fee is charged
+			cacheCtx, _ := s.sdkCtx.CacheContext()
+			_, err = s.msgClient.CreateClass(types.Context{Context: cacheCtx}, createClassMsg)
+			if tc.expCreate {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+
 	// Disable credit class allowlist for credit type tests
 	s.paramSpace.Set(s.sdkCtx, ecocredit.KeyAllowlistEnabled, false)
 
@@ -785,3 +1101,525 @@ func (s *IntegrationTestSuite) TestScenario() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestCreateBatchRetireEvents() {
+	require := s.Require()
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	ctx := types.Context{Context: sdkCtx}
+
+	admin := s.signers[0]
+	issuer := s.signers[1].String()
+	addr1 := s.signers[3].String()
+	addr2 := s.signers[4].String()
+	addr3 := s.signers[5].String()
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", ecocredit.DefaultCreditClassFeeTokens.Int64()))
+	require.NoError(s.bankKeeper.MintCoins(sdkCtx, minttypes.ModuleName, fee))
+	require.NoError(s.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, minttypes.ModuleName, admin, fee))
+	createClsRes, err := s.msgClient.CreateClass(ctx, &ecocredit.MsgCreateClass{
+		Admin:          admin.String(),
+		Issuers:        []string{issuer},
+		CreditTypeName: "carbon",
+	})
+	require.NoError(err)
+
+	start, end := time.Now(), time.Now()
+	createBatchRes, err := s.msgClient.CreateBatch(ctx, &ecocredit.MsgCreateBatch{
+		Issuer:          issuer,
+		ClassId:         createClsRes.ClassId,
+		StartDate:       &start,
+		EndDate:         &end,
+		ProjectLocation: "AB",
+		Issuance: []*ecocredit.MsgCreateBatch_BatchIssuance{
+			{Recipient: addr1, RetiredAmount: "10", RetirementLocation: "GB"},
+			{Recipient: addr2, TradableAmount: "5", RetiredAmount: "2.5", RetirementLocation: "BF"},
+			{Recipient: addr3, TradableAmount: "7"},
+		},
+	})
+	require.NoError(err)
+
+	// a retirement event is emitted for every recipient of retired credits
+	var retired []ecocredit.EventRetire
+	for _, event := range sdkCtx.EventManager().ABCIEvents() {
+		if event.Type != proto.MessageName(&ecocredit.EventRetire{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(err)
+		retired = append(retired, *msg.(*ecocredit.EventRetire))
+	}
+	require.Equal([]ecocredit.EventRetire{
+		{Retirer: addr1, BatchDenom: createBatchRes.BatchDenom, Amount: "10", Location: "GB"},
+		{Retirer: addr2, BatchDenom: createBatchRes.BatchDenom, Amount: "2.5", Location: "BF"},
+	}, retired)
+}
+
+func (s *IntegrationTestSuite) TestClassMaxSupply() {
+	require := s.Require()
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	admin := s.signers[0]
+	issuer := s.signers[1].String()
+	recipient := s.signers[3].String()
+
+	createClass := func(maxSupply string) (string, error) {
+		fee := sdk.NewCoins(sdk.NewInt64Coin("stake", ecocredit.DefaultCreditClassFeeTokens.Int64()))
+		require.NoError(s.bankKeeper.MintCoins(sdkCtx, minttypes.ModuleName, fee))
+		require.NoError(s.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, minttypes.ModuleName, admin, fee))
+		res, err := s.msgClient.CreateClass(ctx, &ecocredit.MsgCreateClass{
+			Admin:          admin.String(),
+			Issuers:        []string{issuer},
+			CreditTypeName: "carbon",
+			MaxSupply:      maxSupply,
+		})
+		if err != nil {
+			return "", err
+		}
+		return res.ClassId, nil
+	}
+	createBatch := func(classID, tradable, retired string) error {
+		start, end := time.Now(), time.Now()
+		_, err := s.msgClient.CreateBatch(ctx, &ecocredit.MsgCreateBatch{
+			Issuer:          issuer,
+			ClassId:         classID,
+			StartDate:       &start,
+			EndDate:         &end,
+			ProjectLocation: "AB",
+			Issuance: []*ecocredit.MsgCreateBatch_BatchIssuance{
+				{Recipient: recipient, TradableAmount: tradable, RetiredAmount: retired, RetirementLocation: "GB"},
+			},
+		})
+		return err
+	}
+	requireIssuedSupply := func(classID, expected string) {
+		res, err := s.queryClient.ClassInfo(ctx, &ecocredit.QueryClassInfoRequest{ClassId: classID})
+		require.NoError(err)
+		issued, err := math.NewDecFromString(res.Info.IssuedSupply)
+		require.NoError(err)
+		exp, err := math.NewDecFromString(expected)
+		require.NoError(err)
+		require.True(exp.IsEqual(issued), "expected issued supply %s, got %s", expected, res.Info.IssuedSupply)
+	}
+
+	// the max supply can't be more precise than the credit type
+	_, err := createClass("10.1234567")
+	require.Error(err)
+
+	classID, err := createClass("100")
+	require.NoError(err)
+
+	// tradable and retired credits both count toward the max supply
+	require.NoError(createBatch(classID, "50", "10.5"))
+	requireIssuedSupply(classID, "60.5")
+
+	// issuance up to the max supply is allowed
+	require.NoError(createBatch(classID, "39.5", ""))
+	requireIssuedSupply(classID, "100")
+
+	// issuance over the max supply is rejected
+	err = createBatch(classID, "0.000001", "")
+	require.Error(err)
+	require.Contains(err.Error(), "exceeding its max supply 100")
+	requireIssuedSupply(classID, "100")
+
+	// cancelled credits still count toward the issued supply
+	res, err := s.queryClient.Batches(ctx, &ecocredit.QueryBatchesRequest{ClassId: classID})
+	require.NoError(err)
+	require.Len(res.Batches, 2)
+	_, err = s.msgClient.Cancel(ctx, &ecocredit.MsgCancel{
+		Holder:  recipient,
+		Credits: []*ecocredit.MsgCancel_CancelCredits{{BatchDenom: res.Batches[1].BatchDenom, Amount: "10"}},
+		Reason:  "reversal",
+	})
+	require.NoError(err)
+	require.Error(createBatch(classID, "1", ""))
+
+	// the issuance is unlimited without max supply
+	classID, err = createClass("")
+	require.NoError(err)
+	require.NoError(createBatch(classID, "1000000000", "1000000000"))
+	requireIssuedSupply(classID, "2000000000")
+}
+
+func (s *IntegrationTestSuite) TestCreditTypeSeq() {
+	require := s.Require()
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+	s.paramSpace.Set(sdkCtx, ecocredit.KeyCreditTypes, ecocredit.DefaultParams().CreditTypes)
+	creditType := ecocredit.DefaultParams().CreditTypes[0]
+
+	admin := s.signers[0]
+	createClass := func(maxSupply string) (string, error) {
+		fee := sdk.NewCoins(sdk.NewInt64Coin("stake", ecocredit.DefaultCreditClassFeeTokens.Int64()))
+		require.NoError(s.bankKeeper.MintCoins(sdkCtx, minttypes.ModuleName, fee))
+		require.NoError(s.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, minttypes.ModuleName, admin, fee))
+		res, err := s.msgClient.CreateClass(ctx, &ecocredit.MsgCreateClass{
+			Admin:          admin.String(),
+			Issuers:        []string{s.signers[1].String()},
+			CreditTypeName: creditType.Name,
+			MaxSupply:      maxSupply,
+		})
+		if err != nil {
+			return "", err
+		}
+		return res.ClassId, nil
+	}
+	querySeq := func() uint64 {
+		res, err := s.queryClient.CreditTypeSeq(ctx, &ecocredit.QueryCreditTypeSeqRequest{Abbreviation: creditType.Abbreviation})
+		require.NoError(err)
+		keeperSeq, err := s.keeper.GetCreditTypeSeq(sdkCtx, creditType.Abbreviation)
+		require.NoError(err)
+		require.Equal(res.SeqNumber, keeperSeq)
+		return res.SeqNumber
+	}
+
+	// consecutive class creations advance the sequence, which predicts the class IDs
+	seq := querySeq()
+	for i := uint64(1); i <= 2; i++ {
+		expClassID, err := ecocredit.FormatClassID(*creditType, seq+i)
+		require.NoError(err)
+		classID, err := createClass("")
+		require.NoError(err)
+		require.Equal(expClassID, classID)
+		require.Equal(seq+i, querySeq())
+	}
+
+	// a failed class creation doesn't advance the sequence
+	_, err := createClass("10.1234567")
+	require.Error(err)
+	require.Equal(seq+2, querySeq())
+
+	// credit types without classes have no sequence yet
+	res, err := s.queryClient.CreditTypeSeq(ctx, &ecocredit.QueryCreditTypeSeqRequest{Abbreviation: "XYZ"})
+	require.NoError(err)
+	require.Zero(res.SeqNumber)
+
+	_, err = s.queryClient.CreditTypeSeq(ctx, &ecocredit.QueryCreditTypeSeqRequest{})
+	require.Error(err)
+}
+
+func (s *IntegrationTestSuite) TestKeeperSendCredits() {
+	require := s.Require()
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	admin := s.signers[0]
+	issuer := s.signers[1].String()
+	msgSender, msgRecipient := s.signers[3], s.signers[4]
+	keeperSender, keeperRecipient := s.signers[5], s.signers[6]
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", ecocredit.DefaultCreditClassFeeTokens.Int64()))
+	require.NoError(s.bankKeeper.MintCoins(sdkCtx, minttypes.ModuleName, fee))
+	require.NoError(s.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, minttypes.ModuleName, admin, fee))
+	createClsRes, err := s.msgClient.CreateClass(ctx, &ecocredit.MsgCreateClass{
+		Admin:          admin.String(),
+		Issuers:        []string{issuer},
+		CreditTypeName: "carbon",
+	})
+	require.NoError(err)
+
+	start, end := time.Now(), time.Now()
+	createBatchRes, err := s.msgClient.CreateBatch(ctx, &ecocredit.MsgCreateBatch{
+		Issuer:          issuer,
+		ClassId:         createClsRes.ClassId,
+		StartDate:       &start,
+		EndDate:         &end,
+		ProjectLocation: "AB",
+		Issuance: []*ecocredit.MsgCreateBatch_BatchIssuance{
+			{Recipient: msgSender.String(), TradableAmount: "10"},
+			{Recipient: keeperSender.String(), TradableAmount: "10"},
+		},
+	})
+	require.NoError(err)
+	batchDenom := createBatchRes.BatchDenom
+
+	credits := []*ecocredit.MsgSend_SendCredits{
+		{BatchDenom: batchDenom, TradableAmount: "2.5", RetiredAmount: "1", RetirementLocation: "GB"},
+	}
+	eventsOf := func(sdkCtx sdk.Context) []proto.Message {
+		var events []proto.Message
+		for _, event := range sdkCtx.EventManager().ABCIEvents() {
+			if event.Type != proto.MessageName(&ecocredit.EventReceive{}) && event.Type != proto.MessageName(&ecocredit.EventRetire{}) {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(event)
+			require.NoError(err)
+			events = append(events, msg)
+		}
+		return events
+	}
+	balance := func(addr sdk.AccAddress) *ecocredit.QueryBalanceResponse {
+		res, err := s.queryClient.Balance(ctx, &ecocredit.QueryBalanceRequest{Account: addr.String(), BatchDenom: batchDenom})
+		require.NoError(err)
+		return res
+	}
+
+	msgCtx := sdkCtx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgClient.Send(types.Context{Context: msgCtx}, &ecocredit.MsgSend{
+		Sender:    msgSender.String(),
+		Recipient: msgRecipient.String(),
+		Credits:   credits,
+	})
+	require.NoError(err)
+
+	keeperCtx := sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(s.keeper.SendCredits(keeperCtx, keeperSender, keeperRecipient, credits))
+
+	// the balances are updated the same way as with Msg/Send
+	require.Equal(balance(msgSender), balance(keeperSender))
+	require.Equal(balance(msgRecipient), balance(keeperRecipient))
+	require.Equal(&ecocredit.QueryBalanceResponse{TradableAmount: "2.5", RetiredAmount: "1"}, balance(keeperRecipient))
+
+	// the supply accounts for the credits retired with both sends
+	supplyRes, err := s.queryClient.Supply(ctx, &ecocredit.QuerySupplyRequest{BatchDenom: batchDenom})
+	require.NoError(err)
+	require.Equal(&ecocredit.QuerySupplyResponse{TradableSupply: "18", RetiredSupply: "2"}, supplyRes)
+
+	// the same events are emitted as with Msg/Send
+	require.Equal([]proto.Message{
+		&ecocredit.EventRetire{Retirer: msgRecipient.String(), BatchDenom: batchDenom, Amount: "1", Location: "GB"},
+		&ecocredit.EventReceive{Sender: msgSender.String(), Recipient: msgRecipient.String(), BatchDenom: batchDenom, Amount: "3.5"},
+	}, eventsOf(msgCtx))
+	require.Equal([]proto.Message{
+		&ecocredit.EventRetire{Retirer: keeperRecipient.String(), BatchDenom: batchDenom, Amount: "1", Location: "GB"},
+		&ecocredit.EventReceive{Sender: keeperSender.String(), Recipient: keeperRecipient.String(), BatchDenom: batchDenom, Amount: "3.5"},
+	}, eventsOf(keeperCtx))
+
+	// nothing is changed when sending fails
+	err = s.keeper.SendCredits(sdkCtx, keeperSender, keeperRecipient, []*ecocredit.MsgSend_SendCredits{
+		{BatchDenom: batchDenom, TradableAmount: "1", RetiredAmount: "0"},
+		{BatchDenom: batchDenom, TradableAmount: "100", RetiredAmount: "0"},
+	})
+	require.Error(err)
+	require.Equal(&ecocredit.QueryBalanceResponse{TradableAmount: "6.5", RetiredAmount: "0"}, balance(keeperSender))
+
+	err = s.keeper.SendCredits(sdkCtx, keeperSender, keeperRecipient, []*ecocredit.MsgSend_SendCredits{
+		{BatchDenom: "unknown", TradableAmount: "1", RetiredAmount: "0"},
+	})
+	require.Error(err)
+
+	// credits are validated like those of Msg/Send
+	for name, credits := range map[string][]*ecocredit.MsgSend_SendCredits{
+		"no credits":                {},
+		"empty batch denom":         {{TradableAmount: "1", RetiredAmount: "0"}},
+		"negative tradable amount":  {{BatchDenom: batchDenom, TradableAmount: "-1", RetiredAmount: "0"}},
+		"invalid retired amount":    {{BatchDenom: batchDenom, TradableAmount: "1", RetiredAmount: "abc"}},
+		"missing retired amount":    {{BatchDenom: batchDenom, TradableAmount: "1"}},
+		"missing retirement region": {{BatchDenom: batchDenom, TradableAmount: "1", RetiredAmount: "1"}},
+	} {
+		err = s.keeper.SendCredits(sdkCtx, keeperSender, keeperRecipient, credits)
+		require.Error(err, name)
+	}
+	require.Equal(&ecocredit.QueryBalanceResponse{TradableAmount: "6.5", RetiredAmount: "0"}, balance(keeperSender))
+
+	// a keeper not obtained from the module can't be used
+	require.Error(server.Keeper{}.SendCredits(sdkCtx, keeperSender, keeperRecipient, credits))
+}
+
+func (s *IntegrationTestSuite) TestSendWholeTradableBalance() {
+	require := s.Require()
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	admin := s.signers[0]
+	issuer := s.signers[1].String()
+	sender, recipient := s.signers[3], s.signers[4]
+
+	// other tests may have changed the credit types of the suite context
+	s.paramSpace.Set(sdkCtx, ecocredit.KeyCreditTypes, ecocredit.DefaultParams().CreditTypes)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", ecocredit.DefaultCreditClassFeeTokens.Int64()))
+	require.NoError(s.bankKeeper.MintCoins(sdkCtx, minttypes.ModuleName, fee))
+	require.NoError(s.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, minttypes.ModuleName, admin, fee))
+	createClsRes, err := s.msgClient.CreateClass(ctx, &ecocredit.MsgCreateClass{
+		Admin:          admin.String(),
+		Issuers:        []string{issuer},
+		CreditTypeName: "carbon",
+	})
+	require.NoError(err)
+
+	start, end := time.Now(), time.Now()
+	createBatchRes, err := s.msgClient.CreateBatch(ctx, &ecocredit.MsgCreateBatch{
+		Issuer:          issuer,
+		ClassId:         createClsRes.ClassId,
+		StartDate:       &start,
+		EndDate:         &end,
+		ProjectLocation: "AB",
+		Issuance: []*ecocredit.MsgCreateBatch_BatchIssuance{
+			{Recipient: sender.String(), TradableAmount: "10.5", RetiredAmount: "1", RetirementLocation: "GB"},
+		},
+	})
+	require.NoError(err)
+	batchDenom := createBatchRes.BatchDenom
+
+	balance := func(addr sdk.AccAddress) *ecocredit.QueryBalanceResponse {
+		res, err := s.queryClient.Balance(ctx, &ecocredit.QueryBalanceRequest{Account: addr.String(), BatchDenom: batchDenom})
+		require.NoError(err)
+		return res
+	}
+	sendAll := func(credits ...*ecocredit.MsgSend_SendCredits) error {
+		_, err := s.msgClient.Send(ctx, &ecocredit.MsgSend{
+			Sender:    sender.String(),
+			Recipient: recipient.String(),
+			Credits:   credits,
+		})
+		return err
+	}
+	all := &ecocredit.MsgSend_SendCredits{BatchDenom: batchDenom, RetiredAmount: "0"}
+
+	// the retired amount can't exceed the tradable balance
+	err = sendAll(&ecocredit.MsgSend_SendCredits{BatchDenom: batchDenom, RetiredAmount: "11", RetirementLocation: "GB"})
+	require.Error(err)
+	require.Equal(&ecocredit.QueryBalanceResponse{TradableAmount: "10.5", RetiredAmount: "1"}, balance(sender))
+
+	// the balance is resolved after the previous credits of the same message,
+	// so that sending the whole balance twice fails rather than overdraws
+	err = sendAll(all, all)
+	require.Error(err)
+	require.Equal(&ecocredit.QueryBalanceResponse{TradableAmount: "10.5", RetiredAmount: "1"}, balance(sender))
+
+	// the whole tradable balance is sent, part of which is retired on receipt
+	err = sendAll(
+		&ecocredit.MsgSend_SendCredits{BatchDenom: batchDenom, TradableAmount: "0.5", RetiredAmount: "0"},
+		&ecocredit.MsgSend_SendCredits{BatchDenom: batchDenom, RetiredAmount: "2", RetirementLocation: "GB"},
+	)
+	require.NoError(err)
+	require.Equal(&ecocredit.QueryBalanceResponse{TradableAmount: "0", RetiredAmount: "1"}, balance(sender))
+	require.Equal(&ecocredit.QueryBalanceResponse{TradableAmount: "8.5", RetiredAmount: "2"}, balance(recipient))
+
+	// nothing is left to send
+	require.Error(sendAll(all))
+	require.Equal(&ecocredit.QueryBalanceResponse{TradableAmount: "0", RetiredAmount: "1"}, balance(sender))
+}
+
+func (s *IntegrationTestSuite) TestCreateClassEvent() {
+	require := s.Require()
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	ctx := types.Context{Context: sdkCtx}
+
+	admin := s.signers[0]
+	issuers := []string{s.signers[1].String(), s.signers[2].String()}
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", ecocredit.DefaultCreditClassFeeTokens.Int64()))
+	require.NoError(s.bankKeeper.MintCoins(sdkCtx, minttypes.ModuleName, fee))
+	require.NoError(s.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, minttypes.ModuleName, admin, fee))
+	createClsRes, err := s.msgClient.CreateClass(ctx, &ecocredit.MsgCreateClass{
+		Admin:          admin.String(),
+		Issuers:        issuers,
+		CreditTypeName: "carbon",
+	})
+	require.NoError(err)
+
+	var created []ecocredit.EventCreateClass
+	for _, event := range sdkCtx.EventManager().ABCIEvents() {
+		if event.Type != proto.MessageName(&ecocredit.EventCreateClass{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(err)
+		created = append(created, *msg.(*ecocredit.EventCreateClass))
+	}
+	require.Equal([]ecocredit.EventCreateClass{
+		{ClassId: createClsRes.ClassId, Admin: admin.String(), Issuers: issuers, CreditType: "carbon"},
+	}, created)
+}
+
+func (s *IntegrationTestSuite) TestClassRetirementByAccount() {
+	require := s.Require()
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	admin := s.signers[0]
+	issuer := s.signers[1].String()
+	addr1 := s.signers[3].String()
+	addr2 := s.signers[4].String()
+
+	createClass := func() string {
+		fee := sdk.NewCoins(sdk.NewInt64Coin("stake", ecocredit.DefaultCreditClassFeeTokens.Int64()))
+		require.NoError(s.bankKeeper.MintCoins(sdkCtx, minttypes.ModuleName, fee))
+		require.NoError(s.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, minttypes.ModuleName, admin, fee))
+		res, err := s.msgClient.CreateClass(ctx, &ecocredit.MsgCreateClass{
+			Admin:          admin.String(),
+			Issuers:        []string{issuer},
+			CreditTypeName: "carbon",
+		})
+		require.NoError(err)
+		return res.ClassId
+	}
+	createBatch := func(classID string, issuance ...*ecocredit.MsgCreateBatch_BatchIssuance) string {
+		start, end := time.Now(), time.Now()
+		res, err := s.msgClient.CreateBatch(ctx, &ecocredit.MsgCreateBatch{
+			Issuer:          issuer,
+			ClassId:         classID,
+			StartDate:       &start,
+			EndDate:         &end,
+			ProjectLocation: "AB",
+			Issuance:        issuance,
+		})
+		require.NoError(err)
+		return res.BatchDenom
+	}
+
+	classID := createClass()
+	otherClassID := createClass()
+	createBatch(classID,
+		&ecocredit.MsgCreateBatch_BatchIssuance{Recipient: addr1, RetiredAmount: "10", RetirementLocation: "GB"},
+		&ecocredit.MsgCreateBatch_BatchIssuance{Recipient: addr2, RetiredAmount: "100", RetirementLocation: "GB"},
+	)
+	batch2 := createBatch(classID,
+		&ecocredit.MsgCreateBatch_BatchIssuance{Recipient: addr1, TradableAmount: "5", RetiredAmount: "2.5", RetirementLocation: "BF"},
+	)
+	createBatch(classID,
+		&ecocredit.MsgCreateBatch_BatchIssuance{Recipient: addr2, TradableAmount: "3"},
+	)
+	createBatch(otherClassID,
+		&ecocredit.MsgCreateBatch_BatchIssuance{Recipient: addr1, RetiredAmount: "1000", RetirementLocation: "GB"},
+	)
+
+	_, err := s.msgClient.Retire(ctx, &ecocredit.MsgRetire{
+		Holder:   addr1,
+		Credits:  []*ecocredit.MsgRetire_RetireCredits{{BatchDenom: batch2, Amount: "1.25"}},
+		Location: "BF",
+	})
+	require.NoError(err)
+
+	testCases := []struct {
+		name       string
+		classID    string
+		account    string
+		expErr     string
+		expRetired string
+	}{
+		{"retired in multiple batches", classID, addr1, "", "13.75"},
+		{"retired in a single batch", classID, addr2, "", "100"},
+		{"other class", otherClassID, addr1, "", "1000"},
+		{"no retired credits", classID, s.signers[2].String(), "", "0"},
+		{"invalid class ID", "abcde", addr1, "class ID didn't match the format", ""},
+		{"invalid account", classID, "abcde", "decoding bech32 failed", ""},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.ClassRetirementByAccount(ctx, &ecocredit.QueryClassRetirementByAccountRequest{
+				ClassId: tc.classID,
+				Account: tc.account,
+			})
+			if tc.expErr != "" {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErr)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tc.expRetired, res.RetiredAmount)
+		})
+	}
+}
@@ -42,6 +42,9 @@ type MsgCreateClass struct {
 	Metadata []byte `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// credit_type_name describes the type of credit (e.g. "carbon", "biodiversity").
 	CreditTypeName string `protobuf:"bytes,4,opt,name=credit_type_name,json=creditTypeName,proto3" json:"credit_type_name,omitempty"`
+	// max_supply is the optional maximum amount of credits, tradable and
+	// retired, that can be issued in the credit class. It is unlimited if empty.
+	MaxSupply string `protobuf:"bytes,5,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
 }
 
 func (m *MsgCreateClass) Reset()         { *m = MsgCreateClass{} }
@@ -105,6 +108,13 @@ func (m *MsgCreateClass) GetCreditTypeName() string {
 	return ""
 }
 
+func (m *MsgCreateClass) GetMaxSupply() string {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return ""
+}
+
 // MsgCreateClassResponse is the Msg/CreateClass response type.
 type MsgCreateClassResponse struct {
 	// class_id is the unique ID of the newly created credit class.
@@ -458,7 +468,12 @@ type MsgSend_SendCredits struct {
 	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
 	// tradable_amount is the number of credits in this transfer that can be
 	// traded by the recipient. Decimal values are acceptable within the
-	// precision returned by Query/Precision.
+	// precision returned by Query/Precision. If empty, the whole tradable
+	// balance of the sender in the batch is sent, retired_amount of which is
+	// retired on receipt and the rest remains tradable. The balance is read
+	// when the message is executed, after any previous message or transaction
+	// in the block, so the sender is left with no tradable credits of the
+	// batch and can't be overdrawn.
 	TradableAmount string `protobuf:"bytes,2,opt,name=tradable_amount,json=tradableAmount,proto3" json:"tradable_amount,omitempty"`
 	// retired_amount is the number of credits in this transfer that are
 	// effectively retired by the issuer on receipt. Decimal values are
@@ -739,6 +754,10 @@ type MsgCancel struct {
 	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
 	// credits are the credits being cancelled.
 	Credits []*MsgCancel_CancelCredits `protobuf:"bytes,2,rep,name=credits,proto3" json:"credits,omitempty"`
+	// reason is the reason for cancelling the credits. It must not be empty. The
+	// text before its first colon, or the whole reason if it has none, is the
+	// reason category used to index cancellations, e.g. "double counting: ...".
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *MsgCancel) Reset()         { *m = MsgCancel{} }
@@ -788,6 +807,13 @@ func (m *MsgCancel) GetCredits() []*MsgCancel_CancelCredits {
 	return nil
 }
 
+func (m *MsgCancel) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // CancelCredits specifies a batch and the number of credits being cancelled.
 type MsgCancel_CancelCredits struct {
 	// batch_denom is the unique ID of the credit batch.
@@ -882,6 +908,192 @@ func (m *MsgCancelResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelResponse proto.InternalMessageInfo
 
+// MsgAddClassCreator is the Msg/AddClassCreator request type.
+type MsgAddClassCreator struct {
+	// authority is the address of the account allowed to manage the class
+	// creator allowlist.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// creator is the address of the account to add to the allowlist.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgAddClassCreator) Reset()         { *m = MsgAddClassCreator{} }
+func (m *MsgAddClassCreator) String() string { return proto.CompactTextString(m) }
+func (*MsgAddClassCreator) ProtoMessage()    {}
+func (*MsgAddClassCreator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96891bdd11ac56ed, []int{10}
+}
+func (m *MsgAddClassCreator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddClassCreator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddClassCreator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddClassCreator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddClassCreator.Merge(m, src)
+}
+func (m *MsgAddClassCreator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddClassCreator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddClassCreator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddClassCreator proto.InternalMessageInfo
+
+func (m *MsgAddClassCreator) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddClassCreator) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// MsgAddClassCreatorResponse is the Msg/AddClassCreator response type.
+type MsgAddClassCreatorResponse struct {
+}
+
+func (m *MsgAddClassCreatorResponse) Reset()         { *m = MsgAddClassCreatorResponse{} }
+func (m *MsgAddClassCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddClassCreatorResponse) ProtoMessage()    {}
+func (*MsgAddClassCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96891bdd11ac56ed, []int{11}
+}
+func (m *MsgAddClassCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddClassCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddClassCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddClassCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddClassCreatorResponse.Merge(m, src)
+}
+func (m *MsgAddClassCreatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddClassCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddClassCreatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddClassCreatorResponse proto.InternalMessageInfo
+
+// MsgRemoveClassCreator is the Msg/RemoveClassCreator request type.
+type MsgRemoveClassCreator struct {
+	// authority is the address of the account allowed to manage the class
+	// creator allowlist.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// creator is the address of the account to remove from the allowlist.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgRemoveClassCreator) Reset()         { *m = MsgRemoveClassCreator{} }
+func (m *MsgRemoveClassCreator) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveClassCreator) ProtoMessage()    {}
+func (*MsgRemoveClassCreator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96891bdd11ac56ed, []int{12}
+}
+func (m *MsgRemoveClassCreator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveClassCreator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveClassCreator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveClassCreator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveClassCreator.Merge(m, src)
+}
+func (m *MsgRemoveClassCreator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveClassCreator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveClassCreator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveClassCreator proto.InternalMessageInfo
+
+func (m *MsgRemoveClassCreator) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveClassCreator) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// MsgRemoveClassCreatorResponse is the Msg/RemoveClassCreator response type.
+type MsgRemoveClassCreatorResponse struct {
+}
+
+func (m *MsgRemoveClassCreatorResponse) Reset()         { *m = MsgRemoveClassCreatorResponse{} }
+func (m *MsgRemoveClassCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveClassCreatorResponse) ProtoMessage()    {}
+func (*MsgRemoveClassCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96891bdd11ac56ed, []int{13}
+}
+func (m *MsgRemoveClassCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveClassCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveClassCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveClassCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveClassCreatorResponse.Merge(m, src)
+}
+func (m *MsgRemoveClassCreatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveClassCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveClassCreatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveClassCreatorResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateClass)(nil), "regen.ecocredit.v1alpha1.MsgCreateClass")
 	proto.RegisterType((*MsgCreateClassResponse)(nil), "regen.ecocredit.v1alpha1.MsgCreateClassResponse")
@@ -897,62 +1109,74 @@ func init() {
 	proto.RegisterType((*MsgCancel)(nil), "regen.ecocredit.v1alpha1.MsgCancel")
 	proto.RegisterType((*MsgCancel_CancelCredits)(nil), "regen.ecocredit.v1alpha1.MsgCancel.CancelCredits")
 	proto.RegisterType((*MsgCancelResponse)(nil), "regen.ecocredit.v1alpha1.MsgCancelResponse")
+	proto.RegisterType((*MsgAddClassCreator)(nil), "regen.ecocredit.v1alpha1.MsgAddClassCreator")
+	proto.RegisterType((*MsgAddClassCreatorResponse)(nil), "regen.ecocredit.v1alpha1.MsgAddClassCreatorResponse")
+	proto.RegisterType((*MsgRemoveClassCreator)(nil), "regen.ecocredit.v1alpha1.MsgRemoveClassCreator")
+	proto.RegisterType((*MsgRemoveClassCreatorResponse)(nil), "regen.ecocredit.v1alpha1.MsgRemoveClassCreatorResponse")
 }
 
 func init() { proto.RegisterFile("regen/ecocredit/v1alpha1/tx.proto", fileDescriptor_96891bdd11ac56ed) }
 
 var fileDescriptor_96891bdd11ac56ed = []byte{
-	// 794 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0xae, 0x93, 0xdc, 0xfc, 0x9c, 0x90, 0xf4, 0x5e, 0xdf, 0xab, 0xca, 0x58, 0x28, 0x4d, 0x53,
-	0x21, 0x5c, 0xa1, 0xda, 0xa4, 0x95, 0x90, 0x10, 0x0b, 0x44, 0x53, 0x89, 0x56, 0x25, 0x2c, 0x4c,
-	0x57, 0xdd, 0x44, 0x13, 0xfb, 0xe0, 0x18, 0x6c, 0x8f, 0x65, 0x4f, 0xa0, 0x7d, 0x01, 0x76, 0x48,
-	0x7d, 0x12, 0xd8, 0xf7, 0x09, 0x58, 0x76, 0x85, 0xd8, 0x81, 0xda, 0x15, 0x6f, 0x81, 0x3c, 0x33,
-	0x76, 0xe2, 0x22, 0x1a, 0x4b, 0x20, 0xdd, 0x4d, 0xdc, 0x73, 0xfc, 0x9d, 0x33, 0xdf, 0xf9, 0xe6,
-	0x9b, 0x71, 0x61, 0x2f, 0x41, 0x0f, 0x23, 0x0b, 0x1d, 0xea, 0x24, 0xe8, 0xfa, 0xcc, 0xfa, 0x7e,
-	0x4c, 0x82, 0x78, 0x41, 0xc6, 0x16, 0xbb, 0x36, 0xe3, 0x84, 0x32, 0xaa, 0x6a, 0x1c, 0x62, 0x16,
-	0x10, 0x33, 0x87, 0xe8, 0x6f, 0x3c, 0xea, 0x51, 0x0e, 0xb2, 0xb2, 0xbf, 0x04, 0x5e, 0xdf, 0xf5,
-	0x28, 0xf5, 0x02, 0xb4, 0x78, 0x34, 0x5f, 0x7e, 0x63, 0x31, 0x3f, 0xc4, 0x94, 0x91, 0x30, 0x16,
-	0x80, 0xd1, 0x8f, 0x0a, 0xf4, 0xa7, 0xa9, 0x37, 0x49, 0x90, 0x30, 0x9c, 0x04, 0x24, 0x4d, 0xd5,
-	0x37, 0xf0, 0x82, 0xb8, 0xa1, 0x1f, 0x69, 0xca, 0x50, 0x31, 0x3a, 0xb6, 0x08, 0x54, 0x0d, 0x5a,
-	0x7e, 0x9a, 0x2e, 0x31, 0x49, 0xb5, 0xda, 0xb0, 0x6e, 0x74, 0xec, 0x3c, 0x54, 0x75, 0x68, 0x87,
-	0xc8, 0x88, 0x4b, 0x18, 0xd1, 0xea, 0x43, 0xc5, 0x78, 0xc7, 0x2e, 0x62, 0xd5, 0x80, 0x97, 0x82,
-	0xe8, 0x8c, 0xdd, 0xc4, 0x38, 0x8b, 0x48, 0x88, 0x5a, 0x83, 0xb7, 0xed, 0x8b, 0xfc, 0xe5, 0x4d,
-	0x8c, 0x5f, 0x91, 0x10, 0x47, 0xc7, 0xb0, 0x53, 0xe6, 0x61, 0x63, 0x1a, 0xd3, 0x28, 0x45, 0xf5,
-	0x5d, 0x68, 0x3b, 0x59, 0x62, 0xe6, 0xbb, 0x92, 0x52, 0x8b, 0xc7, 0xe7, 0xee, 0xe8, 0xa7, 0xc6,
-	0x1a, 0xfb, 0x13, 0xc2, 0x9c, 0x85, 0xba, 0x03, 0x4d, 0x41, 0x4c, 0x62, 0x65, 0x54, 0xea, 0x52,
-	0x2b, 0x75, 0x51, 0x6d, 0x68, 0x67, 0x20, 0x12, 0x39, 0xa8, 0xd5, 0x87, 0x75, 0xa3, 0x7b, 0xf4,
-	0xb1, 0xf9, 0x6f, 0x3a, 0x9b, 0xe5, 0xe5, 0x4c, 0xfe, 0x7b, 0x2e, 0xab, 0xed, 0xa2, 0x4f, 0x49,
-	0x94, 0xc6, 0x13, 0x51, 0x3e, 0x03, 0x48, 0x19, 0x49, 0xd8, 0xcc, 0x25, 0x0c, 0xb5, 0x17, 0x43,
-	0xc5, 0xe8, 0x1e, 0xe9, 0xa6, 0xd8, 0x29, 0x33, 0xdf, 0x29, 0xf3, 0x32, 0xdf, 0xa9, 0x93, 0xc6,
-	0xed, 0x1f, 0xbb, 0x8a, 0xdd, 0xe1, 0x35, 0xa7, 0x84, 0xa1, 0xfa, 0x29, 0xb4, 0x31, 0x72, 0x45,
-	0x79, 0xb3, 0x62, 0x79, 0x0b, 0x23, 0x97, 0x17, 0x1f, 0xc0, 0xcb, 0x38, 0xa1, 0xdf, 0xa2, 0xc3,
-	0x66, 0x01, 0x75, 0x08, 0xf3, 0x69, 0xa4, 0xb5, 0xb8, 0x20, 0xdb, 0x32, 0xff, 0xa5, 0x4c, 0xeb,
-	0x3f, 0x2b, 0xd0, 0x2b, 0x0d, 0xa8, 0xbe, 0x07, 0x9d, 0x04, 0x1d, 0x3f, 0xf6, 0x31, 0x62, 0x52,
-	0xe0, 0x55, 0x42, 0xfd, 0x00, 0xb6, 0x59, 0x42, 0x5c, 0x32, 0x0f, 0x70, 0x46, 0x42, 0xba, 0x8c,
-	0x98, 0x94, 0xba, 0x9f, 0xa7, 0x3f, 0xe7, 0x59, 0xf5, 0x7d, 0xe8, 0x27, 0xc8, 0xfc, 0x04, 0xdd,
-	0x1c, 0x57, 0xe7, 0xb8, 0x9e, 0xcc, 0x4a, 0x98, 0x05, 0xaf, 0x45, 0x22, 0xc4, 0x68, 0x8d, 0xad,
-	0x30, 0x90, 0xba, 0x7a, 0x95, 0x13, 0x1e, 0x7d, 0xb2, 0x66, 0x22, 0x4e, 0xbc, 0x30, 0xd1, 0x2e,
-	0x74, 0xe7, 0x59, 0x62, 0xe6, 0x62, 0x44, 0x43, 0x49, 0x1d, 0x78, 0xea, 0x34, 0xcb, 0x8c, 0xee,
-	0x6a, 0xd0, 0x9a, 0xa6, 0xde, 0xd7, 0x18, 0xb9, 0x99, 0x87, 0x52, 0x8c, 0xdc, 0x95, 0x87, 0x44,
-	0x54, 0x9e, 0xbe, 0xf6, 0x74, 0xfa, 0x2f, 0xa0, 0x25, 0xcc, 0x92, 0x4a, 0x17, 0x1d, 0x3e, 0xeb,
-	0xa2, 0x6c, 0x25, 0x33, 0xfb, 0x99, 0x88, 0x22, 0x3b, 0xaf, 0xd6, 0x7f, 0x51, 0xa0, 0xbb, 0xf6,
-	0x62, 0x23, 0xf7, 0xb7, 0xaf, 0xfb, 0x2b, 0xd8, 0x96, 0x13, 0xe5, 0x82, 0x8f, 0x7e, 0x53, 0xa0,
-	0x33, 0x4d, 0x3d, 0x9b, 0x83, 0x33, 0x45, 0x17, 0x34, 0x58, 0x53, 0x54, 0x44, 0xea, 0xc5, 0x4a,
-	0xb3, 0x1a, 0xd7, 0x6c, 0xfc, 0xac, 0x66, 0xa2, 0x9b, 0x29, 0x1e, 0x4f, 0x75, 0xcb, 0xce, 0x5c,
-	0xc1, 0x55, 0xcc, 0x55, 0xc4, 0xfa, 0x19, 0xf4, 0x4a, 0x55, 0x9b, 0x45, 0xdd, 0x81, 0x66, 0x49,
-	0x4b, 0x19, 0x8d, 0x5e, 0xc3, 0xab, 0x82, 0x49, 0x31, 0xed, 0x9d, 0x98, 0x76, 0x92, 0x1d, 0x92,
-	0xe0, 0xff, 0x9a, 0x56, 0x74, 0x33, 0xc5, 0xe3, 0x1f, 0x2e, 0x39, 0x83, 0x5e, 0xe9, 0xcd, 0x7f,
-	0x9d, 0x48, 0x34, 0xcb, 0x27, 0x3a, 0xfa, 0xab, 0x0e, 0xf5, 0x69, 0xea, 0xa9, 0x3e, 0x74, 0xd7,
-	0x3f, 0x0e, 0x46, 0x85, 0x9b, 0x91, 0x23, 0xf5, 0x8f, 0xaa, 0x22, 0x8b, 0x33, 0x5a, 0x2c, 0x25,
-	0x6e, 0x72, 0xa3, 0xea, 0x25, 0x5c, 0x69, 0xa9, 0xf2, 0x75, 0x70, 0x09, 0x0d, 0x7e, 0xd2, 0xf7,
-	0x36, 0x1e, 0x51, 0xfd, 0x60, 0x23, 0xa4, 0xe8, 0x7a, 0x05, 0x4d, 0xe9, 0xf7, 0xfd, 0x0a, 0x36,
-	0xd6, 0x3f, 0xac, 0x00, 0x5a, 0xef, 0x2d, 0xdd, 0xb5, 0x5f, 0xc1, 0x34, 0x1b, 0x7a, 0x97, 0xf7,
-	0xfa, 0xe4, 0xe2, 0xd7, 0x87, 0x81, 0x72, 0xff, 0x30, 0x50, 0xfe, 0x7c, 0x18, 0x28, 0xb7, 0x8f,
-	0x83, 0xad, 0xfb, 0xc7, 0xc1, 0xd6, 0xef, 0x8f, 0x83, 0xad, 0xab, 0xb1, 0xe7, 0xb3, 0xc5, 0x72,
-	0x6e, 0x3a, 0x34, 0xb4, 0x78, 0xc3, 0xc3, 0x08, 0xd9, 0x0f, 0x34, 0xf9, 0x4e, 0x46, 0x01, 0xba,
-	0x1e, 0x26, 0xd6, 0xf5, 0xea, 0x9f, 0x96, 0x79, 0x93, 0x7f, 0x82, 0x8e, 0xff, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0xac, 0x7b, 0xe6, 0xfa, 0xce, 0x08, 0x00, 0x00,
+	// 917 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0x8e, 0x1d, 0x3f, 0x93, 0xa4, 0x9d, 0x96, 0xc8, 0xac, 0x5a, 0x27, 0x75, 0x85,
+	0x70, 0x55, 0xba, 0xdb, 0xa4, 0x08, 0x84, 0x38, 0xa0, 0x26, 0x95, 0xa0, 0x6a, 0x0d, 0x92, 0x9b,
+	0x53, 0x2f, 0xd6, 0x78, 0xf7, 0xb1, 0x5e, 0xd8, 0xdd, 0x59, 0xed, 0x8c, 0x4b, 0x72, 0xe1, 0x1b,
+	0x20, 0xf5, 0x4b, 0x70, 0x85, 0x3b, 0x9f, 0x80, 0x63, 0xc5, 0x01, 0x71, 0xa3, 0x4a, 0xbe, 0x08,
+	0xda, 0x99, 0xd9, 0xb1, 0xd7, 0x2d, 0xf1, 0x56, 0x54, 0xea, 0xc5, 0xf6, 0x7b, 0xfe, 0xbd, 0x3f,
+	0xbf, 0xdf, 0xbe, 0x37, 0xb3, 0x70, 0x23, 0xc3, 0x00, 0x13, 0x17, 0x3d, 0xe6, 0x65, 0xe8, 0x87,
+	0xc2, 0x7d, 0xb6, 0x4f, 0xa3, 0x74, 0x4a, 0xf7, 0x5d, 0x71, 0xe2, 0xa4, 0x19, 0x13, 0x8c, 0x74,
+	0x25, 0xc4, 0x31, 0x10, 0xa7, 0x80, 0xd8, 0x57, 0x03, 0x16, 0x30, 0x09, 0x72, 0xf3, 0x5f, 0x0a,
+	0x6f, 0xef, 0x06, 0x8c, 0x05, 0x11, 0xba, 0xd2, 0x9a, 0xcc, 0xbe, 0x73, 0x45, 0x18, 0x23, 0x17,
+	0x34, 0x4e, 0x15, 0xa0, 0xff, 0x8b, 0x05, 0x5b, 0x43, 0x1e, 0x1c, 0x65, 0x48, 0x05, 0x1e, 0x45,
+	0x94, 0x73, 0x72, 0x15, 0xd6, 0xa9, 0x1f, 0x87, 0x49, 0xd7, 0xda, 0xb3, 0x06, 0xed, 0x91, 0x32,
+	0x48, 0x17, 0x5a, 0x21, 0xe7, 0x33, 0xcc, 0x78, 0xb7, 0xb6, 0x57, 0x1f, 0xb4, 0x47, 0x85, 0x49,
+	0x6c, 0xd8, 0x88, 0x51, 0x50, 0x9f, 0x0a, 0xda, 0xad, 0xef, 0x59, 0x83, 0xf7, 0x46, 0xc6, 0x26,
+	0x03, 0xb8, 0xa4, 0x1a, 0x1d, 0x8b, 0xd3, 0x14, 0xc7, 0x09, 0x8d, 0xb1, 0xdb, 0x90, 0x69, 0xb7,
+	0x94, 0xff, 0xf8, 0x34, 0xc5, 0x6f, 0x68, 0x8c, 0xe4, 0x3a, 0x40, 0x4c, 0x4f, 0xc6, 0x7c, 0x96,
+	0xa6, 0xd1, 0x69, 0x77, 0x5d, 0x62, 0xda, 0x31, 0x3d, 0x79, 0x22, 0x1d, 0xfd, 0x7b, 0xb0, 0x53,
+	0x6e, 0x73, 0x84, 0x3c, 0x65, 0x09, 0x47, 0xf2, 0x01, 0x6c, 0x78, 0xb9, 0x63, 0x1c, 0xfa, 0xba,
+	0xe3, 0x96, 0xb4, 0x1f, 0xfa, 0xfd, 0x9f, 0x1b, 0x0b, 0xe4, 0x0e, 0xa9, 0xf0, 0xa6, 0x64, 0x07,
+	0x9a, 0xaa, 0x6f, 0x8d, 0xd5, 0x56, 0x29, 0x4b, 0xad, 0x94, 0x85, 0x8c, 0x60, 0x23, 0x07, 0xd1,
+	0xc4, 0xc3, 0x6e, 0x7d, 0xaf, 0x3e, 0xe8, 0x1c, 0x7c, 0xea, 0xfc, 0xd7, 0x63, 0x70, 0xca, 0xe5,
+	0x1c, 0xf9, 0xf9, 0x50, 0x47, 0x8f, 0x4c, 0x9e, 0x92, 0x66, 0x8d, 0x25, 0xcd, 0xbe, 0x04, 0xe0,
+	0x82, 0x66, 0x62, 0xec, 0x53, 0x81, 0x52, 0x89, 0xce, 0x81, 0xed, 0xa8, 0x07, 0xe9, 0x14, 0x0f,
+	0xd2, 0x39, 0x2e, 0x1e, 0xe4, 0x61, 0xe3, 0xf9, 0x3f, 0xbb, 0xd6, 0xa8, 0x2d, 0x63, 0x1e, 0x50,
+	0x81, 0xe4, 0x0b, 0xd8, 0xc0, 0xc4, 0x57, 0xe1, 0xcd, 0x8a, 0xe1, 0x2d, 0x4c, 0x7c, 0x19, 0x7c,
+	0x0b, 0x2e, 0xa5, 0x19, 0xfb, 0x1e, 0x3d, 0x31, 0x8e, 0x98, 0x47, 0x45, 0xc8, 0x92, 0x6e, 0x4b,
+	0x0a, 0xb2, 0xad, 0xfd, 0x8f, 0xb5, 0xdb, 0xfe, 0xd5, 0x82, 0xcd, 0x12, 0x41, 0x72, 0x0d, 0xda,
+	0x19, 0x7a, 0x61, 0x1a, 0x62, 0x22, 0xb4, 0xc0, 0x73, 0x07, 0xf9, 0x08, 0xb6, 0x45, 0x46, 0x7d,
+	0x3a, 0x89, 0x70, 0x4c, 0x63, 0x36, 0x4b, 0x84, 0x96, 0x7a, 0xab, 0x70, 0xdf, 0x97, 0x5e, 0xf2,
+	0x21, 0x6c, 0x65, 0x28, 0xc2, 0x0c, 0xfd, 0x02, 0x57, 0x97, 0xb8, 0x4d, 0xed, 0xd5, 0x30, 0x17,
+	0xae, 0x28, 0x47, 0x8c, 0xc9, 0x42, 0xb7, 0x6a, 0xbe, 0xc8, 0xfc, 0xaf, 0xa2, 0xe1, 0xfe, 0xe7,
+	0x0b, 0x43, 0x24, 0x1b, 0x37, 0x43, 0xb4, 0x0b, 0x9d, 0x49, 0xee, 0x18, 0xfb, 0x98, 0xb0, 0x58,
+	0xb7, 0x0e, 0xd2, 0xf5, 0x20, 0xf7, 0xf4, 0x7f, 0xaf, 0x41, 0x6b, 0xc8, 0x83, 0x27, 0x98, 0xf8,
+	0xf9, 0x0c, 0x71, 0x4c, 0xfc, 0xf9, 0x0c, 0x29, 0xab, 0xcc, 0xbe, 0xb6, 0xcc, 0xfe, 0x2b, 0x68,
+	0xa9, 0x61, 0xe1, 0x7a, 0x8a, 0xee, 0x5c, 0x38, 0x45, 0x79, 0x25, 0x27, 0xff, 0x38, 0x52, 0x41,
+	0xa3, 0x22, 0xda, 0xfe, 0xcd, 0x82, 0xce, 0xc2, 0x1f, 0x2b, 0x7b, 0x7f, 0xf7, 0xba, 0x5f, 0x86,
+	0x6d, 0xcd, 0xa8, 0x10, 0xbc, 0xff, 0x97, 0x05, 0xed, 0x21, 0x0f, 0x46, 0x12, 0x9c, 0x2b, 0x3a,
+	0x65, 0xd1, 0x82, 0xa2, 0xca, 0x22, 0x8f, 0xe6, 0x9a, 0xd5, 0xa4, 0x66, 0xfb, 0x17, 0x6a, 0xa6,
+	0xb2, 0x39, 0xea, 0x6b, 0x59, 0xb7, 0x7c, 0xe7, 0x4c, 0xaf, 0x8a, 0x97, 0xb1, 0xed, 0xaf, 0x61,
+	0xb3, 0x14, 0xb5, 0x5a, 0xd4, 0x1d, 0x68, 0x96, 0xb4, 0xd4, 0x56, 0xff, 0x0a, 0x5c, 0x36, 0x9d,
+	0x18, 0xb6, 0x7f, 0x2a, 0xb6, 0x47, 0xf9, 0x92, 0x44, 0x6f, 0x8b, 0xad, 0xca, 0xe6, 0xa8, 0xaf,
+	0x57, 0xd8, 0xee, 0x40, 0x33, 0x43, 0xca, 0x0d, 0x57, 0x6d, 0xe5, 0x4c, 0x4b, 0x11, 0xff, 0x97,
+	0xa9, 0x4a, 0x66, 0x98, 0x3e, 0x06, 0x32, 0xe4, 0xc1, 0x7d, 0xdf, 0x97, 0x87, 0xb4, 0x5c, 0x35,
+	0x26, 0x37, 0x83, 0xce, 0xc4, 0x94, 0x65, 0xa1, 0x38, 0x2d, 0xce, 0x05, 0xe3, 0xc8, 0xaf, 0x16,
+	0x4f, 0x01, 0xcd, 0xd1, 0xab, 0xcc, 0xfe, 0x35, 0xb0, 0x5f, 0xcd, 0x66, 0x6a, 0x7d, 0x0b, 0xef,
+	0x4b, 0xa9, 0x63, 0xf6, 0x0c, 0xdf, 0x4a, 0xb9, 0x5d, 0xb8, 0xfe, 0xda, 0x84, 0x45, 0xc5, 0x83,
+	0x97, 0xeb, 0x50, 0x1f, 0xf2, 0x80, 0x84, 0xd0, 0x59, 0xbc, 0x31, 0x07, 0x15, 0xee, 0x03, 0x89,
+	0xb4, 0xef, 0x56, 0x45, 0x9a, 0x93, 0xc9, 0x94, 0x52, 0xf7, 0xd7, 0xa0, 0xea, 0xd5, 0x63, 0xdf,
+	0xad, 0x8a, 0x34, 0xa5, 0x8e, 0xa1, 0x21, 0xcf, 0xb7, 0x1b, 0x2b, 0x0f, 0x26, 0xfb, 0xd6, 0x4a,
+	0x88, 0xc9, 0xfa, 0x14, 0x9a, 0x7a, 0xcb, 0x6f, 0x56, 0x58, 0x5e, 0xfb, 0x76, 0x05, 0xd0, 0x62,
+	0x6e, 0xbd, 0x53, 0x37, 0x2b, 0xac, 0x8a, 0x7d, 0xbb, 0x02, 0xc8, 0xe4, 0x9e, 0xc1, 0xf6, 0xf2,
+	0x18, 0x7f, 0x7c, 0x61, 0xfc, 0x12, 0xda, 0xfe, 0xe4, 0x4d, 0xd0, 0xa6, 0xec, 0x4f, 0x40, 0x5e,
+	0x33, 0xd1, 0xee, 0x0a, 0x55, 0x96, 0x03, 0xec, 0xcf, 0xde, 0x30, 0xa0, 0xa8, 0x7f, 0xf8, 0xe8,
+	0x8f, 0xb3, 0x9e, 0xf5, 0xe2, 0xac, 0x67, 0xbd, 0x3c, 0xeb, 0x59, 0xcf, 0xcf, 0x7b, 0x6b, 0x2f,
+	0xce, 0x7b, 0x6b, 0x7f, 0x9f, 0xf7, 0xd6, 0x9e, 0xee, 0x07, 0xa1, 0x98, 0xce, 0x26, 0x8e, 0xc7,
+	0x62, 0x57, 0x26, 0xbf, 0x93, 0xa0, 0xf8, 0x91, 0x65, 0x3f, 0x68, 0x2b, 0x42, 0x3f, 0xc0, 0xcc,
+	0x3d, 0x99, 0xbf, 0xc0, 0x4e, 0x9a, 0xf2, 0x7d, 0xe3, 0xde, 0xbf, 0x03, 0x00, 0x13, 0x32, 0x33,
+	0xaf, 0xda, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -983,6 +1207,14 @@ type MsgClient interface {
 	// deducts them from the tradable supply, effectively cancelling their
 	// issuance on Regen Ledger
 	Cancel(ctx context.Context, in *MsgCancel, opts ...grpc.CallOption) (*MsgCancelResponse, error)
+	// AddClassCreator adds an address to the state-based allowlist of accounts
+	// permitted to create credit classes. It can only be called by the allowlist
+	// authority.
+	AddClassCreator(ctx context.Context, in *MsgAddClassCreator, opts ...grpc.CallOption) (*MsgAddClassCreatorResponse, error)
+	// RemoveClassCreator removes an address from the state-based allowlist of
+	// accounts permitted to create credit classes. It can only be called by the
+	// allowlist authority.
+	RemoveClassCreator(ctx context.Context, in *MsgRemoveClassCreator, opts ...grpc.CallOption) (*MsgRemoveClassCreatorResponse, error)
 }
 
 type msgClient struct {
@@ -1038,6 +1270,24 @@ func (c *msgClient) Cancel(ctx context.Context, in *MsgCancel, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) AddClassCreator(ctx context.Context, in *MsgAddClassCreator, opts ...grpc.CallOption) (*MsgAddClassCreatorResponse, error) {
+	out := new(MsgAddClassCreatorResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1alpha1.Msg/AddClassCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveClassCreator(ctx context.Context, in *MsgRemoveClassCreator, opts ...grpc.CallOption) (*MsgRemoveClassCreatorResponse, error) {
+	out := new(MsgRemoveClassCreatorResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1alpha1.Msg/RemoveClassCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateClass creates a new credit class with an approved list of issuers and
@@ -1056,6 +1306,14 @@ type MsgServer interface {
 	// deducts them from the tradable supply, effectively cancelling their
 	// issuance on Regen Ledger
 	Cancel(context.Context, *MsgCancel) (*MsgCancelResponse, error)
+	// AddClassCreator adds an address to the state-based allowlist of accounts
+	// permitted to create credit classes. It can only be called by the allowlist
+	// authority.
+	AddClassCreator(context.Context, *MsgAddClassCreator) (*MsgAddClassCreatorResponse, error)
+	// RemoveClassCreator removes an address from the state-based allowlist of
+	// accounts permitted to create credit classes. It can only be called by the
+	// allowlist authority.
+	RemoveClassCreator(context.Context, *MsgRemoveClassCreator) (*MsgRemoveClassCreatorResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1077,6 +1335,12 @@ func (*UnimplementedMsgServer) Retire(ctx context.Context, req *MsgRetire) (*Msg
 func (*UnimplementedMsgServer) Cancel(ctx context.Context, req *MsgCancel) (*MsgCancelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
 }
+func (*UnimplementedMsgServer) AddClassCreator(ctx context.Context, req *MsgAddClassCreator) (*MsgAddClassCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddClassCreator not implemented")
+}
+func (*UnimplementedMsgServer) RemoveClassCreator(ctx context.Context, req *MsgRemoveClassCreator) (*MsgRemoveClassCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveClassCreator not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1172,6 +1436,42 @@ func _Msg_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddClassCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddClassCreator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddClassCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1alpha1.Msg/AddClassCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddClassCreator(ctx, req.(*MsgAddClassCreator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveClassCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveClassCreator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveClassCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1alpha1.Msg/RemoveClassCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveClassCreator(ctx, req.(*MsgRemoveClassCreator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "regen.ecocredit.v1alpha1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1196,6 +1496,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Cancel",
 			Handler:    _Msg_Cancel_Handler,
 		},
+		{
+			MethodName: "AddClassCreator",
+			Handler:    _Msg_AddClassCreator_Handler,
+		},
+		{
+			MethodName: "RemoveClassCreator",
+			Handler:    _Msg_RemoveClassCreator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/v1alpha1/tx.proto",
@@ -1221,6 +1529,13 @@ func (m *MsgCreateClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaxSupply) > 0 {
+		i -= len(m.MaxSupply)
+		copy(dAtA[i:], m.MaxSupply)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxSupply)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.CreditTypeName) > 0 {
 		i -= len(m.CreditTypeName)
 		copy(dAtA[i:], m.CreditTypeName)
@@ -1706,6 +2021,13 @@ func (m *MsgCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Credits) > 0 {
 		for iNdEx := len(m.Credits) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1790,6 +2112,126 @@ func (m *MsgCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAddClassCreator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddClassCreator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddClassCreator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddClassCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddClassCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddClassCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveClassCreator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveClassCreator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveClassCreator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveClassCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveClassCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveClassCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1825,6 +2267,10 @@ func (m *MsgCreateClass) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.MaxSupply)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2040,6 +2486,10 @@ func (m *MsgCancel) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2069,6 +2519,58 @@ func (m *MsgCancelResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAddClassCreator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddClassCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveClassCreator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveClassCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2234,6 +2736,38 @@ func (m *MsgCreateClass) Unmarshal(dAtA []byte) error {
 			}
 			m.CreditTypeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3699,6 +4233,38 @@ func (m *MsgCancel) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3893,6 +4459,346 @@ func (m *MsgCancelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAddClassCreator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddClassCreator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddClassCreator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddClassCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddClassCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddClassCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveClassCreator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveClassCreator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveClassCreator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveClassCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveClassCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveClassCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,14 +1,17 @@
 package ecocredit
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"strings"
+	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/regen-network/regen-ledger/orm"
 )
 
-var _, _, _ orm.PrimaryKeyed = &ClassInfo{}, &BatchInfo{}, &CreditTypeSeq{}
+var _, _, _, _ orm.PrimaryKeyed = &ClassInfo{}, &BatchInfo{}, &CreditTypeSeq{}, &Cancellation{}
 
 func (m *ClassInfo) PrimaryKeyFields() []interface{} {
 	return []interface{}{m.ClassId}
@@ -22,6 +25,10 @@ func (m *CreditTypeSeq) PrimaryKeyFields() []interface{} {
 	return []interface{}{m.Abbreviation}
 }
 
+func (m *Cancellation) PrimaryKeyFields() []interface{} {
+	return []interface{}{m.Id}
+}
+
 // AssertClassIssuer makes sure that the issuer is part of issuers of given classID.
 // Returns ErrUnauthorized otherwise.
 func (m *ClassInfo) AssertClassIssuer(issuer string) error {
@@ -33,12 +40,80 @@ func (m *ClassInfo) AssertClassIssuer(issuer string) error {
 	return sdkerrors.ErrUnauthorized
 }
 
+// OverlapsDateRange returns true if the batch's [StartDate, EndDate] period
+// overlaps the inclusive [start, end] range. A nil bound on either the batch
+// or the range is treated as unbounded.
+func (m *BatchInfo) OverlapsDateRange(start, end *time.Time) bool {
+	if end != nil && m.StartDate != nil && m.StartDate.After(*end) {
+		return false
+	}
+	if start != nil && m.EndDate != nil && m.EndDate.Before(*start) {
+		return false
+	}
+	return true
+}
+
+// MaxCancelReasonLength is the maximum length in bytes of the reason given for
+// cancelling credits.
+const MaxCancelReasonLength = 256
+
+// ValidateCancelReason returns an error if the reason for cancelling credits
+// is empty, too long or has an empty reason category.
+func ValidateCancelReason(reason string) error {
+	if reason == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("reason should not be empty")
+	}
+	if len(reason) > MaxCancelReasonLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("reason can't be longer than %d bytes", MaxCancelReasonLength)
+	}
+	if CancelReasonCategory(reason) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("reason category should not be empty")
+	}
+	return nil
+}
+
+// CancelReasonCategory returns the category of the reason for cancelling
+// credits, which is the text before its first colon, or the whole reason if it
+// has none, with leading and trailing whitespace removed. E.g. the category of
+// "double counting: also issued on another registry" is "double counting".
+func CancelReasonCategory(reason string) string {
+	if i := strings.Index(reason, ":"); i >= 0 {
+		reason = reason[:i]
+	}
+	return strings.TrimSpace(reason)
+}
+
+// ValidateAddresses returns an error for the first of the given addresses that
+// is not a valid bech32 account address, mentioning its index in the list.
+func ValidateAddresses(addrs []string) error {
+	for i, addr := range addrs {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "address %q at index %d: %s", addr, i, err)
+		}
+	}
+	return nil
+}
+
 // Normalize credit type name by removing whitespace and converting to lowercase
 func NormalizeCreditTypeName(name string) string {
-	return fastRemoveWhitespace(strings.ToLower(name))
+	return RemoveAllUnicodeWhitespace(strings.ToLower(name))
+}
+
+// RemoveAllUnicodeWhitespace removes all the characters of str which are
+// whitespace according to unicode.IsSpace, including non-breaking spaces, so
+// that visually identical names normalize to the same value. ASCII only
+// strings take a faster byte-wise path.
+func RemoveAllUnicodeWhitespace(str string) string {
+	for i := 0; i < len(str); i++ {
+		if str[i] >= utf8.RuneSelf {
+			return removeUnicodeWhitespace(str)
+		}
+	}
+
+	return removeASCIIWhitespace(str)
 }
 
-func fastRemoveWhitespace(str string) string {
+func removeUnicodeWhitespace(str string) string {
 	var b strings.Builder
 	b.Grow(len(str))
 	for _, ch := range str {
@@ -48,3 +123,18 @@ func fastRemoveWhitespace(str string) string {
 	}
 	return b.String()
 }
+
+// removeASCIIWhitespace removes the ASCII whitespace characters, which are
+// the only ones unicode.IsSpace reports for an ASCII only string.
+func removeASCIIWhitespace(str string) string {
+	var b strings.Builder
+	b.Grow(len(str))
+	for i := 0; i < len(str); i++ {
+		switch ch := str[i]; ch {
+		case '\t', '\n', '\v', '\f', '\r', ' ':
+		default:
+			b.WriteByte(ch)
+		}
+	}
+	return b.String()
+}
@@ -43,6 +43,12 @@ type ClassInfo struct {
 	CreditType *CreditType `protobuf:"bytes,5,opt,name=credit_type,json=creditType,proto3" json:"credit_type,omitempty"`
 	// The number of batches issued in this credit class.
 	NumBatches uint64 `protobuf:"varint,6,opt,name=num_batches,json=numBatches,proto3" json:"num_batches,omitempty"`
+	// max_supply is the maximum amount of credits, tradable and retired, that
+	// can be issued in the credit class. It is unlimited if empty.
+	MaxSupply string `protobuf:"bytes,7,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// issued_supply is the cumulative amount of credits, tradable and retired,
+	// issued in the credit class. Cancelled credits are not deducted from it.
+	IssuedSupply string `protobuf:"bytes,8,opt,name=issued_supply,json=issuedSupply,proto3" json:"issued_supply,omitempty"`
 }
 
 func (m *ClassInfo) Reset()         { *m = ClassInfo{} }
@@ -120,6 +126,20 @@ func (m *ClassInfo) GetNumBatches() uint64 {
 	return 0
 }
 
+func (m *ClassInfo) GetMaxSupply() string {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return ""
+}
+
+func (m *ClassInfo) GetIssuedSupply() string {
+	if m != nil {
+		return m.IssuedSupply
+	}
+	return ""
+}
+
 // BatchInfo represents the high-level on-chain information for a credit batch.
 type BatchInfo struct {
 	// class_id is the unique ID of credit class.
@@ -248,6 +268,108 @@ func (m *BatchInfo) GetProjectLocation() string {
 	return ""
 }
 
+// Cancellation records credits of a batch cancelled by a holder, along with
+// the reason for the cancellation.
+type Cancellation struct {
+	// id is the unique ID of the cancellation.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// class_id is the unique ID of the credit class of the cancelled credits.
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// batch_denom is the unique ID of the credit batch of the cancelled credits.
+	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// holder is the address of the account which cancelled the credits.
+	Holder string `protobuf:"bytes,4,opt,name=holder,proto3" json:"holder,omitempty"`
+	// amount is the decimal number of credits cancelled.
+	Amount string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// reason is the reason given for cancelling the credits.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// reason_category is the category of the reason, i.e. the text before the
+	// first colon of the reason, or the whole reason if it has none.
+	ReasonCategory string `protobuf:"bytes,7,opt,name=reason_category,json=reasonCategory,proto3" json:"reason_category,omitempty"`
+}
+
+func (m *Cancellation) Reset()         { *m = Cancellation{} }
+func (m *Cancellation) String() string { return proto.CompactTextString(m) }
+func (*Cancellation) ProtoMessage()    {}
+func (*Cancellation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5342f4dcaeff1a84, []int{2}
+}
+func (m *Cancellation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Cancellation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Cancellation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Cancellation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cancellation.Merge(m, src)
+}
+func (m *Cancellation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Cancellation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cancellation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Cancellation proto.InternalMessageInfo
+
+func (m *Cancellation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Cancellation) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *Cancellation) GetBatchDenom() string {
+	if m != nil {
+		return m.BatchDenom
+	}
+	return ""
+}
+
+func (m *Cancellation) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *Cancellation) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *Cancellation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Cancellation) GetReasonCategory() string {
+	if m != nil {
+		return m.ReasonCategory
+	}
+	return ""
+}
+
 // Params defines the updatable global parameters of the ecocredit module for
 // use with the x/params module.
 type Params struct {
@@ -261,13 +383,23 @@ type Params struct {
 	AllowlistEnabled bool `protobuf:"varint,3,opt,name=allowlist_enabled,json=allowlistEnabled,proto3" json:"allowlist_enabled,omitempty"`
 	// credit_types is a list of definitions for credit types
 	CreditTypes []*CreditType `protobuf:"bytes,4,rep,name=credit_types,json=creditTypes,proto3" json:"credit_types,omitempty"`
+	// allowlist_authority is the account address allowed to add and remove
+	// class creators from the state-based allowlist. If empty, the state-based
+	// allowlist can't be managed.
+	AllowlistAuthority string `protobuf:"bytes,5,opt,name=allowlist_authority,json=allowlistAuthority,proto3" json:"allowlist_authority,omitempty"`
+	// max_class_metadata_length is the maximum length in bytes of the metadata
+	// attached to a new credit class. It must be positive.
+	MaxClassMetadataLength uint64 `protobuf:"varint,6,opt,name=max_class_metadata_length,json=maxClassMetadataLength,proto3" json:"max_class_metadata_length,omitempty"`
+	// max_batch_metadata_length is the maximum length in bytes of the metadata
+	// attached to a new credit batch. It must be positive.
+	MaxBatchMetadataLength uint64 `protobuf:"varint,7,opt,name=max_batch_metadata_length,json=maxBatchMetadataLength,proto3" json:"max_batch_metadata_length,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5342f4dcaeff1a84, []int{2}
+	return fileDescriptor_5342f4dcaeff1a84, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -324,6 +456,27 @@ func (m *Params) GetCreditTypes() []*CreditType {
 	return nil
 }
 
+func (m *Params) GetAllowlistAuthority() string {
+	if m != nil {
+		return m.AllowlistAuthority
+	}
+	return ""
+}
+
+func (m *Params) GetMaxClassMetadataLength() uint64 {
+	if m != nil {
+		return m.MaxClassMetadataLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBatchMetadataLength() uint64 {
+	if m != nil {
+		return m.MaxBatchMetadataLength
+	}
+	return 0
+}
+
 // CreditType defines the measurement unit/precision of a certain credit type
 // (e.g. carbon, biodiversity...)
 type CreditType struct {
@@ -336,13 +489,19 @@ type CreditType struct {
 	Unit string `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
 	// the decimal precision
 	Precision uint32 `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
+	// allow_fractional defines whether credits of this type can be retired in
+	// fractional amounts. If false, only whole credits can be retired. It
+	// defaults to false when unset, but the credit types of chains upgrading from
+	// ecocredit version 1 are migrated to true, as fractional amounts could
+	// always be retired before.
+	AllowFractional bool `protobuf:"varint,5,opt,name=allow_fractional,json=allowFractional,proto3" json:"allow_fractional,omitempty"`
 }
 
 func (m *CreditType) Reset()         { *m = CreditType{} }
 func (m *CreditType) String() string { return proto.CompactTextString(m) }
 func (*CreditType) ProtoMessage()    {}
 func (*CreditType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5342f4dcaeff1a84, []int{3}
+	return fileDescriptor_5342f4dcaeff1a84, []int{4}
 }
 func (m *CreditType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -399,6 +558,13 @@ func (m *CreditType) GetPrecision() uint32 {
 	return 0
 }
 
+func (m *CreditType) GetAllowFractional() bool {
+	if m != nil {
+		return m.AllowFractional
+	}
+	return false
+}
+
 // CreditTypeSeq associates a sequence number with a credit type abbreviation.
 // This represents the number of credit classes created with that credit type.
 type CreditTypeSeq struct {
@@ -412,7 +578,7 @@ func (m *CreditTypeSeq) Reset()         { *m = CreditTypeSeq{} }
 func (m *CreditTypeSeq) String() string { return proto.CompactTextString(m) }
 func (*CreditTypeSeq) ProtoMessage()    {}
 func (*CreditTypeSeq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5342f4dcaeff1a84, []int{4}
+	return fileDescriptor_5342f4dcaeff1a84, []int{5}
 }
 func (m *CreditTypeSeq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,6 +624,7 @@ func (m *CreditTypeSeq) GetSeqNumber() uint64 {
 func init() {
 	proto.RegisterType((*ClassInfo)(nil), "regen.ecocredit.v1alpha1.ClassInfo")
 	proto.RegisterType((*BatchInfo)(nil), "regen.ecocredit.v1alpha1.BatchInfo")
+	proto.RegisterType((*Cancellation)(nil), "regen.ecocredit.v1alpha1.Cancellation")
 	proto.RegisterType((*Params)(nil), "regen.ecocredit.v1alpha1.Params")
 	proto.RegisterType((*CreditType)(nil), "regen.ecocredit.v1alpha1.CreditType")
 	proto.RegisterType((*CreditTypeSeq)(nil), "regen.ecocredit.v1alpha1.CreditTypeSeq")
@@ -468,53 +635,65 @@ func init() {
 }
 
 var fileDescriptor_5342f4dcaeff1a84 = []byte{
-	// 724 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x6e, 0xf3, 0x44,
-	0x10, 0x8e, 0x93, 0x34, 0x89, 0x27, 0x29, 0x84, 0x55, 0x55, 0xb9, 0x11, 0x24, 0x21, 0xea, 0x21,
-	0x15, 0xaa, 0x4d, 0x0a, 0x37, 0x0e, 0x88, 0xa4, 0x05, 0x55, 0x20, 0x84, 0x4c, 0x4f, 0x5c, 0xac,
-	0xb5, 0x3d, 0x4d, 0x4d, 0xed, 0xdd, 0xd4, 0xbb, 0x4e, 0xe9, 0x5b, 0xf4, 0x39, 0xe0, 0x45, 0x7a,
-	0xec, 0x11, 0x71, 0xa0, 0xa8, 0x7d, 0x03, 0x9e, 0x00, 0x79, 0x77, 0x93, 0xb4, 0xfc, 0xff, 0xaf,
-	0xbf, 0xa7, 0xcc, 0x7c, 0x33, 0x5f, 0x66, 0xbf, 0x99, 0x2f, 0x81, 0xfd, 0x1c, 0xe7, 0xc8, 0x3c,
-	0x8c, 0x78, 0x94, 0x63, 0x9c, 0x48, 0x6f, 0x39, 0xa1, 0xe9, 0xe2, 0x82, 0x4e, 0x3c, 0x79, 0xb3,
-	0x40, 0xe1, 0x2e, 0x72, 0x2e, 0x39, 0x71, 0x54, 0x97, 0xbb, 0xee, 0x72, 0x57, 0x5d, 0xbd, 0x7e,
-	0xc4, 0x45, 0xc6, 0x85, 0x17, 0x52, 0x81, 0xde, 0x72, 0x12, 0xa2, 0xa4, 0x13, 0x2f, 0xe2, 0x09,
-	0xd3, 0xcc, 0xde, 0xce, 0x9c, 0xcf, 0xb9, 0x0a, 0xbd, 0x32, 0x32, 0xe8, 0x60, 0xce, 0xf9, 0x3c,
-	0x45, 0x4f, 0x65, 0x61, 0x71, 0xee, 0xc9, 0x24, 0x43, 0x21, 0x69, 0xb6, 0xd0, 0x0d, 0xa3, 0xbf,
-	0x2c, 0xb0, 0x67, 0x29, 0x15, 0xe2, 0x94, 0x9d, 0x73, 0xb2, 0x07, 0xad, 0xa8, 0x4c, 0x82, 0x24,
-	0x76, 0xac, 0xa1, 0x35, 0xb6, 0xfd, 0xa6, 0xca, 0x4f, 0x63, 0xb2, 0x03, 0x5b, 0x34, 0xce, 0x12,
-	0xe6, 0x54, 0x15, 0xae, 0x13, 0xe2, 0x40, 0x33, 0x11, 0xa2, 0xc0, 0x5c, 0x38, 0xb5, 0x61, 0xad,
-	0xec, 0x37, 0x29, 0xe9, 0x41, 0x2b, 0x43, 0x49, 0x63, 0x2a, 0xa9, 0x53, 0x1f, 0x5a, 0xe3, 0x8e,
-	0xbf, 0xce, 0xc9, 0x09, 0xb4, 0xb5, 0xbc, 0xa0, 0xd4, 0xee, 0x6c, 0x0d, 0xad, 0x71, 0xfb, 0x68,
-	0xdf, 0x7d, 0x97, 0x76, 0x77, 0xa6, 0xf2, 0xb3, 0x9b, 0x05, 0xfa, 0x10, 0xad, 0x63, 0x32, 0x80,
-	0x36, 0x2b, 0xb2, 0x20, 0xa4, 0x32, 0xba, 0x40, 0xe1, 0x34, 0x86, 0xd6, 0xb8, 0xee, 0x03, 0x2b,
-	0xb2, 0xa9, 0x46, 0x46, 0xff, 0x56, 0xc1, 0x56, 0xf1, 0xfb, 0xc4, 0x0d, 0xa0, 0xad, 0xbe, 0x25,
-	0x88, 0x91, 0xf1, 0xcc, 0x48, 0x04, 0x05, 0x1d, 0x97, 0x08, 0xd9, 0x85, 0x86, 0x16, 0xe6, 0xd4,
-	0x54, 0xcd, 0x64, 0xe4, 0x53, 0xe8, 0x48, 0x2e, 0x69, 0x1a, 0xd0, 0x8c, 0x17, 0x4c, 0x2a, 0xa5,
-	0xb6, 0xdf, 0x56, 0xd8, 0x37, 0x0a, 0x7a, 0xb1, 0x88, 0xad, 0xff, 0x2d, 0xe2, 0x00, 0xba, 0x9a,
-	0x18, 0x44, 0x94, 0x45, 0x98, 0xa6, 0x18, 0x2b, 0x19, 0xb6, 0xff, 0xa1, 0xc6, 0x67, 0x2b, 0x98,
-	0x7c, 0x0d, 0x20, 0x24, 0xcd, 0x65, 0x10, 0x53, 0x89, 0x4e, 0x53, 0xad, 0xac, 0xe7, 0xea, 0xf3,
-	0xba, 0xab, 0xf3, 0xba, 0x67, 0xab, 0xf3, 0x4e, 0xeb, 0xb7, 0x0f, 0x03, 0xcb, 0xb7, 0x15, 0xe7,
-	0x98, 0x4a, 0x24, 0x5f, 0x41, 0x0b, 0x59, 0xac, 0xe9, 0xad, 0x57, 0xd2, 0x9b, 0xc8, 0x62, 0x45,
-	0x3e, 0x80, 0xee, 0x22, 0xe7, 0xbf, 0x62, 0x24, 0x83, 0x94, 0x47, 0x54, 0x26, 0x9c, 0x39, 0xb6,
-	0x7e, 0xa8, 0xc1, 0x7f, 0x30, 0xf0, 0xe8, 0x8f, 0x2a, 0x34, 0x7e, 0xa2, 0x39, 0xcd, 0x04, 0x29,
-	0xa0, 0x6b, 0xee, 0xac, 0x17, 0x7f, 0x8e, 0xe8, 0x58, 0xc3, 0xda, 0xb8, 0x7d, 0xb4, 0xe7, 0x6a,
-	0x3b, 0xbb, 0xa5, 0x9d, 0x5d, 0x63, 0x67, 0x77, 0xc6, 0x13, 0x36, 0xfd, 0xfc, 0xee, 0xef, 0x41,
-	0xe5, 0xf7, 0x87, 0xc1, 0x78, 0x9e, 0xc8, 0x8b, 0x22, 0x74, 0x23, 0x9e, 0x79, 0xc6, 0xfb, 0xfa,
-	0xe3, 0x50, 0xc4, 0x97, 0xe6, 0x47, 0x53, 0x12, 0x84, 0xff, 0x81, 0x1e, 0xa2, 0x6c, 0xfc, 0x2d,
-	0x22, 0xf9, 0x12, 0x76, 0x69, 0x9a, 0xf2, 0x6b, 0x8c, 0xcd, 0xdc, 0x28, 0x47, 0x2a, 0x79, 0x2e,
-	0x9c, 0xaa, 0xf2, 0xe8, 0x8e, 0xa9, 0x2a, 0xc2, 0xcc, 0xd4, 0xc8, 0x67, 0xf0, 0x91, 0xc2, 0xd3,
-	0x44, 0xc8, 0x00, 0x19, 0x0d, 0xcb, 0x63, 0x94, 0xd7, 0x6e, 0xf9, 0xdd, 0x75, 0xe1, 0x44, 0xe3,
-	0xe4, 0x3b, 0xe8, 0x3c, 0x73, 0xb0, 0x70, 0xea, 0x4a, 0xd5, 0xeb, 0x2c, 0xdc, 0xde, 0x58, 0x58,
-	0x8c, 0x96, 0x00, 0x9b, 0x12, 0x21, 0x50, 0x67, 0x34, 0x43, 0x63, 0x4f, 0x15, 0x93, 0x11, 0x74,
-	0x68, 0x18, 0xe6, 0xb8, 0x4c, 0xf4, 0xda, 0xb5, 0x39, 0x5f, 0x60, 0x25, 0xaf, 0x60, 0x89, 0x34,
-	0xe6, 0x54, 0x31, 0xf9, 0x18, 0xec, 0x45, 0x8e, 0x51, 0x22, 0x4a, 0x52, 0xe9, 0xcb, 0x6d, 0x7f,
-	0x03, 0x8c, 0x7c, 0xd8, 0xde, 0xcc, 0xfd, 0x19, 0xaf, 0xde, 0x18, 0x63, 0xbd, 0x65, 0xcc, 0x27,
-	0x00, 0x02, 0xaf, 0x02, 0x56, 0x64, 0x21, 0xe6, 0xea, 0x21, 0x75, 0xdf, 0x16, 0x78, 0xf5, 0xa3,
-	0x02, 0xa6, 0xdf, 0xdf, 0x3d, 0xf6, 0xad, 0xfb, 0xc7, 0xbe, 0xf5, 0xcf, 0x63, 0xdf, 0xba, 0x7d,
-	0xea, 0x57, 0xee, 0x9f, 0xfa, 0x95, 0x3f, 0x9f, 0xfa, 0x95, 0x5f, 0x26, 0xcf, 0x6e, 0xa9, 0x56,
-	0x74, 0xc8, 0x50, 0x5e, 0xf3, 0xfc, 0xd2, 0x64, 0x29, 0xc6, 0x73, 0xcc, 0xbd, 0xdf, 0x36, 0x7f,
-	0x8f, 0x61, 0x43, 0x99, 0xf2, 0x8b, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xff, 0x5f, 0x26, 0xca,
-	0x38, 0x05, 0x00, 0x00,
+	// 917 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0x51, 0x6f, 0x1b, 0x45,
+	0x10, 0xc7, 0x73, 0xb6, 0x13, 0xfb, 0xc6, 0x6e, 0x12, 0x96, 0x28, 0xba, 0x44, 0xd4, 0x36, 0xa6,
+	0x12, 0xae, 0x50, 0xef, 0x70, 0xe0, 0x05, 0xf1, 0x80, 0x1a, 0xb7, 0x45, 0x15, 0x05, 0xa1, 0x6b,
+	0x9f, 0x78, 0x39, 0xed, 0xdd, 0x4d, 0xec, 0xa3, 0x77, 0xbb, 0xce, 0xed, 0x5e, 0x9a, 0x7c, 0x8b,
+	0x7e, 0x02, 0x24, 0x5e, 0xf9, 0x24, 0x95, 0x78, 0xa9, 0x78, 0xe2, 0x89, 0xa2, 0xe4, 0x1b, 0xf0,
+	0x09, 0xd0, 0xcd, 0xae, 0xed, 0xa6, 0x14, 0xe8, 0x93, 0x77, 0x7e, 0x33, 0x73, 0x3b, 0xfb, 0xdf,
+	0x99, 0x35, 0xdc, 0x2a, 0x71, 0x86, 0x22, 0xc0, 0x44, 0x26, 0x25, 0xa6, 0x99, 0x0e, 0xce, 0x26,
+	0x3c, 0x5f, 0xcc, 0xf9, 0x24, 0xd0, 0x17, 0x0b, 0x54, 0xfe, 0xa2, 0x94, 0x5a, 0x32, 0x8f, 0xa2,
+	0xfc, 0x55, 0x94, 0xbf, 0x8c, 0x3a, 0xec, 0x27, 0x52, 0x15, 0x52, 0x05, 0x31, 0x57, 0x18, 0x9c,
+	0x4d, 0x62, 0xd4, 0x7c, 0x12, 0x24, 0x32, 0x13, 0x26, 0xf3, 0x70, 0x6f, 0x26, 0x67, 0x92, 0x96,
+	0x41, 0xbd, 0xb2, 0x74, 0x30, 0x93, 0x72, 0x96, 0x63, 0x40, 0x56, 0x5c, 0x9d, 0x04, 0x3a, 0x2b,
+	0x50, 0x69, 0x5e, 0x2c, 0x4c, 0xc0, 0xe8, 0xa7, 0x06, 0xb8, 0xd3, 0x9c, 0x2b, 0xf5, 0x50, 0x9c,
+	0x48, 0x76, 0x00, 0x9d, 0xa4, 0x36, 0xa2, 0x2c, 0xf5, 0x9c, 0xa1, 0x33, 0x76, 0xc3, 0x36, 0xd9,
+	0x0f, 0x53, 0xb6, 0x07, 0x9b, 0x3c, 0x2d, 0x32, 0xe1, 0x35, 0x88, 0x1b, 0x83, 0x79, 0xd0, 0xce,
+	0x94, 0xaa, 0xb0, 0x54, 0x5e, 0x73, 0xd8, 0xac, 0xe3, 0xad, 0xc9, 0x0e, 0xa1, 0x53, 0xa0, 0xe6,
+	0x29, 0xd7, 0xdc, 0x6b, 0x0d, 0x9d, 0x71, 0x2f, 0x5c, 0xd9, 0xec, 0x3e, 0x74, 0xcd, 0xf1, 0xa2,
+	0xfa, 0xec, 0xde, 0xe6, 0xd0, 0x19, 0x77, 0x8f, 0x6e, 0xf9, 0xff, 0x76, 0x76, 0x7f, 0x4a, 0xf6,
+	0x93, 0x8b, 0x05, 0x86, 0x90, 0xac, 0xd6, 0x6c, 0x00, 0x5d, 0x51, 0x15, 0x51, 0xcc, 0x75, 0x32,
+	0x47, 0xe5, 0x6d, 0x0d, 0x9d, 0x71, 0x2b, 0x04, 0x51, 0x15, 0xc7, 0x86, 0xb0, 0x9b, 0x00, 0x05,
+	0x3f, 0x8f, 0x54, 0xb5, 0x58, 0xe4, 0x17, 0x5e, 0x9b, 0x0a, 0x77, 0x0b, 0x7e, 0xfe, 0x98, 0x00,
+	0xfb, 0x08, 0x6e, 0x50, 0xb5, 0xe9, 0x32, 0xa2, 0x43, 0x11, 0x3d, 0x03, 0x4d, 0xd0, 0xe8, 0xaf,
+	0x06, 0xb8, 0xf4, 0xbd, 0xff, 0x13, 0x68, 0x00, 0x5d, 0xaa, 0x24, 0x4a, 0x51, 0xc8, 0xc2, 0xca,
+	0x04, 0x84, 0xee, 0xd5, 0x84, 0xed, 0xc3, 0x96, 0x11, 0xc7, 0x6b, 0x92, 0xcf, 0x5a, 0xec, 0x43,
+	0xe8, 0x69, 0xa9, 0x79, 0x1e, 0xf1, 0x42, 0x56, 0x42, 0x93, 0x5a, 0x6e, 0xd8, 0x25, 0x76, 0x97,
+	0xd0, 0x35, 0x31, 0x37, 0xdf, 0x10, 0xf3, 0x36, 0xec, 0x9a, 0xc4, 0x28, 0xe1, 0x22, 0xc1, 0x3c,
+	0xc7, 0x94, 0xa4, 0x70, 0xc3, 0x1d, 0xc3, 0xa7, 0x4b, 0xcc, 0xbe, 0x02, 0x50, 0x9a, 0x97, 0x3a,
+	0x4a, 0xb9, 0x46, 0xd2, 0xa3, 0x7b, 0x74, 0xe8, 0x9b, 0x16, 0xf1, 0x97, 0x2d, 0xe2, 0x3f, 0x59,
+	0xb6, 0xc8, 0x71, 0xeb, 0xf9, 0xab, 0x81, 0x13, 0xba, 0x94, 0x73, 0x8f, 0x6b, 0x64, 0x5f, 0x42,
+	0x07, 0x45, 0x6a, 0xd2, 0x3b, 0xef, 0x98, 0xde, 0x46, 0x91, 0x52, 0xf2, 0x6d, 0xd8, 0x5d, 0x94,
+	0xf2, 0x47, 0x4c, 0x74, 0x94, 0xcb, 0x84, 0xeb, 0x4c, 0x0a, 0xcf, 0x35, 0x85, 0x5a, 0xfe, 0xc8,
+	0xe2, 0xd1, 0xaf, 0x0e, 0xf4, 0x6c, 0xd9, 0x04, 0xd8, 0x36, 0x34, 0xac, 0xe2, 0xad, 0xb0, 0x91,
+	0xa5, 0xd7, 0xee, 0xa1, 0xf1, 0x9f, 0xf7, 0xd0, 0x7c, 0xdb, 0x3d, 0xcc, 0x65, 0x9e, 0x62, 0x69,
+	0x95, 0xb6, 0x56, 0xcd, 0xed, 0x0d, 0x6c, 0x1a, 0x6e, 0xac, 0x9a, 0x97, 0xc8, 0x95, 0x14, 0x56,
+	0x56, 0x6b, 0xb1, 0x8f, 0x61, 0xc7, 0xac, 0xa2, 0x84, 0x6b, 0x9c, 0xc9, 0x72, 0xd9, 0x62, 0xdb,
+	0x06, 0x4f, 0x2d, 0x1d, 0xfd, 0xd6, 0x84, 0xad, 0xef, 0x79, 0xc9, 0x0b, 0xc5, 0x2a, 0xd8, 0xb5,
+	0x9d, 0x6f, 0xca, 0x3f, 0x41, 0xf4, 0x9c, 0x61, 0x73, 0xdc, 0x3d, 0x3a, 0xf0, 0xcd, 0x80, 0xfb,
+	0xf5, 0x80, 0xfb, 0x76, 0xc0, 0xfd, 0xa9, 0xcc, 0xc4, 0xf1, 0xa7, 0x2f, 0xfe, 0x18, 0x6c, 0xfc,
+	0xf2, 0x6a, 0x30, 0x9e, 0x65, 0x7a, 0x5e, 0xc5, 0x7e, 0x22, 0x8b, 0xc0, 0xbe, 0x06, 0xe6, 0xe7,
+	0x8e, 0x4a, 0x9f, 0xda, 0x67, 0xa4, 0x4e, 0x50, 0xe1, 0xb6, 0xd9, 0x84, 0x06, 0xfb, 0x01, 0x22,
+	0xfb, 0x1c, 0xf6, 0x79, 0x9e, 0xcb, 0x67, 0x98, 0xda, 0x7d, 0x93, 0x12, 0xb9, 0x96, 0xa5, 0xf2,
+	0x1a, 0x34, 0xb5, 0x7b, 0xd6, 0x4b, 0x09, 0x53, 0xeb, 0x63, 0x9f, 0xc0, 0x7b, 0xc4, 0xf3, 0x4c,
+	0xe9, 0x08, 0x05, 0x8f, 0xeb, 0xd6, 0xaa, 0xf5, 0xec, 0x84, 0xbb, 0x2b, 0xc7, 0x7d, 0xc3, 0xd9,
+	0xd7, 0xd0, 0x7b, 0x6d, 0xa6, 0x95, 0xd7, 0x1a, 0x36, 0xdf, 0x79, 0xa8, 0xbb, 0xeb, 0xa1, 0x56,
+	0x2c, 0x80, 0xf7, 0xd7, 0xbb, 0xf2, 0x4a, 0xcf, 0x65, 0x99, 0xe9, 0x0b, 0x7b, 0x27, 0x6c, 0xe5,
+	0xba, 0xbb, 0xf4, 0xb0, 0x2f, 0xe0, 0xa0, 0x9e, 0x72, 0x73, 0xb0, 0xe5, 0x58, 0x44, 0x39, 0x8a,
+	0x99, 0x9e, 0xdb, 0x47, 0x61, 0xbf, 0xe0, 0xe7, 0x74, 0xb6, 0x6f, 0xad, 0xfb, 0x11, 0x79, 0x97,
+	0xa9, 0xa6, 0x5f, 0xde, 0x4c, 0x6d, 0xaf, 0x52, 0x69, 0xfe, 0xaf, 0xa7, 0x8e, 0x7e, 0x76, 0x00,
+	0xd6, 0x47, 0x60, 0x0c, 0x5a, 0x82, 0x17, 0x68, 0x1f, 0x05, 0x5a, 0xb3, 0x11, 0xf4, 0x78, 0x1c,
+	0x97, 0x78, 0x96, 0x99, 0x66, 0x37, 0x8d, 0x7a, 0x8d, 0xd5, 0x79, 0x95, 0xc8, 0xb4, 0x6d, 0x53,
+	0x5a, 0xb3, 0x0f, 0xc0, 0x5d, 0x94, 0x98, 0x64, 0xaa, 0x4e, 0xaa, 0x7b, 0xf4, 0x46, 0xb8, 0x06,
+	0x34, 0xef, 0xb5, 0x08, 0xd1, 0x49, 0xc9, 0x93, 0xfa, 0x1b, 0x3c, 0x27, 0x71, 0x3a, 0xe1, 0x0e,
+	0xf1, 0x07, 0x2b, 0x3c, 0x0a, 0xe1, 0xc6, 0xba, 0xc4, 0xc7, 0x78, 0xfa, 0x8f, 0x8a, 0x9c, 0xb7,
+	0x54, 0x74, 0x13, 0x40, 0xe1, 0x69, 0x24, 0xaa, 0x22, 0xc6, 0x92, 0x6a, 0x6e, 0x85, 0xae, 0xc2,
+	0xd3, 0xef, 0x08, 0x1c, 0x7f, 0xf3, 0xe2, 0xb2, 0xef, 0xbc, 0xbc, 0xec, 0x3b, 0x7f, 0x5e, 0xf6,
+	0x9d, 0xe7, 0x57, 0xfd, 0x8d, 0x97, 0x57, 0xfd, 0x8d, 0xdf, 0xaf, 0xfa, 0x1b, 0x3f, 0x4c, 0x5e,
+	0x6b, 0x4f, 0xba, 0xf5, 0x3b, 0x02, 0xf5, 0x33, 0x59, 0x3e, 0xb5, 0x56, 0x8e, 0xe9, 0x0c, 0xcb,
+	0xe0, 0x7c, 0xfd, 0x1f, 0x18, 0x6f, 0xd1, 0xab, 0xf1, 0xd9, 0xdf, 0x03, 0x00, 0xaf, 0xe7, 0xe2,
+	0x0a, 0x1d, 0x07, 0x00, 0x00,
 }
 
 func (m *ClassInfo) Marshal() (dAtA []byte, err error) {
@@ -537,6 +716,20 @@ func (m *ClassInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IssuedSupply) > 0 {
+		i -= len(m.IssuedSupply)
+		copy(dAtA[i:], m.IssuedSupply)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IssuedSupply)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MaxSupply) > 0 {
+		i -= len(m.MaxSupply)
+		copy(dAtA[i:], m.MaxSupply)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MaxSupply)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.NumBatches != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.NumBatches))
 		i--
@@ -679,6 +872,76 @@ func (m *BatchInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Cancellation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cancellation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Cancellation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReasonCategory) > 0 {
+		i -= len(m.ReasonCategory)
+		copy(dAtA[i:], m.ReasonCategory)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ReasonCategory)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -699,6 +962,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBatchMetadataLength != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxBatchMetadataLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxClassMetadataLength != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxClassMetadataLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AllowlistAuthority) > 0 {
+		i -= len(m.AllowlistAuthority)
+		copy(dAtA[i:], m.AllowlistAuthority)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowlistAuthority)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.CreditTypes) > 0 {
 		for iNdEx := len(m.CreditTypes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -769,6 +1049,16 @@ func (m *CreditType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllowFractional {
+		i--
+		if m.AllowFractional {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Precision != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Precision))
 		i--
@@ -875,6 +1165,14 @@ func (m *ClassInfo) Size() (n int) {
 	if m.NumBatches != 0 {
 		n += 1 + sovTypes(uint64(m.NumBatches))
 	}
+	l = len(m.MaxSupply)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IssuedSupply)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -923,6 +1221,42 @@ func (m *BatchInfo) Size() (n int) {
 	return n
 }
 
+func (m *Cancellation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ReasonCategory)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -950,6 +1284,16 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	l = len(m.AllowlistAuthority)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MaxClassMetadataLength != 0 {
+		n += 1 + sovTypes(uint64(m.MaxClassMetadataLength))
+	}
+	if m.MaxBatchMetadataLength != 0 {
+		n += 1 + sovTypes(uint64(m.MaxBatchMetadataLength))
+	}
 	return n
 }
 
@@ -974,6 +1318,9 @@ func (m *CreditType) Size() (n int) {
 	if m.Precision != 0 {
 		n += 1 + sovTypes(uint64(m.Precision))
 	}
+	if m.AllowFractional {
+		n += 2
+	}
 	return n
 }
 
@@ -1213,42 +1560,106 @@ func (m *ClassInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BatchInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			m.MaxSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedSupply", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuedSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -1588,6 +1999,270 @@ func (m *BatchInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Cancellation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cancellation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cancellation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonCategory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReasonCategory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1737,6 +2412,76 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowlistAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClassMetadataLength", wireType)
+			}
+			m.MaxClassMetadataLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClassMetadataLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchMetadataLength", wireType)
+			}
+			m.MaxBatchMetadataLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchMetadataLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1905,6 +2650,26 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowFractional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowFractional = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
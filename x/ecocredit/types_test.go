@@ -0,0 +1,127 @@
+package ecocredit
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveAllUnicodeWhitespace(t *testing.T) {
+	specs := map[string]struct {
+		str string
+		exp string
+	}{
+		"empty":                 {"", ""},
+		"no whitespace":         {"carbon", "carbon"},
+		"spaces":                {" car bon ", "carbon"},
+		"tab":                   {"car\tbon", "carbon"},
+		"newlines":              {"car\r\nbon\n", "carbon"},
+		"vertical tab and feed": {"car\vbo\fn", "carbon"},
+		"non-breaking space":    {"car\u00a0bon", "carbon"},
+		"em space":              {"car\u2003bon", "carbon"},
+		"ideographic space":     {"car\u3000bon", "carbon"},
+		"next line":             {"car\u0085bon", "carbon"},
+		"non-ascii letters":     {"bio diversité", "biodiversité"},
+		"zero width space":      {"car\u200bbon", "car\u200bbon"},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, spec.exp, RemoveAllUnicodeWhitespace(spec.str))
+		})
+	}
+}
+
+func TestRemoveASCIIWhitespaceMatchesUnicode(t *testing.T) {
+	var b strings.Builder
+	for ch := 0; ch < 128; ch++ {
+		b.WriteRune(rune(ch))
+	}
+	ascii := b.String()
+
+	require.Equal(t, removeUnicodeWhitespace(ascii), removeASCIIWhitespace(ascii))
+	for ch := rune(0); ch < 128; ch++ {
+		require.Equal(t, unicode.IsSpace(ch), removeASCIIWhitespace(string(ch)) == "", "%q", ch)
+	}
+}
+
+func TestNormalizeCreditTypeName(t *testing.T) {
+	require.Equal(t, "carbon", NormalizeCreditTypeName(" Carbon\t"))
+	require.Equal(t, NormalizeCreditTypeName("bio diversity"), NormalizeCreditTypeName("bio\u00a0diversity"))
+}
+
+func TestCancelReasonCategory(t *testing.T) {
+	require.Equal(t, "double counting", CancelReasonCategory(" double counting : also issued elsewhere"))
+	require.Equal(t, "reversal", CancelReasonCategory("reversal"))
+	require.Equal(t, "", CancelReasonCategory(": no category"))
+}
+
+func TestValidateAddresses(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	specs := map[string]struct {
+		addrs  []string
+		expErr string
+	}{
+		"empty list": {
+			addrs: nil,
+		},
+		"valid addresses": {
+			addrs: []string{addr1.String(), addr2.String(), addr1.String()},
+		},
+		"invalid address": {
+			addrs:  []string{addr1.String(), "foo", addr2.String()},
+			expErr: `address "foo" at index 1`,
+		},
+		"empty address": {
+			addrs:  []string{addr1.String(), addr2.String(), ""},
+			expErr: `address "" at index 2`,
+		},
+		"first invalid address is reported": {
+			addrs:  []string{"foo", "bar"},
+			expErr: `address "foo" at index 0`,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateAddresses(spec.addrs)
+			if spec.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.True(t, sdkerrors.ErrInvalidAddress.Is(err))
+			require.Contains(t, err.Error(), spec.expErr)
+		})
+	}
+}
+
+func BenchmarkRemoveWhitespace(b *testing.B) {
+	ascii := "  a credit type name\twith some white space  "
+	nonASCII := "  a credit type name\u00a0with some white space  "
+
+	b.Run("ascii/ascii-path", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			removeASCIIWhitespace(ascii)
+		}
+	})
+	b.Run("ascii/unicode-path", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			removeUnicodeWhitespace(ascii)
+		}
+	})
+	b.Run("ascii/RemoveAllUnicodeWhitespace", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			RemoveAllUnicodeWhitespace(ascii)
+		}
+	})
+	b.Run("non-ascii/RemoveAllUnicodeWhitespace", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			RemoveAllUnicodeWhitespace(nonASCII)
+		}
+	})
+}
@@ -22,12 +22,15 @@ func QueryCmd(name string) *cobra.Command {
 	queryCmd.AddCommand(
 		QueryGroupInfoCmd(),
 		QueryGroupAccountInfoCmd(),
+		QueryGroupAccountBalanceCmd(),
 		QueryGroupMembersCmd(),
 		QueryGroupsByAdminCmd(),
 		QueryGroupAccountsByGroupCmd(),
 		QueryGroupAccountsByAdminCmd(),
 		QueryProposalCmd(),
+		QueryTallyResultCmd(),
 		QueryProposalsByGroupAccountCmd(),
+		QueryProposalsByStatusCmd(),
 		QueryVoteByProposalVoterCmd(),
 		QueryVotesByProposalCmd(),
 		QueryVotesByVoterCmd(),
@@ -101,6 +104,36 @@ func QueryGroupAccountInfoCmd() *cobra.Command {
 	return cmd
 }
 
+// QueryGroupAccountBalanceCmd creates a CLI command for Query/GroupAccountBalance.
+func QueryGroupAccountBalanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-account-balance [group-account]",
+		Short: "Query for the bank balance of a group account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GroupAccountBalance(cmd.Context(), &group.QueryGroupAccountBalanceRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryGroupMembersCmd creates a CLI command for Query/GroupMembers.
 func QueryGroupMembersCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -290,6 +323,41 @@ func QueryProposalCmd() *cobra.Command {
 	return cmd
 }
 
+// QueryTallyResultCmd creates a CLI command for Query/TallyResult.
+func QueryTallyResultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally-result [proposal-id]",
+		Short: "Query for the tally of a proposal, computed live while voting is open",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TallyResult(cmd.Context(), &group.QueryTallyResultRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryProposalsByGroupAccountCmd creates a CLI command for Query/ProposalsByGroupAccount.
 func QueryProposalsByGroupAccountCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -326,6 +394,51 @@ func QueryProposalsByGroupAccountCmd() *cobra.Command {
 	return cmd
 }
 
+// QueryProposalsByStatusCmd creates a CLI command for Query/ProposalsByStatus.
+func QueryProposalsByStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposals-by-status [group-account] [status]",
+		Short: "Query for proposals by group account address and status with pagination flags",
+		Long: `Query for proposals by group account address and status with pagination flags.
+
+Status is one of STATUS_SUBMITTED, STATUS_CLOSED, STATUS_ABORTED or STATUS_WITHDRAWN.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := group.ProposalStatusFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProposalsByStatus(cmd.Context(), &group.QueryProposalsByStatusRequest{
+				Address:    args[0],
+				Status:     status,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryVoteByProposalVoterCmd creates a CLI command for Query/VoteByProposalVoter.
 func QueryVoteByProposalVoterCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -407,7 +520,7 @@ func QueryVotesByProposalCmd() *cobra.Command {
 func QueryVotesByVoterCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "votes-by-voter [voter]",
-		Short: "Query for votes by voter account address with pagination flags",
+		Short: "Query for votes by voter account address with pagination flags, most recent first with --reverse",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -435,6 +548,7 @@ func QueryVotesByVoterCmd() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "votes-by-voter")
 
 	return cmd
 }
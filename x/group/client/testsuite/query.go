@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/regen-network/regen-ledger/types/testutil/cli"
 	"github.com/regen-network/regen-ledger/x/group"
 	"github.com/regen-network/regen-ledger/x/group/client"
@@ -61,6 +65,7 @@ func (s *IntegrationTestSuite) TestQueryGroupInfo() {
 				s.Require().Equal(s.group.GroupId, g.GroupId)
 				s.Require().Equal(s.group.Admin, g.Admin)
 				s.Require().Equal(s.group.TotalWeight, g.TotalWeight)
+				s.Require().Equal(s.group.MemberCount, g.MemberCount)
 				s.Require().Equal(s.group.Metadata, g.Metadata)
 				s.Require().Equal(s.group.Version, g.Version)
 			}
@@ -193,6 +198,7 @@ func (s *IntegrationTestSuite) TestQueryGroupsByAdmin() {
 					s.Require().Equal(res.Groups[i].Metadata, tc.expectGroups[i].Metadata)
 					s.Require().Equal(res.Groups[i].Version, tc.expectGroups[i].Version)
 					s.Require().Equal(res.Groups[i].TotalWeight, tc.expectGroups[i].TotalWeight)
+					s.Require().Equal(res.Groups[i].MemberCount, tc.expectGroups[i].MemberCount)
 					s.Require().Equal(res.Groups[i].Admin, tc.expectGroups[i].Admin)
 				}
 			}
@@ -259,6 +265,75 @@ func (s *IntegrationTestSuite) TestQueryGroupAccountInfo() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestQueryGroupAccountBalance() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	groupAccountAddr, err := sdk.AccAddressFromBech32(s.groupAccounts[4].Address)
+	s.Require().NoError(err)
+	_, err = banktestutil.MsgSendExec(
+		clientCtx,
+		val.Address,
+		groupAccountAddr,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))), fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectErrMsg string
+	}{
+		{
+			"invalid account address",
+			[]string{"invalid", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+			"decoding bech32 failed: invalid bech32",
+		},
+		{
+			"group account not found",
+			[]string{val.Address.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+			"not found",
+		},
+		{
+			"group account found",
+			[]string{s.groupAccounts[4].Address, fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := client.QueryGroupAccountBalanceCmd()
+
+			out, err := cli.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Contains(out.String(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err, out.String())
+
+				var res group.QueryGroupAccountBalanceResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+
+				s.Require().True(res.Balance.AmountOf(s.cfg.BondDenom).GTE(sdk.NewInt(100)))
+
+				out, err = banktestutil.QueryBalancesExec(clientCtx, groupAccountAddr)
+				s.Require().NoError(err, out.String())
+				var balances banktypes.QueryAllBalancesResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &balances))
+				s.Require().Equal(balances.Balances, res.Balance)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestQueryGroupAccountsByGroup() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
@@ -444,6 +519,64 @@ func (s *IntegrationTestSuite) TestQueryProposal() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestQueryTallyResult() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectErrMsg string
+		expectedCode uint32
+		expectTally  group.Tally
+	}{
+		{
+			"not found",
+			[]string{"12345", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+			"not found",
+			0,
+			group.Tally{},
+		},
+		{
+			"invalid proposal id",
+			[]string{"", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+			"strconv.ParseUint: parsing \"\": invalid syntax",
+			0,
+			group.Tally{},
+		},
+		{
+			"found",
+			[]string{strconv.FormatUint(s.proposal.ProposalId, 10), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			"",
+			0,
+			s.proposal.VoteState,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := client.QueryTallyResultCmd()
+
+			out, err := cli.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Contains(out.String(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err, out.String())
+
+				var res group.QueryTallyResultResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+				s.Require().Equal(tc.expectTally, res.Tally)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestQueryProposalsByGroupAccount() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
@@ -507,6 +640,78 @@ func (s *IntegrationTestSuite) TestQueryProposalsByGroupAccount() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestQueryProposalsByStatus() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name            string
+		args            []string
+		expectErr       bool
+		expectErrMsg    string
+		expectedCode    uint32
+		expectProposals []*group.Proposal
+	}{
+		{
+			"invalid group account address",
+			[]string{"invalid", s.proposal.Status.String()},
+			true,
+			"decoding bech32 failed: invalid bech32 string",
+			0,
+			[]*group.Proposal{},
+		},
+		{
+			"invalid status",
+			[]string{s.groupAccounts[0].Address, "invalid"},
+			true,
+			"'invalid' is not a valid proposal status",
+			0,
+			[]*group.Proposal{},
+		},
+		{
+			"no proposals with status",
+			[]string{s.groupAccounts[0].Address, group.ProposalStatusAborted.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			"",
+			0,
+			[]*group.Proposal{},
+		},
+		{
+			"found proposals",
+			[]string{s.groupAccounts[0].Address, s.proposal.Status.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			"",
+			0,
+			[]*group.Proposal{
+				s.proposal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := client.QueryProposalsByStatusCmd()
+
+			out, err := cli.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err, out.String())
+
+				var res group.QueryProposalsByStatusResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+				s.Require().Equal(len(res.Proposals), len(tc.expectProposals))
+				for i := range res.Proposals {
+					s.Require().Equal(res.Proposals[i], tc.expectProposals[i])
+				}
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestQueryVoteByProposalVoter() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
@@ -651,6 +856,16 @@ func (s *IntegrationTestSuite) TestQueryVotesByVoter() {
 				s.vote,
 			},
 		},
+		{
+			"found votes most recent first",
+			[]string{val.Address.String(), fmt.Sprintf("--%s", flags.FlagReverse), fmt.Sprintf("--%s=1", flags.FlagLimit), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			"",
+			0,
+			[]*group.Vote{
+				s.vote,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
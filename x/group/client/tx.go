@@ -45,6 +45,8 @@ func TxCmd(name string) *cobra.Command {
 		MsgCreateProposalCmd(),
 		MsgVoteCmd(),
 		MsgExecCmd(),
+		MsgPruneProposalCmd(),
+		MsgWithdrawProposalCmd(),
 	)
 
 	return txCmd
@@ -648,3 +650,86 @@ func MsgExecCmd() *cobra.Command {
 
 	return cmd
 }
+
+// MsgPruneProposalCmd creates a CLI command for Msg/MsgPruneProposal.
+func MsgPruneProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-proposal [proposal-id]",
+		Short: "Delete a finalized proposal and its votes",
+		Long: `Delete a finalized proposal and its votes.
+
+Only proposals that were aborted, withdrawn, rejected, or accepted and successfully executed can be pruned.
+The '--from' signer must be the group admin.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgPruneProposal{
+				ProposalId: proposalID,
+				Signer:     clientCtx.GetFromAddress().String(),
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgWithdrawProposalCmd creates a CLI command for Msg/MsgWithdrawProposal.
+func MsgWithdrawProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-proposal [proposal-id] [address]",
+		Short: "Withdraw a proposal still open for voting",
+		Long: `Withdraw a proposal still open for voting.
+
+The address must be one of the proposers or the group admin. Note, the '--from' flag is
+ignored as it is implied from [address].`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgWithdrawProposal{
+				ProposalId: proposalID,
+				Address:    clientCtx.GetFromAddress().String(),
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
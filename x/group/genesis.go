@@ -1,13 +1,79 @@
 package group
 
-import "github.com/cosmos/cosmos-sdk/codec/types"
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
 
 // NewGenesisState creates a new genesis state with default values.
 func NewGenesisState() *GenesisState {
 	return &GenesisState{}
 }
 
+// Validate performs basic validation of every group, member, account, proposal and vote,
+// checks that they reference existing entries and that the sequences are not behind the
+// imported IDs so that new groups and proposals don't collide with them.
 func (s GenesisState) Validate() error {
+	groupIDs := make(map[uint64]struct{}, len(s.Groups))
+	for _, g := range s.Groups {
+		if err := g.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "group %d", g.GroupId)
+		}
+		if g.GroupId > s.GroupSeq {
+			return sdkerrors.Wrapf(ErrInvalid, "group id %d greater than group sequence %d", g.GroupId, s.GroupSeq)
+		}
+		groupIDs[g.GroupId] = struct{}{}
+	}
+
+	memberCounts := make(map[uint64]uint64, len(s.Groups))
+	for _, m := range s.GroupMembers {
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "group member")
+		}
+		if _, ok := groupIDs[m.GroupId]; !ok {
+			return sdkerrors.Wrapf(ErrInvalid, "group member of unknown group %d", m.GroupId)
+		}
+		memberCounts[m.GroupId]++
+	}
+	for _, g := range s.Groups {
+		if g.MemberCount != memberCounts[g.GroupId] {
+			return sdkerrors.Wrapf(ErrInvalid, "group %d has member count %d but %d members", g.GroupId, g.MemberCount, memberCounts[g.GroupId])
+		}
+	}
+
+	accounts := make(map[string]struct{}, len(s.GroupAccounts))
+	for _, a := range s.GroupAccounts {
+		if err := a.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "group account %s", a.Address)
+		}
+		if _, ok := groupIDs[a.GroupId]; !ok {
+			return sdkerrors.Wrapf(ErrInvalid, "group account %s of unknown group %d", a.Address, a.GroupId)
+		}
+		accounts[a.Address] = struct{}{}
+	}
+
+	proposalIDs := make(map[uint64]struct{}, len(s.Proposals))
+	for _, p := range s.Proposals {
+		if err := p.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "proposal %d", p.ProposalId)
+		}
+		if p.ProposalId > s.ProposalSeq {
+			return sdkerrors.Wrapf(ErrInvalid, "proposal id %d greater than proposal sequence %d", p.ProposalId, s.ProposalSeq)
+		}
+		if _, ok := accounts[p.Address]; !ok {
+			return sdkerrors.Wrapf(ErrInvalid, "proposal %d of unknown group account %s", p.ProposalId, p.Address)
+		}
+		proposalIDs[p.ProposalId] = struct{}{}
+	}
+
+	for _, v := range s.Votes {
+		if err := v.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "vote")
+		}
+		if _, ok := proposalIDs[v.ProposalId]; !ok {
+			return sdkerrors.Wrapf(ErrInvalid, "vote for unknown proposal %d", v.ProposalId)
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,139 @@
+package group
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	_, _, admin := testdata.KeyTestPubAddr()
+	_, _, member := testdata.KeyTestPubAddr()
+	_, _, accountAddr := testdata.KeyTestPubAddr()
+
+	validState := func() GenesisState {
+		account := &GroupAccountInfo{
+			Address:       accountAddr.String(),
+			GroupId:       1,
+			Admin:         admin.String(),
+			Version:       1,
+			DerivationKey: []byte("derivation key"),
+		}
+		require.NoError(t, account.SetDecisionPolicy(NewThresholdDecisionPolicy("1", proto.Duration{Seconds: 1})))
+		return GenesisState{
+			GroupSeq:        1,
+			Groups:          []*GroupInfo{{GroupId: 1, Admin: admin.String(), Version: 1, TotalWeight: "1", MemberCount: 1}},
+			GroupMembers:    []*GroupMember{{GroupId: 1, Member: &Member{Address: member.String(), Weight: "1"}}},
+			GroupAccountSeq: 1,
+			GroupAccounts:   []*GroupAccountInfo{account},
+			ProposalSeq:     1,
+			Proposals: []*Proposal{{
+				ProposalId:          1,
+				Address:             accountAddr.String(),
+				Proposers:           []string{member.String()},
+				SubmittedAt:         proto.Timestamp{Seconds: 1},
+				GroupVersion:        1,
+				GroupAccountVersion: 1,
+				Status:              ProposalStatusSubmitted,
+				Result:              ProposalResultUnfinalized,
+				VoteState:           Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+				Timeout:             proto.Timestamp{Seconds: 2},
+				ExecutorResult:      ProposalExecutorResultNotRun,
+			}},
+			Votes: []*Vote{{ProposalId: 1, Voter: member.String(), Choice: Choice_CHOICE_YES, SubmittedAt: proto.Timestamp{Seconds: 1}}},
+		}
+	}
+
+	specs := map[string]struct {
+		malleate func(s *GenesisState)
+		expErr   bool
+	}{
+		"all good": {
+			malleate: func(s *GenesisState) {},
+		},
+		"empty": {
+			malleate: func(s *GenesisState) { *s = *NewGenesisState() },
+		},
+		"sequences ahead of imported ids": {
+			malleate: func(s *GenesisState) {
+				s.GroupSeq = 10
+				s.ProposalSeq = 10
+			},
+		},
+		"group sequence behind group ids": {
+			malleate: func(s *GenesisState) { s.GroupSeq = 0 },
+			expErr:   true,
+		},
+		"proposal sequence behind proposal ids": {
+			malleate: func(s *GenesisState) { s.ProposalSeq = 0 },
+			expErr:   true,
+		},
+		"invalid group": {
+			malleate: func(s *GenesisState) { s.Groups[0].Admin = "invalid" },
+			expErr:   true,
+		},
+		"member of unknown group": {
+			malleate: func(s *GenesisState) { s.GroupMembers[0].GroupId = 2 },
+			expErr:   true,
+		},
+		"member count below group members": {
+			malleate: func(s *GenesisState) { s.Groups[0].MemberCount = 0 },
+			expErr:   true,
+		},
+		"member count above group members": {
+			malleate: func(s *GenesisState) { s.Groups[0].MemberCount = 2 },
+			expErr:   true,
+		},
+		"group account of unknown group": {
+			malleate: func(s *GenesisState) { s.GroupAccounts[0].GroupId = 2 },
+			expErr:   true,
+		},
+		"proposal of unknown group account": {
+			malleate: func(s *GenesisState) { s.Proposals[0].Address = admin.String() },
+			expErr:   true,
+		},
+		"invalid proposal": {
+			malleate: func(s *GenesisState) { s.Proposals[0].Proposers = nil },
+			expErr:   true,
+		},
+		"proposal with final tally result": {
+			malleate: func(s *GenesisState) {
+				s.Proposals[0].Status = ProposalStatusClosed
+				s.Proposals[0].Result = ProposalResultAccepted
+				s.Proposals[0].FinalTallyResult = &FinalTallyResult{Tally: s.Proposals[0].VoteState, TotalWeight: "1"}
+			},
+		},
+		"proposal with invalid final tally result": {
+			malleate: func(s *GenesisState) {
+				s.Proposals[0].FinalTallyResult = &FinalTallyResult{Tally: Tally{YesCount: "-1"}, TotalWeight: "1"}
+			},
+			expErr: true,
+		},
+		"proposal with invalid earliest execution time": {
+			malleate: func(s *GenesisState) {
+				s.Proposals[0].EarliestExecutionTime = &proto.Timestamp{Nanos: -1}
+			},
+			expErr: true,
+		},
+		"vote for unknown proposal": {
+			malleate: func(s *GenesisState) { s.Votes[0].ProposalId = 2 },
+			expErr:   true,
+		},
+		"invalid vote": {
+			malleate: func(s *GenesisState) { s.Votes[0].Voter = sdk.AccAddress{}.String() },
+			expErr:   true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			state := validState()
+			spec.malleate(&state)
+			err := state.Validate()
+			assert.Equal(t, spec.expErr, err != nil, err)
+		})
+	}
+}
@@ -82,7 +82,7 @@ func (a Module) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (Module) ConsensusVersion() uint64 { return 1 }
+func (Module) ConsensusVersion() uint64 { return 2 }
 
 // AppModuleSimulation functions
 
@@ -121,11 +121,13 @@ func TestMsgCreateGroupAccount(t *testing.T) {
 	_, _, myAddr := testdata.KeyTestPubAddr()
 
 	specs := map[string]struct {
-		admin     sdk.AccAddress
-		group     uint64
-		threshold string
-		timeout   proto.Duration
-		expErr    bool
+		admin            sdk.AccAddress
+		group            uint64
+		threshold        string
+		timeout          proto.Duration
+		spendLimit       sdk.Coins
+		spendLimitPeriod proto.Duration
+		expErr           bool
 	}{
 		"all good with minimum fields set": {
 			admin:     myAddr,
@@ -176,6 +178,31 @@ func TestMsgCreateGroupAccount(t *testing.T) {
 			timeout: proto.Duration{Seconds: 1},
 			expErr:  true,
 		},
+		"spend limit with period": {
+			admin:            myAddr,
+			group:            1,
+			threshold:        "1",
+			timeout:          proto.Duration{Seconds: 1},
+			spendLimit:       sdk.NewCoins(sdk.NewInt64Coin("regen", 100)),
+			spendLimitPeriod: proto.Duration{Seconds: 3600},
+		},
+		"spend limit without period": {
+			admin:      myAddr,
+			group:      1,
+			threshold:  "1",
+			timeout:    proto.Duration{Seconds: 1},
+			spendLimit: sdk.NewCoins(sdk.NewInt64Coin("regen", 100)),
+			expErr:     true,
+		},
+		"invalid spend limit": {
+			admin:            myAddr,
+			group:            1,
+			threshold:        "1",
+			timeout:          proto.Duration{Seconds: 1},
+			spendLimit:       sdk.Coins{sdk.Coin{Denom: "regen", Amount: sdk.ZeroInt()}},
+			spendLimitPeriod: proto.Duration{Seconds: 3600},
+			expErr:           true,
+		},
 		"decision policy with negative threshold": {
 			admin:     myAddr,
 			group:     1,
@@ -196,6 +223,8 @@ func TestMsgCreateGroupAccount(t *testing.T) {
 				},
 			)
 			require.NoError(t, err)
+			m.SpendLimit = spec.spendLimit
+			m.SpendLimitPeriod = spec.spendLimitPeriod
 
 			if spec.expErr {
 				require.Error(t, m.ValidateBasic())
@@ -206,6 +235,78 @@ func TestMsgCreateGroupAccount(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateGroupMembers(t *testing.T) {
+	_, _, myAddr := testdata.KeyTestPubAddr()
+	_, _, member1 := testdata.KeyTestPubAddr()
+	_, _, member2 := testdata.KeyTestPubAddr()
+
+	specs := map[string]struct {
+		src    MsgUpdateGroupMembers
+		expErr bool
+	}{
+		"all good with add, update and remove": {
+			src: MsgUpdateGroupMembers{
+				GroupId: 1,
+				Admin:   myAddr.String(),
+				MemberUpdates: []Member{
+					{Address: member1.String(), Weight: "0.5"},
+					{Address: member2.String(), Weight: "0"},
+				},
+			},
+		},
+		"group id required": {
+			src: MsgUpdateGroupMembers{
+				Admin:         myAddr.String(),
+				MemberUpdates: []Member{{Address: member1.String(), Weight: "1"}},
+			},
+			expErr: true,
+		},
+		"admin required": {
+			src: MsgUpdateGroupMembers{
+				GroupId:       1,
+				MemberUpdates: []Member{{Address: member1.String(), Weight: "1"}},
+			},
+			expErr: true,
+		},
+		"member updates required": {
+			src: MsgUpdateGroupMembers{
+				GroupId: 1,
+				Admin:   myAddr.String(),
+			},
+			expErr: true,
+		},
+		"negative weight not allowed": {
+			src: MsgUpdateGroupMembers{
+				GroupId:       1,
+				Admin:         myAddr.String(),
+				MemberUpdates: []Member{{Address: member1.String(), Weight: "-1"}},
+			},
+			expErr: true,
+		},
+		"duplicate members not allowed": {
+			src: MsgUpdateGroupMembers{
+				GroupId: 1,
+				Admin:   myAddr.String(),
+				MemberUpdates: []Member{
+					{Address: member1.String(), Weight: "1"},
+					{Address: member1.String(), Weight: "0"},
+				},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestMsgCreateProposalRequest(t *testing.T) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	groupAccAddr := addr.String()
@@ -341,3 +442,93 @@ func TestMsgVote(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPruneProposal(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	specs := map[string]struct {
+		src    MsgPruneProposal
+		expErr bool
+	}{
+		"all good": {
+			src: MsgPruneProposal{
+				ProposalId: 1,
+				Signer:     addr.String(),
+			},
+		},
+		"proposal required": {
+			src: MsgPruneProposal{
+				Signer: addr.String(),
+			},
+			expErr: true,
+		},
+		"signer required": {
+			src: MsgPruneProposal{
+				ProposalId: 1,
+			},
+			expErr: true,
+		},
+		"valid signer address required": {
+			src: MsgPruneProposal{
+				ProposalId: 1,
+				Signer:     "invalid-signer-address",
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgWithdrawProposal(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	specs := map[string]struct {
+		src    MsgWithdrawProposal
+		expErr bool
+	}{
+		"all good": {
+			src: MsgWithdrawProposal{
+				ProposalId: 1,
+				Address:    addr.String(),
+			},
+		},
+		"proposal required": {
+			src: MsgWithdrawProposal{
+				Address: addr.String(),
+			},
+			expErr: true,
+		},
+		"address required": {
+			src: MsgWithdrawProposal{
+				ProposalId: 1,
+			},
+			expErr: true,
+		},
+		"valid address required": {
+			src: MsgWithdrawProposal{
+				ProposalId: 1,
+				Address:    "invalid-address",
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
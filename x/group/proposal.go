@@ -1,9 +1,14 @@
 package group
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/types/module/server"
 )
 
@@ -24,6 +29,39 @@ func (p *Proposal) SetMsgs(msgs []sdk.Msg) error {
 	return nil
 }
 
+// VotingPeriodEnd returns the end of the voting window of the proposal, from which on no votes are accepted anymore.
+func (p Proposal) VotingPeriodEnd() (time.Time, error) {
+	return gogotypes.TimestampFromProto(&p.Timeout)
+}
+
+// ExecutionDelayElapsed returns whether the execution delay of the proposal is over at the given time.
+// This is always the case for proposals without an earliest execution time.
+func (p Proposal) ExecutionDelayElapsed(t time.Time) (bool, error) {
+	if p.EarliestExecutionTime == nil {
+		return true, nil
+	}
+	earliest, err := gogotypes.TimestampFromProto(p.EarliestExecutionTime)
+	if err != nil {
+		return false, err
+	}
+	return !t.Before(earliest), nil
+}
+
+// IsFinalized returns whether the proposal can't change anymore, i.e. it was aborted, withdrawn,
+// rejected, or accepted and successfully executed.
+func (p Proposal) IsFinalized() bool {
+	switch {
+	case p.Status == ProposalStatusAborted, p.Status == ProposalStatusWithdrawn:
+		return true
+	case p.Status != ProposalStatusClosed:
+		return false
+	case p.Result == ProposalResultRejected:
+		return true
+	default:
+		return p.Result == ProposalResultAccepted && p.ExecutorResult == ProposalExecutorResultSuccess
+	}
+}
+
 func (p Proposal) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(p.Address)
 	if err != nil {
@@ -78,6 +116,25 @@ func (p Proposal) ValidateBasic() error {
 	if p.Timeout.Seconds == 0 && p.Timeout.Nanos == 0 {
 		return sdkerrors.Wrap(ErrEmpty, "timeout")
 	}
+	// group total weight is optional for proposals submitted before it was snapshotted
+	if p.GroupTotalWeight != "" {
+		if _, err := math.NewNonNegativeDecFromString(p.GroupTotalWeight); err != nil {
+			return sdkerrors.Wrap(err, "group total weight")
+		}
+	}
+	if p.FinalTallyResult != nil {
+		if err := p.FinalTallyResult.Tally.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "final tally result")
+		}
+		if _, err := math.NewNonNegativeDecFromString(p.FinalTallyResult.TotalWeight); err != nil {
+			return sdkerrors.Wrap(err, "final tally result total weight")
+		}
+	}
+	if p.EarliestExecutionTime != nil {
+		if _, err := gogotypes.TimestampFromProto(p.EarliestExecutionTime); err != nil {
+			return sdkerrors.Wrap(err, "earliest execution time")
+		}
+	}
 	msgs := p.GetMsgs()
 	for i, msg := range msgs {
 		if err := msg.ValidateBasic(); err != nil {
@@ -6,6 +6,8 @@ package group
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -856,6 +858,125 @@ func (m *QueryProposalsByGroupAccountResponse) GetPagination() *query.PageRespon
 	return nil
 }
 
+// QueryProposalsByStatusRequest is the Query/ProposalsByStatus request type.
+type QueryProposalsByStatusRequest struct {
+	// address is the group account address related to proposals.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// status is the status of the proposals.
+	Status Proposal_Status `protobuf:"varint,2,opt,name=status,proto3,enum=regen.group.v1alpha1.Proposal_Status" json:"status,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsByStatusRequest) Reset()         { *m = QueryProposalsByStatusRequest{} }
+func (m *QueryProposalsByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByStatusRequest) ProtoMessage()    {}
+func (*QueryProposalsByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2523b81f3b315123, []int{16}
+}
+func (m *QueryProposalsByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByStatusRequest.Merge(m, src)
+}
+func (m *QueryProposalsByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsByStatusRequest proto.InternalMessageInfo
+
+func (m *QueryProposalsByStatusRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryProposalsByStatusRequest) GetStatus() Proposal_Status {
+	if m != nil {
+		return m.Status
+	}
+	return ProposalStatusInvalid
+}
+
+func (m *QueryProposalsByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProposalsByStatusResponse is the Query/ProposalsByStatus response type.
+type QueryProposalsByStatusResponse struct {
+	// proposals are the proposals with given group account and status.
+	Proposals []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsByStatusResponse) Reset()         { *m = QueryProposalsByStatusResponse{} }
+func (m *QueryProposalsByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByStatusResponse) ProtoMessage()    {}
+func (*QueryProposalsByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2523b81f3b315123, []int{17}
+}
+func (m *QueryProposalsByStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByStatusResponse.Merge(m, src)
+}
+func (m *QueryProposalsByStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsByStatusResponse proto.InternalMessageInfo
+
+func (m *QueryProposalsByStatusResponse) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryProposalsByStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryVoteByProposalVoterResponse is the Query/VoteByProposalVoter request type.
 type QueryVoteByProposalVoterRequest struct {
 	// proposal_id is the unique ID of a proposal.
@@ -868,7 +989,7 @@ func (m *QueryVoteByProposalVoterRequest) Reset()         { *m = QueryVoteByProp
 func (m *QueryVoteByProposalVoterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteByProposalVoterRequest) ProtoMessage()    {}
 func (*QueryVoteByProposalVoterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2523b81f3b315123, []int{16}
+	return fileDescriptor_2523b81f3b315123, []int{18}
 }
 func (m *QueryVoteByProposalVoterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -921,7 +1042,7 @@ func (m *QueryVoteByProposalVoterResponse) Reset()         { *m = QueryVoteByPro
 func (m *QueryVoteByProposalVoterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteByProposalVoterResponse) ProtoMessage()    {}
 func (*QueryVoteByProposalVoterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2523b81f3b315123, []int{17}
+	return fileDescriptor_2523b81f3b315123, []int{19}
 }
 func (m *QueryVoteByProposalVoterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -969,7 +1090,7 @@ func (m *QueryVotesByProposalRequest) Reset()         { *m = QueryVotesByProposa
 func (m *QueryVotesByProposalRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesByProposalRequest) ProtoMessage()    {}
 func (*QueryVotesByProposalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2523b81f3b315123, []int{18}
+	return fileDescriptor_2523b81f3b315123, []int{20}
 }
 func (m *QueryVotesByProposalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1145,7 @@ func (m *QueryVotesByProposalResponse) Reset()         { *m = QueryVotesByPropos
 func (m *QueryVotesByProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesByProposalResponse) ProtoMessage()    {}
 func (*QueryVotesByProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2523b81f3b315123, []int{19}
+	return fileDescriptor_2523b81f3b315123, []int{21}
 }
 func (m *QueryVotesByProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1071,7 +1192,7 @@ func (m *QueryVotesByProposalResponse) GetPagination() *query.PageResponse {
 type QueryVotesByVoterRequest struct {
 	// voter is a proposal voter account address.
 	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
-	// pagination defines an optional pagination for the request.
+	// pagination defines an optional pagination for the request, in descending proposal ID order if reverse is set.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
@@ -1079,7 +1200,7 @@ func (m *QueryVotesByVoterRequest) Reset()         { *m = QueryVotesByVoterReque
 func (m *QueryVotesByVoterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesByVoterRequest) ProtoMessage()    {}
 func (*QueryVotesByVoterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2523b81f3b315123, []int{20}
+	return fileDescriptor_2523b81f3b315123, []int{22}
 }
 func (m *QueryVotesByVoterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1134,7 +1255,7 @@ func (m *QueryVotesByVoterResponse) Reset()         { *m = QueryVotesByVoterResp
 func (m *QueryVotesByVoterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesByVoterResponse) ProtoMessage()    {}
 func (*QueryVotesByVoterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2523b81f3b315123, []int{21}
+	return fileDescriptor_2523b81f3b315123, []int{23}
 }
 func (m *QueryVotesByVoterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1177,6 +1298,229 @@ func (m *QueryVotesByVoterResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryGroupAccountBalanceRequest is the Query/GroupAccountBalance request type.
+type QueryGroupAccountBalanceRequest struct {
+	// address is the account address of the group account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryGroupAccountBalanceRequest) Reset()         { *m = QueryGroupAccountBalanceRequest{} }
+func (m *QueryGroupAccountBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupAccountBalanceRequest) ProtoMessage()    {}
+func (*QueryGroupAccountBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2523b81f3b315123, []int{24}
+}
+func (m *QueryGroupAccountBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupAccountBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupAccountBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupAccountBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupAccountBalanceRequest.Merge(m, src)
+}
+func (m *QueryGroupAccountBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupAccountBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupAccountBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupAccountBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryGroupAccountBalanceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryGroupAccountBalanceResponse is the Query/GroupAccountBalance response type.
+type QueryGroupAccountBalanceResponse struct {
+	// balance is the bank balance of the group account.
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *QueryGroupAccountBalanceResponse) Reset()         { *m = QueryGroupAccountBalanceResponse{} }
+func (m *QueryGroupAccountBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupAccountBalanceResponse) ProtoMessage()    {}
+func (*QueryGroupAccountBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2523b81f3b315123, []int{25}
+}
+func (m *QueryGroupAccountBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupAccountBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupAccountBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupAccountBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupAccountBalanceResponse.Merge(m, src)
+}
+func (m *QueryGroupAccountBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupAccountBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupAccountBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupAccountBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryGroupAccountBalanceResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+// QueryTallyResultRequest is the Query/TallyResult request type.
+type QueryTallyResultRequest struct {
+	// proposal_id is the unique id of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryTallyResultRequest) Reset()         { *m = QueryTallyResultRequest{} }
+func (m *QueryTallyResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyResultRequest) ProtoMessage()    {}
+func (*QueryTallyResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2523b81f3b315123, []int{26}
+}
+func (m *QueryTallyResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyResultRequest.Merge(m, src)
+}
+func (m *QueryTallyResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyResultRequest proto.InternalMessageInfo
+
+func (m *QueryTallyResultRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryTallyResultResponse is the Query/TallyResult response type.
+type QueryTallyResultResponse struct {
+	// tally is the sum of weighted votes of the proposal.
+	Tally Tally `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally"`
+	// total_weight is the total group weight the votes are tallied against, i.e. the one at the
+	// submission of the proposal.
+	TotalWeight string `protobuf:"bytes,2,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
+	// finalized is true when the proposal is finalized and the tally is its final tally result.
+	Finalized bool `protobuf:"varint,3,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	// would_pass is whether the tally is enough for the decision policy to accept the proposal
+	// at the current block time. For finalized proposals, it's whether the proposal was accepted.
+	WouldPass bool `protobuf:"varint,4,opt,name=would_pass,json=wouldPass,proto3" json:"would_pass,omitempty"`
+	// would_abort is true when the group or group account was modified since the submission of the
+	// open proposal, so that it would be aborted instead of tallied. would_pass is false then.
+	WouldAbort bool `protobuf:"varint,5,opt,name=would_abort,json=wouldAbort,proto3" json:"would_abort,omitempty"`
+}
+
+func (m *QueryTallyResultResponse) Reset()         { *m = QueryTallyResultResponse{} }
+func (m *QueryTallyResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyResultResponse) ProtoMessage()    {}
+func (*QueryTallyResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2523b81f3b315123, []int{27}
+}
+func (m *QueryTallyResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyResultResponse.Merge(m, src)
+}
+func (m *QueryTallyResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyResultResponse proto.InternalMessageInfo
+
+func (m *QueryTallyResultResponse) GetTally() Tally {
+	if m != nil {
+		return m.Tally
+	}
+	return Tally{}
+}
+
+func (m *QueryTallyResultResponse) GetTotalWeight() string {
+	if m != nil {
+		return m.TotalWeight
+	}
+	return ""
+}
+
+func (m *QueryTallyResultResponse) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
+func (m *QueryTallyResultResponse) GetWouldPass() bool {
+	if m != nil {
+		return m.WouldPass
+	}
+	return false
+}
+
+func (m *QueryTallyResultResponse) GetWouldAbort() bool {
+	if m != nil {
+		return m.WouldAbort
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "regen.group.v1alpha1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "regen.group.v1alpha1.QueryGroupInfoResponse")
@@ -1194,87 +1538,113 @@ func init() {
 	proto.RegisterType((*QueryProposalResponse)(nil), "regen.group.v1alpha1.QueryProposalResponse")
 	proto.RegisterType((*QueryProposalsByGroupAccountRequest)(nil), "regen.group.v1alpha1.QueryProposalsByGroupAccountRequest")
 	proto.RegisterType((*QueryProposalsByGroupAccountResponse)(nil), "regen.group.v1alpha1.QueryProposalsByGroupAccountResponse")
+	proto.RegisterType((*QueryProposalsByStatusRequest)(nil), "regen.group.v1alpha1.QueryProposalsByStatusRequest")
+	proto.RegisterType((*QueryProposalsByStatusResponse)(nil), "regen.group.v1alpha1.QueryProposalsByStatusResponse")
 	proto.RegisterType((*QueryVoteByProposalVoterRequest)(nil), "regen.group.v1alpha1.QueryVoteByProposalVoterRequest")
 	proto.RegisterType((*QueryVoteByProposalVoterResponse)(nil), "regen.group.v1alpha1.QueryVoteByProposalVoterResponse")
 	proto.RegisterType((*QueryVotesByProposalRequest)(nil), "regen.group.v1alpha1.QueryVotesByProposalRequest")
 	proto.RegisterType((*QueryVotesByProposalResponse)(nil), "regen.group.v1alpha1.QueryVotesByProposalResponse")
 	proto.RegisterType((*QueryVotesByVoterRequest)(nil), "regen.group.v1alpha1.QueryVotesByVoterRequest")
 	proto.RegisterType((*QueryVotesByVoterResponse)(nil), "regen.group.v1alpha1.QueryVotesByVoterResponse")
+	proto.RegisterType((*QueryGroupAccountBalanceRequest)(nil), "regen.group.v1alpha1.QueryGroupAccountBalanceRequest")
+	proto.RegisterType((*QueryGroupAccountBalanceResponse)(nil), "regen.group.v1alpha1.QueryGroupAccountBalanceResponse")
+	proto.RegisterType((*QueryTallyResultRequest)(nil), "regen.group.v1alpha1.QueryTallyResultRequest")
+	proto.RegisterType((*QueryTallyResultResponse)(nil), "regen.group.v1alpha1.QueryTallyResultResponse")
 }
 
 func init() { proto.RegisterFile("regen/group/v1alpha1/query.proto", fileDescriptor_2523b81f3b315123) }
 
 var fileDescriptor_2523b81f3b315123 = []byte{
-	// 1090 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x94, 0xb4, 0x49, 0x5e, 0x5a, 0x8a, 0x06, 0x03, 0xee, 0x52, 0x9c, 0x74, 0xa9, 0x4a,
-	0xd5, 0xc4, 0x3b, 0xb1, 0x4d, 0xea, 0x90, 0x80, 0x44, 0x8d, 0x44, 0x94, 0x43, 0xa4, 0x62, 0x24,
-	0x84, 0xe0, 0x80, 0xd6, 0xf1, 0x74, 0x6b, 0x11, 0xef, 0xb8, 0xbb, 0xeb, 0xd0, 0x28, 0xb2, 0x84,
-	0x90, 0x80, 0x2b, 0x12, 0x12, 0x12, 0x08, 0x90, 0x38, 0xc1, 0x85, 0x1b, 0x9c, 0x90, 0x90, 0xb8,
-	0xc1, 0x01, 0xa9, 0x82, 0x0b, 0x47, 0x94, 0xf0, 0x87, 0xa0, 0x9d, 0x1f, 0xf6, 0xae, 0x3d, 0x5e,
-	0xef, 0x56, 0x56, 0xe0, 0xe6, 0x99, 0xbc, 0x6f, 0xde, 0xf7, 0xbe, 0xf7, 0x76, 0xe6, 0x53, 0x60,
-	0xd9, 0xa3, 0x0e, 0x75, 0x89, 0xe3, 0xb1, 0x6e, 0x87, 0x1c, 0x94, 0xec, 0xfd, 0xce, 0x5d, 0xbb,
-	0x44, 0xee, 0x75, 0xa9, 0x77, 0x68, 0x75, 0x3c, 0x16, 0x30, 0x9c, 0xe3, 0x11, 0x16, 0x8f, 0xb0,
-	0x54, 0x84, 0xa1, 0xc7, 0x05, 0x87, 0x1d, 0xea, 0x0b, 0x9c, 0x71, 0xd9, 0x61, 0xcc, 0xd9, 0xa7,
-	0xc4, 0xee, 0xb4, 0x88, 0xed, 0xba, 0x2c, 0xb0, 0x83, 0x16, 0x73, 0xd5, 0x5f, 0x73, 0x0e, 0x73,
-	0x18, 0xff, 0x49, 0xc2, 0x5f, 0x72, 0xf7, 0xc6, 0x1e, 0xf3, 0xdb, 0xcc, 0x27, 0x0d, 0xdb, 0xa7,
-	0x82, 0x04, 0x39, 0x28, 0x35, 0x68, 0x60, 0x97, 0x48, 0xc7, 0x76, 0x5a, 0x2e, 0x3f, 0x42, 0xc4,
-	0x9a, 0x65, 0x78, 0xe2, 0xb5, 0x30, 0x62, 0x3b, 0xa4, 0xb0, 0xe3, 0xde, 0x61, 0x75, 0x7a, 0xaf,
-	0x4b, 0xfd, 0x00, 0x5f, 0x82, 0x79, 0x4e, 0xeb, 0x9d, 0x56, 0x33, 0x8f, 0x96, 0xd1, 0xf5, 0xd9,
-	0xfa, 0x1c, 0x5f, 0xef, 0x34, 0xcd, 0x5d, 0x78, 0x72, 0x18, 0xe3, 0x77, 0x98, 0xeb, 0x53, 0x5c,
-	0x81, 0xd9, 0x96, 0x7b, 0x87, 0x71, 0xc0, 0x62, 0x79, 0xc9, 0xd2, 0x15, 0x6d, 0x0d, 0x60, 0x3c,
-	0xd8, 0xdc, 0x80, 0xcb, 0x83, 0xe3, 0x6e, 0xed, 0xed, 0xb1, 0xae, 0x1b, 0x44, 0x99, 0xe4, 0x61,
-	0xce, 0x6e, 0x36, 0x3d, 0xea, 0xfb, 0xfc, 0xdc, 0x85, 0xba, 0x5a, 0x9a, 0x6f, 0xc3, 0x33, 0x63,
-	0x90, 0x92, 0xcf, 0x66, 0x8c, 0xcf, 0xb5, 0x04, 0x3e, 0x51, 0xb4, 0xa0, 0xd5, 0x83, 0xfc, 0xe0,
-	0xf0, 0x5d, 0xda, 0x6e, 0x50, 0xcf, 0x9f, 0x2c, 0x0e, 0x7e, 0x15, 0x60, 0x20, 0x72, 0xfe, 0x8c,
-	0x4c, 0x2c, 0x3a, 0x62, 0x85, 0x1d, 0xb1, 0xc4, 0x58, 0xc8, 0x8e, 0x58, 0xb7, 0x6d, 0x87, 0xca,
-	0x63, 0xeb, 0x11, 0xa4, 0xf9, 0x0d, 0x82, 0x4b, 0x9a, 0xfc, 0xb2, 0xb0, 0x2d, 0x98, 0x6b, 0x8b,
-	0xad, 0x3c, 0x5a, 0x7e, 0xe4, 0xfa, 0x62, 0xf9, 0x4a, 0x42, 0x6d, 0x02, 0x5c, 0x57, 0x08, 0xbc,
-	0xad, 0xa1, 0xf8, 0xdc, 0x44, 0x8a, 0x22, 0x73, 0x8c, 0xe3, 0x61, 0x94, 0xa2, 0x5f, 0x3b, 0xbc,
-	0xd5, 0x6c, 0xb7, 0x5c, 0xa5, 0x51, 0x0e, 0xce, 0xda, 0xe1, 0x5a, 0x36, 0x4d, 0x2c, 0xa6, 0x26,
-	0xcf, 0xd7, 0x08, 0x0c, 0x5d, 0x6e, 0xa9, 0x4f, 0x15, 0xce, 0x71, 0x25, 0x94, 0x3c, 0x13, 0x47,
-	0x51, 0x86, 0x4f, 0x4f, 0x9b, 0x0f, 0x11, 0x2c, 0x8f, 0x0c, 0xa7, 0x5f, 0x13, 0xcb, 0x53, 0x9c,
-	0xa3, 0x9f, 0x10, 0x5c, 0x49, 0xe0, 0x21, 0xf5, 0xda, 0x85, 0x47, 0x05, 0x11, 0x5b, 0x06, 0x48,
-	0xdd, 0xd2, 0x7e, 0x32, 0x17, 0x9c, 0xe8, 0xe9, 0xd3, 0x53, 0xf1, 0xfd, 0x31, 0x2a, 0x9e, 0xe2,
-	0xa4, 0x8d, 0x13, 0x30, 0x3e, 0x70, 0xff, 0x57, 0x01, 0xab, 0x90, 0xe3, 0xe4, 0x6f, 0x7b, 0xac,
-	0xc3, 0x7c, 0x7b, 0x5f, 0x69, 0xb6, 0x04, 0x8b, 0x1d, 0xb9, 0x35, 0x18, 0x3e, 0x50, 0x5b, 0x3b,
-	0x4d, 0xf3, 0x75, 0xf9, 0x30, 0x0c, 0x80, 0xfd, 0x3b, 0x75, 0x5e, 0x85, 0xc9, 0x7b, 0xb5, 0xa0,
-	0xaf, 0xb1, 0x8f, 0xec, 0xc7, 0x9b, 0x1f, 0x23, 0x78, 0x36, 0x76, 0xaa, 0x1a, 0x44, 0x59, 0xf8,
-	0xc4, 0x2b, 0x7f, 0x6a, 0x5d, 0xfd, 0x1e, 0xc1, 0xd5, 0x64, 0x26, 0xb2, 0xdc, 0x17, 0x61, 0x41,
-	0xd1, 0x57, 0x3d, 0x9d, 0x54, 0xef, 0x00, 0x30, 0xbd, 0x3e, 0xbe, 0x09, 0x4b, 0x9c, 0xee, 0x1b,
-	0x2c, 0xa0, 0xb5, 0x3e, 0xe9, 0x70, 0xe5, 0xa5, 0x6d, 0x69, 0xf8, 0x9d, 0x1c, 0x84, 0x00, 0xce,
-	0x63, 0xa1, 0x2e, 0x16, 0x66, 0x5d, 0x7e, 0x61, 0xda, 0x93, 0xa5, 0x08, 0x16, 0xcc, 0x86, 0xc1,
-	0xb2, 0xdf, 0x86, 0xbe, 0xfe, 0x10, 0x52, 0xe7, 0x71, 0xe6, 0x47, 0x08, 0x9e, 0xee, 0x1f, 0xea,
-	0xd7, 0x32, 0x4f, 0xdf, 0xd4, 0xda, 0xfc, 0x39, 0x92, 0xe6, 0x62, 0x84, 0x88, 0xac, 0x6c, 0x4d,
-	0x68, 0xa2, 0x5a, 0x9b, 0x54, 0x9a, 0x08, 0x9c, 0x5e, 0x4b, 0xef, 0x4b, 0x83, 0x21, 0xa9, 0xc5,
-	0x7a, 0xd9, 0x6f, 0x15, 0x8a, 0xb4, 0x6a, 0x6a, 0xaa, 0x7c, 0xa6, 0xbc, 0x45, 0x3c, 0xf5, 0x7f,
-	0x2e, 0x49, 0xf9, 0xf7, 0x8b, 0x70, 0x96, 0x13, 0xc3, 0x5f, 0x22, 0x58, 0xe8, 0xbf, 0xce, 0x78,
-	0x45, 0xcf, 0x41, 0xeb, 0x5c, 0x8d, 0xd5, 0x74, 0xc1, 0x22, 0xbd, 0xf9, 0xfc, 0x07, 0x7f, 0xfe,
-	0xf3, 0xe9, 0x19, 0x0b, 0xaf, 0x12, 0xad, 0x17, 0x17, 0xb6, 0x80, 0x1c, 0xa9, 0x67, 0xba, 0x47,
-	0x42, 0x73, 0x88, 0x7f, 0x40, 0xf0, 0xd8, 0xf0, 0x1d, 0x8e, 0xcb, 0x93, 0x12, 0x8f, 0x9a, 0x5b,
-	0xa3, 0x92, 0x09, 0x23, 0x39, 0x57, 0x39, 0xe7, 0x12, 0x26, 0x89, 0x9c, 0xd5, 0x4b, 0x44, 0x8e,
-	0xe4, 0xe5, 0xd9, 0xc3, 0xdf, 0x21, 0x38, 0x1f, 0xf5, 0x93, 0xd8, 0x9a, 0x94, 0x3e, 0x6e, 0x7c,
-	0x0d, 0x92, 0x3a, 0x3e, 0x13, 0xd5, 0x88, 0xbc, 0xca, 0xa4, 0x7e, 0x8b, 0xe0, 0x42, 0xcc, 0xdb,
-	0xe1, 0x89, 0xb9, 0x87, 0x7c, 0x81, 0xb1, 0x96, 0x1e, 0x20, 0xd9, 0x56, 0x38, 0xdb, 0x22, 0x5e,
-	0x49, 0x16, 0x36, 0xc4, 0x70, 0x59, 0xdb, 0x2d, 0xb7, 0x87, 0x7f, 0x41, 0x90, 0xd3, 0x99, 0x2b,
-	0x7c, 0x33, 0x65, 0x6f, 0x87, 0x5c, 0xa1, 0x51, 0xcd, 0x8c, 0x93, 0xf4, 0x37, 0x38, 0xfd, 0x32,
-	0x5e, 0x4b, 0x2b, 0xb6, 0x1a, 0x11, 0xfc, 0xf3, 0x68, 0x0d, 0x42, 0xf4, 0x0c, 0x35, 0xc4, 0xb4,
-	0xaf, 0x66, 0xc6, 0xc9, 0x1a, 0xd6, 0x79, 0x0d, 0x04, 0x17, 0xf5, 0x35, 0xc4, 0xb5, 0x1f, 0x14,
-	0xf0, 0x05, 0x82, 0x79, 0x75, 0xb9, 0xe3, 0x1b, 0x09, 0xc9, 0x87, 0x9e, 0x22, 0x63, 0x25, 0x55,
-	0x6c, 0x3a, 0x72, 0xfd, 0x77, 0x9f, 0x1c, 0x45, 0x9e, 0xb7, 0x1e, 0xfe, 0x03, 0xc1, 0x53, 0x63,
-	0x7c, 0x06, 0x7e, 0x21, 0x45, 0x7e, 0xbd, 0x4b, 0x32, 0x36, 0x1f, 0x06, 0x2a, 0x2b, 0x79, 0x99,
-	0x57, 0xb2, 0x89, 0x37, 0x12, 0x46, 0xa5, 0x38, 0x7a, 0x83, 0x0c, 0x4a, 0xc4, 0xbf, 0x21, 0x78,
-	0x5c, 0xe3, 0x19, 0xf0, 0x7a, 0x02, 0xab, 0xf1, 0xee, 0xc5, 0xb8, 0x99, 0x15, 0x26, 0x0b, 0x79,
-	0x85, 0x17, 0xf2, 0x12, 0xde, 0xca, 0xd4, 0x12, 0xc2, 0x1f, 0x2e, 0x72, 0xc4, 0xdf, 0xd5, 0x1e,
-	0xfe, 0x11, 0xc1, 0xc5, 0x21, 0x87, 0x80, 0x4b, 0x13, 0x08, 0x8d, 0xda, 0x1a, 0xa3, 0x9c, 0x05,
-	0x22, 0xf9, 0x6f, 0x71, 0xfe, 0xeb, 0xb8, 0xf2, 0x10, 0xfc, 0xf1, 0x57, 0x08, 0xce, 0x47, 0xdf,
-	0xf0, 0xc4, 0xfb, 0x5c, 0xe3, 0x33, 0x12, 0xef, 0x73, 0x9d, 0x39, 0x30, 0x57, 0x39, 0xdd, 0x6b,
-	0xf8, 0xaa, 0x9e, 0x2e, 0xd7, 0xb3, 0xaf, 0x6b, 0x6d, 0xfb, 0xd7, 0xe3, 0x02, 0x7a, 0x70, 0x5c,
-	0x40, 0x7f, 0x1f, 0x17, 0xd0, 0x27, 0x27, 0x85, 0x99, 0x07, 0x27, 0x85, 0x99, 0xbf, 0x4e, 0x0a,
-	0x33, 0x6f, 0x15, 0x9d, 0x56, 0x70, 0xb7, 0xdb, 0xb0, 0xf6, 0x58, 0x5b, 0x9c, 0x54, 0x74, 0x69,
-	0xf0, 0x1e, 0xf3, 0xde, 0x95, 0xab, 0x7d, 0xda, 0x74, 0xa8, 0x47, 0xee, 0x8b, 0x04, 0x8d, 0x73,
-	0xfc, 0xbf, 0x55, 0x95, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xf2, 0xc6, 0xce, 0x69, 0x13,
-	0x00, 0x00,
+	// 1418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xd1, 0x6f, 0xdb, 0xd4,
+	0x17, 0xee, 0xdd, 0xda, 0xb5, 0x3d, 0xdd, 0xf6, 0xfb, 0x71, 0x97, 0xb1, 0xcc, 0xeb, 0xd2, 0xce,
+	0x8c, 0x31, 0x6d, 0x8b, 0xdd, 0x24, 0x5b, 0x5b, 0x5a, 0x86, 0x58, 0x26, 0x31, 0x86, 0x34, 0xa9,
+	0x78, 0x08, 0x10, 0x3c, 0x4c, 0x4e, 0xe2, 0x79, 0xd6, 0x12, 0xdf, 0xcc, 0xd7, 0xd9, 0x56, 0xaa,
+	0x48, 0x08, 0x09, 0x10, 0x6f, 0x48, 0x93, 0x90, 0x40, 0x80, 0xc4, 0xd3, 0x00, 0x89, 0x37, 0x78,
+	0x42, 0x42, 0x20, 0xf1, 0x30, 0xde, 0x2a, 0xe0, 0x81, 0x27, 0x40, 0x2d, 0x7f, 0x08, 0xf2, 0xbd,
+	0xd7, 0x89, 0x9d, 0x38, 0x8e, 0x5d, 0xa2, 0xb1, 0xa7, 0xf4, 0x5e, 0x9f, 0xef, 0xde, 0xef, 0xfb,
+	0xce, 0xf1, 0xf5, 0xb9, 0x2a, 0xcc, 0x3b, 0x86, 0x69, 0xd8, 0xaa, 0xe9, 0x90, 0x56, 0x53, 0xbd,
+	0x5d, 0xd0, 0xeb, 0xcd, 0x1b, 0x7a, 0x41, 0xbd, 0xd5, 0x32, 0x9c, 0x75, 0xa5, 0xe9, 0x10, 0x97,
+	0xe0, 0x0c, 0x8b, 0x50, 0x58, 0x84, 0xe2, 0x47, 0x48, 0xd1, 0x38, 0x77, 0xbd, 0x69, 0x50, 0x8e,
+	0x93, 0x66, 0x4d, 0x42, 0xcc, 0xba, 0xa1, 0xea, 0x4d, 0x4b, 0xd5, 0x6d, 0x9b, 0xb8, 0xba, 0x6b,
+	0x11, 0xdb, 0x7f, 0x9a, 0x31, 0x89, 0x49, 0xd8, 0x9f, 0xaa, 0xf7, 0x97, 0x98, 0x3d, 0x55, 0x25,
+	0xb4, 0x41, 0xa8, 0x5a, 0xd1, 0xa9, 0xc1, 0x49, 0xa8, 0xb7, 0x0b, 0x15, 0xc3, 0xd5, 0x0b, 0x6a,
+	0x53, 0x37, 0x2d, 0x9b, 0x2d, 0x21, 0x62, 0x73, 0xc1, 0x58, 0x3f, 0xaa, 0x4a, 0x2c, 0xf1, 0x5c,
+	0x2e, 0xc2, 0xc1, 0x97, 0xbc, 0x15, 0x2e, 0x79, 0x14, 0x2f, 0xdb, 0xd7, 0x89, 0x66, 0xdc, 0x6a,
+	0x19, 0xd4, 0xc5, 0x87, 0x61, 0x8a, 0xd1, 0xbe, 0x66, 0xd5, 0xb2, 0x68, 0x1e, 0x9d, 0x1c, 0xd7,
+	0x26, 0xd9, 0xf8, 0x72, 0x4d, 0xbe, 0x02, 0x8f, 0xf7, 0x62, 0x68, 0x93, 0xd8, 0xd4, 0xc0, 0x25,
+	0x18, 0xb7, 0xec, 0xeb, 0x84, 0x01, 0x66, 0x8a, 0x73, 0x4a, 0x94, 0x29, 0x4a, 0x17, 0xc6, 0x82,
+	0xe5, 0x65, 0x98, 0xed, 0x2e, 0x77, 0xa1, 0x5a, 0x25, 0x2d, 0xdb, 0x0d, 0x32, 0xc9, 0xc2, 0xa4,
+	0x5e, 0xab, 0x39, 0x06, 0xa5, 0x6c, 0xdd, 0x69, 0xcd, 0x1f, 0xca, 0x6f, 0xc0, 0xd1, 0x01, 0x48,
+	0xc1, 0x67, 0x25, 0xc4, 0xe7, 0x44, 0x0c, 0x9f, 0x20, 0x9a, 0xd3, 0x6a, 0x43, 0xb6, 0xbb, 0xf8,
+	0x15, 0xa3, 0x51, 0x31, 0x1c, 0x3a, 0xdc, 0x1c, 0xfc, 0x3c, 0x40, 0x37, 0x09, 0xd9, 0x5d, 0x62,
+	0x63, 0x9e, 0x05, 0xc5, 0xcb, 0x82, 0xc2, 0xcb, 0x46, 0xe4, 0x42, 0x59, 0xd3, 0x4d, 0x43, 0x2c,
+	0xab, 0x05, 0x90, 0xf2, 0xe7, 0x08, 0x0e, 0x47, 0xec, 0x2f, 0x84, 0xad, 0xc2, 0x64, 0x83, 0x4f,
+	0x65, 0xd1, 0xfc, 0xee, 0x93, 0x33, 0xc5, 0x63, 0x31, 0xda, 0x38, 0x58, 0xf3, 0x11, 0xf8, 0x52,
+	0x04, 0xc5, 0xa7, 0x86, 0x52, 0xe4, 0x3b, 0x87, 0x38, 0xae, 0x07, 0x29, 0xd2, 0xf2, 0xfa, 0x85,
+	0x5a, 0xc3, 0xb2, 0x7d, 0x8f, 0x32, 0x30, 0xa1, 0x7b, 0x63, 0x91, 0x34, 0x3e, 0x18, 0x99, 0x3d,
+	0x9f, 0x21, 0x90, 0xa2, 0xf6, 0x16, 0xfe, 0x2c, 0xc1, 0x1e, 0xe6, 0x84, 0x6f, 0xcf, 0xd0, 0x52,
+	0x14, 0xe1, 0xa3, 0xf3, 0xe6, 0x1d, 0x04, 0xf3, 0x7d, 0xc5, 0x49, 0xcb, 0x7c, 0xf8, 0x10, 0xeb,
+	0xe8, 0x3b, 0x04, 0xc7, 0x62, 0x78, 0x08, 0xbf, 0xae, 0xc0, 0x7e, 0x4e, 0x44, 0x17, 0x01, 0xc2,
+	0xb7, 0xa4, 0xaf, 0xcc, 0x3e, 0x33, 0xb8, 0xfa, 0xe8, 0x5c, 0x7c, 0x6b, 0x80, 0x8b, 0x0f, 0xb1,
+	0xd2, 0x06, 0x19, 0x18, 0x2e, 0xb8, 0x47, 0xd5, 0xc0, 0x25, 0xc8, 0x30, 0xf2, 0x6b, 0x0e, 0x69,
+	0x12, 0xaa, 0xd7, 0x7d, 0xcf, 0xe6, 0x60, 0xa6, 0x29, 0xa6, 0xba, 0xc5, 0x07, 0xfe, 0xd4, 0xe5,
+	0x9a, 0x7c, 0x15, 0x0e, 0xf6, 0x00, 0x3b, 0x67, 0xea, 0x94, 0x1f, 0x26, 0xce, 0xd5, 0x5c, 0xb4,
+	0xc6, 0x0e, 0xb2, 0x13, 0x2f, 0xbf, 0x87, 0xe0, 0x89, 0xd0, 0xaa, 0x7e, 0x21, 0x0a, 0xe1, 0x43,
+	0x8f, 0xfc, 0x91, 0x65, 0xf5, 0x6b, 0x04, 0xc7, 0xe3, 0x99, 0x08, 0xb9, 0xcf, 0xc0, 0xb4, 0x4f,
+	0xdf, 0xcf, 0xe9, 0x30, 0xbd, 0x5d, 0xc0, 0xe8, 0xf2, 0xf8, 0x03, 0x12, 0xdf, 0xba, 0x00, 0xdf,
+	0xab, 0xae, 0xee, 0xb6, 0xe8, 0x70, 0xcf, 0xce, 0xc3, 0x1e, 0xca, 0x42, 0x19, 0x81, 0xfd, 0xc5,
+	0x27, 0xe3, 0xf9, 0x2b, 0x62, 0x5d, 0x01, 0xea, 0xb1, 0x7c, 0xf7, 0x8e, 0x2d, 0xbf, 0x8f, 0x20,
+	0x37, 0x48, 0xc2, 0xa3, 0x65, 0xf6, 0x6b, 0x30, 0xc7, 0x88, 0xbe, 0x42, 0x5c, 0xa3, 0xdc, 0xa1,
+	0xeb, 0x8d, 0x9c, 0xa4, 0xef, 0x8f, 0x77, 0x28, 0xdd, 0xf6, 0x00, 0x8c, 0xc7, 0xb4, 0xc6, 0x07,
+	0xb2, 0x26, 0x8e, 0xb3, 0xc8, 0x95, 0x85, 0x09, 0x0a, 0x8c, 0x7b, 0xc1, 0xe2, 0xe5, 0x92, 0xa2,
+	0xf5, 0x7b, 0x10, 0x8d, 0xc5, 0xc9, 0xef, 0x22, 0x38, 0xd2, 0x59, 0x94, 0x96, 0x53, 0xbf, 0xea,
+	0x23, 0x7b, 0xa7, 0x3e, 0x42, 0x30, 0x1b, 0x4d, 0x44, 0x28, 0x5b, 0xe0, 0x9e, 0xf8, 0xa9, 0x8d,
+	0x93, 0xc6, 0x03, 0x47, 0x97, 0xd2, 0xbb, 0xa2, 0x9b, 0x13, 0xd4, 0x42, 0xb9, 0xec, 0xa4, 0x0a,
+	0x05, 0x52, 0x35, 0x32, 0x57, 0x3e, 0xf4, 0x1b, 0xb9, 0xf0, 0xd6, 0xff, 0xbd, 0x25, 0xab, 0xa2,
+	0xca, 0x83, 0xc7, 0x5e, 0x59, 0xaf, 0xeb, 0x76, 0xd5, 0x18, 0xde, 0x7a, 0xbf, 0x1f, 0xf5, 0x61,
+	0xee, 0xa0, 0x85, 0x38, 0x03, 0x26, 0x2b, 0x7c, 0x4a, 0xc8, 0x3b, 0x1c, 0xe2, 0xe9, 0x33, 0xbc,
+	0x48, 0x2c, 0xbb, 0xbc, 0xf0, 0xe0, 0x8f, 0xb9, 0xb1, 0xaf, 0xfe, 0x9c, 0x3b, 0x69, 0x5a, 0xee,
+	0x8d, 0x56, 0x45, 0xa9, 0x92, 0x86, 0xca, 0x83, 0xc5, 0x4f, 0x9e, 0xd6, 0x6e, 0x8a, 0xab, 0x93,
+	0x07, 0xa0, 0x9a, 0xbf, 0xb6, 0xbc, 0x02, 0x87, 0x18, 0x95, 0x97, 0xf5, 0x7a, 0x7d, 0x5d, 0x33,
+	0x68, 0xab, 0xee, 0x26, 0xfe, 0xcc, 0xfd, 0x86, 0x20, 0xdb, 0x0f, 0xee, 0x74, 0x91, 0x13, 0xae,
+	0x37, 0x2d, 0x5e, 0xc5, 0x23, 0xd1, 0xc9, 0x61, 0xc8, 0xf2, 0xb8, 0xc7, 0x5f, 0xe3, 0xf1, 0xf8,
+	0x18, 0xec, 0x75, 0x89, 0xab, 0xd7, 0xaf, 0xdd, 0x31, 0x2c, 0xf3, 0x86, 0x2b, 0xce, 0x80, 0x19,
+	0x36, 0xf7, 0x2a, 0x9b, 0xc2, 0xb3, 0x30, 0x7d, 0xdd, 0xb2, 0xf5, 0xba, 0xf5, 0xa6, 0x51, 0x63,
+	0x87, 0xea, 0x94, 0xd6, 0x9d, 0xc0, 0x47, 0x01, 0xee, 0x90, 0x56, 0xbd, 0x76, 0xad, 0xa9, 0x53,
+	0x9a, 0x1d, 0xe7, 0x8f, 0xd9, 0xcc, 0x9a, 0x4e, 0xa9, 0x27, 0x8b, 0x3f, 0xd6, 0x2b, 0xc4, 0x71,
+	0xb3, 0x13, 0xec, 0x39, 0x47, 0x5c, 0xf0, 0x66, 0x8a, 0xf7, 0x32, 0x30, 0xc1, 0x64, 0xe1, 0x4f,
+	0x10, 0x4c, 0x77, 0xda, 0x5c, 0x7c, 0x3a, 0x5a, 0x42, 0xe4, 0x15, 0x50, 0x3a, 0x93, 0x2c, 0x98,
+	0x9b, 0x25, 0x9f, 0x7d, 0xfb, 0xd7, 0xbf, 0xef, 0xed, 0x52, 0xf0, 0x19, 0x35, 0xf2, 0xd2, 0xcb,
+	0x86, 0x54, 0xdd, 0xf0, 0xfb, 0xdd, 0xb6, 0xea, 0xdd, 0xb2, 0xf0, 0x37, 0x08, 0xfe, 0xdf, 0xdb,
+	0x0c, 0xe1, 0xe2, 0xb0, 0x8d, 0xfb, 0x6f, 0x89, 0x52, 0x29, 0x15, 0x46, 0x70, 0x5e, 0x62, 0x9c,
+	0x0b, 0x58, 0x8d, 0xe5, 0xec, 0xb7, 0x74, 0xea, 0x86, 0xa8, 0xfe, 0x36, 0xfe, 0x02, 0xc1, 0xde,
+	0xe0, 0xc5, 0x0c, 0x2b, 0xc3, 0xb6, 0x0f, 0xdf, 0x20, 0x25, 0x35, 0x71, 0x7c, 0x2a, 0xaa, 0x01,
+	0x7b, 0xfd, 0xdb, 0xde, 0x7d, 0x04, 0xfb, 0x42, 0x97, 0x24, 0x3c, 0x74, 0xef, 0x9e, 0x06, 0x5b,
+	0x5a, 0x48, 0x0e, 0x10, 0x6c, 0x4b, 0x8c, 0x6d, 0x1e, 0x9f, 0x8e, 0x37, 0xd6, 0xc3, 0x30, 0x5b,
+	0x1b, 0x96, 0xdd, 0xc6, 0x3f, 0x22, 0xc8, 0x44, 0xdd, 0x52, 0xf0, 0x62, 0xc2, 0xdc, 0xf6, 0x5c,
+	0xaf, 0xa4, 0xa5, 0xd4, 0x38, 0x41, 0x7f, 0x99, 0xd1, 0x2f, 0xe2, 0x85, 0xa4, 0x66, 0xfb, 0x25,
+	0x82, 0xbf, 0xef, 0xd7, 0xc0, 0x4d, 0x4f, 0xa1, 0x21, 0xe4, 0xfd, 0x52, 0x6a, 0x9c, 0xd0, 0x70,
+	0x8e, 0x69, 0x50, 0x71, 0x3e, 0x5a, 0x43, 0xd8, 0xfb, 0xae, 0x80, 0x8f, 0x11, 0x4c, 0xf9, 0x1f,
+	0x6e, 0x7c, 0x2a, 0x66, 0xf3, 0x9e, 0x36, 0x43, 0x3a, 0x9d, 0x28, 0x36, 0x19, 0xb9, 0x4e, 0x4f,
+	0xa7, 0x6e, 0x04, 0x8e, 0xef, 0x36, 0xfe, 0x05, 0xc1, 0xa1, 0x01, 0x0d, 0x3b, 0x7e, 0x3a, 0xc1,
+	0xfe, 0xd1, 0xd7, 0x0d, 0x69, 0x65, 0x27, 0x50, 0xa1, 0xe4, 0x39, 0xa6, 0x64, 0x05, 0x2f, 0xc7,
+	0x94, 0x4a, 0xbe, 0xff, 0x04, 0xe9, 0x4a, 0xc4, 0x9b, 0x08, 0x1e, 0xeb, 0x6b, 0x89, 0x71, 0x29,
+	0x19, 0xa7, 0xd0, 0x1d, 0x40, 0x3a, 0x9b, 0x0e, 0x24, 0x24, 0xac, 0x31, 0x09, 0x2f, 0xe2, 0x17,
+	0x76, 0x2a, 0x41, 0xe5, 0x37, 0x05, 0x75, 0x83, 0xff, 0xb6, 0xf1, 0xcf, 0x08, 0x0e, 0x44, 0xb4,
+	0xb8, 0xf8, 0x5c, 0x0c, 0xbf, 0xc1, 0xcd, 0xb6, 0xb4, 0x98, 0x16, 0x26, 0x84, 0x5d, 0x64, 0xc2,
+	0xce, 0xe3, 0xd5, 0x54, 0x55, 0xa6, 0xb2, 0x3e, 0x4b, 0xdd, 0xf0, 0x7e, 0x9c, 0x36, 0xfe, 0x16,
+	0xc1, 0xff, 0x7a, 0x1a, 0x5a, 0x5c, 0x18, 0x42, 0xa8, 0xbf, 0x0b, 0x97, 0x8a, 0x69, 0x20, 0x82,
+	0xff, 0x2a, 0xe3, 0x7f, 0x0e, 0x97, 0x76, 0xc0, 0x1f, 0x7f, 0x8a, 0x60, 0x6f, 0xb0, 0xe5, 0x8c,
+	0xfd, 0x44, 0x45, 0xb4, 0xc5, 0xb1, 0x9f, 0xa8, 0xa8, 0x5e, 0x56, 0x3e, 0xc3, 0xe8, 0x9e, 0xc0,
+	0xc7, 0xa3, 0xe9, 0x32, 0x3f, 0xbb, 0xbe, 0xfe, 0x84, 0xe0, 0x40, 0x44, 0xf3, 0x18, 0x5b, 0x23,
+	0x83, 0x5b, 0x55, 0x69, 0x31, 0x2d, 0x4c, 0x90, 0x7e, 0x96, 0x91, 0x5e, 0xc6, 0x8b, 0x29, 0x8b,
+	0x5f, 0x34, 0x9f, 0xf8, 0x4b, 0x04, 0x33, 0x81, 0xde, 0x11, 0xe7, 0x63, 0x78, 0xf4, 0x37, 0xa8,
+	0x92, 0x92, 0x34, 0xfc, 0xdf, 0x95, 0x04, 0x6b, 0x4b, 0xcb, 0x97, 0x1e, 0x6c, 0xe5, 0xd0, 0xe6,
+	0x56, 0x0e, 0xfd, 0xb5, 0x95, 0x43, 0x1f, 0x6c, 0xe7, 0xc6, 0x36, 0xb7, 0x73, 0x63, 0xbf, 0x6f,
+	0xe7, 0xc6, 0x5e, 0xcf, 0x07, 0xba, 0x6e, 0xb6, 0x70, 0xde, 0x36, 0xdc, 0x3b, 0xc4, 0xb9, 0x29,
+	0x46, 0x75, 0xa3, 0x66, 0x1a, 0x8e, 0x7a, 0x97, 0xef, 0x57, 0xd9, 0xc3, 0xfe, 0x79, 0x50, 0xfa,
+	0x67, 0x00, 0xfe, 0xdf, 0x49, 0xd0, 0x18, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1305,12 +1675,21 @@ type QueryClient interface {
 	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
 	// ProposalsByGroupAccount queries proposals based on group account address.
 	ProposalsByGroupAccount(ctx context.Context, in *QueryProposalsByGroupAccountRequest, opts ...grpc.CallOption) (*QueryProposalsByGroupAccountResponse, error)
+	// ProposalsByStatus queries proposals based on group account address and proposal status.
+	ProposalsByStatus(ctx context.Context, in *QueryProposalsByStatusRequest, opts ...grpc.CallOption) (*QueryProposalsByStatusResponse, error)
 	// VoteByProposalVoter queries a vote by proposal id and voter.
 	VoteByProposalVoter(ctx context.Context, in *QueryVoteByProposalVoterRequest, opts ...grpc.CallOption) (*QueryVoteByProposalVoterResponse, error)
 	// VotesByProposal queries a vote by proposal.
 	VotesByProposal(ctx context.Context, in *QueryVotesByProposalRequest, opts ...grpc.CallOption) (*QueryVotesByProposalResponse, error)
-	// VotesByVoter queries a vote by voter.
+	// VotesByVoter queries the votes of a voter across proposals, ordered by proposal ID.
+	// Set pagination.reverse to get the votes on the most recent proposals first.
 	VotesByVoter(ctx context.Context, in *QueryVotesByVoterRequest, opts ...grpc.CallOption) (*QueryVotesByVoterResponse, error)
+	// GroupAccountBalance queries the bank balance of a group account.
+	GroupAccountBalance(ctx context.Context, in *QueryGroupAccountBalanceRequest, opts ...grpc.CallOption) (*QueryGroupAccountBalanceResponse, error)
+	// TallyResult queries the tally of a proposal. Open proposals are tallied live with the current
+	// group member weights against the group total weight at submission, without being finalized,
+	// while finalized ones return their final tally result.
+	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
 }
 
 type queryClient struct {
@@ -1393,6 +1772,15 @@ func (c *queryClient) ProposalsByGroupAccount(ctx context.Context, in *QueryProp
 	return out, nil
 }
 
+func (c *queryClient) ProposalsByStatus(ctx context.Context, in *QueryProposalsByStatusRequest, opts ...grpc.CallOption) (*QueryProposalsByStatusResponse, error) {
+	out := new(QueryProposalsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/ProposalsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) VoteByProposalVoter(ctx context.Context, in *QueryVoteByProposalVoterRequest, opts ...grpc.CallOption) (*QueryVoteByProposalVoterResponse, error) {
 	out := new(QueryVoteByProposalVoterResponse)
 	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/VoteByProposalVoter", in, out, opts...)
@@ -1420,6 +1808,24 @@ func (c *queryClient) VotesByVoter(ctx context.Context, in *QueryVotesByVoterReq
 	return out, nil
 }
 
+func (c *queryClient) GroupAccountBalance(ctx context.Context, in *QueryGroupAccountBalanceRequest, opts ...grpc.CallOption) (*QueryGroupAccountBalanceResponse, error) {
+	out := new(QueryGroupAccountBalanceResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/GroupAccountBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error) {
+	out := new(QueryTallyResultResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Query/TallyResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1438,12 +1844,21 @@ type QueryServer interface {
 	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
 	// ProposalsByGroupAccount queries proposals based on group account address.
 	ProposalsByGroupAccount(context.Context, *QueryProposalsByGroupAccountRequest) (*QueryProposalsByGroupAccountResponse, error)
+	// ProposalsByStatus queries proposals based on group account address and proposal status.
+	ProposalsByStatus(context.Context, *QueryProposalsByStatusRequest) (*QueryProposalsByStatusResponse, error)
 	// VoteByProposalVoter queries a vote by proposal id and voter.
 	VoteByProposalVoter(context.Context, *QueryVoteByProposalVoterRequest) (*QueryVoteByProposalVoterResponse, error)
 	// VotesByProposal queries a vote by proposal.
 	VotesByProposal(context.Context, *QueryVotesByProposalRequest) (*QueryVotesByProposalResponse, error)
-	// VotesByVoter queries a vote by voter.
+	// VotesByVoter queries the votes of a voter across proposals, ordered by proposal ID.
+	// Set pagination.reverse to get the votes on the most recent proposals first.
 	VotesByVoter(context.Context, *QueryVotesByVoterRequest) (*QueryVotesByVoterResponse, error)
+	// GroupAccountBalance queries the bank balance of a group account.
+	GroupAccountBalance(context.Context, *QueryGroupAccountBalanceRequest) (*QueryGroupAccountBalanceResponse, error)
+	// TallyResult queries the tally of a proposal. Open proposals are tallied live with the current
+	// group member weights against the group total weight at submission, without being finalized,
+	// while finalized ones return their final tally result.
+	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1474,6 +1889,9 @@ func (*UnimplementedQueryServer) Proposal(ctx context.Context, req *QueryProposa
 func (*UnimplementedQueryServer) ProposalsByGroupAccount(ctx context.Context, req *QueryProposalsByGroupAccountRequest) (*QueryProposalsByGroupAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByGroupAccount not implemented")
 }
+func (*UnimplementedQueryServer) ProposalsByStatus(ctx context.Context, req *QueryProposalsByStatusRequest) (*QueryProposalsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByStatus not implemented")
+}
 func (*UnimplementedQueryServer) VoteByProposalVoter(ctx context.Context, req *QueryVoteByProposalVoterRequest) (*QueryVoteByProposalVoterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoteByProposalVoter not implemented")
 }
@@ -1483,6 +1901,12 @@ func (*UnimplementedQueryServer) VotesByProposal(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) VotesByVoter(ctx context.Context, req *QueryVotesByVoterRequest) (*QueryVotesByVoterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VotesByVoter not implemented")
 }
+func (*UnimplementedQueryServer) GroupAccountBalance(ctx context.Context, req *QueryGroupAccountBalanceRequest) (*QueryGroupAccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupAccountBalance not implemented")
+}
+func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1632,6 +2056,24 @@ func _Query_ProposalsByGroupAccount_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/ProposalsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByStatus(ctx, req.(*QueryProposalsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_VoteByProposalVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryVoteByProposalVoterRequest)
 	if err := dec(in); err != nil {
@@ -1686,17 +2128,53 @@ func _Query_VotesByVoter_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "regen.group.v1alpha1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GroupInfo",
-			Handler:    _Query_GroupInfo_Handler,
-		},
-		{
-			MethodName: "GroupAccountInfo",
-			Handler:    _Query_GroupAccountInfo_Handler,
+func _Query_GroupAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupAccountBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupAccountBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/GroupAccountBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupAccountBalance(ctx, req.(*QueryGroupAccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallyResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Query/TallyResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallyResult(ctx, req.(*QueryTallyResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "regen.group.v1alpha1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GroupInfo",
+			Handler:    _Query_GroupInfo_Handler,
+		},
+		{
+			MethodName: "GroupAccountInfo",
+			Handler:    _Query_GroupAccountInfo_Handler,
 		},
 		{
 			MethodName: "GroupMembers",
@@ -1722,6 +2200,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProposalsByGroupAccount",
 			Handler:    _Query_ProposalsByGroupAccount_Handler,
 		},
+		{
+			MethodName: "ProposalsByStatus",
+			Handler:    _Query_ProposalsByStatus_Handler,
+		},
 		{
 			MethodName: "VoteByProposalVoter",
 			Handler:    _Query_VoteByProposalVoter_Handler,
@@ -1734,6 +2216,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VotesByVoter",
 			Handler:    _Query_VotesByVoter_Handler,
 		},
+		{
+			MethodName: "GroupAccountBalance",
+			Handler:    _Query_GroupAccountBalance_Handler,
+		},
+		{
+			MethodName: "TallyResult",
+			Handler:    _Query_TallyResult_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/group/v1alpha1/query.proto",
@@ -2381,6 +2871,102 @@ func (m *QueryProposalsByGroupAccountResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProposalsByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryVoteByProposalVoterRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2631,6 +3217,171 @@ func (m *QueryVotesByVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGroupAccountBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupAccountBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupAccountBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupAccountBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupAccountBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupAccountBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WouldAbort {
+		i--
+		if m.WouldAbort {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WouldPass {
+		i--
+		if m.WouldPass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TotalWeight) > 0 {
+		i -= len(m.TotalWeight)
+		copy(dAtA[i:], m.TotalWeight)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalWeight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2896,16 +3647,55 @@ func (m *QueryProposalsByGroupAccountResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryVoteByProposalVoterRequest) Size() (n int) {
+func (m *QueryProposalsByStatusRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
-	}
-	l = len(m.Voter)
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteByProposalVoterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -2996,6 +3786,70 @@ func (m *QueryVotesByVoterResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGroupAccountBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupAccountBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTallyResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryTallyResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TotalWeight)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	if m.WouldPass {
+		n += 2
+	}
+	if m.WouldAbort {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4692,7 +5546,7 @@ func (m *QueryProposalsByGroupAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsByStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4715,17 +5569,17 @@ func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteByProposalVoterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsByStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteByProposalVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4735,16 +5589,48 @@ func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Proposal_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4754,23 +5640,27 @@ func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4796,7 +5686,7 @@ func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteByProposalVoterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsByStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4819,15 +5709,15 @@ func (m *QueryVoteByProposalVoterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteByProposalVoterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsByStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteByProposalVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4854,10 +5744,44 @@ func (m *QueryVoteByProposalVoterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Vote == nil {
-				m.Vote = &Vote{}
+			m.Proposals = append(m.Proposals, &Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4885,7 +5809,7 @@ func (m *QueryVoteByProposalVoterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4908,10 +5832,10 @@ func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesByProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteByProposalVoterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteByProposalVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4935,9 +5859,9 @@ func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4947,27 +5871,23 @@ func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4993,7 +5913,7 @@ func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteByProposalVoterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5016,15 +5936,15 @@ func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesByProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteByProposalVoterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteByProposalVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5051,34 +5971,231 @@ func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, &Vote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Vote == nil {
+				m.Vote = &Vote{}
+			}
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if msglen < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotesByProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotesByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotesByProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotesByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
@@ -5360,6 +6477,428 @@ func (m *QueryVotesByVoterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGroupAccountBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupAccountBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupAccountBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupAccountBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupAccountBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupAccountBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalWeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WouldPass", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WouldPass = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WouldAbort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WouldAbort = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
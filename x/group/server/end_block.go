@@ -0,0 +1,97 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// EndBlock closes all the proposals still open for voting whose voting period has ended.
+// Their final tally is decided by the decision policy, unless their group or group account was
+// modified in between, in which case they are aborted. A proposal that can't be loaded is skipped
+// and one that can't be closed is aborted, in both cases the error is logged, so that a single
+// proposal doesn't halt the chain. An error is only returned when the index can't be scanned at
+// all, which doesn't depend on the stored proposals.
+func (s serverImpl) EndBlock(ctx types.Context) error {
+	logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", group.ModuleName))
+
+	// The voting period is over when it ends at or before the block time.
+	end := orm.EncodeTime(ctx.BlockTime().Add(time.Nanosecond))
+	it, err := s.proposalByVotingPeriodEnd.PrefixScan(ctx, nil, end)
+	if err != nil {
+		return err
+	}
+	// Load all proposals upfront as closing them updates the index.
+	var proposals []*group.Proposal
+	for {
+		var proposal group.Proposal
+		rowID, err := it.LoadNext(&proposal)
+		if orm.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			logger.Error("loading expired proposal failed, skipping it", "cause", err, "proposalID", orm.DecodeSequence(rowID))
+			continue
+		}
+		proposals = append(proposals, &proposal)
+	}
+	it.Close()
+
+	for _, proposal := range proposals {
+		// Cache the context so that a failure doesn't leave a partially closed proposal behind.
+		cacheCtx, flush := ctx.CacheContext()
+		closed := *proposal
+		if err := s.closeExpiredProposal(types.Context{Context: cacheCtx}, &closed); err != nil {
+			logger.Error("closing expired proposal failed, aborting it", "cause", err, "proposalID", proposal.ProposalId)
+			if err := s.abortProposal(ctx, proposal); err != nil {
+				logger.Error("aborting expired proposal failed", "cause", err, "proposalID", proposal.ProposalId)
+			}
+			continue
+		}
+		flush()
+	}
+	return nil
+}
+
+// abortProposal aborts the given proposal without tallying its votes, for when closing it failed.
+func (s serverImpl) abortProposal(ctx types.Context, proposal *group.Proposal) error {
+	proposal.Result = group.ProposalResultUnfinalized
+	proposal.Status = group.ProposalStatusAborted
+	return s.proposalTable.Update(ctx, proposal.ProposalId, proposal)
+}
+
+func (s serverImpl) closeExpiredProposal(ctx types.Context, proposal *group.Proposal) error {
+	address, err := sdk.AccAddressFromBech32(proposal.Address)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group account")
+	}
+	accountInfo, err := s.getGroupAccountInfo(ctx, address.Bytes())
+	if err != nil {
+		return sdkerrors.Wrap(err, "load group account")
+	}
+	electorate, err := s.getGroupInfo(ctx, accountInfo.GroupId)
+	if err != nil {
+		return sdkerrors.Wrap(err, "load group")
+	}
+
+	if proposal.GroupAccountVersion != accountInfo.Version || proposal.GroupVersion != electorate.Version {
+		proposal.Result = group.ProposalResultUnfinalized
+		proposal.Status = group.ProposalStatusAborted
+	} else {
+		if err := doTally(ctx, proposal, electorate, accountInfo); err != nil {
+			return err
+		}
+		// Decision policies are final once the voting period is over, but better safe than leaving
+		// the proposal open forever.
+		if proposal.Status == group.ProposalStatusSubmitted {
+			finalizeProposal(proposal, group.ProposalResultRejected, proposalTotalWeight(*proposal, electorate))
+		}
+	}
+	return s.proposalTable.Update(ctx, proposal.ProposalId, proposal)
+}
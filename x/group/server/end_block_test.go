@@ -0,0 +1,97 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/regen-network/regen-ledger/orm"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	servermodule "github.com/regen-network/regen-ledger/types/module/server"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// storeModuleKey is a RootModuleKey which can only be used as a store key.
+type storeModuleKey struct {
+	servermodule.RootModuleKey
+	key *sdk.KVStoreKey
+}
+
+func (k *storeModuleKey) Name() string   { return k.key.Name() }
+func (k *storeModuleKey) String() string { return k.key.String() }
+
+func TestEndBlockAbortsProposalsFailingToClose(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	group.RegisterTypes(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	key := &storeModuleKey{key: sdk.NewKVStoreKey(group.ModuleName)}
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	now := time.Now().UTC()
+	ctx := regentypes.Context{Context: sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger()).WithBlockTime(now)}
+
+	s := newServer(key, nil, nil, cdc)
+
+	_, _, admin := testdata.KeyTestPubAddr()
+	_, _, accountAddr := testdata.KeyTestPubAddr()
+	_, _, unknownAddr := testdata.KeyTestPubAddr()
+	groupID, err := s.groupTable.Create(ctx, &group.GroupInfo{GroupId: 1, Admin: admin.String(), TotalWeight: "1", Version: 1})
+	require.NoError(t, err)
+	accountInfo, err := group.NewGroupAccountInfo(accountAddr, groupID, admin, nil, 1,
+		group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 1}), []byte("derivation key"))
+	require.NoError(t, err)
+	require.NoError(t, s.groupAccountTable.Create(ctx, &accountInfo))
+
+	submittedAt, err := gogotypes.TimestampProto(now.Add(-2 * time.Second))
+	require.NoError(t, err)
+	timeout, err := gogotypes.TimestampProto(now.Add(-time.Second))
+	require.NoError(t, err)
+	createProposal := func(address sdk.AccAddress) uint64 {
+		id, err := s.proposalTable.Create(ctx, &group.Proposal{
+			ProposalId:          s.proposalTable.Sequence().PeekNextVal(ctx),
+			Address:             address.String(),
+			Proposers:           []string{admin.String()},
+			SubmittedAt:         *submittedAt,
+			GroupVersion:        1,
+			GroupAccountVersion: 1,
+			Status:              group.ProposalStatusSubmitted,
+			Result:              group.ProposalResultUnfinalized,
+			VoteState:           group.Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			Timeout:             *timeout,
+			ExecutorResult:      group.ProposalExecutorResultNotRun,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	// the group account of the first proposal doesn't exist, so it can't be closed
+	brokenID := createProposal(unknownAddr)
+	validID := createProposal(accountAddr)
+	// the index entry of the last proposal points to a missing row, so it can't be loaded
+	missingID := createProposal(accountAddr)
+	ctx.KVStore(key).Delete(append([]byte{ProposalTablePrefix}, orm.EncodeSequence(missingID)...))
+
+	require.NoError(t, s.EndBlock(ctx))
+
+	broken, err := s.getProposal(ctx, brokenID)
+	require.NoError(t, err)
+	require.Equal(t, group.ProposalStatusAborted, broken.Status)
+	require.Equal(t, group.ProposalResultUnfinalized, broken.Result)
+	require.Nil(t, broken.FinalTallyResult)
+
+	valid, err := s.getProposal(ctx, validID)
+	require.NoError(t, err)
+	require.Equal(t, group.ProposalStatusClosed, valid.Status)
+	require.Equal(t, group.ProposalResultRejected, valid.Result)
+}
@@ -1,20 +1,31 @@
 package server
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/regen-network/regen-ledger/orm"
 	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/group"
 )
 
+// InitGenesis imports all the group tables and restores their sequences, so that the next
+// group, group account and proposal created get the IDs following the imported ones.
+// Secondary indexes are rebuilt by the tables on import.
 func (s serverImpl) InitGenesis(ctx types.Context, cdc codec.Codec, data json.RawMessage) ([]abci.ValidatorUpdate, error) {
 	var genesisState group.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 
+	if err := genesisState.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid genesis state")
+	}
+
 	if err := s.groupTable.Import(ctx, genesisState.Groups, genesisState.GroupSeq); err != nil {
 		return nil, errors.Wrap(err, "groups")
 	}
@@ -41,47 +52,108 @@ func (s serverImpl) InitGenesis(ctx types.Context, cdc codec.Codec, data json.Ra
 	return []abci.ValidatorUpdate{}, nil
 }
 
+// ExportGenesis streams the values of every group table into the genesis state, along with the
+// table sequences. Each value is encoded as soon as it is read so that the whole state is never
+// held in memory as a GenesisState.
 func (s serverImpl) ExportGenesis(ctx types.Context, cdc codec.Codec) (json.RawMessage, error) {
-	genesisState := group.NewGenesisState()
+	w := newGenesisWriter(cdc)
 
-	var groups []*group.GroupInfo
-	groupSeq, err := s.groupTable.Export(ctx, &groups)
+	w.writeSeq("group_seq", s.groupTable.Sequence().CurVal(ctx))
+	err := w.writeArray("groups", func(fn func(orm.RowID, proto.Message) error) error {
+		_, err := s.groupTable.ExportStream(ctx, fn)
+		return err
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "groups")
 	}
-	genesisState.Groups = groups
-	genesisState.GroupSeq = groupSeq
 
-	var groupMembers []*group.GroupMember
-	_, err = s.groupMemberTable.Export(ctx, &groupMembers)
+	err = w.writeArray("group_members", func(fn func(orm.RowID, proto.Message) error) error {
+		return s.groupMemberTable.ExportStream(ctx, fn)
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "group members")
 	}
-	genesisState.GroupMembers = groupMembers
 
-	var groupAccounts []*group.GroupAccountInfo
-	_, err = s.groupAccountTable.Export(ctx, &groupAccounts)
+	w.writeSeq("group_account_seq", s.groupAccountSeq.CurVal(ctx))
+	err = w.writeArray("group_accounts", func(fn func(orm.RowID, proto.Message) error) error {
+		return s.groupAccountTable.ExportStream(ctx, fn)
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "group accounts")
 	}
-	genesisState.GroupAccounts = groupAccounts
-	genesisState.GroupAccountSeq = s.groupAccountSeq.CurVal(ctx)
 
-	var proposals []*group.Proposal
-	proposalSeq, err := s.proposalTable.Export(ctx, &proposals)
+	w.writeSeq("proposal_seq", s.proposalTable.Sequence().CurVal(ctx))
+	err = w.writeArray("proposals", func(fn func(orm.RowID, proto.Message) error) error {
+		_, err := s.proposalTable.ExportStream(ctx, fn)
+		return err
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "proposals")
 	}
-	genesisState.Proposals = proposals
-	genesisState.ProposalSeq = proposalSeq
 
-	var votes []*group.Vote
-	_, err = s.voteTable.Export(ctx, &votes)
+	err = w.writeArray("votes", func(fn func(orm.RowID, proto.Message) error) error {
+		return s.voteTable.ExportStream(ctx, fn)
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "votes")
 	}
-	genesisState.Votes = votes
 
-	genesisBytes := cdc.MustMarshalJSON(genesisState)
-	return genesisBytes, nil
+	return w.close(), nil
+}
+
+// genesisWriter writes the JSON encoding of a genesis state field by field, in the same format as
+// the codec would for the whole message.
+type genesisWriter struct {
+	cdc    codec.Codec
+	buf    bytes.Buffer
+	fields int
+}
+
+func newGenesisWriter(cdc codec.Codec) *genesisWriter {
+	w := &genesisWriter{cdc: cdc}
+	w.buf.WriteByte('{')
+	return w
+}
+
+func (w *genesisWriter) writeName(name string) {
+	if w.fields > 0 {
+		w.buf.WriteByte(',')
+	}
+	w.fields++
+	fmt.Fprintf(&w.buf, "%q:", name)
+}
+
+// writeSeq writes a uint64 field, which is encoded as a string in proto JSON.
+func (w *genesisWriter) writeSeq(name string, seq uint64) {
+	w.writeName(name)
+	fmt.Fprintf(&w.buf, "\"%d\"", seq)
+}
+
+// writeArray writes a repeated field with every value passed by export to its callback.
+func (w *genesisWriter) writeArray(name string, export func(fn func(orm.RowID, proto.Message) error) error) error {
+	w.writeName(name)
+	w.buf.WriteByte('[')
+	n := 0
+	err := export(func(_ orm.RowID, obj proto.Message) error {
+		bz, err := w.cdc.MarshalJSON(obj.(codec.ProtoMarshaler))
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			w.buf.WriteByte(',')
+		}
+		n++
+		w.buf.Write(bz)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	w.buf.WriteByte(']')
+	return nil
+}
+
+func (w *genesisWriter) close() json.RawMessage {
+	w.buf.WriteByte('}')
+	return w.buf.Bytes()
 }
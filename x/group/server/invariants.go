@@ -12,15 +12,17 @@ import (
 )
 
 const (
-	votesInvariant    = "Tally-Votes"
-	weightInvariant   = "Group-TotalWeight"
-	votesSumInvariant = "Tally-Votes-Sum"
+	votesInvariant       = "Tally-Votes"
+	weightInvariant      = "Group-TotalWeight"
+	votesSumInvariant    = "Tally-Votes-Sum"
+	memberCountInvariant = "Group-MemberCount"
 )
 
 func (s serverImpl) RegisterInvariants(ir sdk.InvariantRegistry) {
 	ir.RegisterRoute(group.ModuleName, votesInvariant, s.tallyVotesInvariant())
 	ir.RegisterRoute(group.ModuleName, weightInvariant, s.groupTotalWeightInvariant())
 	ir.RegisterRoute(group.ModuleName, votesSumInvariant, s.tallyVotesSumInvariant())
+	ir.RegisterRoute(group.ModuleName, memberCountInvariant, s.groupMemberCountInvariant())
 }
 
 func (s serverImpl) tallyVotesInvariant() sdk.Invariant {
@@ -49,6 +51,13 @@ func (s serverImpl) tallyVotesSumInvariant() sdk.Invariant {
 	}
 }
 
+func (s serverImpl) groupMemberCountInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := groupMemberCountInvariant(ctx, s.groupTable, s.groupMemberByGroupIndex)
+		return sdk.FormatInvariant(group.ModuleName, memberCountInvariant, msg), broken
+	}
+}
+
 func tallyVotesInvariant(ctx sdk.Context, prevCtx sdk.Context, proposalTable orm.AutoUInt64Table) (string, bool) {
 
 	var msg string
@@ -191,6 +200,49 @@ func groupTotalWeightInvariant(ctx sdk.Context, groupTable orm.AutoUInt64Table,
 	return msg, broken
 }
 
+func groupMemberCountInvariant(ctx sdk.Context, groupTable orm.AutoUInt64Table, groupMemberByGroupIndex orm.UInt64Index) (string, bool) {
+
+	var msg string
+	var broken bool
+
+	groupIt, err := groupTable.PrefixScan(ctx, 1, math.MaxUint64)
+	if err != nil {
+		msg += fmt.Sprintf("PrefixScan failure on group table\n%v\n", err)
+		return msg, broken
+	}
+	defer groupIt.Close()
+
+	for {
+		// fresh value per group, as unmarshaling doesn't reset fields with zero values
+		var groupInfo group.GroupInfo
+		_, err := groupIt.LoadNext(&groupInfo)
+		if orm.ErrIteratorDone.Is(err) {
+			break
+		}
+		memIt, err := groupMemberByGroupIndex.Get(ctx, groupInfo.GroupId)
+		if err != nil {
+			msg += fmt.Sprintf("error while returning group member iterator for group with ID %d\n%v\n", groupInfo.GroupId, err)
+			return msg, broken
+		}
+		var memberCount uint64
+		for {
+			_, err = memIt.LoadNext(&group.GroupMember{})
+			if orm.ErrIteratorDone.Is(err) {
+				break
+			}
+			memberCount++
+		}
+		memIt.Close()
+
+		if groupInfo.MemberCount != memberCount {
+			broken = true
+			msg += fmt.Sprintf("group's MemberCount must be equal to the number of its members\ngroup member count: %d\nNumber of group members: %d\n", groupInfo.MemberCount, memberCount)
+			break
+		}
+	}
+	return msg, broken
+}
+
 func tallyVotesSumInvariant(ctx sdk.Context, groupTable orm.AutoUInt64Table, proposalTable orm.AutoUInt64Table, groupMemberTable orm.PrimaryKeyTable, voteByProposalIndex orm.UInt64Index, groupAccountTable orm.PrimaryKeyTable) (string, bool) {
 	var msg string
 	var broken bool
@@ -312,6 +312,80 @@ func TestGroupTotalWeightInvariant(t *testing.T) {
 	}
 }
 
+func TestGroupMemberCountInvariant(t *testing.T) {
+	curCtx, cdc, key := getCtxCodecKey(t)
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	_, _, addr3 := testdata.KeyTestPubAddr()
+
+	// Group Table
+	groupTableBuilder, err := orm.NewAutoUInt64TableBuilder(GroupTablePrefix, GroupTableSeqPrefix, key, &group.GroupInfo{}, cdc)
+	require.NoError(t, err)
+	groupTable := groupTableBuilder.Build()
+
+	groupInfo := &group.GroupInfo{
+		GroupId:     1,
+		Admin:       addr1.String(),
+		Version:     1,
+		TotalWeight: "3",
+		MemberCount: 2,
+	}
+	rowID, err := groupTable.Create(curCtx, groupInfo)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), rowID)
+
+	// Group Member Table
+	groupMemberTableBuilder, err := orm.NewPrimaryKeyTableBuilder(GroupMemberTablePrefix, key, &group.GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{}, cdc)
+	require.NoError(t, err)
+	groupMemberByGroupIndex, err := orm.NewUInt64Index(groupMemberTableBuilder, GroupMemberByGroupIndexPrefix, func(val interface{}) ([]uint64, error) {
+		group := val.(*group.GroupMember).GroupId
+		return []uint64{group}, nil
+	})
+	require.NoError(t, err)
+	groupMemberTable := groupMemberTableBuilder.Build()
+
+	specs := map[string]struct {
+		groupMembers []*group.GroupMember
+		expBroken    bool
+	}{
+		"invariant not broken": {
+			groupMembers: []*group.GroupMember{
+				{GroupId: 1, Member: &group.Member{Address: addr1.String(), Weight: "1"}},
+				{GroupId: 1, Member: &group.Member{Address: addr2.String(), Weight: "2"}},
+			},
+			expBroken: false,
+		},
+		"group's MemberCount must not be less than its members": {
+			groupMembers: []*group.GroupMember{
+				{GroupId: 1, Member: &group.Member{Address: addr1.String(), Weight: "1"}},
+				{GroupId: 1, Member: &group.Member{Address: addr2.String(), Weight: "1"}},
+				{GroupId: 1, Member: &group.Member{Address: addr3.String(), Weight: "1"}},
+			},
+			expBroken: true,
+		},
+		"group's MemberCount must not be greater than its members": {
+			groupMembers: []*group.GroupMember{
+				{GroupId: 1, Member: &group.Member{Address: addr1.String(), Weight: "3"}},
+			},
+			expBroken: true,
+		},
+	}
+
+	for _, spec := range specs {
+		cacheCurCtx, _ := curCtx.CacheContext()
+		groupMembers := spec.groupMembers
+
+		for i := 0; i < len(groupMembers); i++ {
+			err := groupMemberTable.Create(cacheCurCtx, groupMembers[i])
+			require.NoError(t, err)
+		}
+
+		_, broken := groupMemberCountInvariant(cacheCurCtx, groupTable, groupMemberByGroupIndex)
+		require.Equal(t, spec.expBroken, broken)
+	}
+}
+
 func TestTallyVotesSumInvariant(t *testing.T) {
 	curCtx, cdc, key := getCtxCodecKey(t)
 
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/regen-network/regen-ledger/orm"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+// migrateV1ToV2 migrates the group state from consensus version 1 to 2.
+func (s serverImpl) migrateV1ToV2(ctx types.Context) error {
+	if err := s.migrateMemberCounts(ctx); err != nil {
+		return err
+	}
+	// proposals submitted before these indexes were added are never closed by EndBlock or
+	// found by status otherwise
+	if err := orm.RebuildIndex(ctx, s.proposalTable, s.proposalByVotingPeriodEnd); err != nil {
+		return err
+	}
+	return orm.RebuildIndex(ctx, s.proposalTable, s.proposalByGroupAccountStatusIndex)
+}
+
+// migrateMemberCounts sets the MemberCount of all groups to the number of their members,
+// as groups created before the member count was tracked have a MemberCount of 0.
+func (s serverImpl) migrateMemberCounts(ctx types.Context) error {
+	memberCounts := make(map[uint64]uint64)
+	err := s.groupMemberTable.ExportStream(ctx, func(_ orm.RowID, obj proto.Message) error {
+		memberCounts[obj.(*group.GroupMember).GroupId]++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var groups []*group.GroupInfo
+	_, err = s.groupTable.ExportStream(ctx, func(_ orm.RowID, obj proto.Message) error {
+		groups = append(groups, obj.(*group.GroupInfo))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, g := range groups {
+		if g.MemberCount == memberCounts[g.GroupId] {
+			continue
+		}
+		g.MemberCount = memberCounts[g.GroupId]
+		if err := s.groupTable.Update(ctx, g.GroupId, g); err != nil {
+			return err
+		}
+	}
+	return nil
+}
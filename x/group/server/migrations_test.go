@@ -0,0 +1,113 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/regen-network/regen-ledger/orm"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/group"
+)
+
+func TestMigrateV1ToV2(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	group.RegisterTypes(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	key := &storeModuleKey{key: sdk.NewKVStoreKey(group.ModuleName)}
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := regentypes.Context{Context: sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())}
+
+	s := newServer(key, nil, nil, cdc)
+
+	_, _, admin := testdata.KeyTestPubAddr()
+	_, _, member1 := testdata.KeyTestPubAddr()
+	_, _, member2 := testdata.KeyTestPubAddr()
+	// groups stored before the member count was tracked
+	for i := 0; i < 3; i++ {
+		_, err := s.groupTable.Create(ctx, &group.GroupInfo{GroupId: uint64(i + 1), Admin: admin.String(), TotalWeight: "0", Version: 1})
+		require.NoError(t, err)
+	}
+	for groupID, members := range map[uint64][]sdk.AccAddress{1: {member1, member2}, 2: {member1}} {
+		for _, member := range members {
+			require.NoError(t, s.groupMemberTable.Create(ctx, &group.GroupMember{
+				GroupId: groupID,
+				Member:  &group.Member{Address: member.String(), Weight: "1"},
+			}))
+		}
+	}
+
+	// proposals stored before the voting period end and status indexes were added
+	_, _, accountAddr := testdata.KeyTestPubAddr()
+	for i := 0; i < 2; i++ {
+		_, err := s.proposalTable.Create(ctx, &group.Proposal{
+			ProposalId:          s.proposalTable.Sequence().PeekNextVal(ctx),
+			Address:             accountAddr.String(),
+			Proposers:           []string{admin.String()},
+			SubmittedAt:         gogotypes.Timestamp{Seconds: 1},
+			GroupVersion:        1,
+			GroupAccountVersion: 1,
+			Status:              group.ProposalStatusSubmitted,
+			Result:              group.ProposalResultUnfinalized,
+			VoteState:           group.Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			Timeout:             gogotypes.Timestamp{Seconds: 2},
+			ExecutorResult:      group.ProposalExecutorResultNotRun,
+		})
+		require.NoError(t, err)
+	}
+	clearPrefix(ctx, key, ProposalByVotingPeriodEndIndexPrefix)
+	clearPrefix(ctx, key, ProposalByGroupAccountStatusIndexPrefix)
+
+	require.NoError(t, s.migrateV1ToV2(ctx))
+
+	for groupID, expCount := range map[uint64]uint64{1: 2, 2: 1, 3: 0} {
+		var g group.GroupInfo
+		_, err := s.groupTable.GetOne(ctx, groupID, &g)
+		require.NoError(t, err)
+		require.Equal(t, expCount, g.MemberCount, "group %d", groupID)
+		require.Equal(t, uint64(1), g.Version, "group %d", groupID)
+	}
+	msg, broken := groupMemberCountInvariant(ctx.Context, s.groupTable, s.groupMemberByGroupIndex)
+	require.False(t, broken, msg)
+
+	require.NoError(t, orm.VerifyIndexes(ctx, s.proposalTable, s.proposalByVotingPeriodEnd, s.proposalByGroupAccountStatusIndex))
+	it, err := s.proposalByVotingPeriodEnd.PrefixScan(ctx, nil, nil)
+	require.NoError(t, err)
+	var proposals []*group.Proposal
+	_, err = orm.ReadAll(it, &proposals)
+	require.NoError(t, err)
+	require.Len(t, proposals, 2)
+	it, err = s.proposalByGroupAccountStatusIndex.Get(ctx, proposalByGroupAccountStatusKey(accountAddr, group.ProposalStatusSubmitted))
+	require.NoError(t, err)
+	proposals = nil
+	_, err = orm.ReadAll(it, &proposals)
+	require.NoError(t, err)
+	require.Len(t, proposals, 2)
+}
+
+// clearPrefix deletes all entries stored under the given prefix.
+func clearPrefix(ctx regentypes.Context, key *storeModuleKey, p byte) {
+	store := prefix.NewStore(ctx.KVStore(key), []byte{p})
+	var keys [][]byte
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, k := range keys {
+		store.Delete(k)
+	}
+}
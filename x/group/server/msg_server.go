@@ -63,6 +63,7 @@ func (s serverImpl) CreateGroup(goCtx context.Context, req *group.MsgCreateGroup
 		Metadata:    metadata,
 		Version:     1,
 		TotalWeight: totalWeight.String(),
+		MemberCount: uint64(len(members.Members)),
 	}
 	groupID, err := s.groupTable.Create(ctx, groupInfo)
 	if err != nil {
@@ -151,6 +152,7 @@ func (s serverImpl) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpda
 				if err := s.groupMemberTable.Delete(ctx, &groupMember); err != nil {
 					return sdkerrors.Wrap(err, "delete member")
 				}
+				g.MemberCount--
 				continue
 			}
 			// If group member already exists, handle update
@@ -169,8 +171,11 @@ func (s serverImpl) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpda
 					return sdkerrors.Wrap(err, "add member")
 				}
 				// else handle create.
-			} else if err := s.groupMemberTable.Create(ctx, &groupMember); err != nil {
-				return sdkerrors.Wrap(err, "add member")
+			} else {
+				if err := s.groupMemberTable.Create(ctx, &groupMember); err != nil {
+					return sdkerrors.Wrap(err, "add member")
+				}
+				g.MemberCount++
 			}
 			// In both cases (handle + update), we need to add the new member's weight to the group total weight.
 			totalWeight, err = totalWeight.Add(newMemberWeight)
@@ -256,6 +261,11 @@ func (s serverImpl) CreateGroupAccount(goCtx context.Context, req *group.MsgCrea
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not group admin")
 	}
 
+	// Prevent group account with a decision policy that can not be met.
+	if err := policy.Validate(g); err != nil {
+		return nil, err
+	}
+
 	// Generate group account address.
 	var accountAddr sdk.AccAddress
 	var accountDerivationKey []byte
@@ -300,6 +310,8 @@ func (s serverImpl) CreateGroupAccount(goCtx context.Context, req *group.MsgCrea
 	if err != nil {
 		return nil, err
 	}
+	groupAccount.SpendLimit = req.SpendLimit
+	groupAccount.SpendLimitPeriod = req.SpendLimitPeriod
 
 	if err := s.groupAccountTable.Create(ctx, &groupAccount); err != nil {
 		return nil, sdkerrors.Wrap(err, "could not create group account")
@@ -449,6 +461,7 @@ func (s serverImpl) CreateProposal(goCtx context.Context, req *group.MsgCreatePr
 		Status:              group.ProposalStatusSubmitted,
 		ExecutorResult:      group.ProposalExecutorResultNotRun,
 		Timeout:             *endTime,
+		GroupTotalWeight:    g.TotalWeight,
 		VoteState: group.Tally{
 			YesCount:     "0",
 			NoCount:      "0",
@@ -484,7 +497,18 @@ func (s serverImpl) CreateProposal(goCtx context.Context, req *group.MsgCreatePr
 				return &group.MsgCreateProposalResponse{ProposalId: id}, sdkerrors.Wrap(err, "The proposal was created but failed on vote")
 			}
 		}
-		// Then try to execute the proposal
+		// Then try to execute the proposal, unless it was already rejected or its execution is delayed
+		proposal, err := s.getProposal(ctx, id)
+		if err != nil {
+			return &group.MsgCreateProposalResponse{ProposalId: id}, err
+		}
+		if proposal.IsFinalized() {
+			return &group.MsgCreateProposalResponse{ProposalId: id}, nil
+		}
+		elapsed, err := proposal.ExecutionDelayElapsed(ctx.BlockTime())
+		if err != nil || !elapsed {
+			return &group.MsgCreateProposalResponse{ProposalId: id}, err
+		}
 		_, err = s.Exec(ctx, &group.MsgExec{
 			ProposalId: id,
 			// We consider the first proposer as the MsgExecRequest signer
@@ -521,7 +545,7 @@ func (s serverImpl) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgV
 	if proposal.Status != group.ProposalStatusSubmitted {
 		return nil, sdkerrors.Wrap(group.ErrInvalid, "proposal not open for voting")
 	}
-	votingPeriodEnd, err := gogotypes.TimestampFromProto(&proposal.Timeout)
+	votingPeriodEnd, err := proposal.VotingPeriodEnd()
 	if err != nil {
 		return nil, err
 	}
@@ -588,8 +612,18 @@ func (s serverImpl) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgV
 		return nil, err
 	}
 
-	// Try to execute proposal immediately
-	if req.Exec == group.Exec_EXEC_TRY {
+	// Try to execute proposal immediately when requested or when the decision policy executes
+	// accepted proposals automatically, unless it was rejected or its execution is delayed
+	tryExec := req.Exec == group.Exec_EXEC_TRY
+	if !tryExec && proposal.Result == group.ProposalResultAccepted {
+		policy := accountInfo.GetDecisionPolicy()
+		tryExec = policy != nil && policy.GetAutoExec()
+	}
+	if tryExec && !proposal.IsFinalized() {
+		elapsed, err := proposal.ExecutionDelayElapsed(ctx.BlockTime())
+		if err != nil || !elapsed {
+			return &group.MsgVoteResponse{}, err
+		}
 		_, err = s.Exec(ctx, &group.MsgExec{
 			ProposalId: id,
 			Signer:     voterAddr,
@@ -609,19 +643,66 @@ func doTally(ctx types.Context, p *group.Proposal, electorate group.GroupInfo, a
 	if err != nil {
 		return err
 	}
-	switch result, err := policy.Allow(p.VoteState, electorate.TotalWeight, ctx.BlockTime().Sub(submittedAt)); {
+	totalWeight := proposalTotalWeight(*p, electorate)
+	switch result, err := policy.Allow(p.VoteState, totalWeight, ctx.BlockTime().Sub(submittedAt)); {
 	case err != nil:
 		return sdkerrors.Wrap(err, "policy execution")
 	case result.Allow && result.Final:
-		p.Result = group.ProposalResultAccepted
-		p.Status = group.ProposalStatusClosed
+		finalizeProposal(p, group.ProposalResultAccepted, totalWeight)
+		if p.EarliestExecutionTime == nil {
+			if p.EarliestExecutionTime, err = earliestExecutionTime(ctx, *p, policy); err != nil {
+				return err
+			}
+		}
 	case !result.Allow && result.Final:
-		p.Result = group.ProposalResultRejected
-		p.Status = group.ProposalStatusClosed
+		finalizeProposal(p, group.ProposalResultRejected, totalWeight)
 	}
 	return nil
 }
 
+// proposalTotalWeight returns the group total weight snapshotted on submission,
+// falling back to the current one for proposals created before it was recorded.
+func proposalTotalWeight(p group.Proposal, electorate group.GroupInfo) string {
+	if p.GroupTotalWeight != "" {
+		return p.GroupTotalWeight
+	}
+	return electorate.TotalWeight
+}
+
+// finalizeProposal closes the proposal with the given result and freezes its current tally
+// in the final tally result, unless it was already finalized.
+func finalizeProposal(p *group.Proposal, result group.Proposal_Result, totalWeight string) {
+	if p.FinalTallyResult != nil {
+		return
+	}
+	p.Result = result
+	p.Status = group.ProposalStatusClosed
+	p.FinalTallyResult = &group.FinalTallyResult{
+		Tally:       p.VoteState,
+		TotalWeight: totalWeight,
+	}
+}
+
+// earliestExecutionTime returns the time from which a proposal accepted in the current block can be executed.
+// Proposals accepted once their voting period is over are considered accepted at its end, so that the result
+// doesn't depend on the block in which the decision is stored.
+func earliestExecutionTime(ctx types.Context, p group.Proposal, policy group.DecisionPolicy) (*gogotypes.Timestamp, error) {
+	acceptedAt := ctx.BlockTime()
+	votingPeriodEnd, err := p.VotingPeriodEnd()
+	if err != nil {
+		return nil, err
+	}
+	if votingPeriodEnd.Before(acceptedAt) {
+		acceptedAt = votingPeriodEnd
+	}
+	executionDelay := policy.GetExecutionDelay()
+	delay, err := gogotypes.DurationFromProto(&executionDelay)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "execution delay")
+	}
+	return gogotypes.TimestampProto(acceptedAt.Add(delay))
+}
+
 // Exec executes the messages from a proposal.
 func (s serverImpl) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecResponse, error) {
 	ctx := types.UnwrapSDKContext(goCtx)
@@ -635,6 +716,15 @@ func (s serverImpl) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgE
 	if proposal.Status != group.ProposalStatusSubmitted && proposal.Status != group.ProposalStatusClosed {
 		return nil, sdkerrors.Wrapf(group.ErrInvalid, "not possible with proposal status %s", proposal.Status.String())
 	}
+	// Closed proposals can only be executed when accepted, and only until they were executed successfully.
+	if proposal.Status == group.ProposalStatusClosed {
+		if proposal.Result != group.ProposalResultAccepted {
+			return nil, sdkerrors.Wrapf(group.ErrInvalid, "not possible with proposal result %s", proposal.Result.String())
+		}
+		if proposal.ExecutorResult == group.ProposalExecutorResultSuccess {
+			return nil, sdkerrors.Wrap(group.ErrInvalid, "proposal already executed")
+		}
+	}
 
 	address, err := sdk.AccAddressFromBech32(proposal.Address)
 	if err != nil {
@@ -678,17 +768,39 @@ func (s serverImpl) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgE
 
 	// Execute proposal payload.
 	if proposal.Status == group.ProposalStatusClosed && proposal.Result == group.ProposalResultAccepted && proposal.ExecutorResult != group.ProposalExecutorResultSuccess {
+		// Ensure that the execution delay of the decision policy is over.
+		elapsed, err := proposal.ExecutionDelayElapsed(ctx.BlockTime())
+		if err != nil {
+			return nil, err
+		}
+		if !elapsed {
+			return nil, sdkerrors.Wrap(group.ErrInvalid, "not possible before the earliest execution time")
+		}
+
 		logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", group.ModuleName))
 		// Cashing context so that we don't update the store in case of failure.
 		ctx, flush := ctx.CacheContext()
 
-		err := s.execMsgs(sdk.WrapSDKContext(ctx), accountInfo.DerivationKey, proposal)
+		accountAddr, err := sdk.AccAddressFromBech32(accountInfo.Address)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "group account")
+		}
+		var balanceBefore sdk.Coins
+		if accountInfo.HasSpendLimit() {
+			balanceBefore = s.bankKeeper.GetAllBalances(ctx, accountAddr)
+		}
+		err = s.execMsgs(sdk.WrapSDKContext(ctx), accountInfo.DerivationKey, proposal)
+		if err == nil {
+			err = s.trackSpend(ctx, accountAddr, balanceBefore)
+		}
 		if err != nil {
 			proposal.ExecutorResult = group.ProposalExecutorResultFailure
+			proposal.ExecutorLog = err.Error()
 			proposalType := reflect.TypeOf(proposal).String()
 			logger.Info("proposal execution failed", "cause", err, "type", proposalType, "proposalID", id)
 		} else {
 			proposal.ExecutorResult = group.ProposalExecutorResultSuccess
+			proposal.ExecutorLog = ""
 			flush()
 		}
 	}
@@ -707,6 +819,149 @@ func (s serverImpl) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgE
 	return res, nil
 }
 
+// PruneProposal deletes a finalized proposal along with all its votes on behalf of the group admin or the group account.
+func (s serverImpl) PruneProposal(goCtx context.Context, req *group.MsgPruneProposal) (*group.MsgPruneProposalResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+	id := req.ProposalId
+
+	proposal, err := s.getProposal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the group admin or the group account itself may discard the final tally result of a proposal.
+	accountAddress, err := sdk.AccAddressFromBech32(proposal.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group account")
+	}
+	if req.Signer != proposal.Address {
+		accountInfo, err := s.getGroupAccountInfo(ctx, accountAddress.Bytes())
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "load group account")
+		}
+		groupInfo, err := s.getGroupInfo(ctx, accountInfo.GroupId)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "load group")
+		}
+		if groupInfo.Admin != req.Signer {
+			return nil, sdkerrors.Wrap(group.ErrUnauthorized, "not the group admin or the group account")
+		}
+	}
+
+	if !proposal.IsFinalized() {
+		return nil, sdkerrors.Wrapf(group.ErrInvalid, "not possible with proposal status %s, result %s and executor result %s",
+			proposal.Status.String(), proposal.Result.String(), proposal.ExecutorResult.String())
+	}
+
+	// Load all the votes first, as no writes may happen while iterating.
+	it, err := s.voteByProposalIndex.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	var votes []*group.Vote
+	if _, err := orm.ReadAll(it, &votes); err != nil {
+		return nil, err
+	}
+	for _, vote := range votes {
+		if err := s.voteTable.Delete(ctx, vote); err != nil {
+			return nil, sdkerrors.Wrap(err, "delete vote")
+		}
+	}
+
+	if err := s.proposalTable.Delete(ctx, id); err != nil {
+		return nil, sdkerrors.Wrap(err, "delete proposal")
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&group.EventPruneProposal{ProposalId: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgPruneProposalResponse{}, nil
+}
+
+// WithdrawProposal withdraws a proposal still open for voting on behalf of one of its proposers or the group admin.
+func (s serverImpl) WithdrawProposal(goCtx context.Context, req *group.MsgWithdrawProposal) (*group.MsgWithdrawProposalResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+	id := req.ProposalId
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "address")
+	}
+	proposal, err := s.getProposal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Status != group.ProposalStatusSubmitted {
+		return nil, sdkerrors.Wrapf(group.ErrInvalid, "not possible with proposal status %s", proposal.Status.String())
+	}
+	votingPeriodEnd, err := proposal.VotingPeriodEnd()
+	if err != nil {
+		return nil, err
+	}
+	if !votingPeriodEnd.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrap(group.ErrExpired, "voting period has ended already")
+	}
+
+	isProposer, err := s.isProposer(ctx, id, address)
+	if err != nil {
+		return nil, err
+	}
+	if !isProposer {
+		accountAddress, err := sdk.AccAddressFromBech32(proposal.Address)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "group account")
+		}
+		accountInfo, err := s.getGroupAccountInfo(ctx, accountAddress.Bytes())
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "load group account")
+		}
+		groupInfo, err := s.getGroupInfo(ctx, accountInfo.GroupId)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "load group")
+		}
+		if groupInfo.Admin != req.Address {
+			return nil, sdkerrors.Wrap(group.ErrUnauthorized, "not a proposer or the group admin")
+		}
+	}
+
+	proposal.Status = group.ProposalStatusWithdrawn
+	if err := s.proposalTable.Update(ctx, id, &proposal); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&group.EventWithdrawProposal{ProposalId: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgWithdrawProposalResponse{}, nil
+}
+
+// isProposer returns whether address is one of the proposers of the given proposal.
+func (s serverImpl) isProposer(ctx types.Context, proposalID uint64, address sdk.AccAddress) (bool, error) {
+	it, err := s.proposalByProposerIndex.Get(ctx, address.Bytes())
+	if err != nil {
+		return false, err
+	}
+	defer it.Close()
+
+	var proposal group.Proposal
+	for {
+		_, err := it.LoadNext(&proposal)
+		if orm.ErrIteratorDone.Is(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if proposal.ProposalId == proposalID {
+			return true, nil
+		}
+	}
+}
+
 type authNGroupReq interface {
 	GetGroupID() uint64
 	GetAdmin() string
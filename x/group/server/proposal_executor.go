@@ -14,19 +14,50 @@ func (s serverImpl) execMsgs(ctx context.Context, derivationKey []byte, proposal
 	derivedKey := s.key.Derive(derivationKey)
 	msgs := proposal.GetMsgs()
 
-	for _, msg := range msgs {
+	for i, msg := range msgs {
 		var reply interface{}
 
 		// Execute the message using the derived key,
 		// this will verify that the message signer is the group account.
 		err := derivedKey.Invoke(ctx, server.TypeURL(msg), msg, reply)
 		if err != nil {
-			return err
+			return errors.Wrapf(err, "message %d (%s)", i, server.TypeURL(msg))
 		}
 	}
 	return nil
 }
 
+// trackSpend records the coins spent by executing a proposal against the spend limit of the group account, if any.
+// The spend is measured as the drop of the group account balance since balanceBefore, so that coins moved by any
+// message count, not only by bank messages. It returns an error if the spend would exceed the spend limit in the
+// current period.
+func (s serverImpl) trackSpend(ctx sdk.Context, address sdk.AccAddress, balanceBefore sdk.Coins) error {
+	// reload the group account, the proposal messages may have updated it
+	accountInfo, err := s.getGroupAccountInfo(sdk.WrapSDKContext(ctx), address)
+	if err != nil {
+		return errors.Wrap(err, "load group account")
+	}
+	if !accountInfo.HasSpendLimit() {
+		return nil
+	}
+	spent := balanceDrop(balanceBefore, s.bankKeeper.GetAllBalances(ctx, address))
+	if err := accountInfo.Spend(spent, ctx.BlockTime()); err != nil {
+		return err
+	}
+	return s.groupAccountTable.Update(ctx, &accountInfo)
+}
+
+// balanceDrop returns the coins of each denom by which the after balance is lower than the before balance.
+func balanceDrop(before, after sdk.Coins) sdk.Coins {
+	drop := sdk.NewCoins()
+	for _, coin := range before {
+		if remaining := after.AmountOf(coin.Denom); remaining.LT(coin.Amount) {
+			drop = drop.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(remaining)))
+		}
+	}
+	return drop
+}
+
 // ensureMsgAuthZ checks that if a message requires signers that all of them are equal to the given group account.
 func ensureMsgAuthZ(msgs []sdk.Msg, groupAccount sdk.AccAddress) error {
 	for i := range msgs {
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/regen-network/regen-ledger/orm"
 	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/group"
@@ -191,6 +192,42 @@ func (s serverImpl) getProposalsByGroupAccount(ctx types.Context, account sdk.Ac
 	return s.proposalByGroupAccountIndex.GetPaginated(ctx, account.Bytes(), pageRequest)
 }
 
+func (s serverImpl) ProposalsByStatus(goCtx context.Context, request *group.QueryProposalsByStatusRequest) (*group.QueryProposalsByStatusResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := group.Proposal_Status_name[int32(request.Status)]; !ok || request.Status == group.ProposalStatusInvalid {
+		return nil, sdkerrors.Wrap(group.ErrInvalid, "status")
+	}
+	it, err := s.getProposalsByGroupAccountStatus(ctx, addr, request.Status, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposals []*group.Proposal
+	pageRes, err := orm.Paginate(it, request.Pagination, &proposals)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryProposalsByStatusResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (s serverImpl) getProposalsByGroupAccountStatus(ctx types.Context, account sdk.AccAddress, status group.Proposal_Status, pageRequest *query.PageRequest) (orm.Iterator, error) {
+	return s.proposalByGroupAccountStatusIndex.GetPaginated(ctx, proposalByGroupAccountStatusKey(account, status), pageRequest)
+}
+
+// proposalByGroupAccountStatusKey builds the proposalByGroupAccountStatusIndex key of
+// the proposals of the given group account with the given status.
+func proposalByGroupAccountStatusKey(account sdk.AccAddress, status group.Proposal_Status) []byte {
+	return orm.BuildCompositeKey(account.Bytes(), uint64(status))
+}
+
 func (s serverImpl) getProposal(ctx types.Context, proposalID uint64) (group.Proposal, error) {
 	var p group.Proposal
 	if _, err := s.proposalTable.GetOne(ctx, proposalID, &p); err != nil {
@@ -241,12 +278,26 @@ func (s serverImpl) VotesByVoter(goCtx context.Context, request *group.QueryVote
 	if err != nil {
 		return nil, err
 	}
+
+	var votes []*group.Vote
+	if request.Pagination != nil && request.Pagination.Reverse {
+		it, pageRes, err := s.voteByVoterIndex.GetPaginatedReverse(ctx, addr.Bytes(), request.Pagination)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := orm.ReadAll(it, &votes); err != nil {
+			return nil, err
+		}
+		return &group.QueryVotesByVoterResponse{
+			Votes:      votes,
+			Pagination: pageRes,
+		}, nil
+	}
+
 	it, err := s.getVotesByVoter(ctx, addr, request.Pagination)
 	if err != nil {
 		return nil, err
 	}
-
-	var votes []*group.Vote
 	pageRes, err := orm.Paginate(it, request.Pagination, &votes)
 	if err != nil {
 		return nil, err
@@ -270,3 +321,110 @@ func (s serverImpl) getVotesByProposal(ctx types.Context, proposalID uint64, pag
 func (s serverImpl) getVotesByVoter(ctx types.Context, voter sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
 	return s.voteByVoterIndex.GetPaginated(ctx, voter.Bytes(), pageRequest)
 }
+
+func (s serverImpl) GroupAccountBalance(goCtx context.Context, request *group.QueryGroupAccountBalanceRequest) (*group.QueryGroupAccountBalanceResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.getGroupAccountInfo(ctx, addr); err != nil {
+		return nil, sdkerrors.Wrap(err, "group account")
+	}
+
+	return &group.QueryGroupAccountBalanceResponse{Balance: s.bankKeeper.GetAllBalances(ctx.Context, addr)}, nil
+}
+
+func (s serverImpl) TallyResult(goCtx context.Context, request *group.QueryTallyResultRequest) (*group.QueryTallyResultResponse, error) {
+	ctx := types.UnwrapSDKContext(goCtx)
+	proposal, err := s.getProposal(ctx, request.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.FinalTallyResult != nil {
+		return &group.QueryTallyResultResponse{
+			Tally:       proposal.FinalTallyResult.Tally,
+			TotalWeight: proposal.FinalTallyResult.TotalWeight,
+			Finalized:   true,
+			WouldPass:   proposal.Result == group.ProposalResultAccepted,
+		}, nil
+	}
+	if proposal.Status != group.ProposalStatusSubmitted {
+		return nil, sdkerrors.Wrapf(group.ErrInvalid, "no tally for proposal with status %s", proposal.Status)
+	}
+
+	address, err := sdk.AccAddressFromBech32(proposal.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "group account")
+	}
+	accountInfo, err := s.getGroupAccountInfo(ctx, address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load group account")
+	}
+	electorate, err := s.getGroupInfo(ctx, accountInfo.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	tally, err := s.liveTally(ctx, proposal.ProposalId, electorate.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	totalWeight := proposalTotalWeight(proposal, electorate)
+
+	// Like on Vote, Exec and at the end of the voting period, a modified group or group account
+	// aborts the proposal.
+	if proposal.GroupAccountVersion != accountInfo.Version || proposal.GroupVersion != electorate.Version {
+		return &group.QueryTallyResultResponse{
+			Tally:       tally,
+			TotalWeight: totalWeight,
+			WouldAbort:  true,
+		}, nil
+	}
+
+	policy := accountInfo.GetDecisionPolicy()
+	if policy == nil {
+		return nil, sdkerrors.Wrap(group.ErrEmpty, "nil policy")
+	}
+	submittedAt, err := gogotypes.TimestampFromProto(&proposal.SubmittedAt)
+	if err != nil {
+		return nil, err
+	}
+	result, err := policy.Allow(tally, totalWeight, ctx.BlockTime().Sub(submittedAt))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "policy execution")
+	}
+
+	return &group.QueryTallyResultResponse{
+		Tally:       tally,
+		TotalWeight: totalWeight,
+		WouldPass:   result.Allow,
+	}, nil
+}
+
+// liveTally sums the votes of a proposal weighted with the current weights of
+// the group members. Votes of addresses that left the group are not counted.
+func (s serverImpl) liveTally(ctx types.Context, proposalID, groupID uint64) (group.Tally, error) {
+	tally := group.Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"}
+	it, err := s.voteByProposalIndex.Get(ctx, proposalID)
+	if err != nil {
+		return group.Tally{}, err
+	}
+	var votes []*group.Vote
+	if _, err := orm.ReadAll(it, &votes); err != nil {
+		return group.Tally{}, err
+	}
+	for _, vote := range votes {
+		member := group.GroupMember{GroupId: groupID, Member: &group.Member{Address: vote.Voter}}
+		switch err := s.groupMemberTable.GetOne(ctx, orm.PrimaryKey(&member), &member); {
+		case orm.ErrNotFound.Is(err):
+			continue
+		case err != nil:
+			return group.Tally{}, sdkerrors.Wrapf(err, "address: %s", vote.Voter)
+		}
+		if err := tally.Add(*vote, member.Member.Weight); err != nil {
+			return group.Tally{}, sdkerrors.Wrap(err, "add vote")
+		}
+	}
+	return tally, nil
+}
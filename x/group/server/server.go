@@ -30,10 +30,12 @@ const (
 	GroupAccountByAdminIndexPrefix byte = 0x23
 
 	// Proposal Table
-	ProposalTablePrefix               byte = 0x30
-	ProposalTableSeqPrefix            byte = 0x31
-	ProposalByGroupAccountIndexPrefix byte = 0x32
-	ProposalByProposerIndexPrefix     byte = 0x33
+	ProposalTablePrefix                     byte = 0x30
+	ProposalTableSeqPrefix                  byte = 0x31
+	ProposalByGroupAccountIndexPrefix       byte = 0x32
+	ProposalByProposerIndexPrefix           byte = 0x33
+	ProposalByVotingPeriodEndIndexPrefix    byte = 0x34
+	ProposalByGroupAccountStatusIndexPrefix byte = 0x35
 
 	// Vote Table
 	VoteTablePrefix           byte = 0x40
@@ -63,14 +65,16 @@ type serverImpl struct {
 	groupAccountByAdminIndex orm.Index
 
 	// Proposal Table
-	proposalTable               orm.AutoUInt64Table
-	proposalByGroupAccountIndex orm.Index
-	proposalByProposerIndex     orm.Index
+	proposalTable                     orm.AutoUInt64Table
+	proposalByGroupAccountIndex       orm.Index
+	proposalByProposerIndex           orm.Index
+	proposalByVotingPeriodEnd         orm.MultiKeyIndex
+	proposalByGroupAccountStatusIndex orm.MultiKeyIndex
 
 	// Vote Table
 	voteTable           orm.PrimaryKeyTable
 	voteByProposalIndex orm.UInt64Index
-	voteByVoterIndex    orm.Index
+	voteByVoterIndex    orm.MultiKeyIndex
 }
 
 func newServer(storeKey servermodule.RootModuleKey, accKeeper exported.AccountKeeper, bankKeeper exported.BankKeeper, cdc codec.Codec) serverImpl {
@@ -175,6 +179,32 @@ func newServer(storeKey servermodule.RootModuleKey, accKeeper exported.AccountKe
 	if err != nil {
 		panic(err.Error())
 	}
+	s.proposalByGroupAccountStatusIndex, err = orm.NewIndex(proposalTableBuilder, ProposalByGroupAccountStatusIndexPrefix, func(value interface{}) ([]orm.RowID, error) {
+		proposal := value.(*group.Proposal)
+		addr, err := sdk.AccAddressFromBech32(proposal.Address)
+		if err != nil {
+			return nil, err
+		}
+		return []orm.RowID{proposalByGroupAccountStatusKey(addr, proposal.Status)}, nil
+	})
+	if err != nil {
+		panic(err.Error())
+	}
+	s.proposalByVotingPeriodEnd, err = orm.NewIndex(proposalTableBuilder, ProposalByVotingPeriodEndIndexPrefix, func(value interface{}) ([]orm.RowID, error) {
+		proposal := value.(*group.Proposal)
+		// Only proposals open for voting can expire.
+		if proposal.Status != group.ProposalStatusSubmitted {
+			return nil, nil
+		}
+		votingPeriodEnd, err := proposal.VotingPeriodEnd()
+		if err != nil {
+			return nil, err
+		}
+		return []orm.RowID{orm.EncodeTime(votingPeriodEnd)}, nil
+	})
+	if err != nil {
+		panic(err.Error())
+	}
 	s.proposalTable = proposalTableBuilder.Build()
 
 	// Vote Table
@@ -210,6 +240,10 @@ func RegisterServices(configurator servermodule.Configurator, accountKeeper expo
 	configurator.RegisterInvariantsHandler(impl.RegisterInvariants)
 	configurator.RegisterGenesisHandlers(impl.InitGenesis, impl.ExportGenesis)
 	configurator.RegisterWeightedOperationsHandler(impl.WeightedOperations)
+	configurator.RegisterEndBlockHandler(impl.EndBlock)
+	if err := configurator.RegisterMigrationHandler(1, impl.migrateV1ToV2); err != nil {
+		panic(err)
+	}
 
 	// Require servers from external modules for ADR 033 message routing
 	configurator.RequireServer((*ecocredit.MsgServer)(nil))
@@ -87,7 +87,7 @@ func TestServer(t *testing.T) {
 
 	ecocreditModule := ecocredit.NewModule(ecocreditSubspace, bankKeeper)
 	ff.SetModules([]module.Module{
-		group.Module{AccountKeeper: accountKeeper},
+		group.Module{AccountKeeper: accountKeeper, BankKeeper: bankKeeper},
 		ecocreditModule,
 		data.Module{},
 	})
@@ -1,19 +1,23 @@
 package testsuite
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	proto "github.com/gogo/protobuf/types"
+
+	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/group"
 )
 
 func (s *IntegrationTestSuite) TestInitExportGenesis() {
 	require := s.Require()
-	ctx := s.genesisCtx
+	sdkCtx, _ := s.genesisCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
 	cdc := s.fixture.Codec()
 
 	now := time.Now()
@@ -66,7 +70,7 @@ func (s *IntegrationTestSuite) TestInitExportGenesis() {
 
 	genesisState := &group.GenesisState{
 		GroupSeq:        2,
-		Groups:          []*group.GroupInfo{{GroupId: 1, Admin: s.addr1.String(), Metadata: []byte("1"), Version: 1, TotalWeight: "1"}, {GroupId: 2, Admin: s.addr2.String(), Metadata: []byte("2"), Version: 2, TotalWeight: "2"}},
+		Groups:          []*group.GroupInfo{{GroupId: 1, Admin: s.addr1.String(), Metadata: []byte("1"), Version: 1, TotalWeight: "1", MemberCount: 1}, {GroupId: 2, Admin: s.addr2.String(), Metadata: []byte("2"), Version: 2, TotalWeight: "2", MemberCount: 1}},
 		GroupMembers:    []*group.GroupMember{{GroupId: 1, Member: &group.Member{Address: s.addr1.String(), Weight: "1", Metadata: []byte("member metadata")}}, {GroupId: 2, Member: &group.Member{Address: s.addr1.String(), Weight: "2", Metadata: []byte("member metadata")}}},
 		GroupAccountSeq: 1,
 		GroupAccounts:   []*group.GroupAccountInfo{groupAccount},
@@ -133,6 +137,8 @@ func (s *IntegrationTestSuite) TestInitExportGenesis() {
 	var exportedGenesisState group.GenesisState
 	err = cdc.UnmarshalJSON(exported[group.ModuleName], &exportedGenesisState)
 	require.NoError(err)
+	// the streamed export is encoded like the codec encodes the whole state
+	require.JSONEq(string(cdc.MustMarshalJSON(&exportedGenesisState)), string(exported[group.ModuleName]))
 
 	require.Equal(genesisState.Groups, exportedGenesisState.Groups)
 	require.Equal(genesisState.GroupMembers, exportedGenesisState.GroupMembers)
@@ -158,6 +164,91 @@ func (s *IntegrationTestSuite) TestInitExportGenesis() {
 
 }
 
+func (s *IntegrationTestSuite) TestExportImportGenesisRoundTrip() {
+	require := s.Require()
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	// a proposal still open for voting, on top of the suite setup
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupAccountAddr.String(),
+		ToAddress:   s.addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposalID := createProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{s.addr5.String()}, group.Choice_CHOICE_YES)
+
+	exported, err := s.fixture.ExportGenesis(sdkCtx)
+	require.NoError(err)
+
+	importSDKCtx, _ := s.genesisCtx.CacheContext()
+	importSDKCtx = importSDKCtx.WithBlockTime(s.blockTime)
+	importCtx := types.Context{Context: importSDKCtx}
+	_, err = s.fixture.InitGenesis(importSDKCtx, exported)
+	require.NoError(err)
+
+	// export -> import -> export yields the same state
+	reExported, err := s.fixture.ExportGenesis(importSDKCtx)
+	require.NoError(err)
+	require.JSONEq(string(exported[group.ModuleName]), string(reExported[group.ModuleName]))
+
+	// secondary indexes are rebuilt
+	queries := map[string]func(ctx context.Context) (interface{}, error){
+		"groups by admin": func(ctx context.Context) (interface{}, error) {
+			return s.queryClient.GroupsByAdmin(ctx, &group.QueryGroupsByAdminRequest{Admin: s.addr1.String()})
+		},
+		"group accounts by group": func(ctx context.Context) (interface{}, error) {
+			return s.queryClient.GroupAccountsByGroup(ctx, &group.QueryGroupAccountsByGroupRequest{GroupId: s.groupID})
+		},
+		"group accounts by admin": func(ctx context.Context) (interface{}, error) {
+			return s.queryClient.GroupAccountsByAdmin(ctx, &group.QueryGroupAccountsByAdminRequest{Admin: s.addr1.String()})
+		},
+		"proposals by group account": func(ctx context.Context) (interface{}, error) {
+			return s.queryClient.ProposalsByGroupAccount(ctx, &group.QueryProposalsByGroupAccountRequest{Address: s.groupAccountAddr.String()})
+		},
+		"proposals by status": func(ctx context.Context) (interface{}, error) {
+			return s.queryClient.ProposalsByStatus(ctx, &group.QueryProposalsByStatusRequest{Address: s.groupAccountAddr.String(), Status: group.ProposalStatusSubmitted})
+		},
+		"votes by voter": func(ctx context.Context) (interface{}, error) {
+			return s.queryClient.VotesByVoter(ctx, &group.QueryVotesByVoterRequest{Voter: s.addr5.String()})
+		},
+	}
+	for name, query := range queries {
+		exp, err := query(ctx)
+		require.NoError(err, name)
+		got, err := query(importCtx)
+		require.NoError(err, name)
+		require.Equal(exp, got, name)
+	}
+
+	// sequences continue after the imported IDs
+	createGroup := func(ctx context.Context) uint64 {
+		res, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+			Admin:   s.addr1.String(),
+			Members: []group.Member{{Address: s.addr2.String(), Weight: "1"}},
+		})
+		require.NoError(err)
+		return res.GroupId
+	}
+	createGroupAccount := func(ctx context.Context, groupID uint64) string {
+		req := &group.MsgCreateGroupAccount{Admin: s.addr1.String(), GroupId: groupID}
+		require.NoError(req.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", proto.Duration{Seconds: 1})))
+		res, err := s.msgClient.CreateGroupAccount(ctx, req)
+		require.NoError(err)
+		return res.Address
+	}
+	expGroupID := createGroup(ctx)
+	require.Equal(expGroupID, createGroup(importCtx))
+	require.Equal(createGroupAccount(ctx, expGroupID), createGroupAccount(importCtx, expGroupID))
+	expProposalID := createProposal(ctx, s, []sdk.Msg{msgSend}, []string{s.addr5.String()})
+	require.Equal(expProposalID, createProposal(importCtx, s, []sdk.Msg{msgSend}, []string{s.addr5.String()}))
+
+	// and open proposals are still closed at the end of their voting period
+	require.NoError(s.fixture.EndBlock(importSDKCtx.WithBlockTime(s.blockTime.Add(time.Hour))))
+	res, err := s.queryClient.Proposal(importCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+	require.NoError(err)
+	require.Equal(group.ProposalStatusClosed, res.Proposal.Status)
+}
+
 func (s *IntegrationTestSuite) assertGroupAccountsEqual(g *group.GroupAccountInfo, other *group.GroupAccountInfo) {
 	require := s.Require()
 	require.Equal(g.Address, other.Address)
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -159,6 +160,7 @@ func (s *IntegrationTestSuite) TestCreateGroup() {
 			Version:     1,
 			Admin:       s.addr1.String(),
 			TotalWeight: "3",
+			MemberCount: 2,
 			Metadata:    nil,
 		},
 		{
@@ -166,6 +168,7 @@ func (s *IntegrationTestSuite) TestCreateGroup() {
 			Version:     1,
 			Admin:       s.addr1.String(),
 			TotalWeight: "3",
+			MemberCount: 2,
 			Metadata:    nil,
 		},
 	}
@@ -240,6 +243,7 @@ func (s *IntegrationTestSuite) TestCreateGroup() {
 			s.Assert().Equal(spec.req.Metadata, loadedGroupRes.Info.Metadata)
 			s.Assert().Equal(id, loadedGroupRes.Info.GroupId)
 			s.Assert().Equal(uint64(1), loadedGroupRes.Info.Version)
+			s.Assert().Equal(uint64(len(members)), loadedGroupRes.Info.MemberCount)
 
 			// and members are stored as well
 			membersRes, err := s.queryClient.GroupMembers(s.ctx, &group.QueryGroupMembersRequest{GroupId: id})
@@ -264,6 +268,7 @@ func (s *IntegrationTestSuite) TestCreateGroup() {
 				s.Assert().Equal(spec.expGroups[i].Metadata, loadedGroups[i].Metadata)
 				s.Assert().Equal(spec.expGroups[i].Admin, loadedGroups[i].Admin)
 				s.Assert().Equal(spec.expGroups[i].TotalWeight, loadedGroups[i].TotalWeight)
+				s.Assert().Equal(spec.expGroups[i].MemberCount, loadedGroups[i].MemberCount)
 				s.Assert().Equal(spec.expGroups[i].GroupId, loadedGroups[i].GroupId)
 				s.Assert().Equal(spec.expGroups[i].Version, loadedGroups[i].Version)
 			}
@@ -303,6 +308,7 @@ func (s *IntegrationTestSuite) TestUpdateGroupAdmin() {
 				Admin:       newAdmin,
 				Metadata:    nil,
 				TotalWeight: "1",
+				MemberCount: 1,
 				Version:     2,
 			},
 		},
@@ -318,6 +324,7 @@ func (s *IntegrationTestSuite) TestUpdateGroupAdmin() {
 				Admin:       oldAdmin,
 				Metadata:    nil,
 				TotalWeight: "1",
+				MemberCount: 1,
 				Version:     1,
 			},
 		},
@@ -333,6 +340,7 @@ func (s *IntegrationTestSuite) TestUpdateGroupAdmin() {
 				Admin:       oldAdmin,
 				Metadata:    nil,
 				TotalWeight: "1",
+				MemberCount: 1,
 				Version:     1,
 			},
 		},
@@ -375,6 +383,7 @@ func (s *IntegrationTestSuite) TestUpdateGroupMetadata() {
 				Admin:       oldAdmin,
 				Metadata:    []byte{1, 2, 3},
 				TotalWeight: "3",
+				MemberCount: 2,
 				Version:     2,
 			},
 		},
@@ -468,6 +477,7 @@ func (s *IntegrationTestSuite) TestUpdateGroupMembers() {
 				Admin:       myAdmin,
 				Metadata:    nil,
 				TotalWeight: "3",
+				MemberCount: 2,
 				Version:     2,
 			},
 			expMembers: []*group.GroupMember{
@@ -504,6 +514,7 @@ func (s *IntegrationTestSuite) TestUpdateGroupMembers() {
 				Admin:       myAdmin,
 				Metadata:    nil,
 				TotalWeight: "2",
+				MemberCount: 1,
 				Version:     2,
 			},
 			expMembers: []*group.GroupMember{
@@ -531,6 +542,7 @@ func (s *IntegrationTestSuite) TestUpdateGroupMembers() {
 				Admin:       myAdmin,
 				Metadata:    nil,
 				TotalWeight: "1",
+				MemberCount: 1,
 				Version:     2,
 			},
 			expMembers: []*group.GroupMember{
@@ -565,6 +577,7 @@ func (s *IntegrationTestSuite) TestUpdateGroupMembers() {
 				Admin:       myAdmin,
 				Metadata:    nil,
 				TotalWeight: "1",
+				MemberCount: 1,
 				Version:     2,
 			},
 			expMembers: []*group.GroupMember{{
@@ -591,6 +604,7 @@ func (s *IntegrationTestSuite) TestUpdateGroupMembers() {
 				Admin:       myAdmin,
 				Metadata:    nil,
 				TotalWeight: "0",
+				MemberCount: 0,
 				Version:     2,
 			},
 			expMembers: []*group.GroupMember{},
@@ -611,6 +625,7 @@ func (s *IntegrationTestSuite) TestUpdateGroupMembers() {
 				Admin:       myAdmin,
 				Metadata:    nil,
 				TotalWeight: "1",
+				MemberCount: 1,
 				Version:     1,
 			},
 			expMembers: []*group.GroupMember{{
@@ -638,6 +653,7 @@ func (s *IntegrationTestSuite) TestUpdateGroupMembers() {
 				Admin:       myAdmin,
 				Metadata:    nil,
 				TotalWeight: "1",
+				MemberCount: 1,
 				Version:     1,
 			},
 			expMembers: []*group.GroupMember{{
@@ -664,6 +680,7 @@ func (s *IntegrationTestSuite) TestUpdateGroupMembers() {
 				Admin:       myAdmin,
 				Metadata:    nil,
 				TotalWeight: "1",
+				MemberCount: 1,
 				Version:     1,
 			},
 			expMembers: []*group.GroupMember{{
@@ -711,10 +728,118 @@ func (s *IntegrationTestSuite) TestUpdateGroupMembers() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestUpdateGroupMembersInSingleMsg() {
+	myAdmin := s.addr4.String()
+	groupRes, err := s.msgClient.CreateGroup(s.ctx, &group.MsgCreateGroup{
+		Admin: myAdmin,
+		Members: []group.Member{
+			{Address: s.addr2.String(), Weight: "1"},
+			{Address: s.addr3.String(), Weight: "2"},
+			{Address: s.addr5.String(), Weight: "3"},
+		},
+	})
+	s.Require().NoError(err)
+	groupID := groupRes.GroupId
+
+	specs := map[string]struct {
+		memberUpdates []group.Member
+		expErr        bool
+		expGroup      *group.GroupInfo
+		expMembers    []group.Member
+	}{
+		"add, remove and reweight members": {
+			memberUpdates: []group.Member{
+				{Address: s.addr2.String(), Weight: "0"},
+				{Address: s.addr3.String(), Weight: "2.5"},
+				{Address: s.addr6.String(), Weight: "4"},
+			},
+			expGroup: &group.GroupInfo{
+				GroupId:     groupID,
+				Admin:       myAdmin,
+				TotalWeight: "9.5",
+				MemberCount: 3,
+				Version:     2,
+			},
+			expMembers: []group.Member{
+				{Address: s.addr3.String(), Weight: "2.5"},
+				{Address: s.addr5.String(), Weight: "3"},
+				{Address: s.addr6.String(), Weight: "4"},
+			},
+		},
+		"remove all members": {
+			memberUpdates: []group.Member{
+				{Address: s.addr2.String(), Weight: "0"},
+				{Address: s.addr3.String(), Weight: "0"},
+				{Address: s.addr5.String(), Weight: "0"},
+			},
+			expGroup: &group.GroupInfo{
+				GroupId:     groupID,
+				Admin:       myAdmin,
+				TotalWeight: "0",
+				MemberCount: 0,
+				Version:     2,
+			},
+			expMembers: []group.Member{},
+		},
+		"duplicate member entries": {
+			memberUpdates: []group.Member{
+				{Address: s.addr3.String(), Weight: "2.5"},
+				{Address: s.addr6.String(), Weight: "4"},
+				{Address: s.addr3.String(), Weight: "0"},
+			},
+			expErr: true,
+		},
+		"any unknown member to remove fails all updates": {
+			memberUpdates: []group.Member{
+				{Address: s.addr6.String(), Weight: "4"},
+				{Address: s.addr1.String(), Weight: "0"},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+			ctx := types.Context{Context: sdkCtx}
+			_, err := s.msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembers{
+				GroupId:       groupID,
+				Admin:         myAdmin,
+				MemberUpdates: spec.memberUpdates,
+			})
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			res, err := s.queryClient.GroupInfo(ctx, &group.QueryGroupInfoRequest{GroupId: groupID})
+			s.Require().NoError(err)
+			s.Assert().Equal(spec.expGroup, res.Info)
+
+			membersRes, err := s.queryClient.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: groupID})
+			s.Require().NoError(err)
+			loadedMembers := membersRes.Members
+			s.Require().Equal(len(spec.expMembers), len(loadedMembers))
+			sort.Slice(spec.expMembers, func(i, j int) bool {
+				return spec.expMembers[i].Address < spec.expMembers[j].Address
+			})
+			for i := range loadedMembers {
+				s.Assert().Equal(groupID, loadedMembers[i].GroupId)
+				s.Assert().Equal(spec.expMembers[i].Address, loadedMembers[i].Member.Address)
+				s.Assert().Equal(spec.expMembers[i].Weight, loadedMembers[i].Member.Weight)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestCreateGroupAccount() {
 	groupRes, err := s.msgClient.CreateGroup(s.ctx, &group.MsgCreateGroup{
-		Admin:    s.addr1.String(),
-		Members:  nil,
+		Admin: s.addr1.String(),
+		Members: []group.Member{
+			{Address: s.addr1.String(), Weight: "1"},
+			{Address: s.addr2.String(), Weight: "2"},
+		},
 		Metadata: nil,
 	})
 	s.Require().NoError(err)
@@ -736,6 +861,17 @@ func (s *IntegrationTestSuite) TestCreateGroupAccount() {
 				gogotypes.Duration{Seconds: 1},
 			),
 		},
+		"decision policy threshold = total group weight": {
+			req: &group.MsgCreateGroupAccount{
+				Admin:    s.addr1.String(),
+				Metadata: nil,
+				GroupId:  myGroupID,
+			},
+			policy: group.NewThresholdDecisionPolicy(
+				"3",
+				gogotypes.Duration{Seconds: 1},
+			),
+		},
 		"decision policy threshold > total group weight": {
 			req: &group.MsgCreateGroupAccount{
 				Admin:    s.addr1.String(),
@@ -743,9 +879,10 @@ func (s *IntegrationTestSuite) TestCreateGroupAccount() {
 				GroupId:  myGroupID,
 			},
 			policy: group.NewThresholdDecisionPolicy(
-				"10",
+				"3.1",
 				gogotypes.Duration{Seconds: 1},
 			),
+			expErr: true,
 		},
 		"group id does not exists": {
 			req: &group.MsgCreateGroupAccount{
@@ -1051,7 +1188,7 @@ func (s *IntegrationTestSuite) TestGroupAccountsByAdminOrGroup() {
 	admin := s.addr2
 	groupRes, err := s.msgClient.CreateGroup(s.ctx, &group.MsgCreateGroup{
 		Admin:    admin.String(),
-		Members:  nil,
+		Members:  []group.Member{{Address: admin.String(), Weight: "10"}},
 		Metadata: nil,
 	})
 	s.Require().NoError(err)
@@ -1131,6 +1268,140 @@ func (s *IntegrationTestSuite) TestGroupAccountsByAdminOrGroup() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGroupAccountsByGroupPagination() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr2.String(), Weight: "3"}},
+	})
+	s.Require().NoError(err)
+	groupID := groupRes.GroupId
+
+	thresholds := []string{"1", "2", "3"}
+	expAccounts := make(map[string]*group.GroupAccountInfo, len(thresholds))
+	for _, threshold := range thresholds {
+		req := &group.MsgCreateGroupAccount{
+			Admin:   s.addr1.String(),
+			GroupId: groupID,
+		}
+		policy := group.NewThresholdDecisionPolicy(threshold, gogotypes.Duration{Seconds: 1})
+		s.Require().NoError(req.SetDecisionPolicy(policy))
+		res, err := s.msgClient.CreateGroupAccount(ctx, req)
+		s.Require().NoError(err)
+		expAccount := &group.GroupAccountInfo{Address: res.Address, Admin: s.addr1.String(), GroupId: groupID}
+		s.Require().NoError(expAccount.SetDecisionPolicy(policy))
+		expAccounts[res.Address] = expAccount
+	}
+
+	queryAccounts := func(pageReq *query.PageRequest) *group.QueryGroupAccountsByGroupResponse {
+		res, err := s.queryClient.GroupAccountsByGroup(ctx, &group.QueryGroupAccountsByGroupRequest{
+			GroupId:    groupID,
+			Pagination: pageReq,
+		})
+		s.Require().NoError(err)
+		return res
+	}
+
+	// all accounts of the group are returned page by page
+	res := queryAccounts(&query.PageRequest{Limit: 2, CountTotal: true})
+	s.Require().Len(res.GroupAccounts, 2)
+	s.Assert().Equal(uint64(len(thresholds)), res.Pagination.Total)
+	s.Require().NotNil(res.Pagination.NextKey)
+	loaded := res.GroupAccounts
+	res = queryAccounts(&query.PageRequest{Key: res.Pagination.NextKey, Limit: 2})
+	s.Require().Len(res.GroupAccounts, 1)
+	s.Assert().Nil(res.Pagination.NextKey)
+	loaded = append(loaded, res.GroupAccounts...)
+
+	for _, account := range loaded {
+		exp, ok := expAccounts[account.Address]
+		s.Require().True(ok, account.Address)
+		s.Assert().Equal(exp.Admin, account.Admin)
+		s.Assert().Equal(exp.GroupId, account.GroupId)
+		s.Assert().Equal(exp.GetDecisionPolicy(), account.GetDecisionPolicy())
+		delete(expAccounts, account.Address)
+	}
+	s.Assert().Empty(expAccounts)
+
+	// accounts of other groups are not included
+	res, err = s.queryClient.GroupAccountsByGroup(ctx, &group.QueryGroupAccountsByGroupRequest{GroupId: groupID + 1})
+	s.Require().NoError(err)
+	s.Assert().Empty(res.GroupAccounts)
+}
+
+func (s *IntegrationTestSuite) TestQueriesByAdminAfterAdminUpdate() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+	oldAdmin, newAdmin := s.addr3, s.addr4
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:   oldAdmin.String(),
+		Members: []group.Member{{Address: s.addr2.String(), Weight: "1"}},
+	})
+	s.Require().NoError(err)
+	groupID := groupRes.GroupId
+
+	req := &group.MsgCreateGroupAccount{
+		Admin:   oldAdmin.String(),
+		GroupId: groupID,
+	}
+	s.Require().NoError(req.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 1})))
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, req)
+	s.Require().NoError(err)
+	accountAddr := accountRes.Address
+
+	hasGroup := func(admin sdk.AccAddress) bool {
+		res, err := s.queryClient.GroupsByAdmin(ctx, &group.QueryGroupsByAdminRequest{Admin: admin.String()})
+		s.Require().NoError(err)
+		for _, g := range res.Groups {
+			if g.GroupId == groupID {
+				s.Assert().Equal(admin.String(), g.Admin)
+				return true
+			}
+		}
+		return false
+	}
+	hasGroupAccount := func(admin sdk.AccAddress) bool {
+		res, err := s.queryClient.GroupAccountsByAdmin(ctx, &group.QueryGroupAccountsByAdminRequest{Admin: admin.String()})
+		s.Require().NoError(err)
+		for _, acc := range res.GroupAccounts {
+			if acc.Address == accountAddr {
+				s.Assert().Equal(admin.String(), acc.Admin)
+				return true
+			}
+		}
+		return false
+	}
+
+	s.Require().True(hasGroup(oldAdmin))
+	s.Require().False(hasGroup(newAdmin))
+	s.Require().True(hasGroupAccount(oldAdmin))
+	s.Require().False(hasGroupAccount(newAdmin))
+
+	_, err = s.msgClient.UpdateGroupAdmin(ctx, &group.MsgUpdateGroupAdmin{
+		GroupId:  groupID,
+		Admin:    oldAdmin.String(),
+		NewAdmin: newAdmin.String(),
+	})
+	s.Require().NoError(err)
+	s.Assert().False(hasGroup(oldAdmin))
+	s.Assert().True(hasGroup(newAdmin))
+	// the group account admin is managed independently
+	s.Assert().True(hasGroupAccount(oldAdmin))
+	s.Assert().False(hasGroupAccount(newAdmin))
+
+	_, err = s.msgClient.UpdateGroupAccountAdmin(ctx, &group.MsgUpdateGroupAccountAdmin{
+		Admin:    oldAdmin.String(),
+		Address:  accountAddr,
+		NewAdmin: newAdmin.String(),
+	})
+	s.Require().NoError(err)
+	s.Assert().False(hasGroupAccount(oldAdmin))
+	s.Assert().True(hasGroupAccount(newAdmin))
+}
+
 func (s *IntegrationTestSuite) TestCreateProposal() {
 	myGroupID := s.groupID
 	accountAddr := s.groupAccountAddr
@@ -1147,7 +1418,7 @@ func (s *IntegrationTestSuite) TestCreateProposal() {
 		Metadata: nil,
 	}
 	policy := group.NewThresholdDecisionPolicy(
-		"100",
+		"3",
 		gogotypes.Duration{Seconds: 1},
 	)
 	err := accountReq.SetDecisionPolicy(policy)
@@ -1156,6 +1427,20 @@ func (s *IntegrationTestSuite) TestCreateProposal() {
 	s.Require().NoError(err)
 	bigThresholdAddr := bigThresholdRes.Address
 
+	// the threshold is only checked against the group weight on account
+	// creation, so raise it afterwards
+	updatePolicyReq := &group.MsgUpdateGroupAccountDecisionPolicy{
+		Admin:   s.addr1.String(),
+		Address: bigThresholdAddr,
+	}
+	err = updatePolicyReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy(
+		"100",
+		gogotypes.Duration{Seconds: 1},
+	))
+	s.Require().NoError(err)
+	_, err = s.msgClient.UpdateGroupAccountDecisionPolicy(s.ctx, updatePolicyReq)
+	s.Require().NoError(err)
+
 	defaultProposal := group.Proposal{
 		Status: group.ProposalStatusSubmitted,
 		Result: group.ProposalResultUnfinalized,
@@ -1837,111 +2122,669 @@ func (s *IntegrationTestSuite) TestVote() {
 	}
 }
 
-func (s *IntegrationTestSuite) TestExecProposal() {
-	msgSend1 := &banktypes.MsgSend{
-		FromAddress: s.groupAccountAddr.String(),
-		ToAddress:   s.addr2.String(),
-		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+func (s *IntegrationTestSuite) TestVoteFractionalWeights() {
+	members := []group.Member{
+		{Address: s.addr4.String(), Weight: "0.5"},
+		{Address: s.addr3.String(), Weight: "0.25"},
+		{Address: s.addr6.String(), Weight: "0.3"},
 	}
-	msgSend2 := &banktypes.MsgSend{
-		FromAddress: s.groupAccountAddr.String(),
-		ToAddress:   s.addr2.String(),
-		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 10001)},
+	groupRes, err := s.msgClient.CreateGroup(s.ctx, &group.MsgCreateGroup{
+		Admin:    s.addr1.String(),
+		Members:  members,
+		Metadata: nil,
+	})
+	s.Require().NoError(err)
+	myGroupID := groupRes.GroupId
+
+	groupInfoRes, err := s.queryClient.GroupInfo(s.ctx, &group.QueryGroupInfoRequest{GroupId: myGroupID})
+	s.Require().NoError(err)
+	s.Assert().Equal("1.05", groupInfoRes.Info.TotalWeight)
+
+	policy := group.NewThresholdDecisionPolicy(
+		"0.7",
+		gogotypes.Duration{Seconds: 1},
+	)
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:    s.addr1.String(),
+		GroupId:  myGroupID,
+		Metadata: nil,
 	}
-	proposers := []string{s.addr2.String()}
+	err = accountReq.SetDecisionPolicy(policy)
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(s.ctx, accountReq)
+	s.Require().NoError(err)
+	accountAddr := accountRes.Address
 
-	specs := map[string]struct {
-		srcBlockTime      time.Time
-		setupProposal     func(ctx context.Context) uint64
-		expErr            bool
-		expProposalStatus group.Proposal_Status
-		expProposalResult group.Proposal_Result
-		expExecutorResult group.Proposal_ExecutorResult
-		expFromBalances   sdk.Coins
-		expToBalances     sdk.Coins
-	}{
-		"proposal executed when accepted": {
-			setupProposal: func(ctx context.Context) uint64 {
-				msgs := []sdk.Msg{msgSend1}
-				return createProposalAndVote(ctx, s, msgs, proposers, group.Choice_CHOICE_YES)
-			},
-			expProposalStatus: group.ProposalStatusClosed,
-			expProposalResult: group.ProposalResultAccepted,
-			expExecutorResult: group.ProposalExecutorResultSuccess,
-			expFromBalances:   sdk.Coins{sdk.NewInt64Coin("test", 9800)},
-			expToBalances:     sdk.Coins{sdk.NewInt64Coin("test", 200)},
-		},
-		"proposal with ADR 033 executed when accepted": {
-			setupProposal: func(ctx context.Context) uint64 {
-				msgs := []sdk.Msg{&ecocredit.MsgCreateClass{
-					Admin:          s.groupAccountAddr.String(),
-					Issuers:        []string{s.groupAccountAddr.String()},
-					CreditTypeName: "carbon",
-				},
-				}
-				return createProposalAndVote(ctx, s, msgs, proposers, group.Choice_CHOICE_YES)
-			},
-			expProposalStatus: group.ProposalStatusClosed,
-			expProposalResult: group.ProposalResultAccepted,
-			expExecutorResult: group.ProposalExecutorResultSuccess,
-		},
-		"proposal with multiple messages executed when accepted": {
-			setupProposal: func(ctx context.Context) uint64 {
-				msgs := []sdk.Msg{msgSend1, msgSend1}
-				return createProposalAndVote(ctx, s, msgs, proposers, group.Choice_CHOICE_YES)
-			},
-			expProposalStatus: group.ProposalStatusClosed,
-			expProposalResult: group.ProposalResultAccepted,
-			expExecutorResult: group.ProposalExecutorResultSuccess,
-			expFromBalances:   sdk.Coins{sdk.NewInt64Coin("test", 9700)},
-			expToBalances:     sdk.Coins{sdk.NewInt64Coin("test", 300)},
-		},
-		"proposal not executed when rejected": {
-			setupProposal: func(ctx context.Context) uint64 {
-				msgs := []sdk.Msg{msgSend1}
-				return createProposalAndVote(ctx, s, msgs, proposers, group.Choice_CHOICE_NO)
-			},
-			expProposalStatus: group.ProposalStatusClosed,
-			expProposalResult: group.ProposalResultRejected,
-			expExecutorResult: group.ProposalExecutorResultNotRun,
-		},
-		"open proposal must not fail": {
-			setupProposal: func(ctx context.Context) uint64 {
-				return createProposal(ctx, s, []sdk.Msg{msgSend1}, proposers)
-			},
-			expProposalStatus: group.ProposalStatusSubmitted,
-			expProposalResult: group.ProposalResultUnfinalized,
-			expExecutorResult: group.ProposalExecutorResultNotRun,
-		},
-		"existing proposal required": {
-			setupProposal: func(ctx context.Context) uint64 {
-				return 9999
-			},
-			expErr: true,
-		},
-		"Decision policy also applied on timeout": {
-			setupProposal: func(ctx context.Context) uint64 {
-				msgs := []sdk.Msg{msgSend1}
-				return createProposalAndVote(ctx, s, msgs, proposers, group.Choice_CHOICE_NO)
-			},
-			srcBlockTime:      s.blockTime.Add(time.Second),
-			expProposalStatus: group.ProposalStatusClosed,
-			expProposalResult: group.ProposalResultRejected,
-			expExecutorResult: group.ProposalExecutorResultNotRun,
-		},
-		"Decision policy also applied after timeout": {
-			setupProposal: func(ctx context.Context) uint64 {
-				msgs := []sdk.Msg{msgSend1}
-				return createProposalAndVote(ctx, s, msgs, proposers, group.Choice_CHOICE_NO)
-			},
-			srcBlockTime:      s.blockTime.Add(time.Second).Add(time.Millisecond),
-			expProposalStatus: group.ProposalStatusClosed,
-			expProposalResult: group.ProposalResultRejected,
-			expExecutorResult: group.ProposalExecutorResultNotRun,
-		},
-		"with group modified before tally": {
-			setupProposal: func(ctx context.Context) uint64 {
-				myProposalID := createProposal(ctx, s, []sdk.Msg{msgSend1}, proposers)
+	newProposal := func() uint64 {
+		req := &group.MsgCreateProposal{
+			Address:   accountAddr,
+			Proposers: []string{s.addr4.String()},
+		}
+		err := req.SetMsgs([]sdk.Msg{&banktypes.MsgSend{
+			FromAddress: accountAddr,
+			ToAddress:   s.addr5.String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+		}})
+		s.Require().NoError(err)
+		res, err := s.msgClient.CreateProposal(s.ctx, req)
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+	vote := func(proposalID uint64, voter sdk.AccAddress, choice group.Choice) *group.Proposal {
+		_, err := s.msgClient.Vote(s.ctx, &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      voter.String(),
+			Choice:     choice,
+		})
+		s.Require().NoError(err)
+		res, err := s.queryClient.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res.Proposal
+	}
+
+	// yes votes crossing the decimal threshold
+	acceptedID := newProposal()
+	proposal := vote(acceptedID, s.addr4, group.Choice_CHOICE_YES)
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposal.Status)
+	s.Assert().Equal(group.ProposalResultUnfinalized, proposal.Result)
+	s.Assert().Equal("0.5", proposal.VoteState.YesCount)
+
+	proposal = vote(acceptedID, s.addr3, group.Choice_CHOICE_YES)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposal.Result)
+	s.Assert().Equal(group.Tally{
+		YesCount:     "0.75",
+		NoCount:      "0",
+		AbstainCount: "0",
+		VetoCount:    "0",
+	}, proposal.VoteState)
+
+	// no votes leaving the decimal threshold out of reach
+	rejectedID := newProposal()
+	proposal = vote(rejectedID, s.addr4, group.Choice_CHOICE_YES)
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposal.Status)
+
+	proposal = vote(rejectedID, s.addr6, group.Choice_CHOICE_NO)
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposal.Status)
+	s.Assert().Equal(group.ProposalResultUnfinalized, proposal.Result)
+
+	proposal = vote(rejectedID, s.addr3, group.Choice_CHOICE_NO)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultRejected, proposal.Result)
+	s.Assert().Equal(group.Tally{
+		YesCount:     "0.5",
+		NoCount:      "0.55",
+		AbstainCount: "0",
+		VetoCount:    "0",
+	}, proposal.VoteState)
+}
+
+func (s *IntegrationTestSuite) TestVotePercentageDecisionPolicy() {
+	members := []group.Member{
+		{Address: s.addr4.String(), Weight: "2"},
+		{Address: s.addr3.String(), Weight: "1.999"},
+		{Address: s.addr6.String(), Weight: "0.001"},
+	}
+	groupRes, err := s.msgClient.CreateGroup(s.ctx, &group.MsgCreateGroup{
+		Admin:    s.addr1.String(),
+		Members:  members,
+		Metadata: nil,
+	})
+	s.Require().NoError(err)
+	myGroupID := groupRes.GroupId
+
+	policy := group.NewPercentageDecisionPolicy(
+		"0.5",
+		gogotypes.Duration{Seconds: 1},
+	)
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:    s.addr1.String(),
+		GroupId:  myGroupID,
+		Metadata: nil,
+	}
+	err = accountReq.SetDecisionPolicy(policy)
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(s.ctx, accountReq)
+	s.Require().NoError(err)
+	accountAddr := accountRes.Address
+
+	newProposal := func() uint64 {
+		req := &group.MsgCreateProposal{
+			Address:   accountAddr,
+			Proposers: []string{s.addr4.String()},
+		}
+		err := req.SetMsgs([]sdk.Msg{&banktypes.MsgSend{
+			FromAddress: accountAddr,
+			ToAddress:   s.addr5.String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+		}})
+		s.Require().NoError(err)
+		res, err := s.msgClient.CreateProposal(s.ctx, req)
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+	vote := func(proposalID uint64, voter sdk.AccAddress) *group.Proposal {
+		_, err := s.msgClient.Vote(s.ctx, &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      voter.String(),
+			Choice:     group.Choice_CHOICE_YES,
+		})
+		s.Require().NoError(err)
+		res, err := s.queryClient.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res.Proposal
+	}
+
+	// yes votes at the percentage boundary
+	proposalID := newProposal()
+	proposal := vote(proposalID, s.addr4)
+	s.Assert().Equal("4.000", proposal.GroupTotalWeight)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposal.Result)
+
+	// yes votes just below the percentage boundary
+	proposalID = newProposal()
+	proposal = vote(proposalID, s.addr3)
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposal.Status)
+	s.Assert().Equal(group.ProposalResultUnfinalized, proposal.Result)
+
+	proposal = vote(proposalID, s.addr6)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposal.Result)
+}
+
+func (s *IntegrationTestSuite) TestVoteDecisionPolicyQuorum() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	voters := []sdk.AccAddress{s.addr2, s.addr3, s.addr4, s.addr5}
+	members := make([]group.Member, len(voters))
+	for i, voter := range voters {
+		members[i] = group.Member{Address: voter.String(), Weight: "1"}
+	}
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:   s.addr1.String(),
+		Members: members,
+	})
+	s.Require().NoError(err)
+
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(&group.ThresholdDecisionPolicy{
+		Threshold: "1",
+		Quorum:    "0.75",
+		Timeout:   gogotypes.Duration{Seconds: 1},
+	})
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	accountAddr := accountRes.Address
+
+	newProposal := func() uint64 {
+		req := &group.MsgCreateProposal{
+			Address:   accountAddr,
+			Proposers: []string{s.addr2.String()},
+		}
+		err := req.SetMsgs([]sdk.Msg{&banktypes.MsgSend{
+			FromAddress: accountAddr,
+			ToAddress:   s.addr5.String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+		}})
+		s.Require().NoError(err)
+		res, err := s.msgClient.CreateProposal(ctx, req)
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+	getProposal := func(proposalID uint64) *group.Proposal {
+		res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res.Proposal
+	}
+	vote := func(proposalID uint64, voter sdk.AccAddress, choice group.Choice) *group.Proposal {
+		_, err := s.msgClient.Vote(ctx, &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      voter.String(),
+			Choice:     choice,
+		})
+		s.Require().NoError(err)
+		return getProposal(proposalID)
+	}
+
+	// the yes threshold is reached but not the quorum
+	acceptedID := newProposal()
+	proposal := vote(acceptedID, voters[0], group.Choice_CHOICE_YES)
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposal.Status)
+	proposal = vote(acceptedID, voters[1], group.Choice_CHOICE_NO)
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposal.Status)
+	// until turnout reaches the quorum
+	proposal = vote(acceptedID, voters[2], group.Choice_CHOICE_ABSTAIN)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposal.Result)
+
+	// below quorum at the end of the voting period, the proposal fails regardless of the yes votes
+	rejectedID := newProposal()
+	vote(rejectedID, voters[0], group.Choice_CHOICE_YES)
+	proposal = vote(rejectedID, voters[1], group.Choice_CHOICE_YES)
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposal.Status)
+	s.Assert().Equal("4", proposal.GroupTotalWeight)
+
+	s.Require().NoError(s.fixture.EndBlock(sdkCtx.WithBlockTime(s.blockTime.Add(time.Second))))
+	proposal = getProposal(rejectedID)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultRejected, proposal.Result)
+}
+
+func (s *IntegrationTestSuite) TestVoteQuorumDecisionPolicy() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	voters := []sdk.AccAddress{s.addr2, s.addr3, s.addr4, s.addr5, s.addr6}
+	members := make([]group.Member, len(voters))
+	for i, voter := range voters {
+		members[i] = group.Member{Address: voter.String(), Weight: "1"}
+	}
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:   s.addr1.String(),
+		Members: members,
+	})
+	s.Require().NoError(err)
+
+	policy := group.NewQuorumDecisionPolicy(
+		"0.6",
+		"0.5",
+		"0.334",
+		gogotypes.Duration{Seconds: 1},
+	)
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(policy)
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	accountAddr := accountRes.Address
+
+	newProposal := func() uint64 {
+		req := &group.MsgCreateProposal{
+			Address:   accountAddr,
+			Proposers: []string{s.addr2.String()},
+		}
+		err := req.SetMsgs([]sdk.Msg{&banktypes.MsgSend{
+			FromAddress: accountAddr,
+			ToAddress:   s.addr5.String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+		}})
+		s.Require().NoError(err)
+		res, err := s.msgClient.CreateProposal(ctx, req)
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+	getProposal := func(proposalID uint64) *group.Proposal {
+		res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res.Proposal
+	}
+	vote := func(proposalID uint64, voter sdk.AccAddress, choice group.Choice) *group.Proposal {
+		_, err := s.msgClient.Vote(ctx, &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      voter.String(),
+			Choice:     choice,
+		})
+		s.Require().NoError(err)
+		return getProposal(proposalID)
+	}
+
+	// majority yes but vetoed
+	vetoedID := newProposal()
+	for _, voter := range voters[:3] {
+		vote(vetoedID, voter, group.Choice_CHOICE_YES)
+	}
+	proposal := vote(vetoedID, voters[3], group.Choice_CHOICE_VETO)
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposal.Status)
+	proposal = vote(vetoedID, voters[4], group.Choice_CHOICE_VETO)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultRejected, proposal.Result)
+	s.Assert().Equal(group.Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "2"}, proposal.VoteState)
+
+	// abstains count toward the quorum but not toward the yes share
+	quorumID := newProposal()
+	vote(quorumID, voters[0], group.Choice_CHOICE_YES)
+	vote(quorumID, voters[1], group.Choice_CHOICE_ABSTAIN)
+	proposal = vote(quorumID, voters[2], group.Choice_CHOICE_ABSTAIN)
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposal.Status)
+
+	// but the quorum fails when too many members abstain from voting at all
+	noQuorumID := newProposal()
+	vote(noQuorumID, voters[0], group.Choice_CHOICE_YES)
+	proposal = vote(noQuorumID, voters[1], group.Choice_CHOICE_ABSTAIN)
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposal.Status)
+
+	s.Require().NoError(s.fixture.EndBlock(sdkCtx.WithBlockTime(s.blockTime.Add(time.Second))))
+	proposal = getProposal(quorumID)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposal.Result)
+	proposal = getProposal(noQuorumID)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultRejected, proposal.Result)
+}
+
+func (s *IntegrationTestSuite) TestProposalFinalTallyResult() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupAccountAddr.String(),
+		ToAddress:   s.addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	getProposal := func(ctx context.Context, id uint64) *group.Proposal {
+		res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: id})
+		s.Require().NoError(err)
+		return res.Proposal
+	}
+
+	// not set before the proposal is decided
+	proposalID := createProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{s.addr5.String()}, group.Choice_CHOICE_YES)
+	proposal := getProposal(ctx, proposalID)
+	s.Require().Equal(group.ProposalStatusSubmitted, proposal.Status)
+	s.Assert().Nil(proposal.FinalTallyResult)
+
+	// set to the live tally when accepted
+	_, err := s.msgClient.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: s.addr2.String(), Choice: group.Choice_CHOICE_YES})
+	s.Require().NoError(err)
+	proposal = getProposal(ctx, proposalID)
+	s.Require().Equal(group.ProposalResultAccepted, proposal.Result)
+	s.Require().NotNil(proposal.FinalTallyResult)
+	s.Assert().Equal(proposal.VoteState, proposal.FinalTallyResult.Tally)
+	s.Assert().Equal(group.Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "0"}, proposal.FinalTallyResult.Tally)
+	s.Assert().Equal(proposal.GroupTotalWeight, proposal.FinalTallyResult.TotalWeight)
+	finalTally := *proposal.FinalTallyResult
+
+	// and frozen afterwards
+	_, err = s.msgClient.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: s.addr5.String(), Choice: group.Choice_CHOICE_NO})
+	s.Require().Error(err)
+	_, err = s.msgClient.Exec(ctx, &group.MsgExec{Signer: s.addr1.String(), ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().NoError(s.fixture.EndBlock(sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour))))
+	proposal = getProposal(ctx, proposalID)
+	s.Assert().Equal(group.ProposalExecutorResultSuccess, proposal.ExecutorResult)
+	s.Assert().Equal(finalTally, *proposal.FinalTallyResult)
+
+	// set when rejected at the end of the voting period
+	rejectedID := createProposal(ctx, s, []sdk.Msg{msgSend}, []string{s.addr5.String()})
+	_, err = s.msgClient.Vote(ctx, &group.MsgVote{ProposalId: rejectedID, Voter: s.addr5.String(), Choice: group.Choice_CHOICE_ABSTAIN})
+	s.Require().NoError(err)
+	s.Require().NoError(s.fixture.EndBlock(sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour))))
+	proposal = getProposal(ctx, rejectedID)
+	s.Require().Equal(group.ProposalResultRejected, proposal.Result)
+	s.Require().NotNil(proposal.FinalTallyResult)
+	s.Assert().Equal(group.Tally{YesCount: "0", NoCount: "0", AbstainCount: "1", VetoCount: "0"}, proposal.FinalTallyResult.Tally)
+
+	// but not when aborted
+	abortedID := createProposal(ctx, s, []sdk.Msg{msgSend}, []string{s.addr5.String()})
+	_, err = s.msgClient.UpdateGroupMetadata(ctx, &group.MsgUpdateGroupMetadata{
+		Admin:    s.addr1.String(),
+		GroupId:  s.groupID,
+		Metadata: []byte("modified"),
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(s.fixture.EndBlock(sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour))))
+	proposal = getProposal(ctx, abortedID)
+	s.Require().Equal(group.ProposalStatusAborted, proposal.Status)
+	s.Assert().Nil(proposal.FinalTallyResult)
+}
+
+func (s *IntegrationTestSuite) TestTallyResult() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	admin := s.addr1
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin: admin.String(),
+		Members: []group.Member{
+			{Address: s.addr2.String(), Weight: "1"},
+			{Address: s.addr3.String(), Weight: "2"},
+			{Address: s.addr4.String(), Weight: "3"},
+		},
+	})
+	s.Require().NoError(err)
+	groupID := groupRes.GroupId
+
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   admin.String(),
+		GroupId: groupID,
+	}
+	s.Require().NoError(accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("4", gogotypes.Duration{Seconds: 300})))
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+
+	createProposal := func() uint64 {
+		res, err := s.msgClient.CreateProposal(ctx, &group.MsgCreateProposal{
+			Address:   accountRes.Address,
+			Proposers: []string{s.addr2.String()},
+		})
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+	vote := func(proposalID uint64, voter sdk.AccAddress, choice group.Choice) {
+		_, err := s.msgClient.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: voter.String(), Choice: choice})
+		s.Require().NoError(err)
+	}
+	queryTally := func(proposalID uint64) *group.QueryTallyResultResponse {
+		res, err := s.queryClient.TallyResult(ctx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res
+	}
+
+	// live tally of an open proposal
+	proposalID := createProposal()
+	s.Assert().Equal(&group.QueryTallyResultResponse{
+		Tally:       group.Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+		TotalWeight: "6",
+	}, queryTally(proposalID))
+
+	vote(proposalID, s.addr2, group.Choice_CHOICE_YES)
+	vote(proposalID, s.addr3, group.Choice_CHOICE_NO)
+	s.Assert().Equal(&group.QueryTallyResultResponse{
+		Tally:       group.Tally{YesCount: "1", NoCount: "2", AbstainCount: "0", VetoCount: "0"},
+		TotalWeight: "6",
+	}, queryTally(proposalID))
+
+	// the query doesn't finalize the proposal
+	proposalRes, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposalRes.Proposal.Status)
+	s.Assert().Nil(proposalRes.Proposal.FinalTallyResult)
+
+	// the live tally uses the current member weights against the total weight at submission,
+	// but the proposal would be aborted as the group was modified
+	_, err = s.msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembers{
+		GroupId: groupID,
+		Admin:   admin.String(),
+		MemberUpdates: []group.Member{
+			{Address: s.addr2.String(), Weight: "5"},
+			{Address: s.addr3.String(), Weight: "0"},
+		},
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(&group.QueryTallyResultResponse{
+		Tally:       group.Tally{YesCount: "5", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+		TotalWeight: "6",
+		WouldAbort:  true,
+	}, queryTally(proposalID))
+
+	// the same goes for a modified group account
+	proposalID = createProposal()
+	vote(proposalID, s.addr4, group.Choice_CHOICE_YES)
+	s.Assert().Equal(&group.QueryTallyResultResponse{
+		Tally:       group.Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+		TotalWeight: "8",
+	}, queryTally(proposalID))
+	_, err = s.msgClient.UpdateGroupAccountMetadata(ctx, &group.MsgUpdateGroupAccountMetadata{
+		Admin:    admin.String(),
+		Address:  accountRes.Address,
+		Metadata: []byte("modified"),
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(&group.QueryTallyResultResponse{
+		Tally:       group.Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+		TotalWeight: "8",
+		WouldAbort:  true,
+	}, queryTally(proposalID))
+
+	// final tally result of a finalized proposal
+	proposalID = createProposal()
+	vote(proposalID, s.addr4, group.Choice_CHOICE_NO)
+	vote(proposalID, s.addr2, group.Choice_CHOICE_YES)
+	proposalRes, err = s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.ProposalResultAccepted, proposalRes.Proposal.Result)
+	s.Assert().Equal(&group.QueryTallyResultResponse{
+		Tally:       group.Tally{YesCount: "5", NoCount: "3", AbstainCount: "0", VetoCount: "0"},
+		TotalWeight: "8",
+		Finalized:   true,
+		WouldPass:   true,
+	}, queryTally(proposalID))
+
+	// the final tally result doesn't change with the member weights
+	_, err = s.msgClient.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembers{
+		GroupId:       groupID,
+		Admin:         admin.String(),
+		MemberUpdates: []group.Member{{Address: s.addr2.String(), Weight: "1"}},
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal("5", queryTally(proposalID).Tally.YesCount)
+
+	// unknown proposal
+	_, err = s.queryClient.TallyResult(ctx, &group.QueryTallyResultRequest{ProposalId: 9999})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestExecProposal() {
+	msgSend1 := &banktypes.MsgSend{
+		FromAddress: s.groupAccountAddr.String(),
+		ToAddress:   s.addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	msgSend2 := &banktypes.MsgSend{
+		FromAddress: s.groupAccountAddr.String(),
+		ToAddress:   s.addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 10001)},
+	}
+	proposers := []string{s.addr2.String()}
+
+	specs := map[string]struct {
+		srcBlockTime      time.Time
+		srcSigner         sdk.AccAddress
+		setupProposal     func(ctx context.Context) uint64
+		expErr            bool
+		expProposalStatus group.Proposal_Status
+		expProposalResult group.Proposal_Result
+		expExecutorResult group.Proposal_ExecutorResult
+		expExecutorLog    string
+		expFromBalances   sdk.Coins
+		expToBalances     sdk.Coins
+	}{
+		"proposal executed when accepted": {
+			setupProposal: func(ctx context.Context) uint64 {
+				msgs := []sdk.Msg{msgSend1}
+				return createProposalAndVote(ctx, s, msgs, proposers, group.Choice_CHOICE_YES)
+			},
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+			expExecutorResult: group.ProposalExecutorResultSuccess,
+			expFromBalances:   sdk.Coins{sdk.NewInt64Coin("test", 9800)},
+			expToBalances:     sdk.Coins{sdk.NewInt64Coin("test", 200)},
+		},
+		"proposal with ADR 033 executed when accepted": {
+			setupProposal: func(ctx context.Context) uint64 {
+				msgs := []sdk.Msg{&ecocredit.MsgCreateClass{
+					Admin:          s.groupAccountAddr.String(),
+					Issuers:        []string{s.groupAccountAddr.String()},
+					CreditTypeName: "carbon",
+				},
+				}
+				return createProposalAndVote(ctx, s, msgs, proposers, group.Choice_CHOICE_YES)
+			},
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+			expExecutorResult: group.ProposalExecutorResultSuccess,
+		},
+		"proposal with multiple messages executed when accepted": {
+			setupProposal: func(ctx context.Context) uint64 {
+				msgs := []sdk.Msg{msgSend1, msgSend1}
+				return createProposalAndVote(ctx, s, msgs, proposers, group.Choice_CHOICE_YES)
+			},
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+			expExecutorResult: group.ProposalExecutorResultSuccess,
+			expFromBalances:   sdk.Coins{sdk.NewInt64Coin("test", 9700)},
+			expToBalances:     sdk.Coins{sdk.NewInt64Coin("test", 300)},
+		},
+		"proposal executed by any account": {
+			setupProposal: func(ctx context.Context) uint64 {
+				msgs := []sdk.Msg{msgSend1}
+				return createProposalAndVote(ctx, s, msgs, proposers, group.Choice_CHOICE_YES)
+			},
+			srcSigner:         s.addr6,
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+			expExecutorResult: group.ProposalExecutorResultSuccess,
+			expFromBalances:   sdk.Coins{sdk.NewInt64Coin("test", 9800)},
+			expToBalances:     sdk.Coins{sdk.NewInt64Coin("test", 200)},
+		},
+		"proposal not executed when rejected": {
+			setupProposal: func(ctx context.Context) uint64 {
+				msgs := []sdk.Msg{msgSend1}
+				return createProposalAndVote(ctx, s, msgs, proposers, group.Choice_CHOICE_NO)
+			},
+			expErr:            true,
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultRejected,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+		},
+		"open proposal must not fail": {
+			setupProposal: func(ctx context.Context) uint64 {
+				return createProposal(ctx, s, []sdk.Msg{msgSend1}, proposers)
+			},
+			expProposalStatus: group.ProposalStatusSubmitted,
+			expProposalResult: group.ProposalResultUnfinalized,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+		},
+		"existing proposal required": {
+			setupProposal: func(ctx context.Context) uint64 {
+				return 9999
+			},
+			expErr: true,
+		},
+		"Decision policy also applied on timeout": {
+			setupProposal: func(ctx context.Context) uint64 {
+				msgs := []sdk.Msg{msgSend1}
+				return createProposalAndVote(ctx, s, msgs, proposers, group.Choice_CHOICE_NO)
+			},
+			srcBlockTime:      s.blockTime.Add(time.Second),
+			expErr:            true,
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultRejected,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+		},
+		"Decision policy also applied after timeout": {
+			setupProposal: func(ctx context.Context) uint64 {
+				msgs := []sdk.Msg{msgSend1}
+				return createProposalAndVote(ctx, s, msgs, proposers, group.Choice_CHOICE_NO)
+			},
+			srcBlockTime:      s.blockTime.Add(time.Second).Add(time.Millisecond),
+			expErr:            true,
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultRejected,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+		},
+		"with group modified before tally": {
+			setupProposal: func(ctx context.Context) uint64 {
+				myProposalID := createProposal(ctx, s, []sdk.Msg{msgSend1}, proposers)
 
 				// then modify group
 				_, err := s.msgClient.UpdateGroupMetadata(ctx, &group.MsgUpdateGroupMetadata{
@@ -1971,7 +2814,7 @@ func (s *IntegrationTestSuite) TestExecProposal() {
 			expProposalResult: group.ProposalResultUnfinalized,
 			expExecutorResult: group.ProposalExecutorResultNotRun,
 		},
-		"prevent double execution when successful": {
+		"reject double execution when successful": {
 			setupProposal: func(ctx context.Context) uint64 {
 				myProposalID := createProposalAndVote(ctx, s, []sdk.Msg{msgSend1}, proposers, group.Choice_CHOICE_YES)
 
@@ -1979,6 +2822,7 @@ func (s *IntegrationTestSuite) TestExecProposal() {
 				s.Require().NoError(err)
 				return myProposalID
 			},
+			expErr:            true,
 			expProposalStatus: group.ProposalStatusClosed,
 			expProposalResult: group.ProposalResultAccepted,
 			expExecutorResult: group.ProposalExecutorResultSuccess,
@@ -1993,6 +2837,9 @@ func (s *IntegrationTestSuite) TestExecProposal() {
 			expProposalStatus: group.ProposalStatusClosed,
 			expProposalResult: group.ProposalResultAccepted,
 			expExecutorResult: group.ProposalExecutorResultFailure,
+			expExecutorLog:    "message 1 (/cosmos.bank.v1beta1.MsgSend)",
+			expFromBalances:   sdk.Coins{sdk.NewInt64Coin("test", 9900)},
+			expToBalances:     sdk.Coins{sdk.NewInt64Coin("test", 100)},
 		},
 		"executable when failed before": {
 			setupProposal: func(ctx context.Context) uint64 {
@@ -2023,12 +2870,19 @@ func (s *IntegrationTestSuite) TestExecProposal() {
 				ctx = types.Context{Context: sdkCtx}
 			}
 
-			_, err := s.msgClient.Exec(ctx, &group.MsgExec{Signer: s.addr1.String(), ProposalId: proposalID})
+			signer := s.addr1
+			if spec.srcSigner != nil {
+				signer = spec.srcSigner
+			}
+			_, err := s.msgClient.Exec(ctx, &group.MsgExec{Signer: signer.String(), ProposalId: proposalID})
 			if spec.expErr {
 				s.Require().Error(err)
-				return
+				if spec.expProposalStatus == group.ProposalStatusInvalid {
+					return
+				}
+			} else {
+				s.Require().NoError(err)
 			}
-			s.Require().NoError(err)
 
 			// and proposal is updated
 			res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
@@ -2047,6 +2901,12 @@ func (s *IntegrationTestSuite) TestExecProposal() {
 			got = group.Proposal_ExecutorResult_name[int32(proposal.ExecutorResult)]
 			s.Assert().Equal(exp, got)
 
+			if spec.expExecutorLog != "" {
+				s.Assert().Contains(proposal.ExecutorLog, spec.expExecutorLog)
+			} else {
+				s.Assert().Empty(proposal.ExecutorLog)
+			}
+
 			if spec.expFromBalances != nil {
 				fromBalances := s.bankKeeper.GetAllBalances(sdkCtx, s.groupAccountAddr)
 				s.Require().Equal(spec.expFromBalances, fromBalances)
@@ -2059,6 +2919,802 @@ func (s *IntegrationTestSuite) TestExecProposal() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestEndBlockClosesExpiredProposals() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupAccountAddr.String(),
+		ToAddress:   s.addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	// yes votes below the policy threshold
+	openID := createProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{s.addr5.String()}, group.Choice_CHOICE_YES)
+	// no votes at all
+	emptyID := createProposal(ctx, s, []sdk.Msg{msgSend}, []string{s.addr5.String()})
+	// yes votes reaching the policy threshold
+	acceptedID := createProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{s.addr2.String()}, group.Choice_CHOICE_YES)
+
+	// a proposal submitted later ends its voting period later
+	laterCtx := types.Context{Context: sdkCtx.WithBlockTime(s.blockTime.Add(time.Millisecond))}
+	laterID := createProposal(laterCtx, s, []sdk.Msg{msgSend}, []string{s.addr5.String()})
+
+	getProposal := func(ctx context.Context, id uint64) *group.Proposal {
+		res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: id})
+		s.Require().NoError(err)
+		return res.Proposal
+	}
+
+	// nothing expires before the end of the voting period
+	s.Require().NoError(s.fixture.EndBlock(sdkCtx.WithBlockTime(s.blockTime.Add(time.Second - time.Nanosecond))))
+	for _, id := range []uint64{openID, emptyID, laterID} {
+		s.Assert().Equal(group.ProposalStatusSubmitted, getProposal(ctx, id).Status)
+	}
+
+	// votes are not accepted anymore at the end of the voting period
+	endCtx := sdkCtx.WithBlockTime(s.blockTime.Add(time.Second))
+	_, err := s.msgClient.Vote(types.Context{Context: endCtx}, &group.MsgVote{
+		ProposalId: openID,
+		Voter:      s.addr2.String(),
+		Choice:     group.Choice_CHOICE_YES,
+	})
+	s.Require().Error(err)
+	s.Require().True(group.ErrExpired.Is(err))
+
+	// and proposals ending their voting period at block time are rejected
+	s.Require().NoError(s.fixture.EndBlock(endCtx))
+	for _, id := range []uint64{openID, emptyID} {
+		proposal := getProposal(ctx, id)
+		s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+		s.Assert().Equal(group.ProposalResultRejected, proposal.Result)
+		s.Assert().Equal(group.ProposalExecutorResultNotRun, proposal.ExecutorResult)
+	}
+	accepted := getProposal(ctx, acceptedID)
+	s.Assert().Equal(group.ProposalStatusClosed, accepted.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, accepted.Result)
+	s.Assert().Equal(group.ProposalStatusSubmitted, getProposal(ctx, laterID).Status)
+
+	// proposals of a group modified in between are aborted
+	_, err = s.msgClient.UpdateGroupMetadata(ctx, &group.MsgUpdateGroupMetadata{
+		Admin:    s.addr1.String(),
+		GroupId:  s.groupID,
+		Metadata: []byte("modified"),
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(s.fixture.EndBlock(sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour))))
+	later := getProposal(ctx, laterID)
+	s.Assert().Equal(group.ProposalStatusAborted, later.Status)
+	s.Assert().Equal(group.ProposalResultUnfinalized, later.Result)
+
+	// closed proposals are not processed again
+	s.Require().NoError(s.fixture.EndBlock(sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour))))
+	s.Assert().Equal(group.ProposalResultRejected, getProposal(ctx, openID).Result)
+}
+
+func (s *IntegrationTestSuite) TestVotesByVoter() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	queryVotes := func(pageReq *query.PageRequest) *group.QueryVotesByVoterResponse {
+		res, err := s.queryClient.VotesByVoter(ctx, &group.QueryVotesByVoterRequest{
+			Voter:      s.addr5.String(),
+			Pagination: pageReq,
+		})
+		s.Require().NoError(err)
+		return res
+	}
+	proposalIDs := func(votes []*group.Vote) []uint64 {
+		ids := make([]uint64, len(votes))
+		for i, v := range votes {
+			ids[i] = v.ProposalId
+		}
+		return ids
+	}
+	before := queryVotes(&query.PageRequest{CountTotal: true}).Pagination.Total
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupAccountAddr.String(),
+		ToAddress:   s.addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	choices := []group.Choice{group.Choice_CHOICE_YES, group.Choice_CHOICE_NO, group.Choice_CHOICE_ABSTAIN}
+	ids := make([]uint64, len(choices))
+	for i, choice := range choices {
+		ids[i] = createProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{s.addr5.String()}, choice)
+	}
+
+	// the voter gets all three votes, with their proposal and choice
+	res := queryVotes(&query.PageRequest{CountTotal: true})
+	s.Require().Equal(before+3, res.Pagination.Total)
+	s.Require().Len(res.Votes, int(before+3))
+	newVotes := res.Votes[before:]
+	s.Assert().Equal(ids, proposalIDs(newVotes))
+	for i, v := range newVotes {
+		s.Assert().Equal(s.addr5.String(), v.Voter)
+		s.Assert().Equal(choices[i], v.Choice)
+	}
+
+	// most recent first, page by page
+	res = queryVotes(&query.PageRequest{Limit: 2, Reverse: true})
+	s.Assert().Equal([]uint64{ids[2], ids[1]}, proposalIDs(res.Votes))
+	s.Require().NotNil(res.Pagination.NextKey)
+	res = queryVotes(&query.PageRequest{Key: res.Pagination.NextKey, Limit: 1, Reverse: true})
+	s.Assert().Equal([]uint64{ids[0]}, proposalIDs(res.Votes))
+
+	// and in ascending order
+	res = queryVotes(&query.PageRequest{Offset: before, Limit: 2})
+	s.Assert().Equal([]uint64{ids[0], ids[1]}, proposalIDs(res.Votes))
+	s.Require().NotNil(res.Pagination.NextKey)
+	res = queryVotes(&query.PageRequest{Key: res.Pagination.NextKey, Limit: 2})
+	s.Assert().Equal([]uint64{ids[2]}, proposalIDs(res.Votes))
+	s.Assert().Nil(res.Pagination.NextKey)
+}
+
+func (s *IntegrationTestSuite) TestPruneProposal() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupAccountAddr.String(),
+		ToAddress:   s.addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	pruneAs := func(proposalID uint64, signer sdk.AccAddress) error {
+		_, err := s.msgClient.PruneProposal(ctx, &group.MsgPruneProposal{ProposalId: proposalID, Signer: signer.String()})
+		return err
+	}
+	prune := func(proposalID uint64) error {
+		return pruneAs(proposalID, s.addr1)
+	}
+	votesByProposal := func(proposalID uint64) []*group.Vote {
+		res, err := s.queryClient.VotesByProposal(ctx, &group.QueryVotesByProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res.Votes
+	}
+	hasVoteByVoter := func(voter sdk.AccAddress, proposalID uint64) bool {
+		res, err := s.queryClient.VotesByVoter(ctx, &group.QueryVotesByVoterRequest{Voter: voter.String()})
+		s.Require().NoError(err)
+		for _, v := range res.Votes {
+			if v.ProposalId == proposalID {
+				return true
+			}
+		}
+		return false
+	}
+	hasProposalByGroupAccount := func(proposalID uint64) bool {
+		res, err := s.queryClient.ProposalsByGroupAccount(ctx, &group.QueryProposalsByGroupAccountRequest{
+			Address:    s.groupAccountAddr.String(),
+			Pagination: &query.PageRequest{Limit: 1000},
+		})
+		s.Require().NoError(err)
+		for _, p := range res.Proposals {
+			if p.ProposalId == proposalID {
+				return true
+			}
+		}
+		return false
+	}
+
+	// open proposals can't be pruned
+	proposalID := createProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{s.addr5.String()}, group.Choice_CHOICE_YES)
+	err := prune(proposalID)
+	s.Require().Error(err)
+	s.Require().True(group.ErrInvalid.Is(err))
+
+	// nor accepted proposals that weren't executed successfully
+	_, err = s.msgClient.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: s.addr2.String(), Choice: group.Choice_CHOICE_YES})
+	s.Require().NoError(err)
+	s.Require().Error(prune(proposalID))
+	s.Require().Len(votesByProposal(proposalID), 2)
+
+	// executed proposals can only be pruned by the group admin or the group account
+	_, err = s.msgClient.Exec(ctx, &group.MsgExec{Signer: s.addr1.String(), ProposalId: proposalID})
+	s.Require().NoError(err)
+	for _, signer := range []sdk.AccAddress{s.addr2, s.addr3, s.addr5} {
+		err = pruneAs(proposalID, signer)
+		s.Require().Error(err)
+		s.Assert().True(group.ErrUnauthorized.Is(err), err)
+	}
+	res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.ProposalResultAccepted, res.Proposal.Result)
+	s.Assert().Len(votesByProposal(proposalID), 2)
+
+	// and are deleted with all their votes
+	s.Require().NoError(prune(proposalID))
+
+	_, err = s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().Error(err)
+	s.Assert().Empty(votesByProposal(proposalID))
+	for _, voter := range []sdk.AccAddress{s.addr2, s.addr5} {
+		_, err = s.queryClient.VoteByProposalVoter(ctx, &group.QueryVoteByProposalVoterRequest{ProposalId: proposalID, Voter: voter.String()})
+		s.Assert().Error(err)
+		s.Assert().False(hasVoteByVoter(voter, proposalID))
+	}
+	s.Assert().False(hasProposalByGroupAccount(proposalID))
+
+	// and can't be pruned twice
+	s.Require().Error(prune(proposalID))
+
+	// rejected proposals can be pruned as well
+	rejectedID := createProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{s.addr2.String()}, group.Choice_CHOICE_NO)
+	res, err = s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: rejectedID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.ProposalResultRejected, res.Proposal.Result)
+	s.Require().NoError(prune(rejectedID))
+	s.Assert().Empty(votesByProposal(rejectedID))
+	s.Assert().False(hasVoteByVoter(s.addr2, rejectedID))
+
+	// other proposals are left untouched
+	otherID := createProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{s.addr5.String()}, group.Choice_CHOICE_YES)
+	s.Assert().Len(votesByProposal(otherID), 1)
+	s.Assert().True(hasProposalByGroupAccount(otherID))
+
+	// the group account can prune its own proposals through a proposal
+	withdrawnID := createProposal(ctx, s, []sdk.Msg{msgSend}, []string{s.addr5.String()})
+	_, err = s.msgClient.WithdrawProposal(ctx, &group.MsgWithdrawProposal{ProposalId: withdrawnID, Address: s.addr5.String()})
+	s.Require().NoError(err)
+	pruneMsg := &group.MsgPruneProposal{ProposalId: withdrawnID, Signer: s.groupAccountAddr.String()}
+	pruneID := createProposalAndVote(ctx, s, []sdk.Msg{pruneMsg}, []string{s.addr5.String()}, group.Choice_CHOICE_YES)
+	_, err = s.msgClient.Vote(ctx, &group.MsgVote{ProposalId: pruneID, Voter: s.addr2.String(), Choice: group.Choice_CHOICE_YES})
+	s.Require().NoError(err)
+	_, err = s.msgClient.Exec(ctx, &group.MsgExec{Signer: s.addr1.String(), ProposalId: pruneID})
+	s.Require().NoError(err)
+	res, err = s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: pruneID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.ProposalExecutorResultSuccess, res.Proposal.ExecutorResult, res.Proposal.ExecutorLog)
+	_, err = s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: withdrawnID})
+	s.Assert().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestWithdrawProposal() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupAccountAddr.String(),
+		ToAddress:   s.addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	withdraw := func(ctx context.Context, proposalID uint64, address sdk.AccAddress) error {
+		_, err := s.msgClient.WithdrawProposal(ctx, &group.MsgWithdrawProposal{ProposalId: proposalID, Address: address.String()})
+		return err
+	}
+	getProposal := func(ctx context.Context, proposalID uint64) *group.Proposal {
+		res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res.Proposal
+	}
+
+	// only proposers and the group admin can withdraw a proposal
+	proposalID := createProposal(ctx, s, []sdk.Msg{msgSend}, []string{s.addr5.String()})
+	err := withdraw(ctx, proposalID, s.addr2)
+	s.Require().Error(err)
+	s.Assert().True(group.ErrUnauthorized.Is(err), err)
+	s.Assert().Equal(group.ProposalStatusSubmitted, getProposal(ctx, proposalID).Status)
+
+	// a proposer can withdraw its proposal
+	s.Require().NoError(withdraw(ctx, proposalID, s.addr5))
+	proposal := getProposal(ctx, proposalID)
+	s.Assert().Equal(group.ProposalStatusWithdrawn, proposal.Status)
+	s.Assert().Equal(group.ProposalResultUnfinalized, proposal.Result)
+
+	// which blocks further votes and execution
+	_, err = s.msgClient.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: s.addr2.String(), Choice: group.Choice_CHOICE_YES})
+	s.Require().Error(err)
+	_, err = s.msgClient.Exec(ctx, &group.MsgExec{Signer: s.addr1.String(), ProposalId: proposalID})
+	s.Require().Error(err)
+	err = withdraw(ctx, proposalID, s.addr5)
+	s.Require().Error(err)
+	s.Assert().True(group.ErrInvalid.Is(err), err)
+
+	// and leaves the proposal withdrawn after the voting period
+	expiredCtx := sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour))
+	s.Require().NoError(s.fixture.EndBlock(expiredCtx))
+	s.Assert().Equal(group.ProposalStatusWithdrawn, getProposal(ctx, proposalID).Status)
+
+	// the group admin can withdraw a proposal too
+	adminWithdrawnID := createProposal(ctx, s, []sdk.Msg{msgSend}, []string{s.addr5.String()})
+	s.Require().NoError(withdraw(ctx, adminWithdrawnID, s.addr1))
+	s.Assert().Equal(group.ProposalStatusWithdrawn, getProposal(ctx, adminWithdrawnID).Status)
+
+	// but already decided proposals can't be withdrawn anymore
+	rejectedID := createProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{s.addr2.String()}, group.Choice_CHOICE_NO)
+	s.Require().Equal(group.ProposalResultRejected, getProposal(ctx, rejectedID).Result)
+	err = withdraw(ctx, rejectedID, s.addr2)
+	s.Require().Error(err)
+	s.Assert().True(group.ErrInvalid.Is(err), err)
+
+	// withdrawn proposals are finalized and can be pruned
+	_, err = s.msgClient.PruneProposal(ctx, &group.MsgPruneProposal{ProposalId: proposalID, Signer: s.addr1.String()})
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TestProposalsByStatus() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr2.String(), Weight: "2"}, {Address: s.addr5.String(), Weight: "1"}},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("2", gogotypes.Duration{Seconds: 1}))
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	accountAddr, err := sdk.AccAddressFromBech32(accountRes.Address)
+	s.Require().NoError(err)
+	s.Require().NoError(fundAccount(s.bankKeeper, sdkCtx, accountAddr, sdk.Coins{sdk.NewInt64Coin("test", 10000)}))
+
+	newProposal := func(choice group.Choice) uint64 {
+		req := &group.MsgCreateProposal{
+			Address:   accountAddr.String(),
+			Proposers: []string{s.addr2.String()},
+		}
+		err := req.SetMsgs([]sdk.Msg{&banktypes.MsgSend{
+			FromAddress: accountAddr.String(),
+			ToAddress:   s.addr5.String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+		}})
+		s.Require().NoError(err)
+		res, err := s.msgClient.CreateProposal(ctx, req)
+		s.Require().NoError(err)
+		if choice != group.Choice_CHOICE_UNSPECIFIED {
+			_, err = s.msgClient.Vote(ctx, &group.MsgVote{ProposalId: res.ProposalId, Voter: s.addr2.String(), Choice: choice})
+			s.Require().NoError(err)
+		}
+		return res.ProposalId
+	}
+
+	openIDs := []uint64{
+		newProposal(group.Choice_CHOICE_UNSPECIFIED),
+		newProposal(group.Choice_CHOICE_UNSPECIFIED),
+		newProposal(group.Choice_CHOICE_UNSPECIFIED),
+	}
+	rejectedID := newProposal(group.Choice_CHOICE_NO)
+	executedID := newProposal(group.Choice_CHOICE_YES)
+	_, err = s.msgClient.Exec(ctx, &group.MsgExec{Signer: s.addr1.String(), ProposalId: executedID})
+	s.Require().NoError(err)
+
+	specs := map[string]struct {
+		status      group.Proposal_Status
+		pageRequest *query.PageRequest
+		expIDs      []uint64
+		expTotal    uint64
+		expErr      bool
+	}{
+		"submitted": {
+			status: group.ProposalStatusSubmitted,
+			expIDs: openIDs,
+		},
+		"submitted first page": {
+			status:      group.ProposalStatusSubmitted,
+			pageRequest: &query.PageRequest{Limit: 2, CountTotal: true},
+			expIDs:      openIDs[:2],
+			expTotal:    3,
+		},
+		"submitted last page": {
+			status:      group.ProposalStatusSubmitted,
+			pageRequest: &query.PageRequest{Offset: 2, Limit: 2},
+			expIDs:      openIDs[2:],
+		},
+		"closed": {
+			status: group.ProposalStatusClosed,
+			expIDs: []uint64{rejectedID, executedID},
+		},
+		"aborted": {
+			status: group.ProposalStatusAborted,
+			expIDs: []uint64{},
+		},
+		"invalid status": {
+			status: group.ProposalStatusInvalid,
+			expErr: true,
+		},
+		"unknown status": {
+			status: group.Proposal_Status(10),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			res, err := s.queryClient.ProposalsByStatus(ctx, &group.QueryProposalsByStatusRequest{
+				Address:    accountAddr.String(),
+				Status:     spec.status,
+				Pagination: spec.pageRequest,
+			})
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			ids := make([]uint64, len(res.Proposals))
+			for i, p := range res.Proposals {
+				s.Assert().Equal(spec.status, p.Status)
+				ids[i] = p.ProposalId
+			}
+			s.Assert().Equal(spec.expIDs, ids)
+			if spec.pageRequest != nil && spec.pageRequest.CountTotal {
+				s.Assert().Equal(spec.expTotal, res.Pagination.Total)
+			}
+		})
+	}
+
+	// the index follows the status of proposals as they get closed
+	_, err = s.msgClient.Vote(ctx, &group.MsgVote{ProposalId: openIDs[0], Voter: s.addr2.String(), Choice: group.Choice_CHOICE_YES})
+	s.Require().NoError(err)
+	res, err := s.queryClient.ProposalsByStatus(ctx, &group.QueryProposalsByStatusRequest{
+		Address: accountAddr.String(),
+		Status:  group.ProposalStatusSubmitted,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Proposals, 2)
+	s.Assert().Equal(openIDs[1], res.Proposals[0].ProposalId)
+	s.Assert().Equal(openIDs[2], res.Proposals[1].ProposalId)
+}
+
+func (s *IntegrationTestSuite) TestExecProposalSpendLimit() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr2.String(), Weight: "1"}},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:            s.addr1.String(),
+		GroupId:          groupRes.GroupId,
+		SpendLimit:       sdk.NewCoins(sdk.NewInt64Coin("test", 250)),
+		SpendLimitPeriod: gogotypes.Duration{Seconds: 3600},
+	}
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 1}))
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	accountAddr, err := sdk.AccAddressFromBech32(accountRes.Address)
+	s.Require().NoError(err)
+	s.Require().NoError(fundAccount(s.bankKeeper, sdkCtx, accountAddr, sdk.Coins{sdk.NewInt64Coin("test", 10000)}))
+
+	newAcceptedProposal := func(amount int64) uint64 {
+		req := &group.MsgCreateProposal{
+			Address:   accountAddr.String(),
+			Proposers: []string{s.addr2.String()},
+		}
+		err := req.SetMsgs([]sdk.Msg{&banktypes.MsgSend{
+			FromAddress: accountAddr.String(),
+			ToAddress:   s.addr5.String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("test", amount)},
+		}})
+		s.Require().NoError(err)
+		res, err := s.msgClient.CreateProposal(ctx, req)
+		s.Require().NoError(err)
+		_, err = s.msgClient.Vote(ctx, &group.MsgVote{ProposalId: res.ProposalId, Voter: s.addr2.String(), Choice: group.Choice_CHOICE_YES})
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+	exec := func(ctx context.Context, proposalID uint64) *group.Proposal {
+		_, err := s.msgClient.Exec(ctx, &group.MsgExec{Signer: s.addr1.String(), ProposalId: proposalID})
+		s.Require().NoError(err)
+		res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res.Proposal
+	}
+	periodSpent := func(ctx context.Context) sdk.Coins {
+		res, err := s.queryClient.GroupAccountInfo(ctx, &group.QueryGroupAccountInfoRequest{Address: accountAddr.String()})
+		s.Require().NoError(err)
+		return res.Info.PeriodSpent
+	}
+
+	// spend accumulates across proposals within the period
+	first, second, third := newAcceptedProposal(100), newAcceptedProposal(100), newAcceptedProposal(100)
+	s.Assert().Equal(group.ProposalExecutorResultSuccess, exec(ctx, first).ExecutorResult)
+	s.Assert().Equal(group.ProposalExecutorResultSuccess, exec(ctx, second).ExecutorResult)
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 200)), periodSpent(ctx))
+
+	// until it would exceed the spend limit
+	proposal := exec(ctx, third)
+	s.Assert().Equal(group.ProposalExecutorResultFailure, proposal.ExecutorResult)
+	s.Assert().Contains(proposal.ExecutorLog, "spend limit")
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 200)), periodSpent(ctx))
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 9800)), s.bankKeeper.GetAllBalances(sdkCtx, accountAddr))
+
+	// still within the period
+	lateCtx := types.Context{Context: sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour - time.Second))}
+	s.Assert().Equal(group.ProposalExecutorResultFailure, exec(lateCtx, third).ExecutorResult)
+
+	// and the spend resets after the period boundary
+	nextPeriodCtx := types.Context{Context: sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour))}
+	s.Assert().Equal(group.ProposalExecutorResultSuccess, exec(nextPeriodCtx, third).ExecutorResult)
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 100)), periodSpent(nextPeriodCtx))
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 9700)), s.bankKeeper.GetAllBalances(sdkCtx, accountAddr))
+}
+
+func (s *IntegrationTestSuite) TestExecProposalSpendLimitNonBankMsg() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	// creating a credit class charges a fee to the class admin outside of the bank Msg service
+	classFee := sdk.NewCoins(sdk.NewInt64Coin("test", 200))
+	s.paramSpace.Set(sdkCtx, ecocredit.KeyCreditClassFee, classFee)
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr2.String(), Weight: "1"}},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:            s.addr1.String(),
+		GroupId:          groupRes.GroupId,
+		SpendLimit:       sdk.NewCoins(sdk.NewInt64Coin("test", 250)),
+		SpendLimitPeriod: gogotypes.Duration{Seconds: 3600},
+	}
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 1}))
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	accountAddr, err := sdk.AccAddressFromBech32(accountRes.Address)
+	s.Require().NoError(err)
+	s.Require().NoError(fundAccount(s.bankKeeper, sdkCtx, accountAddr, sdk.Coins{sdk.NewInt64Coin("test", 10000)}))
+
+	execCreateClass := func() *group.Proposal {
+		req := &group.MsgCreateProposal{
+			Address:   accountAddr.String(),
+			Proposers: []string{s.addr2.String()},
+		}
+		err := req.SetMsgs([]sdk.Msg{&ecocredit.MsgCreateClass{
+			Admin:          accountAddr.String(),
+			Issuers:        []string{accountAddr.String()},
+			CreditTypeName: "carbon",
+		}})
+		s.Require().NoError(err)
+		res, err := s.msgClient.CreateProposal(ctx, req)
+		s.Require().NoError(err)
+		_, err = s.msgClient.Vote(ctx, &group.MsgVote{ProposalId: res.ProposalId, Voter: s.addr2.String(), Choice: group.Choice_CHOICE_YES})
+		s.Require().NoError(err)
+		_, err = s.msgClient.Exec(ctx, &group.MsgExec{Signer: s.addr1.String(), ProposalId: res.ProposalId})
+		s.Require().NoError(err)
+		propRes, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: res.ProposalId})
+		s.Require().NoError(err)
+		return propRes.Proposal
+	}
+
+	// the class fee counts toward the spend limit
+	s.Assert().Equal(group.ProposalExecutorResultSuccess, execCreateClass().ExecutorResult)
+	infoRes, err := s.queryClient.GroupAccountInfo(ctx, &group.QueryGroupAccountInfoRequest{Address: accountAddr.String()})
+	s.Require().NoError(err)
+	s.Assert().Equal(classFee, infoRes.Info.PeriodSpent)
+
+	// so a second class would exceed it
+	proposal := execCreateClass()
+	s.Assert().Equal(group.ProposalExecutorResultFailure, proposal.ExecutorResult)
+	s.Assert().Contains(proposal.ExecutorLog, "spend limit")
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 9800)), s.bankKeeper.GetAllBalances(sdkCtx, accountAddr))
+}
+
+func (s *IntegrationTestSuite) TestExecProposalExecutionDelay() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr2.String(), Weight: "1"}},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(&group.ThresholdDecisionPolicy{
+		Threshold:      "1",
+		Timeout:        gogotypes.Duration{Seconds: 1},
+		ExecutionDelay: gogotypes.Duration{Seconds: 3600},
+	})
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	accountAddr, err := sdk.AccAddressFromBech32(accountRes.Address)
+	s.Require().NoError(err)
+	s.Require().NoError(fundAccount(s.bankKeeper, sdkCtx, accountAddr, sdk.Coins{sdk.NewInt64Coin("test", 10000)}))
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: accountAddr.String(),
+		ToAddress:   s.addr5.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	getProposal := func(ctx context.Context, proposalID uint64) *group.Proposal {
+		res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res.Proposal
+	}
+	exec := func(ctx context.Context, proposalID uint64) error {
+		_, err := s.msgClient.Exec(ctx, &group.MsgExec{Signer: s.addr1.String(), ProposalId: proposalID})
+		return err
+	}
+
+	// the proposal is accepted on creation but its execution is delayed
+	proposalReq := &group.MsgCreateProposal{
+		Address:   accountAddr.String(),
+		Proposers: []string{s.addr2.String()},
+		Exec:      group.Exec_EXEC_TRY,
+	}
+	s.Require().NoError(proposalReq.SetMsgs([]sdk.Msg{msgSend}))
+	proposalRes, err := s.msgClient.CreateProposal(ctx, proposalReq)
+	s.Require().NoError(err)
+	proposal := getProposal(ctx, proposalRes.ProposalId)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposal.Result)
+	s.Assert().Equal(group.ProposalExecutorResultNotRun, proposal.ExecutorResult)
+	expEarliest, err := gogotypes.TimestampProto(s.blockTime.Add(time.Hour))
+	s.Require().NoError(err)
+	s.Assert().Equal(expEarliest, proposal.EarliestExecutionTime)
+
+	// execution fails until the delay is over
+	err = exec(ctx, proposal.ProposalId)
+	s.Require().Error(err)
+	s.Assert().True(group.ErrInvalid.Is(err), err)
+	lateCtx := types.Context{Context: sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour - time.Second))}
+	s.Require().Error(exec(lateCtx, proposal.ProposalId))
+	s.Assert().Equal(group.ProposalExecutorResultNotRun, getProposal(lateCtx, proposal.ProposalId).ExecutorResult)
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 10000)), s.bankKeeper.GetAllBalances(sdkCtx, accountAddr))
+
+	// and succeeds afterwards
+	delayOverCtx := types.Context{Context: sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour))}
+	s.Require().NoError(exec(delayOverCtx, proposal.ProposalId))
+	s.Assert().Equal(group.ProposalExecutorResultSuccess, getProposal(delayOverCtx, proposal.ProposalId).ExecutorResult)
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 9900)), s.bankKeeper.GetAllBalances(sdkCtx, accountAddr))
+
+	// trying to execute on vote doesn't fail the vote either
+	proposalReq.Exec = group.Exec_EXEC_UNSPECIFIED
+	proposalRes, err = s.msgClient.CreateProposal(ctx, proposalReq)
+	s.Require().NoError(err)
+	voteCtx := types.Context{Context: sdkCtx.WithBlockTime(s.blockTime.Add(time.Millisecond))}
+	_, err = s.msgClient.Vote(voteCtx, &group.MsgVote{
+		ProposalId: proposalRes.ProposalId,
+		Voter:      s.addr2.String(),
+		Choice:     group.Choice_CHOICE_YES,
+		Exec:       group.Exec_EXEC_TRY,
+	})
+	s.Require().NoError(err)
+	proposal = getProposal(voteCtx, proposalRes.ProposalId)
+	s.Assert().Equal(group.ProposalResultAccepted, proposal.Result)
+	s.Assert().Equal(group.ProposalExecutorResultNotRun, proposal.ExecutorResult)
+	expEarliest, err = gogotypes.TimestampProto(s.blockTime.Add(time.Hour + time.Millisecond))
+	s.Require().NoError(err)
+	s.Assert().Equal(expEarliest, proposal.EarliestExecutionTime)
+}
+
+func (s *IntegrationTestSuite) TestVoteAutoExec() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr2.String(), Weight: "1"}, {Address: s.addr5.String(), Weight: "1"}},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(&group.ThresholdDecisionPolicy{
+		Threshold: "2",
+		Timeout:   gogotypes.Duration{Seconds: 1},
+		AutoExec:  true,
+	})
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	accountAddr, err := sdk.AccAddressFromBech32(accountRes.Address)
+	s.Require().NoError(err)
+	s.Require().NoError(fundAccount(s.bankKeeper, sdkCtx, accountAddr, sdk.Coins{sdk.NewInt64Coin("test", 10000)}))
+
+	getProposal := func(proposalID uint64) *group.Proposal {
+		res, err := s.queryClient.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res.Proposal
+	}
+	newProposal := func(amount int64) uint64 {
+		req := &group.MsgCreateProposal{
+			Address:   accountAddr.String(),
+			Proposers: []string{s.addr2.String()},
+		}
+		err := req.SetMsgs([]sdk.Msg{&banktypes.MsgSend{
+			FromAddress: accountAddr.String(),
+			ToAddress:   s.addr6.String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("test", amount)},
+		}})
+		s.Require().NoError(err)
+		res, err := s.msgClient.CreateProposal(ctx, req)
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+	vote := func(proposalID uint64, voter sdk.AccAddress) {
+		_, err := s.msgClient.Vote(ctx, &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      voter.String(),
+			Choice:     group.Choice_CHOICE_YES,
+		})
+		s.Require().NoError(err)
+	}
+
+	// the proposal is not executed before it is accepted
+	proposalID := newProposal(100)
+	vote(proposalID, s.addr2)
+	proposal := getProposal(proposalID)
+	s.Assert().Equal(group.ProposalStatusSubmitted, proposal.Status)
+	s.Assert().Equal(group.ProposalExecutorResultNotRun, proposal.ExecutorResult)
+
+	// and executed by the vote which gets it accepted
+	vote(proposalID, s.addr5)
+	proposal = getProposal(proposalID)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposal.Result)
+	s.Assert().Equal(group.ProposalExecutorResultSuccess, proposal.ExecutorResult)
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 9900)), s.bankKeeper.GetAllBalances(sdkCtx, accountAddr))
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 100)), s.bankKeeper.GetAllBalances(sdkCtx, s.addr6))
+
+	// a failing message leaves the proposal accepted but unexecuted, and the vote stored
+	proposalID = newProposal(10001)
+	vote(proposalID, s.addr2)
+	vote(proposalID, s.addr5)
+	proposal = getProposal(proposalID)
+	s.Assert().Equal(group.ProposalStatusClosed, proposal.Status)
+	s.Assert().Equal(group.ProposalResultAccepted, proposal.Result)
+	s.Assert().Equal(group.ProposalExecutorResultFailure, proposal.ExecutorResult)
+	s.Assert().Contains(proposal.ExecutorLog, "message 0 (/cosmos.bank.v1beta1.MsgSend)")
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 9900)), s.bankKeeper.GetAllBalances(sdkCtx, accountAddr))
+	voteRes, err := s.queryClient.VoteByProposalVoter(ctx, &group.QueryVoteByProposalVoterRequest{ProposalId: proposalID, Voter: s.addr5.String()})
+	s.Require().NoError(err)
+	s.Assert().Equal(group.Choice_CHOICE_YES, voteRes.Vote.Choice)
+}
+
+func (s *IntegrationTestSuite) TestGroupAccountBalance() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := types.Context{Context: sdkCtx}
+
+	groupRes, err := s.msgClient.CreateGroup(ctx, &group.MsgCreateGroup{
+		Admin:   s.addr1.String(),
+		Members: []group.Member{{Address: s.addr2.String(), Weight: "1"}},
+	})
+	s.Require().NoError(err)
+	accountReq := &group.MsgCreateGroupAccount{
+		Admin:   s.addr1.String(),
+		GroupId: groupRes.GroupId,
+	}
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", gogotypes.Duration{Seconds: 1}))
+	s.Require().NoError(err)
+	accountRes, err := s.msgClient.CreateGroupAccount(ctx, accountReq)
+	s.Require().NoError(err)
+	accountAddr, err := sdk.AccAddressFromBech32(accountRes.Address)
+	s.Require().NoError(err)
+
+	// a new group account has no balance
+	res, err := s.queryClient.GroupAccountBalance(ctx, &group.QueryGroupAccountBalanceRequest{Address: accountRes.Address})
+	s.Require().NoError(err)
+	s.Assert().True(res.Balance.IsZero())
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin("test", 10000), sdk.NewInt64Coin("token", 5))
+	s.Require().NoError(fundAccount(s.bankKeeper, sdkCtx, accountAddr, funds))
+	res, err = s.queryClient.GroupAccountBalance(ctx, &group.QueryGroupAccountBalanceRequest{Address: accountRes.Address})
+	s.Require().NoError(err)
+	s.Assert().Equal(funds, res.Balance)
+
+	// accounts which are not group accounts are rejected
+	_, err = s.queryClient.GroupAccountBalance(ctx, &group.QueryGroupAccountBalanceRequest{Address: s.addr2.String()})
+	s.Require().Error(err)
+	s.Assert().Contains(err.Error(), "not found")
+
+	_, err = s.queryClient.GroupAccountBalance(ctx, &group.QueryGroupAccountBalanceRequest{Address: "invalid"})
+	s.Require().Error(err)
+}
+
 func createProposal(
 	ctx context.Context, s *IntegrationTestSuite, msgs []sdk.Msg,
 	proposers []string) uint64 {
@@ -2096,7 +3752,7 @@ func createGroupAndGroupAccount(
 ) (string, uint64, group.DecisionPolicy, []byte) {
 	groupRes, err := s.msgClient.CreateGroup(s.ctx, &group.MsgCreateGroup{
 		Admin:    admin.String(),
-		Members:  nil,
+		Members:  []group.Member{{Address: admin.String(), Weight: "1"}},
 		Metadata: nil,
 	})
 	s.Require().NoError(err)
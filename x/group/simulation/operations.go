@@ -15,6 +15,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 
 	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/group"
 	"github.com/regen-network/regen-ledger/x/group/exported"
 )
@@ -262,12 +263,29 @@ func SimulateMsgCreateGroupAccount(ak exported.AccountKeeper, bk exported.BankKe
 			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupAccount, "fail to decode acc address"), nil, err
 		}
 
+		// the threshold must not exceed the group total weight
+		groupInfo, err := qryClient.GroupInfo(regentypes.Context{Context: ctx}, &group.QueryGroupInfoRequest{GroupId: groupID})
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupAccount, "fail to query group info"), nil, err
+		}
+		totalWeight, err := math.NewNonNegativeDecFromString(groupInfo.Info.TotalWeight)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupAccount, "invalid group total weight"), nil, err
+		}
+		if totalWeight.IsZero() {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupAccount, "group has no weight"), nil, nil
+		}
+		threshold := math.NewDecFromInt64(20)
+		if threshold.GT(totalWeight) {
+			threshold = totalWeight
+		}
+
 		msg, err := group.NewMsgCreateGroupAccount(
 			addr,
 			groupID,
 			[]byte(simtypes.RandStringOfLength(r, 10)),
 			&group.ThresholdDecisionPolicy{
-				Threshold: "20",
+				Threshold: threshold.String(),
 				Timeout:   gogotypes.Duration{Seconds: int64(30 * 24 * 60 * 60)},
 			},
 		)
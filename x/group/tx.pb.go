@@ -7,9 +7,12 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	types2 "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -486,6 +489,12 @@ type MsgCreateGroupAccount struct {
 	Metadata []byte `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// decision_policy specifies the group account's decision policy.
 	DecisionPolicy *types.Any `protobuf:"bytes,4,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+	// spend_limit is the optional maximum amount of coins the group account can send by executing proposals
+	// within a spend limit period.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// spend_limit_period is the duration after which the amount spent against the spend limit is reset.
+	// It is required when spend_limit is set.
+	SpendLimitPeriod types2.Duration `protobuf:"bytes,6,opt,name=spend_limit_period,json=spendLimitPeriod,proto3" json:"spend_limit_period"`
 }
 
 func (m *MsgCreateGroupAccount) Reset()         { *m = MsgCreateGroupAccount{} }
@@ -1073,7 +1082,8 @@ var xxx_messageInfo_MsgVoteResponse proto.InternalMessageInfo
 type MsgExec struct {
 	// proposal is the unique ID of the proposal.
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// signer is the account address used to execute the proposal.
+	// signer is the account address used to execute the proposal. It can be any
+	// account, not only a member of the group.
 	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
@@ -1161,6 +1171,191 @@ func (m *MsgExecResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecResponse proto.InternalMessageInfo
 
+// MsgPruneProposal is the Msg/PruneProposal request type.
+type MsgPruneProposal struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// signer is the account address used to prune the proposal. It must be
+	// the group admin or the group account of the proposal.
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgPruneProposal) Reset()         { *m = MsgPruneProposal{} }
+func (m *MsgPruneProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneProposal) ProtoMessage()    {}
+func (*MsgPruneProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4673626e7797578, []int{22}
+}
+func (m *MsgPruneProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneProposal.Merge(m, src)
+}
+func (m *MsgPruneProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneProposal proto.InternalMessageInfo
+
+func (m *MsgPruneProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgPruneProposal) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// MsgPruneProposalResponse is the Msg/PruneProposal response type.
+type MsgPruneProposalResponse struct {
+}
+
+func (m *MsgPruneProposalResponse) Reset()         { *m = MsgPruneProposalResponse{} }
+func (m *MsgPruneProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneProposalResponse) ProtoMessage()    {}
+func (*MsgPruneProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4673626e7797578, []int{23}
+}
+func (m *MsgPruneProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneProposalResponse.Merge(m, src)
+}
+func (m *MsgPruneProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneProposalResponse proto.InternalMessageInfo
+
+// MsgWithdrawProposal is the Msg/WithdrawProposal request type.
+type MsgWithdrawProposal struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// address is the account address of a proposer or of the group admin.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgWithdrawProposal) Reset()         { *m = MsgWithdrawProposal{} }
+func (m *MsgWithdrawProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawProposal) ProtoMessage()    {}
+func (*MsgWithdrawProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4673626e7797578, []int{24}
+}
+func (m *MsgWithdrawProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawProposal.Merge(m, src)
+}
+func (m *MsgWithdrawProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawProposal proto.InternalMessageInfo
+
+func (m *MsgWithdrawProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgWithdrawProposal) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgWithdrawProposalResponse is the Msg/WithdrawProposal response type.
+type MsgWithdrawProposalResponse struct {
+}
+
+func (m *MsgWithdrawProposalResponse) Reset()         { *m = MsgWithdrawProposalResponse{} }
+func (m *MsgWithdrawProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawProposalResponse) ProtoMessage()    {}
+func (*MsgWithdrawProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4673626e7797578, []int{25}
+}
+func (m *MsgWithdrawProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawProposalResponse.Merge(m, src)
+}
+func (m *MsgWithdrawProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawProposalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("regen.group.v1alpha1.Exec", Exec_name, Exec_value)
 	proto.RegisterType((*MsgCreateGroup)(nil), "regen.group.v1alpha1.MsgCreateGroup")
@@ -1185,73 +1380,89 @@ func init() {
 	proto.RegisterType((*MsgVoteResponse)(nil), "regen.group.v1alpha1.MsgVoteResponse")
 	proto.RegisterType((*MsgExec)(nil), "regen.group.v1alpha1.MsgExec")
 	proto.RegisterType((*MsgExecResponse)(nil), "regen.group.v1alpha1.MsgExecResponse")
+	proto.RegisterType((*MsgPruneProposal)(nil), "regen.group.v1alpha1.MsgPruneProposal")
+	proto.RegisterType((*MsgPruneProposalResponse)(nil), "regen.group.v1alpha1.MsgPruneProposalResponse")
+	proto.RegisterType((*MsgWithdrawProposal)(nil), "regen.group.v1alpha1.MsgWithdrawProposal")
+	proto.RegisterType((*MsgWithdrawProposalResponse)(nil), "regen.group.v1alpha1.MsgWithdrawProposalResponse")
 }
 
 func init() { proto.RegisterFile("regen/group/v1alpha1/tx.proto", fileDescriptor_b4673626e7797578) }
 
 var fileDescriptor_b4673626e7797578 = []byte{
-	// 974 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x73, 0xdb, 0x54,
-	0x10, 0xf7, 0x8b, 0xd5, 0xfc, 0xd9, 0x14, 0x37, 0x55, 0x4d, 0x71, 0xd4, 0xc6, 0xf1, 0x88, 0x76,
-	0x6a, 0x9a, 0x46, 0x22, 0x49, 0x0f, 0x14, 0x7a, 0x49, 0x52, 0xd3, 0xf1, 0x0c, 0x66, 0x82, 0xa0,
-	0x0c, 0x70, 0xf1, 0x28, 0xd2, 0x43, 0x11, 0xb5, 0xf5, 0x34, 0x7a, 0x72, 0x12, 0xdf, 0xb8, 0x30,
-	0x03, 0x17, 0x86, 0x19, 0xbe, 0x40, 0x3f, 0x01, 0x27, 0xae, 0x5c, 0xb8, 0xd0, 0xe1, 0xd4, 0x23,
-	0x27, 0x86, 0x49, 0xbe, 0x08, 0xe3, 0x27, 0xe9, 0x55, 0x8a, 0x25, 0x59, 0xea, 0xe4, 0xe6, 0xd5,
-	0xfe, 0x76, 0xf7, 0xb7, 0x7f, 0xde, 0xee, 0x18, 0xd6, 0x3c, 0x6c, 0x61, 0x47, 0xb5, 0x3c, 0x32,
-	0x72, 0xd5, 0xe3, 0x2d, 0x7d, 0xe0, 0x1e, 0xe9, 0x5b, 0xaa, 0x7f, 0xaa, 0xb8, 0x1e, 0xf1, 0x89,
-	0x58, 0x67, 0x6a, 0x85, 0xa9, 0x95, 0x48, 0x2d, 0xd5, 0x2d, 0x62, 0x11, 0x06, 0x50, 0x27, 0xbf,
-	0x02, 0xac, 0xb4, 0x6a, 0x10, 0x3a, 0x24, 0xb4, 0x1f, 0x28, 0x02, 0x21, 0x52, 0x59, 0x84, 0x58,
-	0x03, 0xac, 0x32, 0xe9, 0x70, 0xf4, 0xad, 0xaa, 0x3b, 0xe3, 0x50, 0xd5, 0x4a, 0x27, 0x30, 0x76,
-	0x71, 0x68, 0x2c, 0x7f, 0x8f, 0xa0, 0xd6, 0xa3, 0xd6, 0xbe, 0x87, 0x75, 0x1f, 0x3f, 0x9d, 0xe0,
-	0xc4, 0x3a, 0x5c, 0xd1, 0xcd, 0xa1, 0xed, 0x34, 0x50, 0x0b, 0xb5, 0x97, 0xb4, 0x40, 0x10, 0x1f,
-	0xc3, 0xc2, 0x10, 0x0f, 0x0f, 0xb1, 0x47, 0x1b, 0x73, 0xad, 0x6a, 0x7b, 0x79, 0xfb, 0xb6, 0x92,
-	0x46, 0x5f, 0xe9, 0x31, 0xd0, 0x9e, 0xf0, 0xf2, 0xdf, 0xf5, 0x8a, 0x16, 0x99, 0x88, 0x12, 0x2c,
-	0x0e, 0xb1, 0xaf, 0x9b, 0xba, 0xaf, 0x37, 0xaa, 0x2d, 0xd4, 0xbe, 0xaa, 0x71, 0x59, 0xde, 0x81,
-	0x9b, 0x49, 0x06, 0x1a, 0xa6, 0x2e, 0x71, 0x28, 0x16, 0x57, 0x61, 0x91, 0x79, 0xef, 0xdb, 0x26,
-	0x23, 0x23, 0x68, 0x0b, 0x4c, 0xee, 0x9a, 0xf2, 0xaf, 0x08, 0xde, 0xee, 0x51, 0xeb, 0x99, 0x6b,
-	0x46, 0x56, 0xbd, 0x30, 0x54, 0x3a, 0xfd, 0xb8, 0xab, 0xb9, 0x84, 0x2b, 0xb1, 0x0b, 0xb5, 0x80,
-	0x66, 0x7f, 0xc4, 0xbc, 0xd1, 0x46, 0xb5, 0x70, 0x82, 0x6f, 0x05, 0x96, 0x01, 0x0d, 0x2a, 0xaf,
-	0xc3, 0x5a, 0x2a, 0xa9, 0x28, 0x23, 0xd9, 0x80, 0x1b, 0x49, 0xc0, 0x2e, 0x63, 0x57, 0x9a, 0xf3,
-	0x2d, 0x58, 0x72, 0xf0, 0x49, 0x3f, 0x30, 0xaa, 0x32, 0xa3, 0x45, 0x07, 0x9f, 0x30, 0x6f, 0xf2,
-	0x1a, 0xdc, 0x4a, 0x09, 0xc2, 0x39, 0x60, 0x56, 0xef, 0x04, 0xc9, 0xa0, 0x13, 0xe5, 0x69, 0xe4,
-	0xb5, 0xb5, 0x05, 0xcd, 0xf4, 0x30, 0x9c, 0xc8, 0x1f, 0x41, 0x0f, 0x63, 0x9d, 0xdf, 0x35, 0x0c,
-	0x32, 0x72, 0xfc, 0x4b, 0x25, 0x22, 0x7e, 0x06, 0xd7, 0x4c, 0x6c, 0xd8, 0xd4, 0x26, 0x4e, 0xdf,
-	0x25, 0x03, 0xdb, 0x18, 0x37, 0x84, 0x16, 0x6a, 0x2f, 0x6f, 0xd7, 0x95, 0xe0, 0xe5, 0x28, 0xd1,
-	0xcb, 0x51, 0x76, 0x9d, 0xf1, 0x9e, 0xf8, 0xf7, 0xef, 0x9b, 0xb5, 0x27, 0xa1, 0xc1, 0x01, 0xc3,
-	0x6b, 0x35, 0x33, 0x21, 0x7f, 0x28, 0xfc, 0xf8, 0x62, 0xbd, 0x22, 0x3f, 0x62, 0xdd, 0x9e, 0xa6,
-	0xcf, 0xe7, 0xb7, 0x01, 0x0b, 0xba, 0x69, 0x7a, 0x98, 0xd2, 0x30, 0x91, 0x48, 0x94, 0x6d, 0x90,
-	0x2e, 0xb4, 0x28, 0x30, 0xcd, 0x1b, 0x87, 0x98, 0xb7, 0xb9, 0x84, 0xb7, 0xfc, 0x69, 0xb8, 0x03,
-	0x72, 0x76, 0x28, 0xde, 0x8b, 0xdf, 0x10, 0xbc, 0x9b, 0x0a, 0x4b, 0x56, 0xa2, 0x34, 0xb5, 0x94,
-	0xe2, 0x57, 0x2f, 0xa5, 0xf8, 0x9b, 0xb0, 0x51, 0x80, 0x2f, 0xcf, 0xef, 0xf9, 0xc5, 0x97, 0x19,
-	0xc2, 0x67, 0xcc, 0x7e, 0x76, 0x62, 0x79, 0xa3, 0x7f, 0x0f, 0xee, 0xe6, 0x06, 0xe3, 0xac, 0xfe,
-	0x42, 0x70, 0x9d, 0x8f, 0xd0, 0x81, 0x47, 0x5c, 0x42, 0xf5, 0x41, 0xf6, 0xd8, 0x88, 0xb7, 0x61,
-	0xc9, 0x65, 0xa8, 0x68, 0x0d, 0x2f, 0x69, 0xaf, 0x3f, 0xe4, 0x3e, 0x82, 0x36, 0x08, 0x43, 0x6a,
-	0xd1, 0x86, 0xc0, 0x56, 0x5b, 0x6a, 0xf1, 0x35, 0x86, 0x10, 0x15, 0x10, 0xf0, 0x29, 0x36, 0x1a,
-	0x57, 0x5a, 0xa8, 0x5d, 0xdb, 0x96, 0xd2, 0x97, 0x60, 0xe7, 0x14, 0x1b, 0x1a, 0xc3, 0x85, 0xed,
-	0x78, 0x0c, 0xab, 0x53, 0x89, 0xf0, 0x77, 0xb0, 0x0e, 0xcb, 0x6e, 0xf8, 0xed, 0xf5, 0x2a, 0x87,
-	0xe8, 0x53, 0xd7, 0x94, 0xff, 0x44, 0xb0, 0xd0, 0xa3, 0xd6, 0x97, 0xc4, 0x9f, 0x0d, 0x9e, 0x74,
-	0xea, 0x98, 0xf8, 0xd8, 0x0b, 0x3b, 0x12, 0x08, 0xe2, 0x43, 0x98, 0x37, 0x8e, 0x88, 0x6d, 0x60,
-	0x96, 0x7a, 0x2d, 0x6b, 0x7b, 0xef, 0x33, 0x8c, 0x16, 0x62, 0x13, 0x25, 0x13, 0x2e, 0x94, 0xac,
-	0x64, 0x21, 0xe4, 0xeb, 0x70, 0x2d, 0xcc, 0x81, 0xf7, 0x77, 0x8f, 0xa5, 0x35, 0xc1, 0xcc, 0x4e,
-	0xeb, 0x26, 0xcc, 0x53, 0xdb, 0x72, 0x78, 0x5e, 0xa1, 0x14, 0xba, 0x65, 0x71, 0x42, 0xb7, 0xf7,
-	0xef, 0x83, 0xc0, 0x7c, 0xd6, 0x61, 0xa5, 0xf3, 0x55, 0x67, 0xbf, 0xff, 0xec, 0xd3, 0xcf, 0x0f,
-	0x3a, 0xfb, 0xdd, 0x8f, 0xbb, 0x9d, 0x27, 0x2b, 0x15, 0xf1, 0x2a, 0x2c, 0xb2, 0xaf, 0x5f, 0x68,
-	0x5f, 0xaf, 0xa0, 0xed, 0x9f, 0x00, 0xaa, 0x3d, 0x6a, 0x89, 0x3a, 0x2c, 0xc7, 0x8f, 0xfc, 0x9d,
-	0x8c, 0xe3, 0x96, 0xd8, 0x67, 0xd2, 0x83, 0x22, 0x28, 0xde, 0xe6, 0x63, 0x10, 0x53, 0xee, 0xf1,
-	0x46, 0xa6, 0x8f, 0x69, 0xb0, 0xb4, 0x53, 0x02, 0xcc, 0xe3, 0xba, 0xb0, 0x32, 0x75, 0x51, 0xdf,
-	0x2b, 0xe2, 0x88, 0x41, 0xa5, 0xad, 0xc2, 0x50, 0x1e, 0x71, 0x0c, 0x37, 0xd2, 0xee, 0xe7, 0x83,
-	0x62, 0xec, 0x03, 0xb4, 0xf4, 0xb0, 0x0c, 0x3a, 0x5e, 0xe4, 0x94, 0x83, 0xb9, 0x51, 0xa4, 0x51,
-	0x21, 0x38, 0xa7, 0xc8, 0x39, 0xb7, 0xec, 0x07, 0x04, 0xef, 0x64, 0xdd, 0xab, 0xf7, 0x0b, 0x55,
-	0x30, 0x66, 0x21, 0x7d, 0x50, 0xd6, 0x82, 0xf3, 0x78, 0x81, 0xa0, 0x35, 0xf3, 0x4a, 0x3d, 0x2a,
-	0xe1, 0x3e, 0x69, 0x2a, 0xed, 0xbe, 0xb1, 0x29, 0xa7, 0xf8, 0x33, 0x02, 0x29, 0xe7, 0xd2, 0xec,
-	0x94, 0x88, 0xc0, 0x87, 0xe5, 0xa3, 0x37, 0x30, 0xe2, 0x84, 0xbe, 0x83, 0xda, 0x85, 0x13, 0x73,
-	0x6f, 0xc6, 0x08, 0x44, 0x40, 0x49, 0x2d, 0x08, 0xe4, 0xb1, 0x3e, 0x01, 0x81, 0xad, 0xf1, 0xb5,
-	0x4c, 0xc3, 0x89, 0x5a, 0xba, 0x9b, 0xab, 0x8e, 0x7b, 0x63, 0x9b, 0x2e, 0xdb, 0xdb, 0x44, 0x9d,
-	0xe3, 0x2d, 0xbe, 0x37, 0xf7, 0x9e, 0xbe, 0x3c, 0x6b, 0xa2, 0x57, 0x67, 0x4d, 0xf4, 0xdf, 0x59,
-	0x13, 0xfd, 0x72, 0xde, 0xac, 0xbc, 0x3a, 0x6f, 0x56, 0xfe, 0x39, 0x6f, 0x56, 0xbe, 0xd9, 0xb4,
-	0x6c, 0xff, 0x68, 0x74, 0xa8, 0x18, 0x64, 0xa8, 0x32, 0x57, 0x9b, 0x0e, 0xf6, 0x4f, 0x88, 0xf7,
-	0x3c, 0x94, 0x06, 0xd8, 0xb4, 0xb0, 0xa7, 0x9e, 0x06, 0x7f, 0xa5, 0x0e, 0xe7, 0xd9, 0xdd, 0xdc,
-	0xf9, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x44, 0x4b, 0xbb, 0x9d, 0xe1, 0x0d, 0x00, 0x00,
+	// 1164 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x73, 0xdb, 0xd4,
+	0x13, 0xb7, 0x62, 0xe7, 0xd7, 0xba, 0x75, 0x5d, 0x35, 0xcd, 0x57, 0x51, 0x6b, 0xc7, 0xa3, 0x6f,
+	0x43, 0x4d, 0xd3, 0x48, 0x75, 0xd2, 0x03, 0x85, 0x5e, 0xf2, 0x8b, 0x4e, 0x86, 0x9a, 0x31, 0x82,
+	0xf2, 0xeb, 0xe2, 0x91, 0xa5, 0x87, 0x22, 0x62, 0xeb, 0x69, 0xf4, 0xe4, 0xfc, 0xb8, 0x71, 0x61,
+	0x86, 0x13, 0xc3, 0x0c, 0xff, 0x40, 0xcf, 0x1c, 0x38, 0xf1, 0x17, 0x70, 0xa1, 0xc3, 0xa9, 0x47,
+	0x4e, 0xc0, 0x24, 0xfc, 0x15, 0x9c, 0x18, 0x3f, 0x3d, 0xbd, 0x58, 0x8e, 0xe4, 0xc8, 0x81, 0x53,
+	0xb2, 0xda, 0xcf, 0x7e, 0x76, 0xf7, 0xed, 0xee, 0xdb, 0x67, 0xa8, 0xf8, 0xc8, 0x46, 0xae, 0x66,
+	0xfb, 0xb8, 0xef, 0x69, 0x87, 0x0d, 0xa3, 0xeb, 0xed, 0x1b, 0x0d, 0x2d, 0x38, 0x56, 0x3d, 0x1f,
+	0x07, 0x58, 0x5c, 0xa0, 0x6a, 0x95, 0xaa, 0xd5, 0x48, 0x2d, 0x2f, 0xd8, 0xd8, 0xc6, 0x14, 0xa0,
+	0x0d, 0xfe, 0x0b, 0xb1, 0xf2, 0x92, 0x89, 0x49, 0x0f, 0x93, 0x76, 0xa8, 0x08, 0x85, 0x48, 0x65,
+	0x63, 0x6c, 0x77, 0x91, 0x46, 0xa5, 0x4e, 0xff, 0x0b, 0xcd, 0x70, 0x4f, 0x98, 0xaa, 0x3a, 0xaa,
+	0xb2, 0xfa, 0xbe, 0x11, 0x38, 0xd8, 0x8d, 0xf4, 0x21, 0x91, 0xd6, 0x31, 0x08, 0xd2, 0x0e, 0x1b,
+	0x1d, 0x14, 0x18, 0x0d, 0xcd, 0xc4, 0x4e, 0xa4, 0xaf, 0x25, 0x27, 0x70, 0xe2, 0x21, 0xe6, 0x5c,
+	0xf9, 0x4a, 0x80, 0x52, 0x93, 0xd8, 0xdb, 0x3e, 0x32, 0x02, 0xf4, 0x6c, 0x80, 0x13, 0x17, 0x60,
+	0xda, 0xb0, 0x7a, 0x8e, 0x2b, 0x09, 0x35, 0xa1, 0x3e, 0xaf, 0x87, 0x82, 0xf8, 0x14, 0x66, 0x7b,
+	0xa8, 0xd7, 0x41, 0x3e, 0x91, 0xa6, 0x6a, 0xf9, 0x7a, 0x71, 0xfd, 0xae, 0x9a, 0x94, 0xbe, 0xda,
+	0xa4, 0xa0, 0xad, 0xc2, 0xab, 0xdf, 0x97, 0x73, 0x7a, 0x64, 0x22, 0xca, 0x30, 0xd7, 0x43, 0x81,
+	0x61, 0x19, 0x81, 0x21, 0xe5, 0x6b, 0x42, 0xfd, 0x9a, 0xce, 0x65, 0x65, 0x03, 0x16, 0xe3, 0x11,
+	0xe8, 0x88, 0x78, 0xd8, 0x25, 0x48, 0x5c, 0x82, 0x39, 0xca, 0xde, 0x76, 0x2c, 0x1a, 0x4c, 0x41,
+	0x9f, 0xa5, 0xf2, 0x9e, 0xa5, 0x7c, 0x2f, 0xc0, 0xed, 0x26, 0xb1, 0x5f, 0x78, 0x56, 0x64, 0xd5,
+	0x64, 0xae, 0x92, 0xc3, 0x1f, 0xa6, 0x9a, 0x8a, 0x51, 0x89, 0x7b, 0x50, 0x0a, 0xc3, 0x6c, 0xf7,
+	0x29, 0x1b, 0x91, 0xf2, 0x99, 0x13, 0xbc, 0x1e, 0x5a, 0x86, 0x61, 0x10, 0x65, 0x19, 0x2a, 0x89,
+	0x41, 0x45, 0x19, 0x29, 0x26, 0xdc, 0x8a, 0x03, 0x36, 0x69, 0x74, 0x13, 0xc7, 0x7c, 0x07, 0xe6,
+	0x5d, 0x74, 0xd4, 0x0e, 0x8d, 0xf2, 0xd4, 0x68, 0xce, 0x45, 0x47, 0x94, 0x4d, 0xa9, 0xc0, 0x9d,
+	0x04, 0x27, 0x3c, 0x06, 0x04, 0x8b, 0x71, 0x75, 0x93, 0x55, 0x62, 0xf2, 0x30, 0xc6, 0x95, 0xb5,
+	0x06, 0xd5, 0x64, 0x37, 0x3c, 0x90, 0xbf, 0xa7, 0xe0, 0x76, 0xbc, 0xf2, 0x9b, 0xa6, 0x89, 0xfb,
+	0x6e, 0xf0, 0x9f, 0x06, 0x22, 0x7e, 0x00, 0x37, 0x2c, 0x64, 0x3a, 0xc4, 0xc1, 0x6e, 0xdb, 0xc3,
+	0x5d, 0xc7, 0x3c, 0x91, 0x0a, 0x35, 0xa1, 0x5e, 0x5c, 0x5f, 0x50, 0xc3, 0xf1, 0x52, 0xa3, 0xf1,
+	0x52, 0x37, 0xdd, 0x93, 0x2d, 0xf1, 0xd7, 0x9f, 0xd6, 0x4a, 0x3b, 0xcc, 0xa0, 0x45, 0xf1, 0x7a,
+	0xc9, 0x8a, 0xc9, 0x62, 0x17, 0x8a, 0xc4, 0x43, 0xae, 0xd5, 0xee, 0x3a, 0x3d, 0x27, 0x90, 0xa6,
+	0x69, 0xbf, 0x2c, 0xa9, 0x6c, 0xac, 0x07, 0xd3, 0xa8, 0xb2, 0x69, 0x54, 0xb7, 0xb1, 0xe3, 0x6e,
+	0x3d, 0x1a, 0x34, 0xcb, 0x0f, 0x7f, 0x2c, 0xd7, 0x6d, 0x27, 0xd8, 0xef, 0x77, 0x54, 0x13, 0xf7,
+	0xd8, 0x1d, 0xc0, 0xfe, 0xac, 0x11, 0xeb, 0x80, 0xcd, 0xe5, 0xc0, 0x80, 0xe8, 0x40, 0xf9, 0x9f,
+	0x0f, 0xe8, 0xc5, 0x26, 0x88, 0x43, 0xde, 0xda, 0x1e, 0xf2, 0x1d, 0x6c, 0x49, 0x33, 0x34, 0x87,
+	0xa5, 0x0b, 0x39, 0xec, 0xb0, 0x2b, 0x82, 0x75, 0x68, 0xf9, 0x9c, 0xa8, 0x45, 0x0d, 0xdf, 0x2e,
+	0x7c, 0xf3, 0x72, 0x39, 0xa7, 0x3c, 0x81, 0x4a, 0xe2, 0xd9, 0xf3, 0xe1, 0x93, 0x60, 0xd6, 0xb0,
+	0x2c, 0x1f, 0x11, 0xc2, 0xaa, 0x10, 0x89, 0x8a, 0x03, 0xf2, 0x48, 0x7f, 0x85, 0xa6, 0xe3, 0x7a,
+	0x79, 0x88, 0x6d, 0x2a, 0xc6, 0x36, 0xbe, 0x95, 0xef, 0x81, 0x92, 0xee, 0x8a, 0x37, 0xd2, 0x8f,
+	0x02, 0xfc, 0x3f, 0x11, 0x16, 0x2f, 0xe3, 0xc4, 0xa1, 0x25, 0x74, 0x4e, 0xfe, 0xdf, 0x75, 0x0e,
+	0x3b, 0xfc, 0x35, 0x58, 0xcd, 0x10, 0x2f, 0xcf, 0xef, 0x00, 0x2a, 0x89, 0xf0, 0x4b, 0x06, 0x37,
+	0x3d, 0xb1, 0x71, 0x73, 0x7b, 0x1f, 0x56, 0xc6, 0x3a, 0xe3, 0x51, 0xfd, 0x22, 0xc0, 0x4d, 0xde,
+	0x42, 0x2d, 0x1f, 0x7b, 0x98, 0x18, 0xdd, 0xf4, 0xb6, 0x11, 0xef, 0xc2, 0xbc, 0x47, 0x51, 0xd1,
+	0x0e, 0x99, 0xd7, 0xcf, 0x3f, 0x8c, 0x9d, 0xe0, 0x3a, 0x14, 0x7a, 0xc4, 0x26, 0x52, 0xa1, 0x96,
+	0x4f, 0x3b, 0x7c, 0x9d, 0x22, 0x44, 0x15, 0x0a, 0xe8, 0x18, 0x99, 0xd2, 0x74, 0x4d, 0xa8, 0x97,
+	0xd6, 0xe5, 0xe4, 0x1b, 0x7c, 0xf7, 0x18, 0x99, 0x3a, 0xc5, 0xb1, 0x72, 0x3c, 0x85, 0xa5, 0x0b,
+	0x89, 0xf0, 0x39, 0x58, 0x86, 0xa2, 0xc7, 0xbe, 0x9d, 0xef, 0x21, 0x88, 0x3e, 0xed, 0x59, 0xca,
+	0xcf, 0x02, 0xcc, 0x36, 0x89, 0xfd, 0x31, 0x0e, 0x2e, 0x07, 0x0f, 0x2a, 0x75, 0x88, 0x03, 0xe4,
+	0xb3, 0x8a, 0x84, 0x82, 0xf8, 0x18, 0x66, 0xcc, 0x7d, 0xec, 0x98, 0x88, 0xa6, 0x5e, 0x4a, 0x5b,
+	0x3d, 0xdb, 0x14, 0xa3, 0x33, 0x6c, 0xec, 0xc8, 0x0a, 0x23, 0x47, 0x36, 0xe1, 0x41, 0x28, 0x37,
+	0xe1, 0x06, 0xcb, 0x81, 0xd7, 0x77, 0x8b, 0xa6, 0x35, 0xc0, 0x5c, 0x9e, 0xd6, 0x22, 0xcc, 0x10,
+	0xc7, 0x76, 0x79, 0x5e, 0x4c, 0x62, 0xb4, 0xd4, 0x4f, 0x44, 0xfb, 0x1e, 0x94, 0x9b, 0xc4, 0x6e,
+	0xf9, 0x7d, 0xf7, 0xbc, 0x69, 0xae, 0xcc, 0x2f, 0x83, 0x34, 0x4a, 0xc6, 0x1d, 0xb5, 0xe8, 0xae,
+	0xfd, 0xc4, 0x09, 0xf6, 0x2d, 0xdf, 0x38, 0xca, 0xee, 0x2b, 0x75, 0x6c, 0xd8, 0x62, 0x1d, 0x65,
+	0x8c, 0x1c, 0x3e, 0x78, 0x00, 0x05, 0x7a, 0x5a, 0x0b, 0x50, 0xde, 0xfd, 0x74, 0x77, 0xbb, 0xfd,
+	0xe2, 0xfd, 0x0f, 0x5b, 0xbb, 0xdb, 0x7b, 0xef, 0xee, 0xed, 0xee, 0x94, 0x73, 0xe2, 0x35, 0x98,
+	0xa3, 0x5f, 0x3f, 0xd2, 0x3f, 0x2b, 0x0b, 0xeb, 0x7f, 0x15, 0x21, 0xdf, 0x24, 0xb6, 0x68, 0x40,
+	0x71, 0xf8, 0xed, 0x75, 0x2f, 0xe5, 0xcd, 0x11, 0xbb, 0xa9, 0xe5, 0x87, 0x59, 0x50, 0xbc, 0x81,
+	0x0f, 0x41, 0x4c, 0x78, 0x26, 0xad, 0xa6, 0x72, 0x5c, 0x04, 0xcb, 0x1b, 0x13, 0x80, 0xb9, 0x5f,
+	0x0f, 0xca, 0x17, 0x1e, 0x3a, 0x6f, 0x66, 0x21, 0xa2, 0x50, 0xb9, 0x91, 0x19, 0xca, 0x3d, 0x9e,
+	0xc0, 0xad, 0xa4, 0x67, 0xcd, 0xc3, 0x6c, 0xd1, 0x87, 0x68, 0xf9, 0xf1, 0x24, 0xe8, 0xe1, 0x43,
+	0x4e, 0x78, 0xc7, 0xac, 0x66, 0x29, 0x14, 0x03, 0xcb, 0x1b, 0x13, 0x80, 0xb9, 0xdf, 0xaf, 0x05,
+	0xf8, 0x5f, 0xda, 0x26, 0x7e, 0x94, 0xe9, 0x04, 0x87, 0x2c, 0xe4, 0xb7, 0x26, 0xb5, 0xe0, 0x71,
+	0xbc, 0x14, 0xa0, 0x76, 0xe9, 0xfe, 0x7d, 0x32, 0x01, 0x7d, 0xdc, 0x54, 0xde, 0xbc, 0xb2, 0x29,
+	0x0f, 0xf1, 0x5b, 0x01, 0xe4, 0x31, 0x3b, 0x74, 0x63, 0x02, 0x0f, 0xbc, 0x59, 0xde, 0xb9, 0x82,
+	0x11, 0x0f, 0xe8, 0x4b, 0x28, 0x8d, 0x2c, 0xcf, 0xfb, 0x97, 0xb4, 0x40, 0x04, 0x94, 0xb5, 0x8c,
+	0x40, 0xee, 0xeb, 0x39, 0x14, 0xe8, 0x82, 0xaa, 0xa4, 0x1a, 0x0e, 0xd4, 0xf2, 0xca, 0x58, 0xf5,
+	0x30, 0x1b, 0xbd, 0xe9, 0xd2, 0xd9, 0x06, 0x6a, 0x79, 0x65, 0xac, 0x9a, 0xb3, 0xd9, 0x70, 0x3d,
+	0xbe, 0x0e, 0xde, 0x48, 0xb5, 0x8b, 0xe1, 0x64, 0x35, 0x1b, 0x6e, 0xf8, 0x46, 0xba, 0xb0, 0x0e,
+	0xd2, 0x6f, 0xa4, 0x51, 0xa8, 0xdc, 0xc8, 0x0c, 0x8d, 0x3c, 0x6e, 0x3d, 0x7b, 0x75, 0x5a, 0x15,
+	0x5e, 0x9f, 0x56, 0x85, 0x3f, 0x4f, 0xab, 0xc2, 0x77, 0x67, 0xd5, 0xdc, 0xeb, 0xb3, 0x6a, 0xee,
+	0xb7, 0xb3, 0x6a, 0xee, 0xf3, 0xb5, 0xa1, 0x9f, 0x02, 0x94, 0x76, 0xcd, 0x45, 0xc1, 0x11, 0xf6,
+	0x0f, 0x98, 0xd4, 0x45, 0x96, 0x8d, 0x7c, 0xed, 0x38, 0xfc, 0xf1, 0xde, 0x99, 0xa1, 0x8f, 0x9d,
+	0x8d, 0x7f, 0x06, 0x00, 0xaa, 0xcb, 0xa9, 0x3b, 0x93, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1288,6 +1499,10 @@ type MsgClient interface {
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
+	// PruneProposal deletes a finalized proposal and its votes.
+	PruneProposal(ctx context.Context, in *MsgPruneProposal, opts ...grpc.CallOption) (*MsgPruneProposalResponse, error)
+	// WithdrawProposal withdraws a proposal still open for voting.
+	WithdrawProposal(ctx context.Context, in *MsgWithdrawProposal, opts ...grpc.CallOption) (*MsgWithdrawProposalResponse, error)
 }
 
 type msgClient struct {
@@ -1397,6 +1612,24 @@ func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) PruneProposal(ctx context.Context, in *MsgPruneProposal, opts ...grpc.CallOption) (*MsgPruneProposalResponse, error) {
+	out := new(MsgPruneProposalResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/PruneProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawProposal(ctx context.Context, in *MsgWithdrawProposal, opts ...grpc.CallOption) (*MsgWithdrawProposalResponse, error) {
+	out := new(MsgWithdrawProposalResponse)
+	err := c.cc.Invoke(ctx, "/regen.group.v1alpha1.Msg/WithdrawProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1421,6 +1654,10 @@ type MsgServer interface {
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
+	// PruneProposal deletes a finalized proposal and its votes.
+	PruneProposal(context.Context, *MsgPruneProposal) (*MsgPruneProposalResponse, error)
+	// WithdrawProposal withdraws a proposal still open for voting.
+	WithdrawProposal(context.Context, *MsgWithdrawProposal) (*MsgWithdrawProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1460,6 +1697,12 @@ func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVote
 func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (*UnimplementedMsgServer) PruneProposal(ctx context.Context, req *MsgPruneProposal) (*MsgPruneProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneProposal not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawProposal(ctx context.Context, req *MsgWithdrawProposal) (*MsgWithdrawProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1663,6 +1906,42 @@ func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PruneProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/PruneProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneProposal(ctx, req.(*MsgPruneProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.group.v1alpha1.Msg/WithdrawProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawProposal(ctx, req.(*MsgWithdrawProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "regen.group.v1alpha1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1711,6 +1990,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
 		},
+		{
+			MethodName: "PruneProposal",
+			Handler:    _Msg_PruneProposal_Handler,
+		},
+		{
+			MethodName: "WithdrawProposal",
+			Handler:    _Msg_WithdrawProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/group/v1alpha1/tx.proto",
@@ -2017,6 +2304,30 @@ func (m *MsgCreateGroupAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.SpendLimitPeriod.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.DecisionPolicy != nil {
 		{
 			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
@@ -2513,61 +2824,177 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgPruneProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgPruneProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPruneProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovTx(uint64(m.GroupId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGroupMembers) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupMembers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.GroupId != 0 {
 		n += 1 + sovTx(uint64(m.GroupId))
@@ -2669,6 +3096,14 @@ func (m *MsgCreateGroupAccount) Size() (n int) {
 		l = m.DecisionPolicy.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.SpendLimitPeriod.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -2879,6 +3314,56 @@ func (m *MsgExecResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPruneProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3831,6 +4316,73 @@ func (m *MsgCreateGroupAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types1.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimitPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimitPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5214,6 +5766,320 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPruneProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
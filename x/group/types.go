@@ -53,6 +53,8 @@ type DecisionPolicy interface {
 
 	orm.Validateable
 	GetTimeout() types.Duration
+	GetExecutionDelay() types.Duration
+	GetAutoExec() bool
 	Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error)
 	Validate(g GroupInfo) error
 }
@@ -62,10 +64,11 @@ var _ DecisionPolicy = &ThresholdDecisionPolicy{}
 
 // NewThresholdDecisionPolicy creates a threshold DecisionPolicy
 func NewThresholdDecisionPolicy(threshold string, timeout types.Duration) DecisionPolicy {
-	return &ThresholdDecisionPolicy{threshold, timeout}
+	return &ThresholdDecisionPolicy{Threshold: threshold, Timeout: timeout}
 }
 
-// Allow allows a proposal to pass when the tally of yes votes equals or exceeds the threshold before the timeout.
+// Allow allows a proposal to pass when the tally of yes votes equals or exceeds the threshold before the timeout,
+// provided that the optional quorum is reached.
 func (p ThresholdDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
 	timeout, err := types.DurationFromProto(&p.Timeout)
 	if err != nil {
@@ -83,14 +86,19 @@ func (p ThresholdDecisionPolicy) Allow(tally Tally, totalPower string, votingDur
 	if err != nil {
 		return DecisionPolicyResult{}, err
 	}
-	if yesCount.Cmp(threshold) >= 0 {
-		return DecisionPolicyResult{Allow: true, Final: true}, nil
-	}
-
 	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
 	if err != nil {
 		return DecisionPolicyResult{}, err
 	}
+	if yesCount.Cmp(threshold) >= 0 {
+		quorumReached, err := reachesQuorum(p.Quorum, tally, totalPowerDec)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		// More votes can still reach the quorum until the timeout.
+		return DecisionPolicyResult{Allow: quorumReached, Final: quorumReached}, nil
+	}
+
 	totalCounts, err := tally.TotalCounts()
 	if err != nil {
 		return DecisionPolicyResult{}, err
@@ -129,6 +137,287 @@ func (p ThresholdDecisionPolicy) ValidateBasic() error {
 	if _, err := math.NewPositiveDecFromString(p.Threshold); err != nil {
 		return sdkerrors.Wrap(err, "threshold")
 	}
+	if err := validateOptionalQuorum(p.Quorum); err != nil {
+		return sdkerrors.Wrap(err, "quorum")
+	}
+
+	timeout, err := types.DurationFromProto(&p.Timeout)
+	if err != nil {
+		return sdkerrors.Wrap(err, "timeout")
+	}
+
+	if timeout <= time.Nanosecond {
+		return sdkerrors.Wrap(ErrInvalid, "timeout")
+	}
+	if err := validateExecutionDelay(p.ExecutionDelay); err != nil {
+		return sdkerrors.Wrap(err, "execution delay")
+	}
+	return nil
+}
+
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &PercentageDecisionPolicy{}
+
+// NewPercentageDecisionPolicy creates a percentage DecisionPolicy
+func NewPercentageDecisionPolicy(percentage string, timeout types.Duration) DecisionPolicy {
+	return &PercentageDecisionPolicy{Percentage: percentage, Timeout: timeout}
+}
+
+// Allow allows a proposal to pass when the share of yes votes in the total power equals or exceeds the percentage
+// before the timeout, provided that the optional quorum is reached.
+func (p PercentageDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	timeout, err := types.DurationFromProto(&p.Timeout)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if timeout <= votingDuration {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	percentage, err := math.NewPositiveDecFromString(p.Percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if totalPowerDec.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	yesCount, err := math.NewNonNegativeDecFromString(tally.YesCount)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	yesPercentage, err := yesCount.Quo(totalPowerDec)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if yesPercentage.Cmp(percentage) >= 0 {
+		quorumReached, err := reachesQuorum(p.Quorum, tally, totalPowerDec)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		// More votes can still reach the quorum until the timeout.
+		return DecisionPolicyResult{Allow: quorumReached, Final: quorumReached}, nil
+	}
+
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	undecided, err := math.SubNonNegative(totalPowerDec, totalCounts)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	sum, err := yesCount.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	sumPercentage, err := sum.Quo(totalPowerDec)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if sumPercentage.Cmp(percentage) < 0 {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// Validate is a no-op as a valid percentage can always be reached by a group with a positive total weight
+func (p *PercentageDecisionPolicy) Validate(g GroupInfo) error {
+	return nil
+}
+
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	percentage, err := math.NewPositiveDecFromString(p.Percentage)
+	if err != nil {
+		return sdkerrors.Wrap(err, "percentage")
+	}
+	if percentage.Cmp(math.NewDecFromInt64(1)) > 0 {
+		return sdkerrors.Wrap(ErrInvalid, "percentage must not be greater than 1")
+	}
+	if err := validateOptionalQuorum(p.Quorum); err != nil {
+		return sdkerrors.Wrap(err, "quorum")
+	}
+
+	timeout, err := types.DurationFromProto(&p.Timeout)
+	if err != nil {
+		return sdkerrors.Wrap(err, "timeout")
+	}
+
+	if timeout <= time.Nanosecond {
+		return sdkerrors.Wrap(ErrInvalid, "timeout")
+	}
+	if err := validateExecutionDelay(p.ExecutionDelay); err != nil {
+		return sdkerrors.Wrap(err, "execution delay")
+	}
+	return nil
+}
+
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &QuorumDecisionPolicy{}
+
+// NewQuorumDecisionPolicy creates a quorum DecisionPolicy
+func NewQuorumDecisionPolicy(quorum, threshold, vetoThreshold string, timeout types.Duration) DecisionPolicy {
+	return &QuorumDecisionPolicy{Quorum: quorum, Threshold: threshold, VetoThreshold: vetoThreshold, Timeout: timeout}
+}
+
+// Allow allows a proposal to pass when, at the end of the voting period, the share of the total power that voted
+// reaches the quorum, the share of yes votes in the yes, no and veto votes reaches the threshold and the share of
+// veto votes in all the votes stays below the veto threshold.
+// Before the timeout, the proposal is only decided once the remaining votes can't change the outcome anymore.
+func (p QuorumDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	timeout, err := types.DurationFromProto(&p.Timeout)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	quorum, err := math.NewPositiveDecFromString(p.Quorum)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "quorum")
+	}
+	threshold, err := math.NewPositiveDecFromString(p.Threshold)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "threshold")
+	}
+	vetoThreshold, err := math.NewPositiveDecFromString(p.VetoThreshold)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "veto threshold")
+	}
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if totalPowerDec.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	yesCount, err := tally.GetYesCount()
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "yes count")
+	}
+	vetoCount, err := tally.GetVetoCount()
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "veto count")
+	}
+	abstainCount, err := tally.GetAbstainCount()
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "abstain count")
+	}
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	// abstain votes count toward the quorum but not toward the yes share
+	nonAbstainCounts, err := math.SubNonNegative(totalCounts, abstainCount)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	if timeout <= votingDuration {
+		pass, err := quorumTallyPasses(yesCount, vetoCount, totalCounts, nonAbstainCounts, totalPowerDec, quorum, threshold, vetoThreshold)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		return DecisionPolicyResult{Allow: pass, Final: true}, nil
+	}
+
+	undecided, err := math.SubNonNegative(totalPowerDec, totalCounts)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	// Enough veto votes reject the proposal outright, whatever the remaining votes are.
+	vetoShare, err := vetoCount.Quo(totalPowerDec)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if vetoShare.Cmp(vetoThreshold) >= 0 {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	// The proposal can't pass anymore, even if all the remaining votes are yes.
+	bestYes, err := yesCount.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	bestNonAbstain, err := nonAbstainCounts.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if bestNonAbstain.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	bestYesShare, err := bestYes.Quo(bestNonAbstain)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if bestYesShare.Cmp(threshold) < 0 {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	// The proposal passes anyway, even if all the remaining votes are veto.
+	worstVeto, err := vetoCount.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	pass, err := quorumTallyPasses(yesCount, worstVeto, totalPowerDec, bestNonAbstain, totalPowerDec, quorum, threshold, vetoThreshold)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if pass {
+		quorumReached, err := reachesShare(totalCounts, totalPowerDec, quorum)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		if quorumReached {
+			return DecisionPolicyResult{Allow: true, Final: true}, nil
+		}
+	}
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// quorumTallyPasses returns whether the given votes reach the quorum and the yes threshold without reaching the
+// veto threshold.
+func quorumTallyPasses(yesCount, vetoCount, totalCounts, nonAbstainCounts, totalPower, quorum, threshold, vetoThreshold math.Dec) (bool, error) {
+	if ok, err := reachesShare(totalCounts, totalPower, quorum); err != nil || !ok {
+		return false, err
+	}
+	if vetoed, err := reachesShare(vetoCount, totalCounts, vetoThreshold); err != nil || vetoed {
+		return false, err
+	}
+	return reachesShare(yesCount, nonAbstainCounts, threshold)
+}
+
+// reachesShare returns whether x divided by total equals or exceeds the given share, false when total is zero.
+func reachesShare(x, total, share math.Dec) (bool, error) {
+	if total.IsZero() {
+		return false, nil
+	}
+	ratio, err := x.Quo(total)
+	if err != nil {
+		return false, err
+	}
+	return ratio.Cmp(share) >= 0, nil
+}
+
+// Validate is a no-op as the quorum and thresholds are shares of the votes and can always be reached by a group
+// with a positive total weight
+func (p *QuorumDecisionPolicy) Validate(g GroupInfo) error {
+	return nil
+}
+
+func (p QuorumDecisionPolicy) ValidateBasic() error {
+	if err := validateShare(p.Quorum); err != nil {
+		return sdkerrors.Wrap(err, "quorum")
+	}
+	if err := validateShare(p.Threshold); err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+	if err := validateShare(p.VetoThreshold); err != nil {
+		return sdkerrors.Wrap(err, "veto threshold")
+	}
 
 	timeout, err := types.DurationFromProto(&p.Timeout)
 	if err != nil {
@@ -138,6 +427,58 @@ func (p ThresholdDecisionPolicy) ValidateBasic() error {
 	if timeout <= time.Nanosecond {
 		return sdkerrors.Wrap(ErrInvalid, "timeout")
 	}
+	if err := validateExecutionDelay(p.ExecutionDelay); err != nil {
+		return sdkerrors.Wrap(err, "execution delay")
+	}
+	return nil
+}
+
+// reachesQuorum returns whether the votes reach the optional quorum share of the total power.
+// An empty quorum is always reached.
+func reachesQuorum(quorum string, tally Tally, totalPower math.Dec) (bool, error) {
+	if quorum == "" {
+		return true, nil
+	}
+	quorumDec, err := math.NewPositiveDecFromString(quorum)
+	if err != nil {
+		return false, sdkerrors.Wrap(err, "quorum")
+	}
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return false, err
+	}
+	return reachesShare(totalCounts, totalPower, quorumDec)
+}
+
+// validateOptionalQuorum returns an error if quorum is set but not a decimal in (0, 1].
+func validateOptionalQuorum(quorum string) error {
+	if quorum == "" {
+		return nil
+	}
+	return validateShare(quorum)
+}
+
+// validateExecutionDelay returns an error if the execution delay is negative.
+func validateExecutionDelay(executionDelay types.Duration) error {
+	delay, err := types.DurationFromProto(&executionDelay)
+	if err != nil {
+		return err
+	}
+	if delay < 0 {
+		return sdkerrors.Wrap(ErrInvalid, "must not be negative")
+	}
+	return nil
+}
+
+// validateShare returns an error if share is not a decimal in (0, 1].
+func validateShare(share string) error {
+	dec, err := math.NewPositiveDecFromString(share)
+	if err != nil {
+		return err
+	}
+	if dec.Cmp(math.NewDecFromInt64(1)) > 0 {
+		return sdkerrors.Wrap(ErrInvalid, "must not be greater than 1")
+	}
 	return nil
 }
 
@@ -225,6 +566,72 @@ func (g GroupAccountInfo) ValidateBasic() error {
 	if g.DerivationKey == nil {
 		return sdkerrors.Wrap(ErrEmpty, "derivationKey")
 	}
+
+	if err := validateSpendLimit(g.SpendLimit, g.SpendLimitPeriod); err != nil {
+		return err
+	}
+	if !g.PeriodSpent.Empty() {
+		if err := g.PeriodSpent.Validate(); err != nil {
+			return sdkerrors.Wrap(err, "period spent")
+		}
+	}
+	return nil
+}
+
+// HasSpendLimit returns whether the coins sent by executing proposals of the group account are limited.
+func (g GroupAccountInfo) HasSpendLimit() bool {
+	return !g.SpendLimit.Empty()
+}
+
+// Spend records the coins sent at the given block time against the spend limit of the group account,
+// starting a new spend limit period first if the current one is over. It returns an error if the
+// coins spent within the period would exceed the spend limit.
+func (g *GroupAccountInfo) Spend(amount sdk.Coins, blockTime time.Time) error {
+	if !g.HasSpendLimit() {
+		return nil
+	}
+
+	period, err := types.DurationFromProto(&g.SpendLimitPeriod)
+	if err != nil {
+		return sdkerrors.Wrap(err, "spend limit period")
+	}
+	periodStart, err := types.TimestampFromProto(&g.PeriodStart)
+	if err != nil {
+		return sdkerrors.Wrap(err, "period start")
+	}
+	if (g.PeriodStart.Seconds == 0 && g.PeriodStart.Nanos == 0) || !blockTime.Before(periodStart.Add(period)) {
+		newPeriodStart, err := types.TimestampProto(blockTime)
+		if err != nil {
+			return sdkerrors.Wrap(err, "period start")
+		}
+		g.PeriodStart = *newPeriodStart
+		g.PeriodSpent = sdk.NewCoins()
+	}
+
+	spent := g.PeriodSpent.Add(amount...)
+	if !spent.IsAllLTE(g.SpendLimit) {
+		return sdkerrors.Wrapf(ErrMaxLimit, "spending %s would exceed spend limit %s with %s already spent in the current period",
+			amount, g.SpendLimit, g.PeriodSpent)
+	}
+	g.PeriodSpent = spent
+	return nil
+}
+
+// validateSpendLimit checks that a spend limit, if any, is made of valid coins and has a positive period.
+func validateSpendLimit(spendLimit sdk.Coins, spendLimitPeriod types.Duration) error {
+	if spendLimit.Empty() {
+		return nil
+	}
+	if err := spendLimit.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "spend limit")
+	}
+	period, err := types.DurationFromProto(&spendLimitPeriod)
+	if err != nil {
+		return sdkerrors.Wrap(err, "spend limit period")
+	}
+	if period <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "spend limit period")
+	}
 	return nil
 }
 
@@ -282,6 +689,16 @@ const MaxMetadataLength = 255
 
 var _ orm.Validateable = GroupInfo{}
 
+// ProposalStatusFromString returns a Proposal_Status from a string. It returns an error
+// if the string is invalid.
+func ProposalStatusFromString(str string) (Proposal_Status, error) {
+	status, ok := Proposal_Status_value[str]
+	if !ok || Proposal_Status(status) == ProposalStatusInvalid {
+		return ProposalStatusInvalid, fmt.Errorf("'%s' is not a valid proposal status", str)
+	}
+	return Proposal_Status(status), nil
+}
+
 func (g GroupInfo) ValidateBasic() error {
 	if g.GroupId == 0 {
 		return sdkerrors.Wrap(ErrEmpty, "group")
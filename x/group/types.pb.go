@@ -7,6 +7,8 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
@@ -79,6 +81,8 @@ const (
 	ProposalStatusClosed Proposal_Status = 2
 	// Final status of a proposal when the group was modified before the final tally.
 	ProposalStatusAborted Proposal_Status = 3
+	// Final status of a proposal when it was withdrawn by a proposer or the group admin before the final tally.
+	ProposalStatusWithdrawn Proposal_Status = 4
 )
 
 var Proposal_Status_name = map[int32]string{
@@ -86,6 +90,7 @@ var Proposal_Status_name = map[int32]string{
 	1: "STATUS_SUBMITTED",
 	2: "STATUS_CLOSED",
 	3: "STATUS_ABORTED",
+	4: "STATUS_WITHDRAWN",
 }
 
 var Proposal_Status_value = map[string]int32{
@@ -93,6 +98,7 @@ var Proposal_Status_value = map[string]int32{
 	"STATUS_SUBMITTED":   1,
 	"STATUS_CLOSED":      2,
 	"STATUS_ABORTED":     3,
+	"STATUS_WITHDRAWN":   4,
 }
 
 func (x Proposal_Status) String() string {
@@ -100,7 +106,7 @@ func (x Proposal_Status) String() string {
 }
 
 func (Proposal_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{6, 0}
+	return fileDescriptor_9b7906b115009838, []int{8, 0}
 }
 
 // Result defines types of proposal results.
@@ -136,7 +142,7 @@ func (x Proposal_Result) String() string {
 }
 
 func (Proposal_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{6, 1}
+	return fileDescriptor_9b7906b115009838, []int{8, 1}
 }
 
 // ExecutorResult defines types of proposal executor results.
@@ -172,7 +178,7 @@ func (x Proposal_ExecutorResult) String() string {
 }
 
 func (Proposal_ExecutorResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{6, 2}
+	return fileDescriptor_9b7906b115009838, []int{8, 2}
 }
 
 // Member represents a group member with an account address,
@@ -293,6 +299,16 @@ type ThresholdDecisionPolicy struct {
 	// timeout is the duration from submission of a proposal to the end of voting period
 	// Within this times votes and exec messages can be submitted.
 	Timeout types.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout"`
+	// quorum is the optional minimum share of the total group weight, as a decimal in (0, 1],
+	// that must have voted for a proposal to succeed, whatever the share of yes votes.
+	Quorum string `protobuf:"bytes,3,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// execution_delay is the optional duration from the acceptance of a proposal until it can be executed,
+	// leaving members time to react before its messages are run.
+	ExecutionDelay types.Duration `protobuf:"bytes,4,opt,name=execution_delay,json=executionDelay,proto3" json:"execution_delay"`
+	// auto_exec, if set, executes the messages of a proposal within the Msg/Vote request
+	// whose vote gets it accepted, once the optional execution delay is over.
+	// The vote is still stored if the execution fails.
+	AutoExec bool `protobuf:"varint,5,opt,name=auto_exec,json=autoExec,proto3" json:"auto_exec,omitempty"`
 }
 
 func (m *ThresholdDecisionPolicy) Reset()         { *m = ThresholdDecisionPolicy{} }
@@ -342,6 +358,213 @@ func (m *ThresholdDecisionPolicy) GetTimeout() types.Duration {
 	return types.Duration{}
 }
 
+func (m *ThresholdDecisionPolicy) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *ThresholdDecisionPolicy) GetExecutionDelay() types.Duration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return types.Duration{}
+}
+
+func (m *ThresholdDecisionPolicy) GetAutoExec() bool {
+	if m != nil {
+		return m.AutoExec
+	}
+	return false
+}
+
+// PercentageDecisionPolicy implements the DecisionPolicy interface
+type PercentageDecisionPolicy struct {
+	// percentage is the minimum share of the total group weight, as a decimal in (0, 1],
+	// that yes votes must reach or exceed for a proposal to succeed.
+	Percentage string `protobuf:"bytes,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// timeout is the duration from submission of a proposal to the end of voting period
+	// Within this times votes and exec messages can be submitted.
+	Timeout types.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout"`
+	// quorum is the optional minimum share of the total group weight, as a decimal in (0, 1],
+	// that must have voted for a proposal to succeed, whatever the share of yes votes.
+	Quorum string `protobuf:"bytes,3,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// execution_delay is the optional duration from the acceptance of a proposal until it can be executed,
+	// leaving members time to react before its messages are run.
+	ExecutionDelay types.Duration `protobuf:"bytes,4,opt,name=execution_delay,json=executionDelay,proto3" json:"execution_delay"`
+	// auto_exec, if set, executes the messages of a proposal within the Msg/Vote request
+	// whose vote gets it accepted, once the optional execution delay is over.
+	// The vote is still stored if the execution fails.
+	AutoExec bool `protobuf:"varint,5,opt,name=auto_exec,json=autoExec,proto3" json:"auto_exec,omitempty"`
+}
+
+func (m *PercentageDecisionPolicy) Reset()         { *m = PercentageDecisionPolicy{} }
+func (m *PercentageDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*PercentageDecisionPolicy) ProtoMessage()    {}
+func (*PercentageDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{3}
+}
+func (m *PercentageDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PercentageDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PercentageDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PercentageDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PercentageDecisionPolicy.Merge(m, src)
+}
+func (m *PercentageDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PercentageDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PercentageDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PercentageDecisionPolicy proto.InternalMessageInfo
+
+func (m *PercentageDecisionPolicy) GetPercentage() string {
+	if m != nil {
+		return m.Percentage
+	}
+	return ""
+}
+
+func (m *PercentageDecisionPolicy) GetTimeout() types.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return types.Duration{}
+}
+
+func (m *PercentageDecisionPolicy) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *PercentageDecisionPolicy) GetExecutionDelay() types.Duration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return types.Duration{}
+}
+
+func (m *PercentageDecisionPolicy) GetAutoExec() bool {
+	if m != nil {
+		return m.AutoExec
+	}
+	return false
+}
+
+// QuorumDecisionPolicy implements the DecisionPolicy interface
+type QuorumDecisionPolicy struct {
+	// quorum is the minimum share of the total group weight, as a decimal in (0, 1],
+	// that must have voted, abstain votes included, for a proposal to succeed.
+	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// threshold is the minimum share of the yes, no and veto votes, as a decimal in (0, 1],
+	// that yes votes must reach or exceed for a proposal to succeed. Abstain votes are not counted.
+	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// veto_threshold is the share of all the votes, as a decimal in (0, 1],
+	// that veto votes must reach or exceed to reject a proposal regardless of the yes votes.
+	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// timeout is the duration from submission of a proposal to the end of voting period
+	// Within this times votes and exec messages can be submitted.
+	Timeout types.Duration `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout"`
+	// execution_delay is the optional duration from the acceptance of a proposal until it can be executed,
+	// leaving members time to react before its messages are run.
+	ExecutionDelay types.Duration `protobuf:"bytes,5,opt,name=execution_delay,json=executionDelay,proto3" json:"execution_delay"`
+	// auto_exec, if set, executes the messages of a proposal within the Msg/Vote request
+	// whose vote gets it accepted, once the optional execution delay is over.
+	// The vote is still stored if the execution fails.
+	AutoExec bool `protobuf:"varint,6,opt,name=auto_exec,json=autoExec,proto3" json:"auto_exec,omitempty"`
+}
+
+func (m *QuorumDecisionPolicy) Reset()         { *m = QuorumDecisionPolicy{} }
+func (m *QuorumDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*QuorumDecisionPolicy) ProtoMessage()    {}
+func (*QuorumDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{4}
+}
+func (m *QuorumDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuorumDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuorumDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuorumDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumDecisionPolicy.Merge(m, src)
+}
+func (m *QuorumDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuorumDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuorumDecisionPolicy proto.InternalMessageInfo
+
+func (m *QuorumDecisionPolicy) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *QuorumDecisionPolicy) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *QuorumDecisionPolicy) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
+func (m *QuorumDecisionPolicy) GetTimeout() types.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return types.Duration{}
+}
+
+func (m *QuorumDecisionPolicy) GetExecutionDelay() types.Duration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return types.Duration{}
+}
+
+func (m *QuorumDecisionPolicy) GetAutoExec() bool {
+	if m != nil {
+		return m.AutoExec
+	}
+	return false
+}
+
 // GroupInfo represents the high-level on-chain information for a group.
 type GroupInfo struct {
 	// group_id is the unique ID of the group.
@@ -357,13 +580,15 @@ type GroupInfo struct {
 	Version uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 	// total_weight is the sum of the group members' weights.
 	TotalWeight string `protobuf:"bytes,5,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
+	// member_count is the number of members of the group.
+	MemberCount uint64 `protobuf:"varint,6,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
 }
 
 func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{3}
+	return fileDescriptor_9b7906b115009838, []int{5}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -427,6 +652,13 @@ func (m *GroupInfo) GetTotalWeight() string {
 	return ""
 }
 
+func (m *GroupInfo) GetMemberCount() uint64 {
+	if m != nil {
+		return m.MemberCount
+	}
+	return 0
+}
+
 // GroupMember represents the relationship between a group and a member.
 type GroupMember struct {
 	// group_id is the unique ID of the group.
@@ -439,7 +671,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{4}
+	return fileDescriptor_9b7906b115009838, []int{6}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,13 +732,23 @@ type GroupAccountInfo struct {
 	// derivation_key is the "derivation" key of the group account,
 	// which is needed to derive the group root module key and execute proposals.
 	DerivationKey []byte `protobuf:"bytes,7,opt,name=derivation_key,json=derivationKey,proto3" json:"derivation_key,omitempty"`
+	// spend_limit is the maximum amount of coins the group account can send by executing proposals
+	// within a spend limit period. The amount spent by a proposal is the decrease of the group account
+	// balance caused by executing its messages. No limit applies when it is empty.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// spend_limit_period is the duration after which the amount spent against the spend limit is reset.
+	SpendLimitPeriod types.Duration `protobuf:"bytes,9,opt,name=spend_limit_period,json=spendLimitPeriod,proto3" json:"spend_limit_period"`
+	// period_start is the timestamp of the start of the current spend limit period.
+	PeriodStart types.Timestamp `protobuf:"bytes,10,opt,name=period_start,json=periodStart,proto3" json:"period_start"`
+	// period_spent is the amount of coins sent by executing proposals within the current spend limit period.
+	PeriodSpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=period_spent,json=periodSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spent"`
 }
 
 func (m *GroupAccountInfo) Reset()         { *m = GroupAccountInfo{} }
 func (m *GroupAccountInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupAccountInfo) ProtoMessage()    {}
 func (*GroupAccountInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{5}
+	return fileDescriptor_9b7906b115009838, []int{7}
 }
 func (m *GroupAccountInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,13 +813,25 @@ type Proposal struct {
 	ExecutorResult Proposal_ExecutorResult `protobuf:"varint,12,opt,name=executor_result,json=executorResult,proto3,enum=regen.group.v1alpha1.Proposal_ExecutorResult" json:"executor_result,omitempty"`
 	// msgs is a list of Msgs that will be executed if the proposal passes.
 	Msgs []*types1.Any `protobuf:"bytes,13,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// executor_log is the log of the last proposal execution. It is empty unless the
+	// executor failed, in which case it names the failing message and the error returned.
+	ExecutorLog string `protobuf:"bytes,14,opt,name=executor_log,json=executorLog,proto3" json:"executor_log,omitempty"`
+	// group_total_weight is the total weight of the group at the time the proposal was submitted.
+	// It is used as the total power when tallying votes.
+	GroupTotalWeight string `protobuf:"bytes,15,opt,name=group_total_weight,json=groupTotalWeight,proto3" json:"group_total_weight,omitempty"`
+	// final_tally_result is the tally the proposal was decided on, along with the total weight tallied against.
+	// It is set once the proposal is accepted or rejected and never updated afterwards.
+	FinalTallyResult *FinalTallyResult `protobuf:"bytes,16,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result,omitempty"`
+	// earliest_execution_time is the time from which an accepted proposal can be executed, that is the time it was
+	// accepted plus the execution delay of the decision policy. It is unset until the proposal is accepted.
+	EarliestExecutionTime *types.Timestamp `protobuf:"bytes,17,opt,name=earliest_execution_time,json=earliestExecutionTime,proto3" json:"earliest_execution_time,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{6}
+	return fileDescriptor_9b7906b115009838, []int{8}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -606,6 +860,61 @@ func (m *Proposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Proposal proto.InternalMessageInfo
 
+// FinalTallyResult is the snapshot of a proposal tally at the time the proposal was decided.
+type FinalTallyResult struct {
+	// tally is the sum of weighted votes at decision time.
+	Tally Tally `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally"`
+	// total_weight is the total group weight the votes were tallied against.
+	TotalWeight string `protobuf:"bytes,2,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
+}
+
+func (m *FinalTallyResult) Reset()         { *m = FinalTallyResult{} }
+func (m *FinalTallyResult) String() string { return proto.CompactTextString(m) }
+func (*FinalTallyResult) ProtoMessage()    {}
+func (*FinalTallyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b7906b115009838, []int{9}
+}
+func (m *FinalTallyResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalTallyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalTallyResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalTallyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalTallyResult.Merge(m, src)
+}
+func (m *FinalTallyResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalTallyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalTallyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalTallyResult proto.InternalMessageInfo
+
+func (m *FinalTallyResult) GetTally() Tally {
+	if m != nil {
+		return m.Tally
+	}
+	return Tally{}
+}
+
+func (m *FinalTallyResult) GetTotalWeight() string {
+	if m != nil {
+		return m.TotalWeight
+	}
+	return ""
+}
+
 // Tally represents the sum of weighted votes.
 type Tally struct {
 	// yes_count is the weighted sum of yes votes.
@@ -622,7 +931,7 @@ func (m *Tally) Reset()         { *m = Tally{} }
 func (m *Tally) String() string { return proto.CompactTextString(m) }
 func (*Tally) ProtoMessage()    {}
 func (*Tally) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{7}
+	return fileDescriptor_9b7906b115009838, []int{10}
 }
 func (m *Tally) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +978,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b7906b115009838, []int{8}
+	return fileDescriptor_9b7906b115009838, []int{11}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -741,10 +1050,13 @@ func init() {
 	proto.RegisterType((*Member)(nil), "regen.group.v1alpha1.Member")
 	proto.RegisterType((*Members)(nil), "regen.group.v1alpha1.Members")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "regen.group.v1alpha1.ThresholdDecisionPolicy")
+	proto.RegisterType((*PercentageDecisionPolicy)(nil), "regen.group.v1alpha1.PercentageDecisionPolicy")
+	proto.RegisterType((*QuorumDecisionPolicy)(nil), "regen.group.v1alpha1.QuorumDecisionPolicy")
 	proto.RegisterType((*GroupInfo)(nil), "regen.group.v1alpha1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "regen.group.v1alpha1.GroupMember")
 	proto.RegisterType((*GroupAccountInfo)(nil), "regen.group.v1alpha1.GroupAccountInfo")
 	proto.RegisterType((*Proposal)(nil), "regen.group.v1alpha1.Proposal")
+	proto.RegisterType((*FinalTallyResult)(nil), "regen.group.v1alpha1.FinalTallyResult")
 	proto.RegisterType((*Tally)(nil), "regen.group.v1alpha1.Tally")
 	proto.RegisterType((*Vote)(nil), "regen.group.v1alpha1.Vote")
 }
@@ -752,88 +1064,113 @@ func init() {
 func init() { proto.RegisterFile("regen/group/v1alpha1/types.proto", fileDescriptor_9b7906b115009838) }
 
 var fileDescriptor_9b7906b115009838 = []byte{
-	// 1282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0xdb, 0xc6,
-	0x12, 0x16, 0x25, 0x59, 0xb2, 0x46, 0xb6, 0x2c, 0xec, 0x73, 0x12, 0x59, 0x76, 0x64, 0x46, 0x41,
-	0x00, 0xe3, 0x3d, 0x58, 0x82, 0xfd, 0xde, 0x3b, 0xd4, 0x68, 0x8a, 0x4a, 0x34, 0x9d, 0xaa, 0x75,
-	0x24, 0x97, 0x94, 0xdc, 0x36, 0x87, 0x0a, 0x14, 0xb9, 0x91, 0xd9, 0x50, 0x5c, 0x81, 0x5c, 0x39,
-	0x51, 0x7f, 0x40, 0x91, 0xea, 0xd4, 0x4b, 0x51, 0xf4, 0x20, 0x20, 0x40, 0xff, 0x42, 0x7f, 0x44,
-	0xd0, 0x53, 0x50, 0xf4, 0x50, 0xf4, 0x50, 0x14, 0xc9, 0xa5, 0x97, 0xfe, 0x87, 0x82, 0xbb, 0x4b,
-	0xdb, 0x8c, 0x65, 0x25, 0x87, 0xde, 0x34, 0x33, 0xdf, 0x37, 0x3b, 0xf3, 0xed, 0x68, 0x96, 0x20,
-	0x7b, 0xb8, 0x8f, 0xdd, 0x6a, 0xdf, 0x23, 0xa3, 0x61, 0xf5, 0x74, 0xc7, 0x70, 0x86, 0x27, 0xc6,
-	0x4e, 0x95, 0x8e, 0x87, 0xd8, 0xaf, 0x0c, 0x3d, 0x42, 0x09, 0x5a, 0x65, 0x88, 0x0a, 0x43, 0x54,
-	0x42, 0x44, 0x71, 0xb5, 0x4f, 0xfa, 0x84, 0x01, 0xaa, 0xc1, 0x2f, 0x8e, 0x2d, 0x96, 0xfa, 0x84,
-	0xf4, 0x1d, 0x5c, 0x65, 0x56, 0x6f, 0xf4, 0xb0, 0x6a, 0x8d, 0x3c, 0x83, 0xda, 0xc4, 0x15, 0xf1,
-	0xcd, 0xd7, 0xe3, 0xd4, 0x1e, 0x60, 0x9f, 0x1a, 0x83, 0xa1, 0x00, 0xac, 0x99, 0xc4, 0x1f, 0x10,
-	0xbf, 0xcb, 0x33, 0x73, 0x23, 0x0c, 0xbd, 0xce, 0x35, 0xdc, 0x31, 0x0f, 0x95, 0x8f, 0x21, 0x75,
-	0x1f, 0x0f, 0x7a, 0xd8, 0x43, 0x05, 0x48, 0x1b, 0x96, 0xe5, 0x61, 0xdf, 0x2f, 0x48, 0xb2, 0xb4,
-	0x95, 0xd1, 0x42, 0x13, 0x5d, 0x87, 0xd4, 0x63, 0x6c, 0xf7, 0x4f, 0x68, 0x21, 0xce, 0x02, 0xc2,
-	0x42, 0x45, 0x58, 0x1c, 0x60, 0x6a, 0x58, 0x06, 0x35, 0x0a, 0x09, 0x59, 0xda, 0x5a, 0xd2, 0xce,
-	0xec, 0xf2, 0x3d, 0x48, 0xf3, 0xbc, 0x3e, 0x7a, 0x17, 0xd2, 0x03, 0xfe, 0xb3, 0x20, 0xc9, 0x89,
-	0xad, 0xec, 0xee, 0x46, 0x65, 0x96, 0x2e, 0x15, 0x8e, 0xaf, 0x27, 0x9f, 0xff, 0xbe, 0x19, 0xd3,
-	0x42, 0x4a, 0xf9, 0x2b, 0x09, 0x6e, 0xb4, 0x4f, 0x3c, 0xec, 0x9f, 0x10, 0xc7, 0xda, 0xc7, 0xa6,
-	0xed, 0xdb, 0xc4, 0x3d, 0x22, 0x8e, 0x6d, 0x8e, 0xd1, 0x06, 0x64, 0x68, 0x18, 0x12, 0x45, 0x9f,
-	0x3b, 0xd0, 0x3b, 0x90, 0x0e, 0x34, 0x22, 0x23, 0x5e, 0x77, 0x76, 0x77, 0xad, 0xc2, 0x75, 0xa8,
-	0x84, 0x3a, 0x54, 0xf6, 0x85, 0xc6, 0xe1, 0xa1, 0x02, 0xbf, 0x87, 0x7e, 0xfe, 0x71, 0x3b, 0x17,
-	0x3d, 0xac, 0xfc, 0xad, 0x04, 0x99, 0x7b, 0x41, 0xc5, 0x0d, 0xf7, 0x21, 0x41, 0x6b, 0xb0, 0xc8,
-	0xca, 0xef, 0xda, 0xfc, 0xe4, 0xa4, 0x96, 0x66, 0x76, 0xc3, 0x42, 0xab, 0xb0, 0x60, 0x58, 0x03,
-	0xdb, 0x15, 0x6a, 0x71, 0x63, 0x9e, 0x58, 0x81, 0xf4, 0xa7, 0xd8, 0x0b, 0xce, 0x2a, 0x24, 0x79,
-	0x2e, 0x61, 0xa2, 0x5b, 0xb0, 0x44, 0x09, 0x35, 0x9c, 0xae, 0xb8, 0x80, 0x05, 0x96, 0x32, 0xcb,
-	0x7c, 0x9f, 0x30, 0x57, 0xf9, 0x73, 0xc8, 0xb2, 0xb2, 0xc4, 0x35, 0xce, 0x29, 0xec, 0x7f, 0x90,
-	0xe2, 0xaa, 0x0a, 0x3d, 0xe6, 0xde, 0x83, 0x26, 0xb0, 0xe5, 0xef, 0xe2, 0x90, 0x67, 0x07, 0xd4,
-	0x4c, 0x93, 0x8c, 0x5c, 0xca, 0xda, 0xbf, 0x7a, 0x58, 0x2e, 0x9e, 0x1f, 0xbf, 0x42, 0x98, 0xc4,
-	0x55, 0xc2, 0x24, 0xaf, 0x16, 0x66, 0x21, 0x2a, 0xcc, 0xc7, 0xb0, 0x62, 0x89, 0xfb, 0xe9, 0x0e,
-	0xd9, 0x05, 0x15, 0x52, 0xac, 0xa9, 0xd5, 0x4b, 0x97, 0x5c, 0x73, 0xc7, 0x75, 0xf4, 0xd3, 0xa5,
-	0x0b, 0xd5, 0x72, 0x56, 0x74, 0x9a, 0xee, 0x40, 0xce, 0xc2, 0x9e, 0x7d, 0xca, 0x26, 0xa2, 0xfb,
-	0x08, 0x8f, 0x0b, 0x69, 0x56, 0xce, 0xf2, 0xb9, 0xf7, 0x23, 0x3c, 0xde, 0x5b, 0x7c, 0xfa, 0x6c,
-	0x33, 0xf6, 0xe7, 0xb3, 0x4d, 0xa9, 0xfc, 0x17, 0xc0, 0xe2, 0x91, 0x47, 0x86, 0xc4, 0x37, 0x1c,
-	0xb4, 0x09, 0xd9, 0xa1, 0xf8, 0x7d, 0x2e, 0x3d, 0x84, 0xae, 0x86, 0x75, 0x51, 0xb2, 0x78, 0x54,
-	0xb2, 0x79, 0xa3, 0xb1, 0x01, 0x19, 0x9e, 0x23, 0xf8, 0xfb, 0x24, 0xe5, 0x44, 0x30, 0xe2, 0x67,
-	0x0e, 0xa4, 0xc0, 0x92, 0x3f, 0xea, 0x0d, 0x6c, 0x4a, 0xb1, 0xd5, 0x35, 0xf8, 0x78, 0x64, 0x77,
-	0x8b, 0x97, 0x24, 0x68, 0x87, 0xbb, 0x42, 0x0c, 0x7a, 0xf6, 0x8c, 0x55, 0xa3, 0xe8, 0x36, 0x2c,
-	0xf3, 0x1b, 0x0b, 0xa5, 0x4e, 0xb1, 0xda, 0x97, 0x98, 0xf3, 0x58, 0xe8, 0xbd, 0x0b, 0xd7, 0x38,
-	0xc8, 0xe0, 0x53, 0x70, 0x06, 0x4e, 0x33, 0xf0, 0xbf, 0xfa, 0x17, 0x26, 0x24, 0xe4, 0xdc, 0x85,
-	0x94, 0x4f, 0x0d, 0x3a, 0xf2, 0x0b, 0x8b, 0xb2, 0xb4, 0x95, 0xdb, 0xbd, 0x33, 0x7b, 0xde, 0x42,
-	0x09, 0x2b, 0x3a, 0x03, 0x6b, 0x82, 0x14, 0xd0, 0x3d, 0xec, 0x8f, 0x1c, 0x5a, 0xc8, 0xbc, 0x15,
-	0x5d, 0x63, 0x60, 0x4d, 0x90, 0xd0, 0xfb, 0x00, 0xa7, 0x84, 0xe2, 0x6e, 0x90, 0x0d, 0x17, 0x80,
-	0x29, 0xb3, 0x3e, 0x3b, 0x45, 0xdb, 0x70, 0x9c, 0xb1, 0x90, 0x26, 0x13, 0x90, 0x82, 0x4a, 0x30,
-	0xda, 0x3b, 0x5f, 0x20, 0xd9, 0xb7, 0x14, 0x36, 0x24, 0xa0, 0x63, 0x58, 0xc1, 0x4f, 0xb0, 0x39,
-	0xa2, 0xc4, 0xeb, 0x8a, 0x2e, 0x96, 0x58, 0x17, 0xdb, 0x6f, 0xe8, 0x42, 0x15, 0x2c, 0xd1, 0x4d,
-	0x0e, 0x47, 0x6c, 0xb4, 0x05, 0xc9, 0x81, 0xdf, 0xf7, 0x0b, 0xcb, 0x6c, 0x93, 0xce, 0x1c, 0x76,
-	0x8d, 0x21, 0xca, 0x2f, 0x24, 0x48, 0x71, 0x45, 0xd1, 0x0e, 0x20, 0xbd, 0x5d, 0x6b, 0x77, 0xf4,
-	0x6e, 0xa7, 0xa9, 0x1f, 0xa9, 0x4a, 0xe3, 0xa0, 0xa1, 0xee, 0xe7, 0x63, 0xc5, 0xb5, 0xc9, 0x54,
-	0xbe, 0x16, 0x9e, 0xcc, 0xb1, 0x0d, 0xf7, 0xd4, 0x70, 0x6c, 0x0b, 0xed, 0x40, 0x5e, 0x50, 0xf4,
-	0x4e, 0xfd, 0x7e, 0xa3, 0xdd, 0x56, 0xf7, 0xf3, 0x52, 0x71, 0x7d, 0x32, 0x95, 0x6f, 0x44, 0x09,
-	0x7a, 0x38, 0x49, 0xe8, 0x3f, 0xb0, 0x2c, 0x28, 0xca, 0x61, 0x4b, 0x57, 0xf7, 0xf3, 0xf1, 0x62,
-	0x61, 0x32, 0x95, 0x57, 0xa3, 0x78, 0xc5, 0x21, 0x3e, 0xb6, 0xd0, 0x36, 0xe4, 0x04, 0xb8, 0x56,
-	0x6f, 0x69, 0x41, 0xf6, 0xc4, 0xac, 0x72, 0x6a, 0x3d, 0xe2, 0x51, 0x6c, 0x15, 0x93, 0x4f, 0x7f,
-	0x28, 0xc5, 0xca, 0xbf, 0x49, 0x90, 0x12, 0x3a, 0xec, 0x00, 0xd2, 0x54, 0xbd, 0x73, 0xd8, 0x9e,
-	0xd7, 0x12, 0xc7, 0x86, 0x2d, 0xfd, 0xff, 0x02, 0xe5, 0xa0, 0xd1, 0xac, 0x1d, 0x36, 0x1e, 0xb0,
-	0xa6, 0x6e, 0x4e, 0xa6, 0xf2, 0x5a, 0x94, 0xd2, 0x71, 0x1f, 0xda, 0xae, 0xe1, 0xd8, 0x5f, 0x62,
-	0x0b, 0x55, 0x61, 0x45, 0xd0, 0x6a, 0x8a, 0xa2, 0x1e, 0xb5, 0x59, 0x63, 0xc5, 0xc9, 0x54, 0xbe,
-	0x1e, 0xe5, 0xd4, 0x4c, 0x13, 0x0f, 0x69, 0x84, 0xa0, 0xa9, 0x1f, 0xaa, 0x0a, 0xef, 0x6d, 0x06,
-	0x41, 0xc3, 0x5f, 0x60, 0xf3, 0xbc, 0xb9, 0xef, 0xe3, 0x90, 0x8b, 0x5e, 0x3e, 0xaa, 0xc3, 0xba,
-	0xfa, 0xa9, 0xaa, 0x74, 0xda, 0x2d, 0xad, 0x3b, 0xb3, 0xdb, 0x5b, 0x93, 0xa9, 0x7c, 0x33, 0xcc,
-	0x1a, 0x25, 0x87, 0x5d, 0xdf, 0x85, 0x1b, 0xaf, 0xe7, 0x68, 0xb6, 0xda, 0x5d, 0xad, 0xd3, 0xcc,
-	0x4b, 0x45, 0x79, 0x32, 0x95, 0x37, 0x66, 0xf3, 0x9b, 0x84, 0x6a, 0x23, 0x17, 0xbd, 0x77, 0x99,
-	0xae, 0x77, 0x14, 0x45, 0xd5, 0xf5, 0x7c, 0x7c, 0xde, 0xf1, 0xfa, 0xc8, 0x34, 0x83, 0xdd, 0x36,
-	0x83, 0x7f, 0x50, 0x6b, 0x1c, 0x76, 0x34, 0x35, 0x9f, 0x98, 0xc7, 0x3f, 0x30, 0x6c, 0x67, 0xe4,
-	0x61, 0xae, 0xcd, 0x5e, 0x32, 0xd8, 0xb9, 0xe5, 0xaf, 0x25, 0x58, 0x60, 0x7f, 0x55, 0xb4, 0x0e,
-	0x99, 0x31, 0xf6, 0xbb, 0x6c, 0xdb, 0x88, 0x07, 0x68, 0x71, 0x8c, 0x7d, 0x25, 0xb0, 0x83, 0x17,
-	0xc8, 0x25, 0x22, 0x26, 0x36, 0xad, 0x4b, 0x78, 0xe8, 0x36, 0x2c, 0x1b, 0x3d, 0x9f, 0x1a, 0xb6,
-	0x2b, 0xe2, 0xfc, 0x25, 0x5a, 0x12, 0x4e, 0x0e, 0xba, 0x09, 0x70, 0x8a, 0x69, 0x98, 0x21, 0xc9,
-	0x3f, 0x2b, 0x02, 0x0f, 0x0b, 0x8b, 0x5a, 0x7e, 0x91, 0x20, 0x79, 0x4c, 0x28, 0x7e, 0xf3, 0xde,
-	0x5f, 0x85, 0x85, 0x60, 0xa5, 0x78, 0xe1, 0xe7, 0x00, 0x33, 0x82, 0xb7, 0xd8, 0x3c, 0x21, 0xb6,
-	0x89, 0x59, 0x09, 0xb9, 0xab, 0xde, 0x62, 0x85, 0x61, 0x34, 0x81, 0x9d, 0xfb, 0x56, 0xfe, 0x13,
-	0x6f, 0xc1, 0xbf, 0x2d, 0x48, 0xf1, 0x23, 0xd1, 0x75, 0x40, 0xca, 0x07, 0xad, 0x86, 0xa2, 0x46,
-	0x47, 0x0e, 0x2d, 0x43, 0x46, 0xf8, 0x9b, 0xad, 0xbc, 0x84, 0x72, 0x00, 0xc2, 0xfc, 0x4c, 0xd5,
-	0xf3, 0x71, 0x84, 0x20, 0x27, 0xec, 0x5a, 0x5d, 0x6f, 0xd7, 0x1a, 0xcd, 0x7c, 0x02, 0xad, 0x40,
-	0x56, 0xf8, 0x8e, 0xd5, 0x76, 0x2b, 0x9f, 0xac, 0xdf, 0x7b, 0xfe, 0xb2, 0x24, 0xbd, 0x78, 0x59,
-	0x92, 0xfe, 0x78, 0x59, 0x92, 0xbe, 0x79, 0x55, 0x8a, 0xbd, 0x78, 0x55, 0x8a, 0xfd, 0xfa, 0xaa,
-	0x14, 0x7b, 0xb0, 0xdd, 0xb7, 0xe9, 0xc9, 0xa8, 0x57, 0x31, 0xc9, 0xa0, 0xca, 0x04, 0xd9, 0x76,
-	0x31, 0x7d, 0x4c, 0xbc, 0x47, 0xc2, 0x72, 0xb0, 0xd5, 0xc7, 0x5e, 0xf5, 0x09, 0xff, 0xea, 0xee,
-	0xa5, 0x58, 0x57, 0xff, 0xfd, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xfa, 0xcf, 0x82, 0x8b, 0x0b,
-	0x00, 0x00,
+	// 1690 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0x1b, 0x5b,
+	0x15, 0xce, 0x38, 0xb6, 0x63, 0x1f, 0x27, 0xce, 0x70, 0x49, 0xdb, 0x89, 0xdb, 0x3a, 0x7e, 0x7e,
+	0x2a, 0x8a, 0x80, 0xd8, 0x24, 0x80, 0x10, 0x15, 0x0f, 0xe1, 0x38, 0x93, 0xd6, 0x90, 0x26, 0x79,
+	0xe3, 0x71, 0x0a, 0x6f, 0xc1, 0x68, 0x3c, 0x73, 0xe3, 0x0c, 0x1d, 0xcf, 0x35, 0x33, 0x77, 0xd2,
+	0x9a, 0x2d, 0x42, 0xea, 0xcb, 0x8a, 0x2d, 0x42, 0x91, 0x9e, 0xc4, 0x0a, 0xd6, 0x2c, 0xf8, 0x13,
+	0x9e, 0x58, 0x3d, 0x21, 0x16, 0x88, 0x05, 0xa0, 0x76, 0xc3, 0x9f, 0x81, 0xee, 0x8f, 0x89, 0x3d,
+	0x89, 0x93, 0x06, 0xa9, 0xab, 0xb7, 0xaa, 0xef, 0xb9, 0xdf, 0x77, 0xee, 0x39, 0xdf, 0x9c, 0x73,
+	0xcf, 0x6d, 0xa0, 0x16, 0xe2, 0x01, 0x0e, 0x9a, 0x83, 0x90, 0xc4, 0xa3, 0xe6, 0xe9, 0xa6, 0xed,
+	0x8f, 0x4e, 0xec, 0xcd, 0x26, 0x1d, 0x8f, 0x70, 0xd4, 0x18, 0x85, 0x84, 0x12, 0xb4, 0xc2, 0x11,
+	0x0d, 0x8e, 0x68, 0x24, 0x88, 0xca, 0xca, 0x80, 0x0c, 0x08, 0x07, 0x34, 0xd9, 0x2f, 0x81, 0xad,
+	0x54, 0x07, 0x84, 0x0c, 0x7c, 0xdc, 0xe4, 0xab, 0x7e, 0x7c, 0xdc, 0x74, 0xe3, 0xd0, 0xa6, 0x1e,
+	0x09, 0xe4, 0xfe, 0xda, 0xe5, 0x7d, 0xea, 0x0d, 0x71, 0x44, 0xed, 0xe1, 0x48, 0x02, 0x56, 0x1d,
+	0x12, 0x0d, 0x49, 0x64, 0x09, 0xcf, 0x62, 0x91, 0x6c, 0x5d, 0xe6, 0xda, 0xc1, 0x38, 0x39, 0x56,
+	0x00, 0x9b, 0x7d, 0x3b, 0xc2, 0xcd, 0xd3, 0xcd, 0x3e, 0xa6, 0xf6, 0x66, 0xd3, 0x21, 0x9e, 0x3c,
+	0xb6, 0x7e, 0x04, 0xf9, 0x67, 0x78, 0xd8, 0xc7, 0x21, 0xd2, 0x60, 0xc1, 0x76, 0xdd, 0x10, 0x47,
+	0x91, 0xa6, 0xd4, 0x94, 0xf5, 0xa2, 0x91, 0x2c, 0xd1, 0x5d, 0xc8, 0xbf, 0xc4, 0xde, 0xe0, 0x84,
+	0x6a, 0x19, 0xbe, 0x21, 0x57, 0xa8, 0x02, 0x85, 0x21, 0xa6, 0xb6, 0x6b, 0x53, 0x5b, 0x9b, 0xaf,
+	0x29, 0xeb, 0x8b, 0xc6, 0xc5, 0xba, 0xfe, 0x04, 0x16, 0x84, 0xdf, 0x08, 0xfd, 0x00, 0x16, 0x86,
+	0xe2, 0xa7, 0xa6, 0xd4, 0xe6, 0xd7, 0x4b, 0x5b, 0x0f, 0x1a, 0xb3, 0x74, 0x6b, 0x08, 0xfc, 0x76,
+	0xf6, 0xf3, 0x7f, 0xad, 0xcd, 0x19, 0x09, 0xa5, 0xfe, 0x9b, 0x0c, 0xdc, 0x33, 0x4f, 0x42, 0x1c,
+	0x9d, 0x10, 0xdf, 0xdd, 0xc1, 0x8e, 0x17, 0x79, 0x24, 0x38, 0x24, 0xbe, 0xe7, 0x8c, 0xd1, 0x03,
+	0x28, 0xd2, 0x64, 0x4b, 0x06, 0x3d, 0x31, 0xa0, 0xef, 0xc3, 0x02, 0xd3, 0x90, 0xc4, 0x22, 0xee,
+	0xd2, 0xd6, 0x6a, 0x43, 0xe8, 0xd4, 0x48, 0x74, 0x6a, 0xec, 0xc8, 0x6f, 0x90, 0x1c, 0x2a, 0xf1,
+	0x2c, 0xe3, 0x5f, 0xc6, 0x24, 0x8c, 0x87, 0x3c, 0xaf, 0xa2, 0x21, 0x57, 0xe8, 0x29, 0x2c, 0xe3,
+	0x57, 0xd8, 0x89, 0x19, 0xc7, 0x72, 0xb1, 0x6f, 0x8f, 0xb5, 0xec, 0xed, 0x5c, 0x97, 0x2f, 0x78,
+	0x3b, 0x8c, 0x86, 0xee, 0x43, 0xd1, 0x8e, 0x29, 0xb1, 0x98, 0x59, 0xcb, 0xd5, 0x94, 0xf5, 0x82,
+	0x51, 0x60, 0x06, 0xfd, 0x15, 0x76, 0x1e, 0xa3, 0xbf, 0xfd, 0x79, 0xa3, 0x9c, 0xce, 0xb5, 0xfe,
+	0x3a, 0x03, 0xda, 0x21, 0x0e, 0x1d, 0x1c, 0x50, 0x7b, 0x80, 0x2f, 0x09, 0x51, 0x05, 0x18, 0x5d,
+	0xec, 0x49, 0x25, 0xa6, 0x2c, 0x5f, 0x32, 0x29, 0xfe, 0x98, 0x81, 0x95, 0x8f, 0x79, 0x14, 0x97,
+	0x64, 0x98, 0xc4, 0xaa, 0xa4, 0x62, 0x4d, 0xd5, 0x49, 0xe6, 0x72, 0x9d, 0x3c, 0x82, 0xf2, 0x29,
+	0xa6, 0xc4, 0x9a, 0x40, 0x44, 0xa6, 0x4b, 0xcc, 0x6a, 0xce, 0x2a, 0xa7, 0xec, 0xff, 0xa9, 0xe1,
+	0x0c, 0xad, 0x72, 0xef, 0x41, 0xab, 0xfc, 0x2d, 0xb4, 0xfa, 0x8b, 0x02, 0xc5, 0x27, 0xac, 0xcf,
+	0x3a, 0xc1, 0x31, 0x41, 0xab, 0x50, 0xe0, 0x4d, 0x67, 0x79, 0xa2, 0x5f, 0xb2, 0xc6, 0x02, 0x5f,
+	0x77, 0x5c, 0xb4, 0x02, 0x39, 0xdb, 0x1d, 0x7a, 0x81, 0xd4, 0x47, 0x2c, 0x6e, 0x6a, 0x71, 0x76,
+	0x61, 0x9c, 0xe2, 0x90, 0x9d, 0xc5, 0x05, 0xc9, 0x1a, 0xc9, 0x12, 0x7d, 0x00, 0x8b, 0x94, 0x50,
+	0xdb, 0xb7, 0xe4, 0xb5, 0x91, 0xe3, 0x2e, 0x4b, 0xdc, 0xf6, 0x9c, 0x9b, 0x18, 0x44, 0x74, 0xb8,
+	0xe5, 0x90, 0x38, 0xa0, 0x3c, 0x97, 0xac, 0x51, 0x12, 0xb6, 0x36, 0x33, 0xd5, 0x7f, 0x0e, 0x25,
+	0x1e, 0xb9, 0xbc, 0x9f, 0x6e, 0x88, 0xfd, 0x3b, 0x90, 0x17, 0x44, 0x59, 0xdd, 0x37, 0x5e, 0x30,
+	0x86, 0xc4, 0xd6, 0x3f, 0xcd, 0x81, 0xca, 0x0f, 0x68, 0x39, 0x3c, 0x06, 0xae, 0xd0, 0xf5, 0xb7,
+	0xe0, 0xf4, 0xf9, 0x99, 0x6b, 0xb4, 0x9b, 0xbf, 0x4e, 0xbb, 0xec, 0xf5, 0xda, 0xe5, 0xd2, 0xda,
+	0x7d, 0x0c, 0xcb, 0xae, 0xfc, 0x84, 0xd6, 0x88, 0x7f, 0x43, 0xae, 0x4d, 0x69, 0x6b, 0xe5, 0x4a,
+	0xad, 0xb4, 0x82, 0xf1, 0x36, 0xfa, 0xeb, 0x95, 0x6f, 0x6e, 0x94, 0xdd, 0x74, 0x5b, 0x3c, 0x82,
+	0xb2, 0x8b, 0x43, 0xef, 0x94, 0x17, 0x96, 0xf5, 0x02, 0x8f, 0xb5, 0x05, 0x1e, 0xce, 0xd2, 0xc4,
+	0xfa, 0x13, 0x3c, 0x46, 0x3e, 0x94, 0xa2, 0x11, 0x0e, 0x5c, 0xcb, 0xf7, 0x86, 0x1e, 0xd5, 0x0a,
+	0xfc, 0xae, 0x5e, 0x6d, 0xc8, 0x49, 0xc3, 0x06, 0x48, 0x43, 0x0e, 0x90, 0x46, 0x9b, 0x78, 0xc1,
+	0xf6, 0xb7, 0x58, 0x85, 0xfe, 0xe9, 0xdf, 0x6b, 0xeb, 0x03, 0x8f, 0x9e, 0xc4, 0xfd, 0x86, 0x43,
+	0x86, 0x72, 0x2c, 0xc9, 0x7f, 0x36, 0x22, 0xf7, 0x85, 0x9c, 0x97, 0x8c, 0x10, 0x19, 0xc0, 0xfd,
+	0xef, 0x31, 0xf7, 0xe8, 0x19, 0xa0, 0xa9, 0xd3, 0xac, 0x11, 0x0e, 0x3d, 0xe2, 0x6a, 0xc5, 0xdb,
+	0xb5, 0x85, 0x3a, 0x71, 0x74, 0xc8, 0x89, 0xa8, 0x0d, 0x8b, 0xc2, 0x85, 0x15, 0x51, 0x3b, 0xa4,
+	0x1a, 0x70, 0x47, 0x95, 0x2b, 0x8e, 0xcc, 0x64, 0xaa, 0x4a, 0x4f, 0x25, 0xc1, 0xea, 0x32, 0x12,
+	0x0a, 0x26, 0x4e, 0x46, 0x38, 0xa0, 0x5a, 0xe9, 0xfd, 0x4b, 0x90, 0x9c, 0xc7, 0xfc, 0x3f, 0x2e,
+	0xbc, 0xfe, 0x6c, 0x6d, 0xee, 0xbf, 0x9f, 0xad, 0x29, 0xf5, 0xdf, 0x2f, 0x41, 0xe1, 0x30, 0x24,
+	0x23, 0x12, 0xd9, 0x3e, 0x5a, 0x83, 0xd2, 0x48, 0xfe, 0x9e, 0x14, 0x3b, 0x24, 0xa6, 0x8e, 0x3b,
+	0x5d, 0xa4, 0x99, 0x74, 0x91, 0xde, 0xd4, 0xaf, 0x0f, 0xa0, 0x28, 0x7c, 0xb0, 0x49, 0x9c, 0xad,
+	0xcd, 0xb3, 0x5b, 0xf0, 0xc2, 0xc0, 0x04, 0x8c, 0xe2, 0xfe, 0xd0, 0xa3, 0x14, 0xbb, 0x96, 0x4d,
+	0xb5, 0xdc, 0x6d, 0x05, 0xbc, 0x60, 0xb5, 0x28, 0xfa, 0x10, 0x96, 0x44, 0x8f, 0x24, 0xc5, 0x2d,
+	0xda, 0x7a, 0x91, 0x1b, 0x8f, 0x64, 0x85, 0x6f, 0xc1, 0x1d, 0x01, 0xb2, 0x45, 0xdf, 0x5d, 0x80,
+	0x17, 0x38, 0xf8, 0xab, 0x83, 0xa9, 0x9e, 0x4c, 0x38, 0x1f, 0x41, 0x3e, 0xa2, 0x36, 0x8d, 0x23,
+	0xad, 0x50, 0x53, 0xd6, 0xcb, 0x5b, 0x8f, 0x66, 0x77, 0x78, 0x22, 0x61, 0xa3, 0xcb, 0xc1, 0x86,
+	0x24, 0x31, 0x7a, 0x88, 0xa3, 0xd8, 0xa7, 0x5a, 0xf1, 0x56, 0x74, 0x83, 0x83, 0x0d, 0x49, 0x42,
+	0x3f, 0x02, 0x38, 0x25, 0x14, 0xb3, 0xd2, 0xa2, 0x58, 0x96, 0xd6, 0xfd, 0xd9, 0x2e, 0x4c, 0xdb,
+	0xf7, 0xc7, 0x52, 0x9a, 0x22, 0x23, 0xb1, 0x48, 0x30, 0x7a, 0x3c, 0x19, 0x1e, 0xa5, 0x5b, 0x0a,
+	0x7b, 0x31, 0x3d, 0x8e, 0x92, 0xe9, 0x41, 0x42, 0x4b, 0x66, 0xb1, 0xc8, 0xb3, 0xd8, 0x78, 0x47,
+	0x16, 0xba, 0x64, 0xc9, 0x6c, 0xca, 0x38, 0xb5, 0x46, 0xeb, 0x90, 0x1d, 0x46, 0x83, 0x48, 0x5b,
+	0xaa, 0xcd, 0x5f, 0x77, 0xbd, 0x18, 0x1c, 0xc1, 0x2e, 0xeb, 0x8b, 0x08, 0x7c, 0x32, 0xd0, 0xca,
+	0xe2, 0x3e, 0x4f, 0x6c, 0x7b, 0x64, 0x80, 0xbe, 0x09, 0x48, 0x7c, 0xd4, 0xd4, 0xc5, 0xbf, 0xcc,
+	0x81, 0x2a, 0xdf, 0x31, 0xa7, 0x6e, 0x7f, 0x13, 0xd0, 0xb1, 0x17, 0xd8, 0xbe, 0x45, 0x99, 0x5c,
+	0x49, 0x56, 0x2a, 0x57, 0xe6, 0x6b, 0xb3, 0xb3, 0xda, 0x65, 0x78, 0xae, 0xae, 0x4c, 0x47, 0x3d,
+	0xbe, 0x64, 0x41, 0x06, 0xdc, 0xc3, 0x76, 0xe8, 0x7b, 0x38, 0xa2, 0xd6, 0x64, 0xde, 0x32, 0x19,
+	0xb5, 0xaf, 0xbc, 0x4b, 0x74, 0xe3, 0x4e, 0x42, 0xd5, 0x13, 0x26, 0xdb, 0xab, 0xff, 0x3a, 0x03,
+	0x79, 0x51, 0x4c, 0x68, 0x13, 0x50, 0xd7, 0x6c, 0x99, 0xbd, 0xae, 0xd5, 0xdb, 0xef, 0x1e, 0xea,
+	0xed, 0xce, 0x6e, 0x47, 0xdf, 0x51, 0xe7, 0x2a, 0xab, 0x67, 0xe7, 0xb5, 0x3b, 0x89, 0xe8, 0x02,
+	0xdb, 0x09, 0x4e, 0x6d, 0xdf, 0x73, 0xd1, 0x26, 0xa8, 0x92, 0xd2, 0xed, 0x6d, 0x3f, 0xeb, 0x98,
+	0xa6, 0xbe, 0xa3, 0x2a, 0x95, 0xfb, 0x67, 0xe7, 0xb5, 0x7b, 0x69, 0x42, 0x37, 0x69, 0x22, 0xf4,
+	0x0d, 0x58, 0x92, 0x94, 0xf6, 0xde, 0x41, 0x57, 0xdf, 0x51, 0x33, 0x15, 0xed, 0xec, 0xbc, 0xb6,
+	0x92, 0xc6, 0xb7, 0x7d, 0x12, 0x61, 0x17, 0x6d, 0x40, 0x59, 0x82, 0x5b, 0xdb, 0x07, 0x06, 0xf3,
+	0x3e, 0x3f, 0x2b, 0x9c, 0x56, 0x9f, 0x84, 0x14, 0x4f, 0x87, 0xf3, 0xbc, 0x63, 0x3e, 0xdd, 0x31,
+	0x5a, 0xcf, 0xf7, 0xd5, 0xec, 0xac, 0x70, 0x9e, 0x7b, 0xf4, 0xc4, 0x0d, 0xed, 0x97, 0x41, 0x25,
+	0xfb, 0xfa, 0x0f, 0xd5, 0xb9, 0xfa, 0x3f, 0x15, 0xc8, 0x4b, 0x91, 0x37, 0x01, 0x19, 0x7a, 0xb7,
+	0xb7, 0x67, 0xde, 0xa4, 0x82, 0xc0, 0x26, 0x2a, 0x7c, 0x77, 0x8a, 0xb2, 0xdb, 0xd9, 0x6f, 0xed,
+	0x75, 0x3e, 0xe1, 0x3a, 0x3c, 0x3c, 0x3b, 0xaf, 0xad, 0xa6, 0x29, 0xbd, 0x80, 0x7f, 0x55, 0xef,
+	0x57, 0xd8, 0x45, 0x4d, 0x58, 0x96, 0xb4, 0x56, 0xbb, 0xad, 0x1f, 0x9a, 0x5c, 0x8b, 0xca, 0xd9,
+	0x79, 0xed, 0x6e, 0x9a, 0xd3, 0x72, 0x1c, 0x3c, 0xa2, 0x29, 0x82, 0xa1, 0xff, 0x58, 0x6f, 0x0b,
+	0x39, 0x66, 0x10, 0x0c, 0xfc, 0x0b, 0xec, 0x50, 0xec, 0xca, 0xe4, 0x7e, 0x97, 0x81, 0x72, 0xba,
+	0x55, 0xd0, 0x36, 0xdc, 0xd7, 0x7f, 0xaa, 0xb7, 0x7b, 0xe6, 0x81, 0x61, 0xcd, 0xcc, 0xf6, 0x83,
+	0xb3, 0xf3, 0xda, 0xc3, 0xc4, 0x6b, 0x9a, 0x9c, 0x64, 0xfd, 0x11, 0xdc, 0xbb, 0xec, 0x63, 0xff,
+	0xc0, 0xb4, 0x8c, 0xde, 0xbe, 0xaa, 0x54, 0x6a, 0x67, 0xe7, 0xb5, 0x07, 0xb3, 0xf9, 0xfb, 0x84,
+	0x1a, 0x71, 0x80, 0x7e, 0x78, 0x95, 0xde, 0xed, 0xb5, 0xdb, 0x7a, 0xb7, 0xab, 0x66, 0x6e, 0x3a,
+	0xbe, 0x1b, 0x3b, 0x0e, 0x9b, 0x04, 0x33, 0xf8, 0xbb, 0xad, 0xce, 0x5e, 0xcf, 0xd0, 0xd5, 0xf9,
+	0x9b, 0xf8, 0xbb, 0xb6, 0xe7, 0xc7, 0x21, 0x16, 0xda, 0x3c, 0xce, 0xb2, 0x09, 0x55, 0x0f, 0x40,
+	0xbd, 0xdc, 0x7e, 0xe8, 0x7b, 0x90, 0xe3, 0xcd, 0xab, 0x29, 0xb7, 0xbd, 0x0e, 0x05, 0xfe, 0xca,
+	0xe3, 0x30, 0x73, 0xe5, 0x71, 0x58, 0xff, 0x54, 0x81, 0x1c, 0x67, 0xb2, 0xf7, 0xee, 0x18, 0x47,
+	0xf2, 0x8d, 0x28, 0x1e, 0x64, 0x85, 0x31, 0x8e, 0xf8, 0x03, 0x91, 0xbd, 0xc8, 0x02, 0x22, 0xf7,
+	0xe4, 0x1c, 0x0c, 0x88, 0xd8, 0xfa, 0x10, 0x96, 0xec, 0x7e, 0x44, 0x6d, 0x2f, 0x90, 0xfb, 0xe2,
+	0x65, 0xb6, 0x28, 0x8d, 0x02, 0xf4, 0x10, 0x80, 0x3f, 0xfc, 0x05, 0x22, 0x2b, 0xfe, 0x5f, 0xc0,
+	0x2c, 0x7c, 0x5b, 0xe6, 0xfe, 0x77, 0x05, 0xb2, 0x47, 0x84, 0xe2, 0x77, 0x4f, 0xe5, 0x15, 0xc8,
+	0xb1, 0x0b, 0x3f, 0x4c, 0x5e, 0xd0, 0x7c, 0xc1, 0xde, 0xa6, 0xce, 0x09, 0xf1, 0x1c, 0xcc, 0x43,
+	0x28, 0x5f, 0xf7, 0x36, 0x6d, 0x73, 0x8c, 0x21, 0xb1, 0x37, 0xbe, 0x1d, 0xdf, 0xc7, 0xa4, 0xfe,
+	0xba, 0x0b, 0x79, 0x71, 0x24, 0xba, 0x0b, 0xa8, 0xfd, 0xf4, 0xa0, 0xd3, 0xd6, 0xd3, 0x25, 0x8e,
+	0x96, 0xa0, 0x28, 0xed, 0xfb, 0x07, 0xaa, 0x82, 0xca, 0x00, 0x72, 0xf9, 0x33, 0xbd, 0xab, 0x66,
+	0x10, 0x82, 0xb2, 0x5c, 0xb7, 0xb6, 0xbb, 0x66, 0xab, 0xb3, 0xaf, 0xce, 0xa3, 0x65, 0x28, 0x49,
+	0xdb, 0x91, 0x6e, 0x1e, 0xa8, 0xd9, 0xed, 0x27, 0x9f, 0xbf, 0xa9, 0x2a, 0x5f, 0xbc, 0xa9, 0x2a,
+	0xff, 0x79, 0x53, 0x55, 0x7e, 0xfb, 0xb6, 0x3a, 0xf7, 0xc5, 0xdb, 0xea, 0xdc, 0x3f, 0xde, 0x56,
+	0xe7, 0x3e, 0xd9, 0x98, 0x7a, 0x31, 0x71, 0x41, 0x36, 0x02, 0x4c, 0x5f, 0x92, 0xf0, 0x85, 0x5c,
+	0xf9, 0xd8, 0x1d, 0xe0, 0xb0, 0xf9, 0x4a, 0xfc, 0xf9, 0xa5, 0x9f, 0xe7, 0x59, 0x7d, 0xfb, 0x7f,
+	0x03, 0x00, 0xeb, 0xea, 0x34, 0xab, 0x94, 0x11, 0x00, 0x00,
 }
 
 func (this *GroupAccountInfo) Equal(that interface{}) bool {
@@ -876,6 +1213,28 @@ func (this *GroupAccountInfo) Equal(that interface{}) bool {
 	if !bytes.Equal(this.DerivationKey, that1.DerivationKey) {
 		return false
 	}
+	if len(this.SpendLimit) != len(that1.SpendLimit) {
+		return false
+	}
+	for i := range this.SpendLimit {
+		if !this.SpendLimit[i].Equal(&that1.SpendLimit[i]) {
+			return false
+		}
+	}
+	if !this.SpendLimitPeriod.Equal(&that1.SpendLimitPeriod) {
+		return false
+	}
+	if !this.PeriodStart.Equal(&that1.PeriodStart) {
+		return false
+	}
+	if len(this.PeriodSpent) != len(that1.PeriodSpent) {
+		return false
+	}
+	for i := range this.PeriodSpent {
+		if !this.PeriodSpent[i].Equal(&that1.PeriodSpent[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *Member) Marshal() (dAtA []byte, err error) {
@@ -979,6 +1338,33 @@ func (m *ThresholdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.AutoExec {
+		i--
+		if m.AutoExec {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.ExecutionDelay.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -999,7 +1385,7 @@ func (m *ThresholdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
+func (m *PercentageDecisionPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1009,51 +1395,64 @@ func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *PercentageDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TotalWeight) > 0 {
-		i -= len(m.TotalWeight)
-		copy(dAtA[i:], m.TotalWeight)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalWeight)))
+	if m.AutoExec {
 		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Version != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		if m.AutoExec {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x28
 	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
+	{
+		size, err := m.ExecutionDelay.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Quorum)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Percentage) > 0 {
+		i -= len(m.Percentage)
+		copy(dAtA[i:], m.Percentage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Percentage)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupMember) Marshal() (dAtA []byte, err error) {
+func (m *QuorumDecisionPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1063,27 +1462,160 @@ func (m *GroupMember) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupMember) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuorumDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuorumDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Member != nil {
-		{
-			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	if m.AutoExec {
+		i--
+		if m.AutoExec {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.ExecutionDelay.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MemberCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MemberCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TotalWeight) > 0 {
+		i -= len(m.TotalWeight)
+		copy(dAtA[i:], m.TotalWeight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalWeight)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Member != nil {
+		{
+			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.GroupId != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
@@ -1113,6 +1645,54 @@ func (m *GroupAccountInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PeriodSpent) > 0 {
+		for iNdEx := len(m.PeriodSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.PeriodStart.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.SpendLimitPeriod.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.DerivationKey) > 0 {
 		i -= len(m.DerivationKey)
 		copy(dAtA[i:], m.DerivationKey)
@@ -1186,6 +1766,48 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EarliestExecutionTime != nil {
+		{
+			size, err := m.EarliestExecutionTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.FinalTallyResult != nil {
+		{
+			size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.GroupTotalWeight) > 0 {
+		i -= len(m.GroupTotalWeight)
+		copy(dAtA[i:], m.GroupTotalWeight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupTotalWeight)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ExecutorLog) > 0 {
+		i -= len(m.ExecutorLog)
+		copy(dAtA[i:], m.ExecutorLog)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExecutorLog)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.Msgs) > 0 {
 		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1286,6 +1908,46 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FinalTallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalTallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalTallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalWeight) > 0 {
+		i -= len(m.TotalWeight)
+		copy(dAtA[i:], m.TotalWeight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalWeight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Tally) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1453,37 +2115,101 @@ func (m *ThresholdDecisionPolicy) Size() (n int) {
 	}
 	l = m.Timeout.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.ExecutionDelay.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.AutoExec {
+		n += 2
+	}
 	return n
 }
 
-func (m *GroupInfo) Size() (n int) {
+func (m *PercentageDecisionPolicy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovTypes(uint64(m.GroupId))
+	l = len(m.Percentage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.Admin)
+	l = m.Timeout.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Quorum)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.Metadata)
+	l = m.ExecutionDelay.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.AutoExec {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuorumDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Quorum)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.Version != 0 {
-		n += 1 + sovTypes(uint64(m.Version))
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = len(m.TotalWeight)
+	l = len(m.VetoThreshold)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = m.Timeout.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ExecutionDelay.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.AutoExec {
+		n += 2
+	}
 	return n
 }
 
-func (m *GroupMember) Size() (n int) {
+func (m *GroupInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GroupId))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	l = len(m.TotalWeight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MemberCount != 0 {
+		n += 1 + sovTypes(uint64(m.MemberCount))
+	}
+	return n
+}
+
+func (m *GroupMember) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1531,6 +2257,22 @@ func (m *GroupAccountInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.SpendLimitPeriod.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PeriodStart.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.PeriodSpent) > 0 {
+		for _, e := range m.PeriodSpent {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1584,6 +2326,37 @@ func (m *Proposal) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	l = len(m.ExecutorLog)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.GroupTotalWeight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.FinalTallyResult != nil {
+		l = m.FinalTallyResult.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.EarliestExecutionTime != nil {
+		l = m.EarliestExecutionTime.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalTallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tally.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.TotalWeight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1825,9 +2598,566 @@ func (m *Members) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutionDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoExec", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoExec = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PercentageDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PercentageDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutionDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoExec", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoExec = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuorumDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuorumDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuorumDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1837,84 +3167,29 @@ func (m *Members) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, Member{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ThresholdDecisionPolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ThresholdDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1924,27 +3199,28 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1971,10 +3247,30 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutionDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoExec", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoExec = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2164,6 +3460,25 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.TotalWeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberCount", wireType)
+			}
+			m.MemberCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2461,9 +3776,146 @@ func (m *GroupAccountInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types1.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivationKey = append(m.DerivationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.DerivationKey == nil {
+				m.DerivationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types2.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimitPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimitPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2490,18 +3942,15 @@ func (m *GroupAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DecisionPolicy == nil {
-				m.DecisionPolicy = &types1.Any{}
-			}
-			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PeriodStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivationKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpent", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2511,24 +3960,24 @@ func (m *GroupAccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DerivationKey = append(m.DerivationKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.DerivationKey == nil {
-				m.DerivationKey = []byte{}
+			m.PeriodSpent = append(m.PeriodSpent, types2.Coin{})
+			if err := m.PeriodSpent[len(m.PeriodSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2929,6 +4378,260 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorLog", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutorLog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupTotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupTotalWeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalTallyResult == nil {
+				m.FinalTallyResult = &FinalTallyResult{}
+			}
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EarliestExecutionTime == nil {
+				m.EarliestExecutionTime = &types.Timestamp{}
+			}
+			if err := m.EarliestExecutionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalTallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalTallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalTallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalWeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
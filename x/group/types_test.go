@@ -91,6 +91,36 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 			srcVotingDuration: time.Millisecond,
 			expResult:         DecisionPolicyResult{Allow: false, Final: false},
 		},
+		"accept when fractional yes count crosses decimal threshold": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold: "0.7",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "0.75", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "1.05",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"open when fractional yes count below decimal threshold": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold: "0.7",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "0.699", NoCount: "0.3", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "1.05",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject as final when fractional remaining votes can't cross decimal threshold": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold: "0.7",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "0.5", NoCount: "0.3", AbstainCount: "0.05", VetoCount: "0"},
+			srcTotalPower:     "1.049",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
 		"veto same as no": {
 			srcPolicy: ThresholdDecisionPolicy{
 				Threshold: "1",
@@ -101,6 +131,72 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 			srcVotingDuration: time.Millisecond,
 			expResult:         DecisionPolicyResult{Allow: false, Final: false},
 		},
+		"accept when quorum reached by turnout at boundary": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold: "2",
+				Quorum:    "0.5",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "3", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"accept when quorum reached by abstain and veto votes": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold: "2",
+				Quorum:    "0.5",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "2", VetoCount: "1"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"open when yes count reached but turnout just below quorum": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold: "2",
+				Quorum:    "0.5",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "2.999", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"open when all turnout voted yes but below quorum": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold: "2",
+				Quorum:    "0.5",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "4", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject as final at timeout when turnout below quorum": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold: "2",
+				Quorum:    "0.5",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "4", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"accept with full turnout": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold: "2",
+				Quorum:    "0.5",
+				Timeout:   proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "8", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -189,6 +285,461 @@ func TestThresholdDecisionPolicyValidateBasic(t *testing.T) {
 		},
 			expErr: true,
 		},
+		"quorum at one": {src: ThresholdDecisionPolicy{
+			Threshold: "1",
+			Quorum:    "1",
+			Timeout:   proto.Duration{Seconds: 1},
+		}},
+		"no zero quorum": {src: ThresholdDecisionPolicy{
+			Threshold: "1",
+			Quorum:    "0",
+			Timeout:   proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"no quorum greater than one": {src: ThresholdDecisionPolicy{
+			Threshold: "1",
+			Quorum:    "1.5",
+			Timeout:   proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"with execution delay": {src: ThresholdDecisionPolicy{
+			Threshold:      "1",
+			Timeout:        proto.Duration{Seconds: 1},
+			ExecutionDelay: proto.Duration{Seconds: 3600},
+		}},
+		"no negative execution delay": {src: ThresholdDecisionPolicy{
+			Threshold:      "1",
+			Timeout:        proto.Duration{Seconds: 1},
+			ExecutionDelay: proto.Duration{Seconds: -1},
+		},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			assert.Equal(t, spec.expErr, err != nil, err)
+		})
+	}
+}
+
+func TestPercentageDecisionPolicy(t *testing.T) {
+	specs := map[string]struct {
+		srcPolicy         PercentageDecisionPolicy
+		srcTally          Tally
+		srcTotalPower     string
+		srcVotingDuration time.Duration
+		expResult         DecisionPolicyResult
+		expErr            error
+	}{
+		"accept when yes percentage equal to percentage": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "0.667",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "667", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "1000",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"open when yes percentage just below percentage": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "0.667",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "666.999", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "1000",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"accept when yes percentage just above percentage": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "0.667",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "667.001", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "1000",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"open when two thirds of the weight voted yes": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "0.667",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "3",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject as final when remaining votes can't cross percentage": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "0.667",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "0", NoCount: "333.001", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "1000",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"open when remaining votes can still reach percentage": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "0.667",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "0", NoCount: "333", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "1000",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"accept when all weight voted yes": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "1",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "1",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"reject as final when total power is zero": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "0.667",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "0",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"reject as final after timeout": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "0.667",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "667", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "1000",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"invalid total power": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "0.5",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "-1",
+			srcVotingDuration: time.Millisecond,
+			expErr:            ErrInvalid,
+		},
+		"accept when quorum reached by turnout at boundary": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "0.2",
+				Quorum:     "0.5",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "3", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"open when yes percentage reached but turnout just below quorum": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "0.2",
+				Quorum:     "0.5",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "2", NoCount: "2.999", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject as final at timeout when turnout below quorum": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: "0.2",
+				Quorum:     "0.5",
+				Timeout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: "4", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			res, err := spec.srcPolicy.Allow(spec.srcTally, spec.srcTotalPower, spec.srcVotingDuration)
+			if spec.expErr != nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expResult, res)
+		})
+	}
+}
+
+func TestPercentageDecisionPolicyValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    PercentageDecisionPolicy
+		expErr bool
+	}{
+		"all good": {src: PercentageDecisionPolicy{
+			Percentage: "0.667",
+			Timeout:    proto.Duration{Seconds: 1},
+		}},
+		"hundred percent": {src: PercentageDecisionPolicy{
+			Percentage: "1",
+			Timeout:    proto.Duration{Seconds: 1},
+		}},
+		"percentage missing": {src: PercentageDecisionPolicy{
+			Timeout: proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"no zero percentage": {src: PercentageDecisionPolicy{
+			Percentage: "0",
+			Timeout:    proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"no negative percentage": {src: PercentageDecisionPolicy{
+			Percentage: "-0.5",
+			Timeout:    proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"no percentage greater than one": {src: PercentageDecisionPolicy{
+			Percentage: "1.01",
+			Timeout:    proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"timeout missing": {src: PercentageDecisionPolicy{
+			Percentage: "0.5",
+		},
+			expErr: true,
+		},
+		"with quorum": {src: PercentageDecisionPolicy{
+			Percentage: "0.5",
+			Quorum:     "0.4",
+			Timeout:    proto.Duration{Seconds: 1},
+		}},
+		"no negative quorum": {src: PercentageDecisionPolicy{
+			Percentage: "0.5",
+			Quorum:     "-0.4",
+			Timeout:    proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"with execution delay": {src: PercentageDecisionPolicy{
+			Percentage:     "0.5",
+			Timeout:        proto.Duration{Seconds: 1},
+			ExecutionDelay: proto.Duration{Seconds: 3600},
+		}},
+		"no negative execution delay": {src: PercentageDecisionPolicy{
+			Percentage:     "0.5",
+			Timeout:        proto.Duration{Seconds: 1},
+			ExecutionDelay: proto.Duration{Nanos: -1},
+		},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			assert.Equal(t, spec.expErr, err != nil, err)
+		})
+	}
+}
+
+func TestQuorumDecisionPolicy(t *testing.T) {
+	policy := QuorumDecisionPolicy{
+		Quorum:        "0.5",
+		Threshold:     "0.5",
+		VetoThreshold: "0.334",
+		Timeout:       proto.Duration{Seconds: 1},
+	}
+	specs := map[string]struct {
+		srcPolicy         QuorumDecisionPolicy
+		srcTally          Tally
+		srcTotalPower     string
+		srcVotingDuration time.Duration
+		expResult         DecisionPolicyResult
+		expErr            error
+	}{
+		"accept when passing with any remaining votes": {
+			srcPolicy:         policy,
+			srcTally:          Tally{YesCount: "6", NoCount: "1", AbstainCount: "1", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"open when remaining veto votes could still reject": {
+			srcPolicy:         policy,
+			srcTally:          Tally{YesCount: "6", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"open when quorum not reached yet": {
+			srcPolicy:         policy,
+			srcTally:          Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject as final when veto threshold reached with majority yes": {
+			srcPolicy:         policy,
+			srcTally:          Tally{YesCount: "6", NoCount: "0", AbstainCount: "0", VetoCount: "4"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"reject as final when remaining yes votes can't reach threshold": {
+			srcPolicy:         policy,
+			srcTally:          Tally{YesCount: "0", NoCount: "6", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"reject as final when all abstained": {
+			srcPolicy:         policy,
+			srcTally:          Tally{YesCount: "0", NoCount: "0", AbstainCount: "10", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"accept at timeout when abstains reach quorum": {
+			srcPolicy:         policy,
+			srcTally:          Tally{YesCount: "2", NoCount: "1", AbstainCount: "3", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"reject at timeout when quorum not reached": {
+			srcPolicy:         policy,
+			srcTally:          Tally{YesCount: "3", NoCount: "1", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"reject at timeout when yes share below threshold": {
+			srcPolicy:         policy,
+			srcTally:          Tally{YesCount: "1", NoCount: "2", AbstainCount: "3", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"reject at timeout when veto share reached": {
+			srcPolicy:         policy,
+			srcTally:          Tally{YesCount: "4", NoCount: "0", AbstainCount: "0", VetoCount: "3"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"reject at timeout when all abstained": {
+			srcPolicy:         policy,
+			srcTally:          Tally{YesCount: "0", NoCount: "0", AbstainCount: "10", VetoCount: "0"},
+			srcTotalPower:     "10",
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"reject when zero total power": {
+			srcPolicy:         policy,
+			srcTally:          Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "0",
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"invalid total power": {
+			srcPolicy:         policy,
+			srcTally:          Tally{YesCount: "1", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			srcTotalPower:     "-1",
+			srcVotingDuration: time.Millisecond,
+			expErr:            ErrInvalid,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			res, err := spec.srcPolicy.Allow(spec.srcTally, spec.srcTotalPower, spec.srcVotingDuration)
+			if spec.expErr != nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expResult, res)
+		})
+	}
+}
+
+func TestQuorumDecisionPolicyValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    QuorumDecisionPolicy
+		expErr bool
+	}{
+		"all good": {src: QuorumDecisionPolicy{
+			Quorum:        "0.4",
+			Threshold:     "0.5",
+			VetoThreshold: "0.334",
+			Timeout:       proto.Duration{Seconds: 1},
+		}},
+		"quorum missing": {src: QuorumDecisionPolicy{
+			Threshold:     "0.5",
+			VetoThreshold: "0.334",
+			Timeout:       proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"no quorum greater than one": {src: QuorumDecisionPolicy{
+			Quorum:        "1.1",
+			Threshold:     "0.5",
+			VetoThreshold: "0.334",
+			Timeout:       proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"no zero threshold": {src: QuorumDecisionPolicy{
+			Quorum:        "0.4",
+			Threshold:     "0",
+			VetoThreshold: "0.334",
+			Timeout:       proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"veto threshold missing": {src: QuorumDecisionPolicy{
+			Quorum:    "0.4",
+			Threshold: "0.5",
+			Timeout:   proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"no negative veto threshold": {src: QuorumDecisionPolicy{
+			Quorum:        "0.4",
+			Threshold:     "0.5",
+			VetoThreshold: "-0.334",
+			Timeout:       proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"timeout missing": {src: QuorumDecisionPolicy{
+			Quorum:        "0.4",
+			Threshold:     "0.5",
+			VetoThreshold: "0.334",
+		},
+			expErr: true,
+		},
+		"no negative execution delay": {src: QuorumDecisionPolicy{
+			Quorum:         "0.4",
+			Threshold:      "0.5",
+			VetoThreshold:  "0.334",
+			Timeout:        proto.Duration{Seconds: 1},
+			ExecutionDelay: proto.Duration{Seconds: -1},
+		},
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -536,6 +1087,42 @@ func TestGroupAccountInfo(t *testing.T) {
 	}
 }
 
+func TestGroupAccountInfoSpend(t *testing.T) {
+	start := time.Unix(1000, 0).UTC()
+	info := GroupAccountInfo{
+		SpendLimit:       sdk.NewCoins(sdk.NewInt64Coin("regen", 250), sdk.NewInt64Coin("stake", 10)),
+		SpendLimitPeriod: proto.Duration{Seconds: 3600},
+	}
+
+	// spend accumulates within the period
+	require.NoError(t, info.Spend(sdk.NewCoins(sdk.NewInt64Coin("regen", 100)), start))
+	require.NoError(t, info.Spend(sdk.NewCoins(sdk.NewInt64Coin("regen", 100), sdk.NewInt64Coin("stake", 10)), start.Add(time.Minute)))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("regen", 200), sdk.NewInt64Coin("stake", 10)), info.PeriodSpent)
+	assert.Equal(t, proto.Timestamp{Seconds: 1000}, info.PeriodStart)
+
+	// up to the spend limit
+	err := info.Spend(sdk.NewCoins(sdk.NewInt64Coin("regen", 51)), start.Add(time.Hour-time.Nanosecond))
+	require.ErrorIs(t, err, ErrMaxLimit)
+	err = info.Spend(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), start.Add(time.Minute))
+	require.ErrorIs(t, err, ErrMaxLimit)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("regen", 200), sdk.NewInt64Coin("stake", 10)), info.PeriodSpent)
+	require.NoError(t, info.Spend(sdk.NewCoins(sdk.NewInt64Coin("regen", 50)), start.Add(time.Hour-time.Nanosecond)))
+
+	// and resets at the period boundary
+	require.NoError(t, info.Spend(sdk.NewCoins(sdk.NewInt64Coin("regen", 250)), start.Add(time.Hour)))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("regen", 250)), info.PeriodSpent)
+	assert.Equal(t, proto.Timestamp{Seconds: 4600}, info.PeriodStart)
+
+	// a single spend can't exceed the limit
+	err = info.Spend(sdk.NewCoins(sdk.NewInt64Coin("regen", 251)), start.Add(3*time.Hour))
+	require.ErrorIs(t, err, ErrMaxLimit)
+
+	// no limit applies without spend limit
+	unlimited := GroupAccountInfo{}
+	require.NoError(t, unlimited.Spend(sdk.NewCoins(sdk.NewInt64Coin("regen", 1000000)), start))
+	assert.True(t, unlimited.PeriodSpent.Empty())
+}
+
 func TestTallyValidateBasic(t *testing.T) {
 	specs := map[string]struct {
 		src    Tally
@@ -792,6 +1379,28 @@ func TestTallyAdd(t *testing.T) {
 	}
 }
 
+func TestTallyFractionalRoundTrip(t *testing.T) {
+	tally := Tally{YesCount: "0", NoCount: "0", AbstainCount: "0", VetoCount: "0"}
+	for _, w := range []string{"0.1", "0.2", "0.000000000000000001"} {
+		require.NoError(t, tally.Add(Vote{Choice: Choice_CHOICE_YES}, w))
+	}
+	require.NoError(t, tally.Add(Vote{Choice: Choice_CHOICE_NO}, "0.5"))
+	require.NoError(t, tally.Sub(Vote{Choice: Choice_CHOICE_YES}, "0.1"))
+
+	exp := Tally{YesCount: "0.200000000000000001", NoCount: "0.5", AbstainCount: "0", VetoCount: "0"}
+	require.Equal(t, exp, tally)
+
+	bz, err := tally.Marshal()
+	require.NoError(t, err)
+	var loaded Tally
+	require.NoError(t, loaded.Unmarshal(bz))
+	require.Equal(t, exp, loaded)
+
+	total, err := loaded.TotalCounts()
+	require.NoError(t, err)
+	require.Equal(t, "0.700000000000000001", total.String())
+}
+
 func TestTallySub(t *testing.T) {
 	specs := map[string]struct {
 		src      Tally